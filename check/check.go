@@ -1,48 +1,247 @@
 package check
 
 import (
+	"context"
 	"fmt"
+	"io/fs"
 	"sort"
+	"time"
 
 	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-multierror"
 )
 
 const (
-	ResourceTypeDataSource = "data source"
-	ResourceTypeResource   = "resource"
+	ResourceTypeAction       = "action"
+	ResourceTypeDataSource   = "data source"
+	ResourceTypeListResource = "list resource"
+	ResourceTypeResource     = "resource"
 
 	// Terraform Registry Storage Limits
 	// https://www.terraform.io/docs/registry/providers/docs.html#storage-limits
 	RegistryMaximumNumberOfFiles = 2000
 	RegistryMaximumSizeOfFile    = 500000 // 500KB
+
+	// RegistryWarningNumberOfFiles is the default number of documentation
+	// files which logs a warning about approaching the Terraform Registry
+	// storage limit.
+	RegistryWarningNumberOfFiles = 1800
+
+	// RegistryMaximumNumberOfGuides is the default maximum number of guides
+	// published for a Terraform Provider version.
+	RegistryMaximumNumberOfGuides = 100
 )
 
 type Check struct {
 	Options *CheckOptions
+
+	// Summary contains statistics of the most recent Run or RunFiles.
+	Summary *CheckSummary
+
+	// ctx is the context of the current RunContext or RunFilesContext, whose
+	// cancellation skips the remaining checks.
+	ctx context.Context
+
+	// filesOnly skips the file mismatch checks during RunFiles.
+	filesOnly bool
 }
 
 type CheckOptions struct {
+	ActionFileMismatch *FileMismatchOptions
+
+	// BasePath is the Terraform Provider codebase path, which documentation
+	// file paths are relative to. It is used to read the checks skipped by
+	// the YAML frontmatter of each file with findings.
+	BasePath string
+
+	// FS, if set, is the file system the YAML frontmatter of each file with
+	// findings is read from, with BasePath relative to its root. It should
+	// match the FS of the file options.
+	FS fs.FS
+
+	// Checks contains the checks selected by identifier. All checks are
+	// selected if nil.
+	Checks *CheckSelection
+
+	// CrossReferences contains the options for verifying links between
+	// documentation of the provider.
+	CrossReferences *CrossReferencesOptions
+
 	DataSourceFileMismatch *FileMismatchOptions
 
+	Deprecated *DeprecatedOptions
+
+	// DeprecatedProviderArguments contains the options for verifying the
+	// provider index documentation of deprecated provider arguments.
+	DeprecatedProviderArguments *DeprecatedProviderArgumentsOptions
+
+	// Directories contains per-directory options, keyed by the directory path
+	// relative to the provider codebase (e.g. docs/guides/images).
+	Directories map[string]*DirectoryOptions
+
+	// ExampleIdentifiers contains the options for verifying documentation
+	// code blocks do not contain real-looking cloud identifiers.
+	ExampleIdentifiers *ExampleIdentifiersOptions
+
+	// ExamplePunctuation contains the options for verifying documentation
+	// code blocks do not contain non-ASCII quotes and dashes.
+	ExamplePunctuation *ExamplePunctuationOptions
+
+	// ExampleSecrets contains the options for verifying documentation code
+	// blocks do not contain secrets.
+	ExampleSecrets *ExampleSecretsOptions
+
+	Examples *ExamplesOptions
+
+	// ForbiddenWords contains the words and phrases which should not be used
+	// in documentation prose.
+	ForbiddenWords *ForbiddenWordsOptions
+
+	// FormatVerbs contains the options for verifying documentation prose and
+	// YAML frontmatter do not contain format verbs.
+	FormatVerbs *FormatVerbsOptions
+
+	FunctionSignature *FunctionSignatureOptions
+
+	// GuideFilenames contains the options for verifying guide file names.
+	GuideFilenames *GuideFilenamesOptions
+
+	// GuideLinks contains the options for verifying links to guides from the
+	// provider index and guide documentation.
+	GuideLinks *GuideLinksOptions
+
+	// HeadingNames contains the canonical heading names and their synonyms.
+	HeadingNames *HeadingNamesOptions
+
+	// IndexSections contains the sections required in the provider index
+	// documentation.
+	IndexSections *IndexSectionsOptions
+
 	LegacyDataSourceFile *LegacyDataSourceFileOptions
 	LegacyGuideFile      *LegacyGuideFileOptions
 	LegacyIndexFile      *LegacyIndexFileOptions
-	LegacyResourceFile   *LegacyResourceFileOptions
+
+	// LegacyNavigation contains the options for verifying the legacy website
+	// navigation files, such as website/aws.erb.
+	LegacyNavigation *LegacyNavigationOptions
+
+	LegacyResourceFile *LegacyResourceFileOptions
+
+	// LineLength contains the options for verifying the maximum length of
+	// prose lines in documentation.
+	LineLength *LineLengthOptions
+
+	ListResourceFileMismatch *FileMismatchOptions
+
+	// MaximumNumberOfFiles overrides the maximum number of documentation
+	// files, which defaults to RegistryMaximumNumberOfFiles.
+	MaximumNumberOfFiles int
+
+	// MaximumNumberOfGuides overrides the maximum number of guides, which
+	// defaults to RegistryMaximumNumberOfGuides.
+	MaximumNumberOfGuides int
+
+	// NestedSchemaLinks contains the options for verifying the nested schema
+	// links and sections of terraform-plugin-docs generated documentation.
+	NestedSchemaLinks *NestedSchemaLinksOptions
+
+	// NewDocumentation contains the options for verifying data sources and
+	// resources added since the previous release are documented.
+	NewDocumentation *NewDocumentationOptions
+
+	// PairedDocumentation contains the options for verifying resource and
+	// data source documentation of the same name are consistent.
+	PairedDocumentation *PairedDocumentationOptions
 
 	ProviderName   string
 	ProviderSource string
 
+	// RawHTML contains the options for verifying raw HTML in documentation
+	// is rendered by the Terraform Registry.
+	RawHTML *RawHTMLOptions
+
+	// RegistryManifest contains the options for verifying the Terraform
+	// Registry manifest of the Terraform Provider codebase.
+	RegistryManifest *RegistryManifestOptions
+
+	// RequireDirectoryStructure, if set, requires all documentation to use
+	// the given directory structure (DirectoryStructureLegacy or
+	// DirectoryStructureRegistry).
+	RequireDirectoryStructure string
+
+	// Removed contains the names of removed data sources and resources,
+	// whose documentation is kept to inform practitioners.
+	Removed *RemovedOptions
+
+	// Renamed contains the previous and new names of renamed data sources and
+	// resources, whose documentation is kept for deprecation.
+	Renamed *RenamedOptions
+
+	RegistryActionFile     *RegistryActionFileOptions
 	RegistryDataSourceFile *RegistryDataSourceFileOptions
 	RegistryGuideFile      *RegistryGuideFileOptions
 	RegistryIndexFile      *RegistryIndexFileOptions
-	RegistryResourceFile   *RegistryResourceFileOptions
+
+	// RegistryListResourceFile contains the options for list resource
+	// documentation, which is checked like resource documentation.
+	RegistryListResourceFile *RegistryResourceFileOptions
+
+	RegistryResourceFile *RegistryResourceFileOptions
 
 	ResourceFileMismatch *FileMismatchOptions
 
+	// Rules contains the custom content rules of the configuration file.
+	Rules *RulesOptions
+
+	// SourceLinks contains the options for verifying links to the Terraform
+	// Provider GitHub repository source tree.
+	SourceLinks *SourceLinksOptions
+
+	Subcategories *SubcategoriesOptions
+
+	TemplateFile *TemplateFileOptions
+
+	// Logger, if set, receives the diagnostic logging of the checks which are
+	// not file based, such as the number of documentation files. File based
+	// checks use the Logger of their FileOptions. Otherwise, the default hclog
+	// logger is used.
+	Logger hclog.Logger
+
+	// Timings, if set, records the time of each check and documentation
+	// directory.
+	Timings *Timings
+
+	// WarningNumberOfFiles overrides the number of documentation files which
+	// logs a warning about approaching the maximum, which defaults to
+	// RegistryWarningNumberOfFiles.
+	WarningNumberOfFiles int
+
 	IgnoreCdktfMissingFiles bool
 }
 
+// CheckSummary contains statistics of a check run.
+type CheckSummary struct {
+	// Files contains the number of checked documentation files, keyed by
+	// directory classification (e.g. registry resource).
+	Files map[string]int
+
+	// IgnoredFindings is the number of findings ignored by configuration,
+	// such as -ignore-file-missing-resources, or skipped by the YAML
+	// frontmatter of their documentation file.
+	IgnoredFindings int
+}
+
+// Log returns the logger of the options, otherwise the default hclog
+// logger.
+func (opts *CheckOptions) Log() hclog.Logger {
+	if opts == nil || opts.Logger == nil {
+		return hclog.L()
+	}
+
+	return opts.Logger
+}
+
 func NewCheck(opts *CheckOptions) *Check {
 	check := &Check{
 		Options: opts,
@@ -55,76 +254,582 @@ func NewCheck(opts *CheckOptions) *Check {
 	return check
 }
 
+// Run runs the enabled checks of the documentation directories. It is
+// equivalent to RunContext with context.Background().
 func (check *Check) Run(directories map[string][]string) error {
-	if err := InvalidDirectoriesCheck(directories); err != nil {
-		return err
+	return check.RunContext(context.Background(), directories)
+}
+
+// RunContext runs the enabled checks of the documentation directories. The
+// context is verified before each check, documentation directory, and file,
+// so once it is canceled or its deadline is exceeded, the remaining checks
+// are skipped and the context error is returned with the findings of the
+// completed checks.
+func (check *Check) RunContext(ctx context.Context, directories map[string][]string) error {
+	check.ctx = ctx
+	defer func() { check.ctx = nil }()
+
+	return check.contextError(check.run(directories))
+}
+
+func (check *Check) run(directories map[string][]string) error {
+	check.Summary = &CheckSummary{
+		Files: make(map[string]int),
+	}
+
+	directories = removeOtherFiles(check.Options.Log(), directories, check.Options.Directories)
+
+	if check.checkEnabled(CheckIDDirectoryInvalid) {
+		if err := check.Options.Timings.Check(CheckIDDirectoryInvalid, func() error { return InvalidDirectoriesCheck(directories) }); err != nil {
+			return NewFinding(CheckIDDirectoryInvalid, "", err)
+		}
 	}
 
-	if err := MixedDirectoriesCheck(directories); err != nil {
+	// Overlapping documentation is reported with mixed directories, so the
+	// documentation present in both directory structures is listed.
+	var directoryResult *multierror.Error
+
+	if check.checkEnabled(CheckIDDirectoryMixed) {
+		if err := check.Options.Timings.Check(CheckIDDirectoryMixed, func() error { return MixedDirectoriesCheck(directories) }); err != nil {
+			directoryResult = multierror.Append(directoryResult, NewFinding(CheckIDDirectoryMixed, "", err))
+		}
+	}
+
+	if check.checkEnabled(CheckIDDirectoryOverlapping) {
+		if err := check.Options.Timings.Check(CheckIDDirectoryOverlapping, func() error { return OverlappingDirectoriesCheck(directories) }); err != nil {
+			directoryResult = multierror.Append(directoryResult, newFindings(CheckIDDirectoryOverlapping, "", err))
+		}
+	}
+
+	if err := directoryResult.ErrorOrNil(); err != nil {
 		return err
 	}
 
-	if err := NumberOfFilesCheck(directories); err != nil {
+	if check.checkEnabled(CheckIDDirectoryStructure) {
+		if err := check.Options.Timings.Check(CheckIDDirectoryStructure, func() error {
+			return RequiredDirectoryStructureCheck(directories, check.Options.RequireDirectoryStructure)
+		}); err != nil {
+			return NewFinding(CheckIDDirectoryStructure, "", err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDNumberOfFiles) {
+		if err := check.Options.Timings.Check(CheckIDNumberOfFiles, func() error {
+			return numberOfFilesCheck(check.Options.Log(), directories, check.Options.MaximumNumberOfFiles, check.Options.WarningNumberOfFiles)
+		}); err != nil {
+			return NewFinding(CheckIDNumberOfFiles, "", err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDNumberOfGuides) {
+		if err := check.Options.Timings.Check(CheckIDNumberOfGuides, func() error {
+			return numberOfGuidesCheck(check.Options.Log(), directories, check.Options.MaximumNumberOfGuides)
+		}); err != nil {
+			return NewFinding(CheckIDNumberOfGuides, "", err)
+		}
+	}
+
+	for directory, files := range directories {
+		check.Summary.Files[DirectoryClassification(directory)] += len(files)
+	}
+
+	result := check.runFiles(directories)
+
+	if check.checkEnabled(CheckIDCrossReferences) {
+		if err := check.Options.Timings.Check(CheckIDCrossReferences, func() error { return NewCrossReferencesCheck(check.Options.CrossReferences).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDDeprecated) {
+		if err := check.Options.Timings.Check(CheckIDDeprecated, func() error { return NewDeprecatedCheck(check.Options.Deprecated).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDDeprecatedProviderArguments) {
+		if err := check.Options.Timings.Check(CheckIDDeprecatedProviderArguments, func() error {
+			return NewDeprecatedProviderArgumentsCheck(check.Options.DeprecatedProviderArguments).Run(directories)
+		}); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDExampleIdentifiers) {
+		if err := check.Options.Timings.Check(CheckIDExampleIdentifiers, func() error { return NewExampleIdentifiersCheck(check.Options.ExampleIdentifiers).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDExamplePunctuation) {
+		if err := check.Options.Timings.Check(CheckIDExamplePunctuation, func() error { return NewExamplePunctuationCheck(check.Options.ExamplePunctuation).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDExampleSecrets) {
+		if err := check.Options.Timings.Check(CheckIDExampleSecrets, func() error { return NewExampleSecretsCheck(check.Options.ExampleSecrets).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDExamples) {
+		if err := check.Options.Timings.Check(CheckIDExamples, func() error { return NewExamplesCheck(check.Options.Examples).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDForbiddenWords) {
+		if err := check.Options.Timings.Check(CheckIDForbiddenWords, func() error { return NewForbiddenWordsCheck(check.Options.ForbiddenWords).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDFormatVerbs) {
+		if err := check.Options.Timings.Check(CheckIDFormatVerbs, func() error { return NewFormatVerbsCheck(check.Options.FormatVerbs).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDFunctionSignature) {
+		if err := check.Options.Timings.Check(CheckIDFunctionSignature, func() error { return NewFunctionSignatureCheck(check.Options.FunctionSignature).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDGuideFilenames) {
+		if err := check.Options.Timings.Check(CheckIDGuideFilenames, func() error { return NewGuideFilenamesCheck(check.Options.GuideFilenames).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDGuideLinks) {
+		if err := check.Options.Timings.Check(CheckIDGuideLinks, func() error { return NewGuideLinksCheck(check.Options.GuideLinks).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDHeadingNames) {
+		if err := check.Options.Timings.Check(CheckIDHeadingNames, func() error { return NewHeadingNamesCheck(check.Options.HeadingNames).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDIndexSections) {
+		if err := check.Options.Timings.Check(CheckIDIndexSections, func() error { return NewIndexSectionsCheck(check.Options.IndexSections).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDLegacyNavigation) {
+		legacyNavigationCheck := NewLegacyNavigationCheck(check.Options.LegacyNavigation)
+
+		if err := check.Options.Timings.Check(CheckIDLegacyNavigation, func() error { return legacyNavigationCheck.Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		check.Summary.IgnoredFindings += legacyNavigationCheck.IgnoredFindings
+	}
+
+	if check.checkEnabled(CheckIDLineLength) {
+		if err := check.Options.Timings.Check(CheckIDLineLength, func() error { return NewLineLengthCheck(check.Options.LineLength).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDNestedSchemaLinks) {
+		if err := check.Options.Timings.Check(CheckIDNestedSchemaLinks, func() error { return NewNestedSchemaLinksCheck(check.Options.NestedSchemaLinks).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDNewDocumentation) {
+		if err := check.Options.Timings.Check(CheckIDNewDocumentation, func() error { return NewNewDocumentationCheck(check.Options.NewDocumentation).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDNumberOfSubcategories) {
+		if err := check.Options.Timings.Check(CheckIDNumberOfSubcategories, func() error { return NewSubcategoriesCheck(check.Options.Subcategories).RunCount(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDPairedDocumentation) {
+		if err := check.Options.Timings.Check(CheckIDPairedDocumentation, func() error { return NewPairedDocumentationCheck(check.Options.PairedDocumentation).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDRawHTML) {
+		if err := check.Options.Timings.Check(CheckIDRawHTML, func() error { return NewRawHTMLCheck(check.Options.RawHTML).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDRegistryManifest) {
+		if err := check.Options.Timings.Check(CheckIDRegistryManifest, func() error { return NewRegistryManifestCheck(check.Options.RegistryManifest).Run() }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDRemoved) {
+		if err := check.Options.Timings.Check(CheckIDRemoved, func() error { return NewRemovedCheck(check.Options.Removed).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDRenamed) {
+		if err := check.Options.Timings.Check(CheckIDRenamed, func() error { return NewRenamedCheck(check.Options.Renamed).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDRules) {
+		if err := check.Options.Timings.Check(CheckIDRules, func() error { return NewRulesCheck(check.Options.Rules).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDSourceLinks) {
+		if err := check.Options.Timings.Check(CheckIDSourceLinks, func() error { return NewSourceLinksCheck(check.Options.SourceLinks).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDSubcategoryConsistency) {
+		if err := check.Options.Timings.Check(CheckIDSubcategoryConsistency, func() error { return NewSubcategoriesCheck(check.Options.Subcategories).RunConsistency(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDSubcategoryMapping) {
+		if err := check.Options.Timings.Check(CheckIDSubcategoryMapping, func() error { return NewSubcategoriesCheck(check.Options.Subcategories).RunMapping(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDSubcategorySize) {
+		if err := check.Options.Timings.Check(CheckIDSubcategorySize, func() error { return NewSubcategoriesCheck(check.Options.Subcategories).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDTemplate) {
+		templateFileCheck := NewTemplateFileCheck(check.Options.TemplateFile)
+		files, err := templateFileCheck.Options.templateFiles()
+
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if len(files) > 0 {
+			check.Summary.Files[TemplateClassification] += len(files)
+
+			if err := check.Options.Timings.Check(CheckIDTemplate, func() error { return templateFileCheck.RunAll(files) }); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	if check.checkEnabled(CheckIDTranslations) {
+		_ = check.Options.Timings.Check(CheckIDTranslations, func() error {
+			translationsCheck(check.Options.Log(), directories)
+
+			return nil
+		})
+	}
+
+	result = check.removeSkippedFindings(result)
+
+	if result != nil {
+		sort.Sort(result)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFiles runs only the checks of individual documentation files, such as
+// the frontmatter and contents checks, e.g. for files changed since a previous
+// Run. Checks across files, such as the directory and file mismatch checks,
+// are skipped as the directories may only contain some files.
+func (check *Check) RunFiles(directories map[string][]string) error {
+	return check.RunFilesContext(context.Background(), directories)
+}
+
+// RunFilesContext runs only the checks of individual documentation files, as
+// RunFiles, with the context verified before each check as RunContext.
+func (check *Check) RunFilesContext(ctx context.Context, directories map[string][]string) error {
+	check.ctx = ctx
+	defer func() { check.ctx = nil }()
+
+	return check.contextError(check.runFilesOnly(directories))
+}
+
+func (check *Check) runFilesOnly(directories map[string][]string) error {
+	check.Summary = &CheckSummary{
+		Files: make(map[string]int),
+	}
+
+	directories = removeOtherFiles(check.Options.Log(), directories, check.Options.Directories)
+
+	for directory, files := range directories {
+		check.Summary.Files[DirectoryClassification(directory)] += len(files)
+	}
+
+	check.filesOnly = true
+	result := check.runFiles(directories)
+	check.filesOnly = false
+
+	if check.checkEnabled(CheckIDDeprecated) {
+		if err := check.Options.Timings.Check(CheckIDDeprecated, func() error { return NewDeprecatedCheck(check.Options.Deprecated).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDDeprecatedProviderArguments) {
+		if err := check.Options.Timings.Check(CheckIDDeprecatedProviderArguments, func() error {
+			return NewDeprecatedProviderArgumentsCheck(check.Options.DeprecatedProviderArguments).Run(directories)
+		}); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDExampleIdentifiers) {
+		if err := check.Options.Timings.Check(CheckIDExampleIdentifiers, func() error { return NewExampleIdentifiersCheck(check.Options.ExampleIdentifiers).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDExamplePunctuation) {
+		if err := check.Options.Timings.Check(CheckIDExamplePunctuation, func() error { return NewExamplePunctuationCheck(check.Options.ExamplePunctuation).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDExampleSecrets) {
+		if err := check.Options.Timings.Check(CheckIDExampleSecrets, func() error { return NewExampleSecretsCheck(check.Options.ExampleSecrets).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDForbiddenWords) {
+		if err := check.Options.Timings.Check(CheckIDForbiddenWords, func() error { return NewForbiddenWordsCheck(check.Options.ForbiddenWords).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDFormatVerbs) {
+		if err := check.Options.Timings.Check(CheckIDFormatVerbs, func() error { return NewFormatVerbsCheck(check.Options.FormatVerbs).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDFunctionSignature) {
+		if err := check.Options.Timings.Check(CheckIDFunctionSignature, func() error { return NewFunctionSignatureCheck(check.Options.FunctionSignature).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDHeadingNames) {
+		if err := check.Options.Timings.Check(CheckIDHeadingNames, func() error { return NewHeadingNamesCheck(check.Options.HeadingNames).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDIndexSections) {
+		if err := check.Options.Timings.Check(CheckIDIndexSections, func() error { return NewIndexSectionsCheck(check.Options.IndexSections).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDLineLength) {
+		if err := check.Options.Timings.Check(CheckIDLineLength, func() error { return NewLineLengthCheck(check.Options.LineLength).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDNestedSchemaLinks) {
+		if err := check.Options.Timings.Check(CheckIDNestedSchemaLinks, func() error { return NewNestedSchemaLinksCheck(check.Options.NestedSchemaLinks).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDRawHTML) {
+		if err := check.Options.Timings.Check(CheckIDRawHTML, func() error { return NewRawHTMLCheck(check.Options.RawHTML).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDRules) {
+		if err := check.Options.Timings.Check(CheckIDRules, func() error { return NewRulesCheck(check.Options.Rules).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if check.checkEnabled(CheckIDSourceLinks) {
+		if err := check.Options.Timings.Check(CheckIDSourceLinks, func() error { return NewSourceLinksCheck(check.Options.SourceLinks).Run(directories) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	result = check.removeSkippedFindings(result)
+
+	if result != nil {
+		sort.Sort(result)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// checkEnabled returns true if the check is enabled and the context of the
+// current run, if any, is not canceled.
+func (check *Check) checkEnabled(id string) bool {
+	if check.canceled() {
+		return false
+	}
+
+	return check.Options.CheckEnabled(id)
+}
+
+// canceled returns true if the context of the current run, if any, is
+// canceled or its deadline is exceeded.
+func (check *Check) canceled() bool {
+	return check.ctx != nil && check.ctx.Err() != nil
+}
+
+// contextError returns the error with the error of the canceled context of
+// the current run, if any, since the remaining checks were skipped.
+func (check *Check) contextError(err error) error {
+	if check.ctx == nil || check.ctx.Err() == nil {
 		return err
 	}
 
+	return multierror.Append(err, fmt.Errorf("error checking documentation, remaining checks skipped: %w", check.ctx.Err()))
+}
+
+// runFiles runs the file mismatch checks of each documentation directory,
+// unless only individual files are checked, and the checks of each file.
+func (check *Check) runFiles(directories map[string][]string) *multierror.Error {
 	var result *multierror.Error
 
+	if files, ok := directories[fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryActionsDirectory)]; ok {
+		start := time.Now()
+
+		if err := check.fileMismatchCheck(check.Options.ActionFileMismatch, files); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if err := check.runAll(files, NewRegistryActionFileCheck(check.Options.RegistryActionFile).Run); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryActionsDirectory), start)
+	}
+
 	if files, ok := directories[fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryDataSourcesDirectory)]; ok {
-		if err := NewFileMismatchCheck(check.Options.DataSourceFileMismatch).Run(files); err != nil {
+		start := time.Now()
+
+		if err := check.fileMismatchCheck(check.Options.DataSourceFileMismatch, files); err != nil {
 			result = multierror.Append(result, err)
 		}
 
-		if err := NewRegistryDataSourceFileCheck(check.Options.RegistryDataSourceFile).RunAll(files); err != nil {
+		if err := check.runAll(files, NewRegistryDataSourceFileCheck(check.Options.RegistryDataSourceFile).Run); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryDataSourcesDirectory), start)
 	}
 
 	if files, ok := directories[fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryGuidesDirectory)]; ok {
-		if err := NewRegistryGuideFileCheck(check.Options.RegistryGuideFile).RunAll(files); err != nil {
+		start := time.Now()
+
+		if err := check.runAll(files, NewRegistryGuideFileCheck(check.Options.RegistryGuideFile).Run); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryGuidesDirectory), start)
 	}
 
 	if files, ok := directories[RegistryIndexDirectory]; ok {
-		if err := NewRegistryIndexFileCheck(check.Options.RegistryIndexFile).RunAll(files); err != nil {
+		start := time.Now()
+
+		if err := check.runAll(files, NewRegistryIndexFileCheck(check.Options.RegistryIndexFile).Run); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(RegistryIndexDirectory, start)
+	}
+
+	if files, ok := directories[fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryListResourcesDirectory)]; ok {
+		start := time.Now()
+
+		if err := check.fileMismatchCheck(check.Options.ListResourceFileMismatch, files); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		resourceFileCheck := NewRegistryResourceFileCheck(check.Options.RegistryListResourceFile)
+
+		if err := check.runAll(files, func(file string) error { return resourceFileCheck.Run(file, markdown.FencedCodeBlockLanguageTerraform) }); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryListResourcesDirectory), start)
 	}
 
 	if files, ok := directories[fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryResourcesDirectory)]; ok {
-		if err := NewFileMismatchCheck(check.Options.ResourceFileMismatch).Run(files); err != nil {
+		start := time.Now()
+
+		if err := check.fileMismatchCheck(check.Options.ResourceFileMismatch, files); err != nil {
 			result = multierror.Append(result, err)
 		}
 
-		if err := NewRegistryResourceFileCheck(check.Options.RegistryResourceFile).RunAll(files, markdown.FencedCodeBlockLanguageTerraform); err != nil {
+		resourceFileCheck := NewRegistryResourceFileCheck(check.Options.RegistryResourceFile)
+
+		if err := check.runAll(files, func(file string) error { return resourceFileCheck.Run(file, markdown.FencedCodeBlockLanguageTerraform) }); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", RegistryIndexDirectory, RegistryResourcesDirectory), start)
 	}
 
 	for _, cdktfLanguage := range ValidCdktfLanguages {
+		if check.canceled() {
+			break
+		}
+
 		if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryDataSourcesDirectory)]; ok {
+			start := time.Now()
+
 			if !check.Options.IgnoreCdktfMissingFiles {
-				if err := NewFileMismatchCheck(check.Options.DataSourceFileMismatch).Run(files); err != nil {
+				if err := check.fileMismatchCheck(check.Options.DataSourceFileMismatch, files); err != nil {
 					result = multierror.Append(result, err)
 				}
 			}
 
-			if err := NewRegistryDataSourceFileCheck(check.Options.RegistryDataSourceFile).RunAll(files); err != nil {
+			if err := check.runAll(files, NewRegistryDataSourceFileCheck(check.Options.RegistryDataSourceFile).Run); err != nil {
 				result = multierror.Append(result, err)
 			}
+
+			check.Options.Timings.Directory(fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryDataSourcesDirectory), start)
 		}
 
 		if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryResourcesDirectory)]; ok {
+			start := time.Now()
+
 			if !check.Options.IgnoreCdktfMissingFiles {
-				if err := NewFileMismatchCheck(check.Options.ResourceFileMismatch).Run(files); err != nil {
+				if err := check.fileMismatchCheck(check.Options.ResourceFileMismatch, files); err != nil {
 					result = multierror.Append(result, err)
 				}
 			}
 
-			if err := NewRegistryResourceFileCheck(check.Options.RegistryResourceFile).RunAll(files, cdktfLanguage); err != nil {
+			resourceFileCheck := NewRegistryResourceFileCheck(check.Options.RegistryResourceFile)
+
+			if err := check.runAll(files, func(file string) error { return resourceFileCheck.Run(file, cdktfLanguage) }); err != nil {
 				result = multierror.Append(result, err)
 			}
+
+			check.Options.Timings.Directory(fmt.Sprintf("%s/%s/%s/%s", RegistryIndexDirectory, CdktfIndexDirectory, cdktfLanguage, RegistryResourcesDirectory), start)
 		}
 	}
 
@@ -132,66 +837,208 @@ func (check *Check) Run(directories map[string][]string) error {
 	legacyResourcesFiles, legacyResourcesOk := directories[fmt.Sprintf("%s/%s", LegacyIndexDirectory, LegacyResourcesDirectory)]
 
 	if legacyDataSourcesOk {
-		if err := NewFileMismatchCheck(check.Options.DataSourceFileMismatch).Run(legacyDataSourcesFiles); err != nil {
+		start := time.Now()
+
+		if err := check.fileMismatchCheck(check.Options.DataSourceFileMismatch, legacyDataSourcesFiles); err != nil {
 			result = multierror.Append(result, err)
 		}
 
-		if err := NewLegacyDataSourceFileCheck(check.Options.LegacyDataSourceFile).RunAll(legacyDataSourcesFiles); err != nil {
+		if err := check.runAll(legacyDataSourcesFiles, NewLegacyDataSourceFileCheck(check.Options.LegacyDataSourceFile).Run); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", LegacyIndexDirectory, LegacyDataSourcesDirectory), start)
 	}
 
 	if files, ok := directories[fmt.Sprintf("%s/%s", LegacyIndexDirectory, LegacyGuidesDirectory)]; ok {
-		if err := NewLegacyGuideFileCheck(check.Options.LegacyGuideFile).RunAll(files); err != nil {
+		start := time.Now()
+
+		if err := check.runAll(files, NewLegacyGuideFileCheck(check.Options.LegacyGuideFile).Run); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", LegacyIndexDirectory, LegacyGuidesDirectory), start)
 	}
 
 	if files, ok := directories[LegacyIndexDirectory]; ok {
-		if err := NewLegacyIndexFileCheck(check.Options.LegacyIndexFile).RunAll(files); err != nil {
+		start := time.Now()
+
+		if err := check.runAll(files, NewLegacyIndexFileCheck(check.Options.LegacyIndexFile).Run); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(LegacyIndexDirectory, start)
 	}
 
 	if legacyResourcesOk {
-		if err := NewFileMismatchCheck(check.Options.ResourceFileMismatch).Run(legacyResourcesFiles); err != nil {
+		start := time.Now()
+
+		if err := check.fileMismatchCheck(check.Options.ResourceFileMismatch, legacyResourcesFiles); err != nil {
 			result = multierror.Append(result, err)
 		}
 
-		if err := NewLegacyResourceFileCheck(check.Options.LegacyResourceFile).RunAll(legacyResourcesFiles, markdown.FencedCodeBlockLanguageTerraform); err != nil {
+		resourceFileCheck := NewLegacyResourceFileCheck(check.Options.LegacyResourceFile)
+
+		if err := check.runAll(legacyResourcesFiles, func(file string) error { return resourceFileCheck.Run(file, markdown.FencedCodeBlockLanguageTerraform) }); err != nil {
 			result = multierror.Append(result, err)
 		}
+
+		check.Options.Timings.Directory(fmt.Sprintf("%s/%s", LegacyIndexDirectory, LegacyResourcesDirectory), start)
 	}
 
 	for _, cdktfLanguage := range ValidCdktfLanguages {
+		if check.canceled() {
+			break
+		}
+
 		if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", LegacyIndexDirectory, CdktfIndexDirectory, cdktfLanguage, LegacyDataSourcesDirectory)]; ok {
+			start := time.Now()
+
 			if !check.Options.IgnoreCdktfMissingFiles {
-				if err := NewFileMismatchCheck(check.Options.DataSourceFileMismatch).Run(files); err != nil {
+				if err := check.fileMismatchCheck(check.Options.DataSourceFileMismatch, files); err != nil {
 					result = multierror.Append(result, err)
 				}
 			}
 
-			if err := NewLegacyDataSourceFileCheck(check.Options.LegacyDataSourceFile).RunAll(files); err != nil {
+			if err := check.runAll(files, NewLegacyDataSourceFileCheck(check.Options.LegacyDataSourceFile).Run); err != nil {
 				result = multierror.Append(result, err)
 			}
+
+			check.Options.Timings.Directory(fmt.Sprintf("%s/%s/%s/%s", LegacyIndexDirectory, CdktfIndexDirectory, cdktfLanguage, LegacyDataSourcesDirectory), start)
 		}
 
 		if files, ok := directories[fmt.Sprintf("%s/%s/%s/%s", LegacyIndexDirectory, CdktfIndexDirectory, cdktfLanguage, LegacyResourcesDirectory)]; ok {
+			start := time.Now()
+
 			if !check.Options.IgnoreCdktfMissingFiles {
-				if err := NewFileMismatchCheck(check.Options.ResourceFileMismatch).Run(files); err != nil {
+				if err := check.fileMismatchCheck(check.Options.ResourceFileMismatch, files); err != nil {
 					result = multierror.Append(result, err)
 				}
 			}
 
-			if err := NewLegacyResourceFileCheck(check.Options.LegacyResourceFile).RunAll(files, cdktfLanguage); err != nil {
+			resourceFileCheck := NewLegacyResourceFileCheck(check.Options.LegacyResourceFile)
+
+			if err := check.runAll(files, func(file string) error { return resourceFileCheck.Run(file, cdktfLanguage) }); err != nil {
 				result = multierror.Append(result, err)
 			}
+
+			check.Options.Timings.Directory(fmt.Sprintf("%s/%s/%s/%s", LegacyIndexDirectory, CdktfIndexDirectory, cdktfLanguage, LegacyResourcesDirectory), start)
 		}
 	}
 
-	if result != nil {
-		sort.Sort(result)
+	var localeDirectories []string
+
+	for directory := range directories {
+		if DirectoryLocale(directory) != "" {
+			localeDirectories = append(localeDirectories, directory)
+		}
+	}
+
+	sort.Strings(localeDirectories)
+
+	// Localized documentation is not compared with the providers schema, as
+	// missing translations are reported by the translations check.
+	for _, directory := range localeDirectories {
+		if check.canceled() {
+			break
+		}
+
+		opts := check.localeFileOptions(directory)
+
+		if opts == nil {
+			continue
+		}
+
+		start := time.Now()
+
+		if err := check.runAll(directories[directory], NewLocaleFileCheck(opts).Run); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		check.Options.Timings.Directory(directory, start)
+	}
+
+	return result
+}
+
+// runAll runs the file check of each file, stopping once the context of the
+// current run, if any, is canceled.
+func (check *Check) runAll(files []string, run func(file string) error) error {
+	var result *multierror.Error
+
+	for _, file := range files {
+		if check.canceled() {
+			break
+		}
+
+		if err := run(file); err != nil {
+			result = multierror.Append(result, err)
+		}
 	}
 
 	return result.ErrorOrNil()
 }
+
+// localeFileOptions returns the options of the localized documentation
+// directory, using the frontmatter options of the same documentation type in
+// the default locale, or nil if the documentation type is not checked.
+func (check *Check) localeFileOptions(directory string) *LocaleFileOptions {
+	switch structure, documentationType := DirectoryStructure(directory), DirectoryDocumentationType(directory); {
+	case structure == DirectoryStructureLegacy && documentationType == DocumentationTypeDataSource:
+		opts := NewLegacyDataSourceFileCheck(check.Options.LegacyDataSourceFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidLegacyFileExtensions, FrontMatter: opts.FrontMatter, ProviderName: opts.ProviderName}
+	case structure == DirectoryStructureLegacy && documentationType == DocumentationTypeGuide:
+		opts := NewLegacyGuideFileCheck(check.Options.LegacyGuideFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidLegacyFileExtensions, FrontMatter: opts.FrontMatter}
+	case structure == DirectoryStructureLegacy && documentationType == DocumentationTypeIndex:
+		opts := NewLegacyIndexFileCheck(check.Options.LegacyIndexFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidLegacyFileExtensions, FrontMatter: opts.FrontMatter}
+	case structure == DirectoryStructureLegacy && documentationType == DocumentationTypeResource:
+		opts := NewLegacyResourceFileCheck(check.Options.LegacyResourceFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidLegacyFileExtensions, FrontMatter: opts.FrontMatter, ProviderName: opts.ProviderName}
+	case structure == DirectoryStructureRegistry && documentationType == DocumentationTypeAction:
+		opts := NewRegistryActionFileCheck(check.Options.RegistryActionFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidRegistryFileExtensions, FrontMatter: opts.FrontMatter, ProviderName: opts.ProviderName}
+	case structure == DirectoryStructureRegistry && documentationType == DocumentationTypeDataSource:
+		opts := NewRegistryDataSourceFileCheck(check.Options.RegistryDataSourceFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidRegistryFileExtensions, FrontMatter: opts.FrontMatter, ProviderName: opts.ProviderName}
+	case structure == DirectoryStructureRegistry && documentationType == DocumentationTypeGuide:
+		opts := NewRegistryGuideFileCheck(check.Options.RegistryGuideFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidRegistryFileExtensions, FrontMatter: opts.FrontMatter}
+	case structure == DirectoryStructureRegistry && documentationType == DocumentationTypeIndex:
+		opts := NewRegistryIndexFileCheck(check.Options.RegistryIndexFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidRegistryFileExtensions, FrontMatter: opts.FrontMatter}
+	case structure == DirectoryStructureRegistry && documentationType == DocumentationTypeListResource:
+		opts := NewRegistryResourceFileCheck(check.Options.RegistryListResourceFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidRegistryFileExtensions, FrontMatter: opts.FrontMatter, ProviderName: opts.ProviderName}
+	case structure == DirectoryStructureRegistry && documentationType == DocumentationTypeResource:
+		opts := NewRegistryResourceFileCheck(check.Options.RegistryResourceFile).Options
+
+		return &LocaleFileOptions{FileOptions: opts.FileOptions, FileExtensions: ValidRegistryFileExtensions, FrontMatter: opts.FrontMatter, ProviderName: opts.ProviderName}
+	}
+
+	return nil
+}
+
+// fileMismatchCheck runs the file mismatch check and records its ignored
+// findings in the summary.
+func (check *Check) fileMismatchCheck(opts *FileMismatchOptions, files []string) error {
+	if check.filesOnly || check.canceled() {
+		return nil
+	}
+
+	fileMismatchCheck := NewFileMismatchCheck(opts)
+	err := check.Options.Timings.Check(CheckIDFileMismatch, func() error { return fileMismatchCheck.Run(files) })
+	check.Summary.IgnoredFindings += fileMismatchCheck.IgnoredFindings
+
+	return err
+}
@@ -1,7 +1,18 @@
 package check
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"reflect"
+	"sort"
 	"testing"
+	"testing/fstest"
+
+	"github.com/hashicorp/go-hclog"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 func TestCheck(t *testing.T) {
@@ -19,10 +30,61 @@ func TestCheck(t *testing.T) {
 			Name:     "valid registry directories with cdktf docs",
 			BasePath: "testdata/valid-registry-directories-with-cdktf",
 		},
+		{
+			Name:     "valid registry directories with other files",
+			BasePath: "testdata/valid-registry-directories-with-other-files",
+			Options: &CheckOptions{
+				Directories: map[string]*DirectoryOptions{
+					"docs/guides": {
+						OtherFileExtensions: []string{".svg"},
+					},
+					"docs/guides/images": {
+						OtherFileExtensions: []string{".png"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "invalid registry directories with other files",
+			BasePath:    "testdata/valid-registry-directories-with-other-files",
+			ExpectError: true,
+		},
+		{
+			Name:     "invalid registry directories with other files and only file size check enabled",
+			BasePath: "testdata/valid-registry-directories-with-other-files",
+			Options: &CheckOptions{
+				Checks: &CheckSelection{
+					Enable: []string{CheckIDFileSize},
+				},
+			},
+		},
+		{
+			Name:     "invalid registry directories with other files and file extension check disabled",
+			BasePath: "testdata/valid-registry-directories-with-other-files",
+			Options: &CheckOptions{
+				Checks: &CheckSelection{
+					Disable: []string{CheckIDFileExtension},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:     "valid registry directories with locales",
+			BasePath: "testdata/valid-registry-directories-with-locales",
+		},
+		{
+			Name:        "invalid registry directories with locales",
+			BasePath:    "testdata/invalid-registry-directories-with-locales",
+			ExpectError: true,
+		},
 		{
 			Name:     "valid legacy directories",
 			BasePath: "testdata/valid-legacy-directories",
 		},
+		{
+			Name:     "valid legacy directories with locales",
+			BasePath: "testdata/valid-legacy-directories-with-locales",
+		},
 		{
 			Name:     "valid legacy directories with cdktf docs",
 			BasePath: "testdata/valid-legacy-directories-with-cdktf",
@@ -46,18 +108,47 @@ func TestCheck(t *testing.T) {
 			BasePath:    "testdata/invalid-mixed-directories",
 			ExpectError: true,
 		},
+		{
+			Name:        "invalid overlapping directories",
+			BasePath:    "testdata/invalid-overlapping-directories",
+			ExpectError: true,
+		},
+		{
+			Name:     "valid required registry directories",
+			BasePath: "testdata/valid-registry-directories",
+			Options: &CheckOptions{
+				RequireDirectoryStructure: DirectoryStructureRegistry,
+			},
+		},
+		{
+			Name:     "invalid required legacy directories",
+			BasePath: "testdata/valid-registry-directories",
+			Options: &CheckOptions{
+				RequireDirectoryStructure: DirectoryStructureLegacy,
+			},
+			ExpectError: true,
+		},
+		{
+			Name:     "invalid required registry directories with mixed directories",
+			BasePath: "testdata/valid-mixed-directories",
+			Options: &CheckOptions{
+				RequireDirectoryStructure: DirectoryStructureRegistry,
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			fileOpts := &FileOptions{
-				BasePath: testCase.BasePath,
-			}
-
 			if testCase.Options == nil {
 				testCase.Options = &CheckOptions{}
 			}
 
+			fileOpts := &FileOptions{
+				BasePath: testCase.BasePath,
+				Checks:   testCase.Options.Checks,
+			}
+
 			if testCase.Options.DataSourceFileMismatch == nil {
 				testCase.Options.DataSourceFileMismatch = &FileMismatchOptions{}
 			}
@@ -106,6 +197,14 @@ func TestCheck(t *testing.T) {
 				testCase.Options.ProviderName = "test"
 			}
 
+			if testCase.Options.RegistryActionFile == nil {
+				testCase.Options.RegistryActionFile = &RegistryActionFileOptions{}
+			}
+
+			if testCase.Options.RegistryActionFile.FileOptions == nil {
+				testCase.Options.RegistryActionFile.FileOptions = fileOpts
+			}
+
 			if testCase.Options.RegistryDataSourceFile == nil {
 				testCase.Options.RegistryDataSourceFile = &RegistryDataSourceFileOptions{}
 			}
@@ -130,6 +229,14 @@ func TestCheck(t *testing.T) {
 				testCase.Options.RegistryIndexFile.FileOptions = fileOpts
 			}
 
+			if testCase.Options.RegistryListResourceFile == nil {
+				testCase.Options.RegistryListResourceFile = &RegistryResourceFileOptions{}
+			}
+
+			if testCase.Options.RegistryListResourceFile.FileOptions == nil {
+				testCase.Options.RegistryListResourceFile.FileOptions = fileOpts
+			}
+
 			if testCase.Options.RegistryResourceFile == nil {
 				testCase.Options.RegistryResourceFile = &RegistryResourceFileOptions{}
 			}
@@ -150,7 +257,7 @@ func TestCheck(t *testing.T) {
 				testCase.Options.ResourceFileMismatch.ProviderName = "test"
 			}
 
-			directories, err := GetDirectories(testCase.BasePath)
+			directories, err := GetDirectories(testCase.BasePath, nil)
 
 			if err != nil {
 				t.Fatalf("error getting directories for path (%s): %s", testCase.BasePath, err)
@@ -165,6 +272,385 @@ func TestCheck(t *testing.T) {
 			if got != nil && !testCase.ExpectError {
 				t.Errorf("expected no error, got error: %s", got)
 			}
+
+			if _, errs := Findings(got); len(errs) > 0 {
+				t.Errorf("expected only findings, got errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestCheck_mixedAndOverlappingDirectories(t *testing.T) {
+	basePath := "testdata/invalid-mixed-directories"
+
+	directories, err := GetDirectories(basePath, nil)
+
+	if err != nil {
+		t.Fatalf("error getting directories for path (%s): %s", basePath, err)
+	}
+
+	findings, errs := Findings(NewCheck(&CheckOptions{}).Run(directories))
+
+	if len(errs) > 0 {
+		t.Fatalf("expected only findings, got errors: %v", errs)
+	}
+
+	var got []string
+
+	for _, finding := range findings {
+		got = append(got, finding.CheckID)
+	}
+
+	sort.Strings(got)
+
+	expected := []string{
+		CheckIDDirectoryMixed,
+		CheckIDDirectoryOverlapping,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestCheck_progress(t *testing.T) {
+	basePath := "testdata/valid-registry-directories"
+
+	var got []string
+
+	fileOpts := &FileOptions{
+		BasePath: basePath,
+		Progress: func(path string) {
+			got = append(got, path)
+		},
+	}
+
+	opts := &CheckOptions{
+		RegistryActionFile: &RegistryActionFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryDataSourceFile: &RegistryDataSourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryIndexFile: &RegistryIndexFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryListResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+	}
+
+	directories, err := GetDirectories(basePath, nil)
+
+	if err != nil {
+		t.Fatalf("error getting directories for path (%s): %s", basePath, err)
+	}
+
+	if err := NewCheck(opts).Run(directories); err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	sort.Strings(got)
+
+	expected := []string{
+		"docs/actions/thing.md",
+		"docs/data-sources/thing.md",
+		"docs/index.md",
+		"docs/list-resources/thing.md",
+		"docs/resources/thing.md",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestCheck_RunFiles(t *testing.T) {
+	basePath := "testdata/valid-registry-directories"
+
+	var got []string
+
+	fileOpts := &FileOptions{
+		BasePath: basePath,
+		Progress: func(path string) {
+			got = append(got, path)
+		},
+	}
+
+	opts := &CheckOptions{
+		RegistryResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		ResourceFileMismatch: &FileMismatchOptions{
+			FileOptions:  fileOpts,
+			ProviderName: "test",
+			ResourceType: ResourceTypeResource,
+			Schemas: map[string]*tfjson.Schema{
+				"test_other": {},
+				"test_thing": {},
+			},
+		},
+	}
+
+	directories := map[string][]string{
+		"docs/resources": {"docs/resources/thing.md"},
+	}
+
+	docsCheck := NewCheck(opts)
+
+	if err := docsCheck.RunFiles(directories); err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	expected := []string{"docs/resources/thing.md"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if docsCheck.Summary.Files[DirectoryClassification("docs/resources")] != 1 {
+		t.Errorf("expected 1 checked file, got summary: %v", docsCheck.Summary.Files)
+	}
+
+	// The file mismatch check, which is skipped above, reports the missing
+	// test_other documentation.
+	if err := docsCheck.Run(directories); err == nil {
+		t.Errorf("expected error, got no error")
+	}
+}
+
+func TestCheck_logger(t *testing.T) {
+	var checkOutput, fileOutput bytes.Buffer
+
+	fileOpts := &FileOptions{
+		BasePath: "testdata/valid-registry-directories",
+		Logger:   hclog.New(&hclog.LoggerOptions{Output: &fileOutput}),
+	}
+
+	opts := &CheckOptions{
+		Logger: hclog.New(&hclog.LoggerOptions{Output: &checkOutput}),
+		RegistryResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		ResourceFileMismatch: &FileMismatchOptions{
+			FileOptions: fileOpts,
+			Files: map[string]string{
+				"test_removed": "removed",
+			},
+			ProviderName: "test",
+			ResourceType: ResourceTypeResource,
+			Schemas: map[string]*tfjson.Schema{
+				"test_thing": {},
+			},
+		},
+		WarningNumberOfFiles: 1,
+	}
+
+	directories := map[string][]string{
+		"docs/resources": {"docs/resources/thing.md"},
+	}
+
+	if err := NewCheck(opts).Run(directories); err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if !bytes.Contains(checkOutput.Bytes(), []byte("Number of documentation files is approaching the maximum")) {
+		t.Errorf("expected number of files warning in check logger output, got: %s", checkOutput.String())
+	}
+
+	if !bytes.Contains(fileOutput.Bytes(), []byte("Mapped documentation file resource not found in providers schema")) {
+		t.Errorf("expected file mismatch warning in file logger output, got: %s", fileOutput.String())
+	}
+}
+
+func TestCheck_RunContext(t *testing.T) {
+	basePath := "testdata/valid-registry-directories"
+
+	fileOpts := &FileOptions{
+		BasePath: basePath,
+	}
+
+	opts := &CheckOptions{
+		CrossReferences: &CrossReferencesOptions{
+			FileOptions: fileOpts,
+			Enable:      true,
+		},
+		RegistryActionFile: &RegistryActionFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryDataSourceFile: &RegistryDataSourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryIndexFile: &RegistryIndexFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryListResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		Timings: NewTimings(),
+	}
+
+	directories, err := GetDirectories(basePath, nil)
+
+	if err != nil {
+		t.Fatalf("error getting directories for path (%s): %s", basePath, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	findings, errs := Findings(NewCheck(opts).RunContext(ctx, directories))
+
+	if len(findings) > 0 {
+		t.Errorf("expected no findings, got: %v", findings)
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		t.Fatalf("expected context canceled error, got: %v", errs)
+	}
+
+	if _, ok := opts.Timings.Checks[CheckIDCrossReferences]; ok {
+		t.Errorf("expected %s check to be skipped", CheckIDCrossReferences)
+	}
+
+	if err := NewCheck(opts).RunContext(context.Background(), directories); err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if _, ok := opts.Timings.Checks[CheckIDCrossReferences]; !ok {
+		t.Errorf("expected %s check to run", CheckIDCrossReferences)
+	}
+}
+
+func TestCheck_RunContext_CanceledDuringFiles(t *testing.T) {
+	basePath := "testdata/valid-registry-directories"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var checkedFiles []string
+
+	fileOpts := &FileOptions{
+		BasePath: basePath,
+		Progress: func(path string) {
+			checkedFiles = append(checkedFiles, path)
+			cancel()
+		},
+	}
+
+	opts := &CheckOptions{
+		RegistryActionFile: &RegistryActionFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryDataSourceFile: &RegistryDataSourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryIndexFile: &RegistryIndexFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryListResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+		RegistryResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+		},
+	}
+
+	directories, err := GetDirectories(basePath, nil)
+
+	if err != nil {
+		t.Fatalf("error getting directories for path (%s): %s", basePath, err)
+	}
+
+	findings, errs := Findings(NewCheck(opts).RunContext(ctx, directories))
+
+	if len(findings) > 0 {
+		t.Errorf("expected no findings, got: %v", findings)
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		t.Fatalf("expected context canceled error, got: %v", errs)
+	}
+
+	if len(checkedFiles) != 1 {
+		t.Errorf("expected remaining files to be skipped after cancellation, got checked files: %v", checkedFiles)
+	}
+}
+
+func TestCheck_FS(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		FS          fs.FS
+		ExpectError bool
+	}{
+		{
+			Name: "valid registry directories",
+			FS:   os.DirFS("testdata/valid-registry-directories"),
+		},
+		{
+			Name: "in-memory tree",
+			FS: fstest.MapFS{
+				"docs/resources/thing.txt": {Data: []byte("# Resource\n")},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []string
+
+			fileOpts := &FileOptions{
+				FS: testCase.FS,
+				Progress: func(path string) {
+					got = append(got, path)
+				},
+			}
+
+			opts := &CheckOptions{
+				FS: testCase.FS,
+				RegistryActionFile: &RegistryActionFileOptions{
+					FileOptions: fileOpts,
+				},
+				RegistryDataSourceFile: &RegistryDataSourceFileOptions{
+					FileOptions: fileOpts,
+				},
+				RegistryIndexFile: &RegistryIndexFileOptions{
+					FileOptions: fileOpts,
+				},
+				RegistryListResourceFile: &RegistryResourceFileOptions{
+					FileOptions: fileOpts,
+				},
+				RegistryResourceFile: &RegistryResourceFileOptions{
+					FileOptions: fileOpts,
+				},
+			}
+
+			directories, err := GetDirectoriesFS(testCase.FS)
+
+			if err != nil {
+				t.Fatalf("error getting directories: %s", err)
+			}
+
+			err = NewCheck(opts).Run(directories)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if len(got) == 0 {
+				t.Errorf("expected files to be checked")
+			}
 		})
 	}
 }
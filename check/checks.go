@@ -0,0 +1,869 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	CheckIDContents                           = "contents"
+	CheckIDContentsAttributeDescriptions      = "contents-attribute-descriptions"
+	CheckIDContentsCallouts                   = "contents-callouts"
+	CheckIDContentsDuplicateAttributes        = "contents-duplicate-attributes"
+	CheckIDContentsExampleReferences          = "contents-example-references"
+	CheckIDContentsIdentity                   = "contents-identity"
+	CheckIDContentsImportBlock                = "contents-import-block"
+	CheckIDContentsMetaArguments              = "contents-meta-arguments"
+	CheckIDContentsSchemaDescriptions         = "contents-schema-descriptions"
+	CheckIDContentsSchemaOrdering             = "contents-schema-ordering"
+	CheckIDContentsSensitiveAttributes        = "contents-sensitive-attributes"
+	CheckIDContentsWriteOnlyAttributes        = "contents-write-only-attributes"
+	CheckIDContentsWriteOnlyAttributesMissing = "contents-write-only-attributes-missing"
+	CheckIDCrossReferences                    = "cross-references"
+	CheckIDDeprecated                         = "deprecated"
+	CheckIDDeprecatedProviderArguments        = "deprecated-provider-arguments"
+	CheckIDDirectoryInvalid                   = "directory-invalid"
+	CheckIDDirectoryMixed                     = "directory-mixed"
+	CheckIDDirectoryOverlapping               = "directory-overlapping"
+	CheckIDDirectoryStructure                 = "directory-structure"
+	CheckIDExampleAttributes                  = "example-attributes"
+	CheckIDExampleDataSource                  = "example-data-source"
+	CheckIDExampleIdentifiers                 = "example-identifiers"
+	CheckIDExamplePunctuation                 = "example-punctuation"
+	CheckIDExampleSecrets                     = "example-secrets"
+	CheckIDExampleUsage                       = "example-usage"
+	CheckIDExamples                           = "examples"
+	CheckIDFileEncoding                       = "file-encoding"
+	CheckIDFileExtension                      = "file-extension"
+	CheckIDFileLineEndings                    = "file-line-endings"
+	CheckIDFileMismatch                       = "file-mismatch"
+	CheckIDFileMissing                        = "file-missing"
+	CheckIDFileName                           = "file-name"
+	CheckIDFileSize                           = "file-size"
+	CheckIDForbiddenWords                     = "forbidden-words"
+	CheckIDFormatVerbs                        = "format-verbs"
+	CheckIDFrontMatter                        = "frontmatter"
+	CheckIDFrontMatterPageTitle               = "frontmatter-page-title"
+	CheckIDFunctionSignature                  = "function-signature"
+	CheckIDGuideFilenames                     = "guide-filenames"
+	CheckIDGuideLinks                         = "guide-links"
+	CheckIDHeadingNames                       = "heading-names"
+	CheckIDImageReferences                    = "image-references"
+	CheckIDIndexSections                      = "index-sections"
+	CheckIDLegacyNavigation                   = "legacy-navigation"
+	CheckIDLineLength                         = "line-length"
+	CheckIDNestedSchemaLinks                  = "nested-schema-links"
+	CheckIDNewDocumentation                   = "new-documentation"
+	CheckIDNumberOfFiles                      = "number-of-files"
+	CheckIDNumberOfGuides                     = "number-of-guides"
+	CheckIDNumberOfSubcategories              = "number-of-subcategories"
+	CheckIDPairedDocumentation                = "paired-documentation"
+	CheckIDRawHTML                            = "raw-html"
+	CheckIDRegistryManifest                   = "registry-manifest"
+	CheckIDRemoved                            = "removed"
+	CheckIDRenamed                            = "renamed"
+	CheckIDRequiredProviders                  = "required-providers"
+	CheckIDRules                              = "rules"
+	CheckIDSourceLinks                        = "source-links"
+	CheckIDSubcategoryBlank                   = "subcategory-blank"
+	CheckIDSubcategoryConsistency             = "subcategory-consistency"
+	CheckIDSubcategoryMapping                 = "subcategory-mapping"
+	CheckIDSubcategorySize                    = "subcategory-size"
+	CheckIDTemplate                           = "template"
+	CheckIDTitleHeading                       = "title-heading"
+	CheckIDTranslations                       = "translations"
+)
+
+const (
+	// ProfileMinimal contains the checks expected to pass for documentation
+	// generated by terraform-plugin-docs.
+	ProfileMinimal = "minimal"
+
+	// ProfileRegistryPublish contains the checks for issues which the
+	// Terraform Registry will reject or not render.
+	ProfileRegistryPublish = "registry-publish"
+
+	// ProfileStrict contains all checks.
+	ProfileStrict = "strict"
+)
+
+// Profiles contains all available profile names.
+var Profiles = []string{
+	ProfileMinimal,
+	ProfileRegistryPublish,
+	ProfileStrict,
+}
+
+// CheckInfo describes an available check.
+type CheckInfo struct {
+	// Description is a short, human readable description of the check.
+	Description string `json:"description"`
+
+	// ID is the unique identifier of the check.
+	ID string `json:"id"`
+
+	// SchemaDependent is true when the check requires the providers schema.
+	SchemaDependent bool `json:"schema_dependent"`
+}
+
+// Checks contains all available checks, sorted by identifier.
+var Checks = []*CheckInfo{
+	{
+		ID:          CheckIDContents,
+		Description: "(Experimental) Resource documentation contains expected sections, headings, and code blocks.",
+	},
+	{
+		ID:          CheckIDContentsAttributeDescriptions,
+		Description: "(Experimental) Resource documentation argument and attribute list items have a description.",
+	},
+	{
+		ID:          CheckIDContentsCallouts,
+		Description: "(Experimental) Resource documentation callouts (->, ~>, and !>) are followed by a space and callout labels (e.g. **Note:**) have a callout marker.",
+	},
+	{
+		ID:          CheckIDContentsDuplicateAttributes,
+		Description: "(Experimental) Resource documentation lists each argument and attribute once and arguments also listed as attributes have the same description.",
+	},
+	{
+		ID:          CheckIDContentsExampleReferences,
+		Description: "(Experimental) Resource documentation example code blocks declare the resources, data sources, and variables they reference, in the same or an adjacent code block.",
+	},
+	{
+		ID:              CheckIDContentsIdentity,
+		Description:     "(Experimental) Resource documentation with an identity schema in the providers schema contains an identity section listing the identity attributes.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDContentsImportBlock,
+		Description: "(Experimental) Resource documentation import sections contain an import block example.",
+	},
+	{
+		ID:          CheckIDContentsMetaArguments,
+		Description: "(Experimental) Resource documentation argument lists do not contain Terraform meta-arguments (count, depends_on, for_each, lifecycle, and provider).",
+	},
+	{
+		ID:              CheckIDContentsSchemaDescriptions,
+		Description:     "(Experimental) Resource documentation attribute descriptions are similar to the providers schema descriptions.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDContentsSchemaOrdering,
+		Description: "(Experimental) Resource documentation schema attribute lists are alphabetically ordered.",
+	},
+	{
+		ID:              CheckIDContentsSensitiveAttributes,
+		Description:     "(Experimental) Resource documentation states sensitive attributes in the providers schema are sensitive.",
+		SchemaDependent: true,
+	},
+	{
+		ID:              CheckIDContentsWriteOnlyAttributes,
+		Description:     "(Experimental) Resource documentation states write-only attributes in the providers schema are write-only and mentions their version attribute.",
+		SchemaDependent: true,
+	},
+	{
+		ID:              CheckIDContentsWriteOnlyAttributesMissing,
+		Description:     "(Experimental) Resource documentation contains write-only attributes in the providers schema.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDCrossReferences,
+		Description: "Documentation links to other documentation of the provider, either relative paths or Terraform Registry URLs, resolve to existing documentation.",
+	},
+	{
+		ID:              CheckIDDeprecated,
+		Description:     "Data source and resource documentation for deprecated data sources and resources in the providers schema contains a deprecation callout.",
+		SchemaDependent: true,
+	},
+	{
+		ID:              CheckIDDeprecatedProviderArguments,
+		Description:     "Provider index documentation of arguments and blocks deprecated in the provider configuration schema notes the deprecation and, with -require-deprecation-replacement, the replacement.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDDirectoryInvalid,
+		Description: "No invalid documentation directories are found.",
+	},
+	{
+		ID:          CheckIDDirectoryMixed,
+		Description: "Documentation does not mix legacy and Terraform Registry directory structures.",
+	},
+	{
+		ID:          CheckIDDirectoryOverlapping,
+		Description: "The same documentation is not present in both legacy and Terraform Registry directory structures.",
+	},
+	{
+		ID:          CheckIDDirectoryStructure,
+		Description: "Documentation only uses the required (legacy or Terraform Registry) directory structure.",
+	},
+	{
+		ID:              CheckIDExampleAttributes,
+		Description:     "Data source and resource documentation example configurations only set arguments and nested blocks in the providers schema, with values and nesting of the schema types.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDExampleDataSource,
+		Description: "Data source documentation example configurations declare data blocks and reference them with the data. prefix.",
+	},
+	{
+		ID:          CheckIDExampleIdentifiers,
+		Description: "Documentation code blocks do not contain real-looking cloud identifiers, such as account IDs, ARNs, subscription GUIDs, and project numbers, instead of placeholders.",
+	},
+	{
+		ID:          CheckIDExamplePunctuation,
+		Description: "Documentation code blocks do not contain non-ASCII quotes and dashes, such as smart quotes and en dashes, which break examples when copied.",
+	},
+	{
+		ID:          CheckIDExampleSecrets,
+		Description: "Documentation code blocks do not contain obvious secrets, such as AWS access key IDs, private keys, bearer tokens, and high-entropy password or token values.",
+	},
+	{
+		ID:          CheckIDExampleUsage,
+		Description: "Data source and resource documentation contains an Example Usage section with a terraform code block.",
+	},
+	{
+		ID:          CheckIDExamples,
+		Description: "Data source and resource documentation have a terraform-plugin-docs example file and example directories match a data source or resource.",
+	},
+	{
+		ID:          CheckIDFileEncoding,
+		Description: "Documentation files are valid UTF-8 without a byte order mark.",
+	},
+	{
+		ID:          CheckIDFileExtension,
+		Description: "Documentation files use a valid file extension.",
+	},
+	{
+		ID:          CheckIDFileLineEndings,
+		Description: "Documentation files use LF (or consistent CRLF, if allowed) line endings, if required.",
+	},
+	{
+		ID:              CheckIDFileMismatch,
+		Description:     "Action, data source, list resource, and resource documentation files match an action, data source, list resource, or resource in the providers schema.",
+		SchemaDependent: true,
+	},
+	{
+		ID:              CheckIDFileMissing,
+		Description:     "Actions, data sources, list resources, and resources in the providers schema have a documentation file.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDFileName,
+		Description: "Data source, list resource, and resource documentation file names, without the file extension, only contain lowercase letters, numbers, and underscores.",
+	},
+	{
+		ID:          CheckIDFileSize,
+		Description: "Documentation files are below the Terraform Registry file size limit.",
+	},
+	{
+		ID:          CheckIDForbiddenWords,
+		Description: "Documentation prose does not contain the words or phrases of the forbidden words file.",
+	},
+	{
+		ID:          CheckIDFormatVerbs,
+		Description: "Documentation prose and YAML frontmatter do not contain Go format verbs, such as %s or %[1]q, left by documentation generation errors.",
+	},
+	{
+		ID:          CheckIDFrontMatter,
+		Description: "Documentation files YAML frontmatter can be parsed and matches expectations.",
+	},
+	{
+		ID:          CheckIDFrontMatterPageTitle,
+		Description: "Action, data source, list resource, and resource documentation YAML frontmatter page_title resource names match the file name.",
+	},
+	{
+		ID:              CheckIDFunctionSignature,
+		Description:     "Function documentation signatures and arguments match the function in the providers schema.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDGuideFilenames,
+		Description: "Guide file names, which are their Terraform Registry URL, are lowercase, contain no spaces, consistently separate words with dashes or underscores, and do not collide after normalization.",
+	},
+	{
+		ID:          CheckIDGuideLinks,
+		Description: "Provider index and guide documentation links to guides resolve to existing guide files and, if required, each guide is linked from the provider index documentation.",
+	},
+	{
+		ID:          CheckIDHeadingNames,
+		Description: "Documentation headings use the configured canonical heading names instead of their synonyms (e.g. Attributes Reference instead of Attribute Reference).",
+	},
+	{
+		ID:          CheckIDImageReferences,
+		Description: "Local images referenced by documentation files exist.",
+	},
+	{
+		ID:          CheckIDIndexSections,
+		Description: "Provider index documentation contains the sections required with -require-index-sections or the configuration file.",
+	},
+	{
+		ID:          CheckIDLegacyNavigation,
+		Description: "Legacy website navigation files (e.g. website/aws.erb), if present, link to existing documentation files and link all legacy documentation files.",
+	},
+	{
+		ID:          CheckIDLineLength,
+		Description: "Prose lines of documentation do not exceed the -maximum-line-length limit. Code blocks, headings, HTML blocks, tables, and YAML frontmatter are not checked.",
+	},
+	{
+		ID:          CheckIDNestedSchemaLinks,
+		Description: "terraform-plugin-docs nested schema links (e.g. #nestedblock--rule) have a matching anchor and Nested Schema for section, and each Nested Schema for section is linked.",
+	},
+	{
+		ID:              CheckIDNewDocumentation,
+		Description:     "Data sources and resources in the providers schema which were not documented in the previous release (e.g. the previous git tag) have a documentation file.",
+		SchemaDependent: true,
+	},
+	{
+		ID:          CheckIDNumberOfFiles,
+		Description: "Number of documentation files is below the Terraform Registry limit.",
+	},
+	{
+		ID:          CheckIDNumberOfGuides,
+		Description: "Number of guides is below the Terraform Registry limit.",
+	},
+	{
+		ID:          CheckIDNumberOfSubcategories,
+		Description: "Number of distinct action, data source, list resource, and resource documentation frontmatter subcategories is at most the configured maximum.",
+	},
+	{
+		ID:          CheckIDPairedDocumentation,
+		Description: "Resource and data source documentation of the same name use the same subcategory and, if required, link to each other.",
+	},
+	{
+		ID:          CheckIDRawHTML,
+		Description: "Raw HTML in documentation only uses tags rendered by the Terraform Registry, allowing additional tags with -allowed-html-tags.",
+	},
+	{
+		ID:          CheckIDRegistryManifest,
+		Description: "Terraform Registry manifest (terraform-registry-manifest.json) parses, declares supported protocol versions, and exists if -require-registry-manifest is given.",
+	},
+	{
+		ID:          CheckIDRemoved,
+		Description: "Removed data source and resource documentation contains a removal callout.",
+	},
+	{
+		ID:          CheckIDRenamed,
+		Description: "Renamed data source and resource documentation contains a deprecation callout and links to the new documentation.",
+	},
+	{
+		ID:          CheckIDRequiredProviders,
+		Description: "Provider index documentation required_providers examples use the provider source address and valid version constraints.",
+	},
+	{
+		ID:          CheckIDRules,
+		Description: "Documentation files satisfy the custom regular expression content rules of the configuration file.",
+	},
+	{
+		ID:          CheckIDSourceLinks,
+		Description: "Links to the Terraform Provider GitHub repository source tree resolve to files and directories in the codebase.",
+	},
+	{
+		ID:          CheckIDSubcategoryBlank,
+		Description: "Documentation frontmatter subcategories, if present, are not empty or only whitespace, unless an empty subcategory is allowed.",
+	},
+	{
+		ID:          CheckIDSubcategoryConsistency,
+		Description: "Documentation frontmatter subcategories do not differ from other subcategories only by case or whitespace.",
+	},
+	{
+		ID:          CheckIDSubcategoryMapping,
+		Description: "Action, data source, list resource, and resource documentation frontmatter subcategories match the subcategory mapping file patterns.",
+	},
+	{
+		ID:          CheckIDSubcategorySize,
+		Description: "Data source and resource documentation frontmatter subcategories contain at most the configured number of entries.",
+	},
+	{
+		ID:          CheckIDTemplate,
+		Description: "terraform-plugin-docs templates parse and only reference available functions, fields, and existing files.",
+	},
+	{
+		ID:          CheckIDTitleHeading,
+		Description: "Action, data source, list resource, and resource documentation title headings reference the resource name of the frontmatter page_title and match the documentation type (e.g. Data Source: or (Resource)).",
+	},
+	{
+		ID:          CheckIDTranslations,
+		Description: "Localized documentation (e.g. docs/ja/) contains translations of the default locale documentation. Missing translations are logged as warnings.",
+	},
+}
+
+// ProfileChecks returns the identifiers of the checks in the profile.
+func ProfileChecks(profile string) ([]string, error) {
+	switch profile {
+	case ProfileMinimal:
+		return []string{
+			CheckIDDirectoryInvalid,
+			CheckIDDirectoryMixed,
+			CheckIDExampleDataSource,
+			CheckIDFileEncoding,
+			CheckIDFileExtension,
+			CheckIDFileLineEndings,
+			CheckIDFileMismatch,
+			CheckIDFileMissing,
+			CheckIDFileSize,
+			CheckIDFrontMatter,
+			CheckIDFrontMatterPageTitle,
+			CheckIDFunctionSignature,
+			CheckIDNumberOfFiles,
+			CheckIDNumberOfGuides,
+			CheckIDRequiredProviders,
+			CheckIDTemplate,
+		}, nil
+	case ProfileRegistryPublish:
+		return []string{
+			CheckIDDirectoryInvalid,
+			CheckIDDirectoryMixed,
+			CheckIDDirectoryOverlapping,
+			CheckIDFileExtension,
+			CheckIDFileSize,
+			CheckIDFrontMatter,
+			CheckIDNumberOfFiles,
+			CheckIDNumberOfGuides,
+		}, nil
+	case ProfileStrict:
+		result := make([]string, 0, len(Checks))
+
+		for _, checkInfo := range Checks {
+			result = append(result, checkInfo.ID)
+		}
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("invalid profile (%s), valid profiles: %s", profile, strings.Join(Profiles, ", "))
+}
+
+// CheckSelection represents the checks selected by identifier.
+type CheckSelection struct {
+	// Disable contains identifiers of checks which should not run.
+	Disable []string
+
+	// Enable, if non-empty, contains identifiers of the only checks which
+	// should run.
+	Enable []string
+}
+
+// contentsCheckIDs contains the identifiers of checks which require the
+// contents check.
+var contentsCheckIDs = []string{
+	CheckIDContentsAttributeDescriptions,
+	CheckIDContentsCallouts,
+	CheckIDContentsDuplicateAttributes,
+	CheckIDContentsExampleReferences,
+	CheckIDContentsIdentity,
+	CheckIDContentsImportBlock,
+	CheckIDContentsMetaArguments,
+	CheckIDContentsSchemaDescriptions,
+	CheckIDContentsSchemaOrdering,
+	CheckIDContentsSensitiveAttributes,
+	CheckIDContentsWriteOnlyAttributes,
+	CheckIDContentsWriteOnlyAttributesMissing,
+}
+
+// NewCheckSelection returns a CheckSelection after verifying all identifiers
+// are valid. Enabling a check which requires contents, such as
+// contents-schema-ordering, also enables contents.
+func NewCheckSelection(enable []string, disable []string) (*CheckSelection, error) {
+	var invalidIDs []string
+
+	for _, id := range append(append([]string{}, enable...), disable...) {
+		if !IsValidCheckID(id) {
+			invalidIDs = append(invalidIDs, id)
+		}
+	}
+
+	if len(invalidIDs) > 0 {
+		return nil, fmt.Errorf("invalid check identifiers (%s), run the checks command for valid identifiers", strings.Join(invalidIDs, ", "))
+	}
+
+	selection := &CheckSelection{
+		Disable: disable,
+		Enable:  enable,
+	}
+
+	for _, id := range contentsCheckIDs {
+		if selection.IsEnabled(id) && !selection.IsEnabled(CheckIDContents) {
+			selection.Enable = append(selection.Enable, CheckIDContents)
+		}
+	}
+
+	return selection, nil
+}
+
+// IsDisabled returns true if the check is explicitly disabled.
+func (s *CheckSelection) IsDisabled(id string) bool {
+	if s == nil {
+		return false
+	}
+
+	return containsString(s.Disable, id)
+}
+
+// IsEnabled returns true if the check is explicitly enabled.
+func (s *CheckSelection) IsEnabled(id string) bool {
+	if s == nil {
+		return false
+	}
+
+	return containsString(s.Enable, id)
+}
+
+// Selected returns true if the check is not disabled and either no checks
+// are explicitly enabled or the check is explicitly enabled.
+func (s *CheckSelection) Selected(id string) bool {
+	if s == nil {
+		return true
+	}
+
+	if s.IsDisabled(id) {
+		return false
+	}
+
+	return len(s.Enable) == 0 || s.IsEnabled(id)
+}
+
+// IsValidCheckID returns true if the identifier matches an available check.
+func IsValidCheckID(id string) bool {
+	for _, checkInfo := range Checks {
+		if checkInfo.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckEnabled returns true if the check is enabled with the options.
+func (opts *CheckOptions) CheckEnabled(id string) bool {
+	if opts == nil {
+		opts = &CheckOptions{}
+	}
+
+	if !opts.Checks.Selected(id) {
+		return false
+	}
+
+	switch id {
+	case CheckIDContents, CheckIDContentsAttributeDescriptions, CheckIDContentsCallouts, CheckIDContentsDuplicateAttributes, CheckIDContentsMetaArguments:
+		return opts.contentsEnabled()
+	case CheckIDContentsExampleReferences:
+		return opts.contentsEnabled() && opts.selfContainedExamplesRequired()
+	case CheckIDContentsIdentity:
+		return opts.contentsEnabled() && opts.identityRequired() && opts.schemasLoaded()
+	case CheckIDContentsImportBlock:
+		return opts.contentsEnabled() && opts.importBlockRequired()
+	case CheckIDContentsSchemaDescriptions:
+		return opts.contentsEnabled() && opts.schemaDescriptionsRequired() && opts.schemasLoaded()
+	case CheckIDContentsSchemaOrdering:
+		return opts.contentsEnabled() && opts.schemaOrderingRequired()
+	case CheckIDContentsSensitiveAttributes, CheckIDContentsWriteOnlyAttributes, CheckIDContentsWriteOnlyAttributesMissing:
+		return opts.contentsEnabled() && opts.schemasLoaded()
+	case CheckIDCrossReferences:
+		return opts.CrossReferences != nil && opts.CrossReferences.Enable
+	case CheckIDDirectoryStructure:
+		return opts.RequireDirectoryStructure != ""
+	case CheckIDExampleIdentifiers:
+		return opts.ExampleIdentifiers != nil && len(opts.ExampleIdentifiers.Patterns) > 0
+	case CheckIDExamplePunctuation:
+		return opts.ExamplePunctuation != nil
+	case CheckIDExampleSecrets:
+		return opts.ExampleSecrets != nil && opts.ExampleSecrets.Enable
+	case CheckIDExampleUsage:
+		return opts.exampleUsageRequired()
+	case CheckIDExamples:
+		return opts.Examples != nil && opts.Examples.Enable
+	case CheckIDSubcategoryConsistency:
+		return opts.Subcategories != nil && opts.Subcategories.EnableConsistency
+	case CheckIDNumberOfSubcategories:
+		return opts.Subcategories != nil && (opts.Subcategories.MaximumSubcategories > 0 || opts.Subcategories.WarningSubcategories > 0)
+	case CheckIDSubcategoryMapping:
+		return opts.Subcategories != nil && len(opts.Subcategories.Mappings) > 0
+	case CheckIDSubcategorySize:
+		return opts.Subcategories != nil && (opts.Subcategories.MaximumEntries > 0 || opts.Subcategories.WarnSingleEntry)
+	case CheckIDFrontMatterPageTitle:
+		return opts.ProviderName != "" && opts.frontMatterPageTitleCheckEnabled()
+	case CheckIDTitleHeading:
+		return opts.ProviderName != "" && opts.titleHeadingCheckEnabled()
+	case CheckIDFileLineEndings:
+		return opts.lineEndingsRequired()
+	case CheckIDFileName:
+		return opts.fileNameCheckEnabled()
+	case CheckIDForbiddenWords:
+		return opts.ForbiddenWords != nil && len(opts.ForbiddenWords.Words) > 0
+	case CheckIDFormatVerbs:
+		return opts.FormatVerbs != nil && opts.FormatVerbs.Enable
+	case CheckIDGuideFilenames:
+		return opts.GuideFilenames != nil && opts.GuideFilenames.Enable
+	case CheckIDGuideLinks:
+		return opts.GuideLinks != nil && opts.GuideLinks.Enable
+	case CheckIDHeadingNames:
+		return opts.HeadingNames != nil && len(opts.HeadingNames.Headings) > 0
+	case CheckIDIndexSections:
+		return opts.IndexSections != nil && len(opts.IndexSections.Sections) > 0
+	case CheckIDLegacyNavigation:
+		return opts.LegacyNavigation != nil && opts.LegacyNavigation.Enable
+	case CheckIDLineLength:
+		return opts.LineLength != nil && opts.LineLength.Maximum > 0
+	case CheckIDNestedSchemaLinks:
+		return opts.NestedSchemaLinks != nil && opts.NestedSchemaLinks.Enable
+	case CheckIDNewDocumentation:
+		return opts.NewDocumentation != nil && opts.NewDocumentation.Ref != "" && (len(opts.NewDocumentation.DataSourceSchemas) > 0 || len(opts.NewDocumentation.ResourceSchemas) > 0)
+	case CheckIDPairedDocumentation:
+		return opts.PairedDocumentation != nil && opts.PairedDocumentation.Enable
+	case CheckIDRawHTML:
+		return opts.RawHTML != nil && opts.RawHTML.Enable
+	case CheckIDRegistryManifest:
+		return opts.RegistryManifest != nil
+	case CheckIDTemplate:
+		return opts.TemplateFile != nil && opts.TemplateFile.Enable
+	case CheckIDRemoved:
+		return opts.Removed != nil && (len(opts.Removed.DataSources) > 0 || len(opts.Removed.Resources) > 0)
+	case CheckIDRules:
+		return opts.Rules != nil && len(opts.Rules.Rules) > 0
+	case CheckIDSourceLinks:
+		return opts.SourceLinks != nil && opts.SourceLinks.Repository != ""
+	case CheckIDRenamed:
+		return opts.Renamed != nil && (len(opts.Renamed.DataSources) > 0 || len(opts.Renamed.Resources) > 0)
+	case CheckIDFunctionSignature:
+		return opts.FunctionSignature != nil && len(opts.FunctionSignature.Schemas) > 0
+	case CheckIDDeprecated:
+		return opts.Deprecated != nil && (len(opts.Deprecated.DataSourceSchemas) > 0 || len(opts.Deprecated.ResourceSchemas) > 0)
+	case CheckIDDeprecatedProviderArguments:
+		return opts.DeprecatedProviderArguments != nil && opts.DeprecatedProviderArguments.Schema != nil
+	case CheckIDExampleAttributes, CheckIDFileMismatch, CheckIDFileMissing:
+		return opts.schemasLoaded()
+	case CheckIDExampleDataSource, CheckIDRequiredProviders:
+		return opts.ProviderName != ""
+	}
+
+	return true
+}
+
+// EnabledChecks returns the identifiers of all enabled checks with the options.
+func (opts *CheckOptions) EnabledChecks() []string {
+	var result []string
+
+	for _, checkInfo := range Checks {
+		if opts.CheckEnabled(checkInfo.ID) {
+			result = append(result, checkInfo.ID)
+		}
+	}
+
+	return result
+}
+
+func (opts *CheckOptions) contentsEnabled() bool {
+	if opts.LegacyResourceFile != nil && opts.LegacyResourceFile.Contents != nil && opts.LegacyResourceFile.Contents.Enable {
+		return true
+	}
+
+	if opts.RegistryResourceFile != nil && opts.RegistryResourceFile.Contents != nil && opts.RegistryResourceFile.Contents.Enable {
+		return true
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) exampleUsageRequired() bool {
+	exampleUsageOptions := []*ExampleUsageOptions{}
+
+	if opts.LegacyDataSourceFile != nil {
+		exampleUsageOptions = append(exampleUsageOptions, opts.LegacyDataSourceFile.ExampleUsage)
+	}
+
+	if opts.LegacyResourceFile != nil {
+		exampleUsageOptions = append(exampleUsageOptions, opts.LegacyResourceFile.ExampleUsage)
+	}
+
+	if opts.RegistryDataSourceFile != nil {
+		exampleUsageOptions = append(exampleUsageOptions, opts.RegistryDataSourceFile.ExampleUsage)
+	}
+
+	if opts.RegistryResourceFile != nil {
+		exampleUsageOptions = append(exampleUsageOptions, opts.RegistryResourceFile.ExampleUsage)
+	}
+
+	for _, exampleUsageOpts := range exampleUsageOptions {
+		if exampleUsageOpts != nil && exampleUsageOpts.Enable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileOptions returns the file options of all file checks.
+func (opts *CheckOptions) fileOptions() []*FileOptions {
+	var result []*FileOptions
+
+	if opts.LegacyDataSourceFile != nil {
+		result = append(result, opts.LegacyDataSourceFile.FileOptions)
+	}
+
+	if opts.LegacyGuideFile != nil {
+		result = append(result, opts.LegacyGuideFile.FileOptions)
+	}
+
+	if opts.LegacyIndexFile != nil {
+		result = append(result, opts.LegacyIndexFile.FileOptions)
+	}
+
+	if opts.LegacyResourceFile != nil {
+		result = append(result, opts.LegacyResourceFile.FileOptions)
+	}
+
+	if opts.RegistryActionFile != nil {
+		result = append(result, opts.RegistryActionFile.FileOptions)
+	}
+
+	if opts.RegistryDataSourceFile != nil {
+		result = append(result, opts.RegistryDataSourceFile.FileOptions)
+	}
+
+	if opts.RegistryGuideFile != nil {
+		result = append(result, opts.RegistryGuideFile.FileOptions)
+	}
+
+	if opts.RegistryIndexFile != nil {
+		result = append(result, opts.RegistryIndexFile.FileOptions)
+	}
+
+	if opts.RegistryListResourceFile != nil {
+		result = append(result, opts.RegistryListResourceFile.FileOptions)
+	}
+
+	if opts.RegistryResourceFile != nil {
+		result = append(result, opts.RegistryResourceFile.FileOptions)
+	}
+
+	return result
+}
+
+func (opts *CheckOptions) fileNameCheckEnabled() bool {
+	for _, fileOpts := range opts.fileOptions() {
+		if fileOpts != nil && fileOpts.EnableFileNameCheck {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) frontMatterPageTitleCheckEnabled() bool {
+	for _, fileOpts := range opts.fileOptions() {
+		if fileOpts != nil && fileOpts.EnableFrontMatterPageTitleCheck {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) titleHeadingCheckEnabled() bool {
+	for _, fileOpts := range opts.fileOptions() {
+		if fileOpts != nil && fileOpts.EnableTitleHeadingCheck {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) identityRequired() bool {
+	if opts.LegacyResourceFile != nil && opts.LegacyResourceFile.Contents != nil && opts.LegacyResourceFile.Contents.RequireIdentity {
+		return true
+	}
+
+	if opts.RegistryResourceFile != nil && opts.RegistryResourceFile.Contents != nil && opts.RegistryResourceFile.Contents.RequireIdentity {
+		return true
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) lineEndingsRequired() bool {
+	for _, fileOpts := range opts.fileOptions() {
+		if fileOpts != nil && fileOpts.RequireLFLineEndings {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) importBlockRequired() bool {
+	if opts.LegacyResourceFile != nil && opts.LegacyResourceFile.Contents != nil && opts.LegacyResourceFile.Contents.RequireImportBlock {
+		return true
+	}
+
+	if opts.RegistryResourceFile != nil && opts.RegistryResourceFile.Contents != nil && opts.RegistryResourceFile.Contents.RequireImportBlock {
+		return true
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) schemaDescriptionsRequired() bool {
+	if opts.LegacyResourceFile != nil && opts.LegacyResourceFile.Contents != nil && opts.LegacyResourceFile.Contents.RequireSchemaDescriptions {
+		return true
+	}
+
+	if opts.RegistryResourceFile != nil && opts.RegistryResourceFile.Contents != nil && opts.RegistryResourceFile.Contents.RequireSchemaDescriptions {
+		return true
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) schemaOrderingRequired() bool {
+	if opts.LegacyResourceFile != nil && opts.LegacyResourceFile.Contents != nil && opts.LegacyResourceFile.Contents.RequireSchemaOrdering {
+		return true
+	}
+
+	if opts.RegistryResourceFile != nil && opts.RegistryResourceFile.Contents != nil && opts.RegistryResourceFile.Contents.RequireSchemaOrdering {
+		return true
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) selfContainedExamplesRequired() bool {
+	if opts.LegacyResourceFile != nil && opts.LegacyResourceFile.Contents != nil && opts.LegacyResourceFile.Contents.RequireSelfContainedExamples {
+		return true
+	}
+
+	if opts.RegistryResourceFile != nil && opts.RegistryResourceFile.Contents != nil && opts.RegistryResourceFile.Contents.RequireSelfContainedExamples {
+		return true
+	}
+
+	return false
+}
+
+func (opts *CheckOptions) schemasLoaded() bool {
+	if opts.ActionFileMismatch != nil && len(opts.ActionFileMismatch.Schemas) > 0 {
+		return true
+	}
+
+	if opts.DataSourceFileMismatch != nil && len(opts.DataSourceFileMismatch.Schemas) > 0 {
+		return true
+	}
+
+	if opts.ListResourceFileMismatch != nil && len(opts.ListResourceFileMismatch.Schemas) > 0 {
+		return true
+	}
+
+	if opts.ResourceFileMismatch != nil && len(opts.ResourceFileMismatch.Schemas) > 0 {
+		return true
+	}
+
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
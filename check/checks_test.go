@@ -0,0 +1,479 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestChecks(t *testing.T) {
+	ids := make([]string, 0, len(Checks))
+	seen := make(map[string]struct{}, len(Checks))
+
+	for _, checkInfo := range Checks {
+		if _, ok := seen[checkInfo.ID]; ok {
+			t.Errorf("duplicate check identifier: %s", checkInfo.ID)
+		}
+
+		if checkInfo.Description == "" {
+			t.Errorf("check (%s) missing description", checkInfo.ID)
+		}
+
+		ids = append(ids, checkInfo.ID)
+		seen[checkInfo.ID] = struct{}{}
+	}
+
+	if !sort.StringsAreSorted(ids) {
+		t.Errorf("checks not sorted by identifier: %v", ids)
+	}
+}
+
+func TestProfileChecks(t *testing.T) {
+	for _, profile := range Profiles {
+		t.Run(profile, func(t *testing.T) {
+			ids, err := ProfileChecks(profile)
+
+			if err != nil {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if len(ids) == 0 {
+				t.Fatalf("expected checks, got none")
+			}
+
+			for _, id := range ids {
+				if !IsValidCheckID(id) {
+					t.Errorf("invalid check identifier: %s", id)
+				}
+			}
+		})
+	}
+
+	if _, err := ProfileChecks("not-a-profile"); err == nil {
+		t.Errorf("expected error for invalid profile, got no error")
+	}
+}
+
+func TestCheckOptionsEnabledChecks(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Options  *CheckOptions
+		Expected []string
+	}{
+		{
+			Name: "nil options",
+			Expected: []string{
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "line endings required",
+			Options: &CheckOptions{
+				RegistryGuideFile: &RegistryGuideFileOptions{
+					FileOptions: &FileOptions{
+						RequireLFLineEndings: true,
+					},
+				},
+			},
+			Expected: []string{
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileLineEndings,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "file name check enabled",
+			Options: &CheckOptions{
+				RegistryResourceFile: &RegistryResourceFileOptions{
+					FileOptions: &FileOptions{
+						EnableFileNameCheck: true,
+					},
+				},
+			},
+			Expected: []string{
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileName,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "template enabled",
+			Options: &CheckOptions{
+				TemplateFile: &TemplateFileOptions{
+					Enable: true,
+				},
+			},
+			Expected: []string{
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTemplate,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "contents with schema ordering",
+			Options: &CheckOptions{
+				RegistryResourceFile: &RegistryResourceFileOptions{
+					Contents: &ContentsOptions{
+						Enable:                true,
+						RequireSchemaOrdering: true,
+					},
+				},
+			},
+			Expected: []string{
+				CheckIDContents,
+				CheckIDContentsAttributeDescriptions,
+				CheckIDContentsCallouts,
+				CheckIDContentsDuplicateAttributes,
+				CheckIDContentsMetaArguments,
+				CheckIDContentsSchemaOrdering,
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "contents with import block",
+			Options: &CheckOptions{
+				LegacyResourceFile: &LegacyResourceFileOptions{
+					Contents: &ContentsOptions{
+						Enable:             true,
+						RequireImportBlock: true,
+					},
+				},
+			},
+			Expected: []string{
+				CheckIDContents,
+				CheckIDContentsAttributeDescriptions,
+				CheckIDContentsCallouts,
+				CheckIDContentsDuplicateAttributes,
+				CheckIDContentsImportBlock,
+				CheckIDContentsMetaArguments,
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "contents with self-contained examples",
+			Options: &CheckOptions{
+				LegacyResourceFile: &LegacyResourceFileOptions{
+					Contents: &ContentsOptions{
+						Enable:                       true,
+						RequireSelfContainedExamples: true,
+					},
+				},
+			},
+			Expected: []string{
+				CheckIDContents,
+				CheckIDContentsAttributeDescriptions,
+				CheckIDContentsCallouts,
+				CheckIDContentsDuplicateAttributes,
+				CheckIDContentsExampleReferences,
+				CheckIDContentsMetaArguments,
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "contents with identity",
+			Options: &CheckOptions{
+				RegistryResourceFile: &RegistryResourceFileOptions{
+					Contents: &ContentsOptions{
+						Enable:          true,
+						RequireIdentity: true,
+					},
+				},
+				ResourceFileMismatch: &FileMismatchOptions{
+					Schemas: map[string]*tfjson.Schema{
+						"test_resource": {},
+					},
+				},
+			},
+			Expected: []string{
+				CheckIDContents,
+				CheckIDContentsAttributeDescriptions,
+				CheckIDContentsCallouts,
+				CheckIDContentsDuplicateAttributes,
+				CheckIDContentsIdentity,
+				CheckIDContentsMetaArguments,
+				CheckIDContentsSensitiveAttributes,
+				CheckIDContentsWriteOnlyAttributes,
+				CheckIDContentsWriteOnlyAttributesMissing,
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDExampleAttributes,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileMismatch,
+				CheckIDFileMissing,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+		{
+			Name: "schemas and required structure",
+			Options: &CheckOptions{
+				Deprecated: &DeprecatedOptions{
+					ResourceSchemas: map[string]*tfjson.Schema{
+						"test_resource": {},
+					},
+				},
+				FunctionSignature: &FunctionSignatureOptions{
+					Schemas: map[string]*tfjson.FunctionSignature{
+						"parse": {},
+					},
+				},
+				RequireDirectoryStructure: DirectoryStructureRegistry,
+				ResourceFileMismatch: &FileMismatchOptions{
+					Schemas: map[string]*tfjson.Schema{
+						"test_resource": {},
+					},
+				},
+			},
+			Expected: []string{
+				CheckIDDeprecated,
+				CheckIDDirectoryInvalid,
+				CheckIDDirectoryMixed,
+				CheckIDDirectoryOverlapping,
+				CheckIDDirectoryStructure,
+				CheckIDExampleAttributes,
+				CheckIDFileEncoding,
+				CheckIDFileExtension,
+				CheckIDFileMismatch,
+				CheckIDFileMissing,
+				CheckIDFileSize,
+				CheckIDFrontMatter,
+				CheckIDFunctionSignature,
+				CheckIDImageReferences,
+				CheckIDNumberOfFiles,
+				CheckIDNumberOfGuides,
+				CheckIDSubcategoryBlank,
+				CheckIDTranslations,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Options.EnabledChecks()
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("expected %v, got %v", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestNewCheckSelection(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Enable        []string
+		Disable       []string
+		Expected      *CheckSelection
+		ExpectedError bool
+	}{
+		{
+			Name:     "empty",
+			Expected: &CheckSelection{},
+		},
+		{
+			Name:    "valid",
+			Enable:  []string{CheckIDFrontMatter},
+			Disable: []string{CheckIDFileSize},
+			Expected: &CheckSelection{
+				Disable: []string{CheckIDFileSize},
+				Enable:  []string{CheckIDFrontMatter},
+			},
+		},
+		{
+			Name:   "schema ordering enables contents",
+			Enable: []string{CheckIDContentsSchemaOrdering},
+			Expected: &CheckSelection{
+				Enable: []string{CheckIDContentsSchemaOrdering, CheckIDContents},
+			},
+		},
+		{
+			Name:   "callouts enables contents",
+			Enable: []string{CheckIDContentsCallouts},
+			Expected: &CheckSelection{
+				Enable: []string{CheckIDContentsCallouts, CheckIDContents},
+			},
+		},
+		{
+			Name:   "import block enables contents",
+			Enable: []string{CheckIDContentsImportBlock},
+			Expected: &CheckSelection{
+				Enable: []string{CheckIDContentsImportBlock, CheckIDContents},
+			},
+		},
+		{
+			Name:          "invalid enable",
+			Enable:        []string{"not-a-check"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid disable",
+			Disable:       []string{"not-a-check"},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := NewCheckSelection(testCase.Enable, testCase.Disable)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) && !testCase.ExpectedError {
+				t.Errorf("expected %#v, got %#v", testCase.Expected, got)
+			}
+		})
+	}
+}
+
+func TestCheckSelectionSelected(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Selection *CheckSelection
+		ID        string
+		Expected  bool
+	}{
+		{
+			Name:     "nil",
+			ID:       CheckIDFrontMatter,
+			Expected: true,
+		},
+		{
+			Name:      "empty",
+			Selection: &CheckSelection{},
+			ID:        CheckIDFrontMatter,
+			Expected:  true,
+		},
+		{
+			Name: "disabled",
+			Selection: &CheckSelection{
+				Disable: []string{CheckIDFrontMatter},
+			},
+			ID:       CheckIDFrontMatter,
+			Expected: false,
+		},
+		{
+			Name: "enabled",
+			Selection: &CheckSelection{
+				Enable: []string{CheckIDFrontMatter},
+			},
+			ID:       CheckIDFrontMatter,
+			Expected: true,
+		},
+		{
+			Name: "not enabled",
+			Selection: &CheckSelection{
+				Enable: []string{CheckIDFrontMatter},
+			},
+			ID:       CheckIDFileSize,
+			Expected: false,
+		},
+		{
+			Name: "enabled and disabled",
+			Selection: &CheckSelection{
+				Disable: []string{CheckIDFrontMatter},
+				Enable:  []string{CheckIDFrontMatter},
+			},
+			ID:       CheckIDFrontMatter,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Selection.Selected(testCase.ID)
+
+			if got != testCase.Expected {
+				t.Errorf("expected %t, got %t", testCase.Expected, got)
+			}
+		})
+	}
+}
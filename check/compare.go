@@ -0,0 +1,146 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/bflad/tfproviderdocs/check/contents"
+)
+
+// DocumentationComparison contains the differences between the documentation
+// of two Terraform Provider versions, with paths sorted.
+type DocumentationComparison struct {
+	// Added contains the new paths of documentation not found in the old
+	// version.
+	Added []string `json:"added,omitempty"`
+
+	// Removed contains the old paths of documentation not found in the new
+	// version.
+	Removed []string `json:"removed,omitempty"`
+
+	// Changed contains documentation with added, removed, or materially
+	// changed attributes.
+	Changed []*DocumentationChange `json:"changed,omitempty"`
+}
+
+// DocumentationChange represents documentation whose attributes changed
+// between versions.
+type DocumentationChange struct {
+	// OldPath is the path in the old version, which differs from Path if the
+	// documentation moved, such as from the legacy to the registry directory
+	// structure.
+	OldPath string `json:"old_path"`
+
+	// Path is the path in the new version.
+	Path string `json:"path"`
+
+	Attributes *contents.AttributeComparison `json:"attributes"`
+}
+
+// HasChanges returns true if any documentation was added, removed, or changed.
+func (c *DocumentationComparison) HasChanges() bool {
+	return len(c.Added) > 0 || len(c.Removed) > 0 || len(c.Changed) > 0
+}
+
+// CompareDocumentation returns the differences between the old and new
+// version documentation files, read with the respective file options.
+// Documentation is matched by type and name, so documentation moved between
+// the legacy and registry directory structures is compared. CDKTF, localized,
+// and unknown documentation is skipped, as it is generated or translated. Only
+// the attributes of action, data source, list resource, and resource
+// documentation are compared.
+func CompareDocumentation(oldFiles []*DocumentationFile, oldOpts *FileOptions, newFiles []*DocumentationFile, newOpts *FileOptions, providerName string) (*DocumentationComparison, error) {
+	result := &DocumentationComparison{}
+	oldKeys := documentationComparisonKeys(oldFiles)
+	newKeys := documentationComparisonKeys(newFiles)
+
+	for key, newFile := range newKeys {
+		oldFile, ok := oldKeys[key]
+
+		if !ok {
+			result.Added = append(result.Added, newFile.Path)
+
+			continue
+		}
+
+		switch newFile.Type {
+		case DocumentationTypeAction, DocumentationTypeDataSource, DocumentationTypeListResource, DocumentationTypeResource:
+		default:
+			continue
+		}
+
+		oldAttributes, err := documentedAttributes(oldFile, oldOpts, providerName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		newAttributes, err := documentedAttributes(newFile, newOpts, providerName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if attributes := contents.CompareAttributes(oldAttributes, newAttributes); attributes.HasChanges() {
+			result.Changed = append(result.Changed, &DocumentationChange{
+				Attributes: attributes,
+				OldPath:    oldFile.Path,
+				Path:       newFile.Path,
+			})
+		}
+	}
+
+	for key, oldFile := range oldKeys {
+		if _, ok := newKeys[key]; !ok {
+			result.Removed = append(result.Removed, oldFile.Path)
+		}
+	}
+
+	sort.Strings(result.Added)
+	sort.Strings(result.Removed)
+	sort.Slice(result.Changed, func(i, j int) bool {
+		return result.Changed[i].Path < result.Changed[j].Path
+	})
+
+	return result, nil
+}
+
+// documentationComparisonKeys returns the documentation files keyed by type
+// and name, e.g. resource/thing, skipping CDKTF, localized, and unknown
+// documentation.
+func documentationComparisonKeys(files []*DocumentationFile) map[string]*DocumentationFile {
+	result := make(map[string]*DocumentationFile, len(files))
+
+	for _, file := range files {
+		if file.CdktfLanguage != "" || file.Locale != "" || file.Type == DocumentationTypeUnknown {
+			continue
+		}
+
+		key := file.Type + "/" + TrimFileExtension(filepath.Base(file.Path))
+
+		if _, ok := result[key]; !ok {
+			result[key] = file
+		}
+	}
+
+	return result
+}
+
+// documentedAttributes returns the documented attributes of the
+// documentation file.
+func documentedAttributes(file *DocumentationFile, opts *FileOptions, providerName string) (map[string]*contents.SchemaAttributeListItem, error) {
+	source, err := opts.ReadFile(file.Path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading file (%s): %w", file.Path, err)
+	}
+
+	doc := contents.NewDocument(opts.FullPath(file.Path), providerName)
+
+	if err := doc.ParseSource(source); err != nil {
+		return nil, err
+	}
+
+	return doc.DocumentedAttributes(), nil
+}
@@ -0,0 +1,97 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestCompareDocumentation(t *testing.T) {
+	oldFS := fstest.MapFS{
+		"website/docs/r/thing.html.markdown":        {Data: []byte("# Resource: test_thing\n\n## Argument Reference\n\n* `name` - (Optional) Name.\n* `tags` - (Optional) Tags.\n\n## Attributes Reference\n\n* `id` - Identifier.\n")},
+		"website/docs/r/other.html.markdown":        {Data: []byte("# Resource: test_other\n\n## Argument Reference\n\n* `name` - (Required) Name.\n")},
+		"website/docs/d/old.html.markdown":          {Data: []byte("# Data Source: test_old\n\n## Argument Reference\n\n* `name` - (Required) Name.\n")},
+		"website/docs/guides/upgrade.html.markdown": {Data: []byte("# Upgrade\n")},
+	}
+	newFS := fstest.MapFS{
+		"docs/resources/thing.md":              {Data: []byte("# Resource: test_thing\n\n## Argument Reference\n\n* `name` - (Required, Forces new resource) Name.\n* `type` - (Optional) Type.\n\n## Attributes Reference\n\n* `id` - Identifier of the thing.\n")},
+		"docs/resources/other.md":              {Data: []byte("# Resource: test_other\n\n## Argument Reference\n\n* `name` - (Required) Name\n")},
+		"docs/data-sources/new.md":             {Data: []byte("# Data Source: test_new\n\n## Argument Reference\n\n* `name` - (Required) Name.\n")},
+		"docs/guides/upgrade.md":               {Data: []byte("# Upgrade\n\nChanged.\n")},
+		"docs/cdktf/python/resources/thing.md": {Data: []byte("# Resource: test_thing\n")},
+	}
+
+	oldOpts := &FileOptions{FS: oldFS}
+	newOpts := &FileOptions{FS: newFS}
+
+	oldFiles := compareTestDocumentationFiles(t, oldOpts, map[string][]string{
+		"website/docs/d":      {"website/docs/d/old.html.markdown"},
+		"website/docs/guides": {"website/docs/guides/upgrade.html.markdown"},
+		"website/docs/r":      {"website/docs/r/other.html.markdown", "website/docs/r/thing.html.markdown"},
+	})
+	newFiles := compareTestDocumentationFiles(t, newOpts, map[string][]string{
+		"docs/cdktf/python/resources": {"docs/cdktf/python/resources/thing.md"},
+		"docs/data-sources":           {"docs/data-sources/new.md"},
+		"docs/guides":                 {"docs/guides/upgrade.md"},
+		"docs/resources":              {"docs/resources/other.md", "docs/resources/thing.md"},
+	})
+
+	got, err := CompareDocumentation(oldFiles, oldOpts, newFiles, newOpts, "test")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"docs/data-sources/new.md"}; !reflect.DeepEqual(got.Added, expected) {
+		t.Errorf("expected added: %q, got: %q", expected, got.Added)
+	}
+
+	if expected := []string{"website/docs/d/old.html.markdown"}; !reflect.DeepEqual(got.Removed, expected) {
+		t.Errorf("expected removed: %q, got: %q", expected, got.Removed)
+	}
+
+	if len(got.Changed) != 1 {
+		t.Fatalf("expected 1 changed documentation file, got: %d", len(got.Changed))
+	}
+
+	change := got.Changed[0]
+
+	if change.OldPath != "website/docs/r/thing.html.markdown" || change.Path != "docs/resources/thing.md" {
+		t.Errorf("unexpected changed paths: %s, %s", change.OldPath, change.Path)
+	}
+
+	if expected := []string{"type"}; !reflect.DeepEqual(change.Attributes.Added, expected) {
+		t.Errorf("expected added attributes: %q, got: %q", expected, change.Attributes.Added)
+	}
+
+	if expected := []string{"tags"}; !reflect.DeepEqual(change.Attributes.Removed, expected) {
+		t.Errorf("expected removed attributes: %q, got: %q", expected, change.Attributes.Removed)
+	}
+
+	var changed []string
+
+	for _, attribute := range change.Attributes.Changed {
+		changed = append(changed, attribute.String())
+	}
+
+	expected := []string{
+		"id (description changed)",
+		"name (now required, no longer optional, now forces new)",
+	}
+
+	if !reflect.DeepEqual(changed, expected) {
+		t.Errorf("expected changed attributes: %q, got: %q", expected, changed)
+	}
+}
+
+func compareTestDocumentationFiles(t *testing.T, opts *FileOptions, directories map[string][]string) []*DocumentationFile {
+	t.Helper()
+
+	files, err := DocumentationFiles(directories, opts, "test")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return files
+}
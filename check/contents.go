@@ -1,9 +1,12 @@
 package check
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/bflad/tfproviderdocs/check/contents"
+	tfjson "github.com/hashicorp/terraform-json"
 )
 
 type ContentsCheck struct {
@@ -14,9 +17,41 @@ type ContentsCheck struct {
 type ContentsOptions struct {
 	*FileOptions
 
-	Enable                bool
-	ProviderName          string
+	Enable bool
+
+	// IdentitySchemas, if set, contains the resource identity schemas for the
+	// identity section check.
+	IdentitySchemas map[string]*tfjson.IdentitySchema
+
+	// MissingAttributes, if set, records the undocumented schema attributes
+	// of each checked file with a schema.
+	MissingAttributes *MissingAttributes
+
+	ProviderName string
+
+	// RequireCalloutPrefix requires callouts to start with **Note:** or, for
+	// !> warning callouts, **Warning:**.
+	RequireCalloutPrefix bool
+
+	RequireIdentity    bool
+	RequireImportBlock bool
+
+	// RequireSchemaDescriptions enables verifying documented attribute
+	// descriptions are similar to the schema descriptions.
+	RequireSchemaDescriptions bool
+
 	RequireSchemaOrdering bool
+
+	// RequireSelfContainedExamples requires resources, data sources, and
+	// variables referenced in example code blocks to be declared in the same
+	// or an adjacent code block.
+	RequireSelfContainedExamples bool
+
+	SchemaOrderingStyle string
+
+	// Schemas, if set, contains the resource schemas for schema dependent
+	// contents checks, such as sensitive attribute documentation.
+	Schemas map[string]*tfjson.Schema
 }
 
 func NewContentsCheck(opts *ContentsOptions) *ContentsCheck {
@@ -40,27 +75,267 @@ func (check *ContentsCheck) Run(path string, exampleLanguage string) error {
 		return nil
 	}
 
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+
+	return check.RunContent(path, content, exampleLanguage)
+}
+
+// RunContent verifies the contents of the documentation file, which have
+// already been read, such as by the documentation file checks.
+func (check *ContentsCheck) RunContent(path string, content []byte, exampleLanguage string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	requireDescriptions := check.Options.CheckSelected(CheckIDContentsAttributeDescriptions)
 	checkOpts := &contents.CheckOptions{
 		ArgumentsSection: &contents.CheckArgumentsSectionOptions{
+			ForbidMetaArguments:   check.Options.CheckSelected(CheckIDContentsMetaArguments),
+			RequireDescriptions:   requireDescriptions,
 			RequireSchemaOrdering: check.Options.RequireSchemaOrdering,
+			SchemaOrderingStyle:   check.Options.SchemaOrderingStyle,
 		},
 		AttributesSection: &contents.CheckAttributesSectionOptions{
+			RequireDescriptions:   requireDescriptions,
 			RequireSchemaOrdering: check.Options.RequireSchemaOrdering,
+			SchemaOrderingStyle:   check.Options.SchemaOrderingStyle,
 		},
 		ExamplesSection: &contents.CheckExamplesSectionOptions{
 			ExpectedCodeBlockLanguage: exampleLanguage,
+			RequireSelfContained:      check.Options.RequireSelfContainedExamples,
+		},
+		ImportSection: &contents.CheckImportSectionOptions{
+			RequireImportBlock: check.Options.RequireImportBlock,
+		},
+		SchemaSection: &contents.CheckSchemaSectionOptions{
+			RequireDescriptions:   requireDescriptions,
+			RequireSchemaOrdering: check.Options.RequireSchemaOrdering,
+			SchemaOrderingStyle:   check.Options.SchemaOrderingStyle,
 		},
 	}
 
+	if check.Options.CheckSelected(CheckIDContentsCallouts) {
+		checkOpts.Callouts = &contents.CheckCalloutsOptions{
+			RequirePrefix: check.Options.RequireCalloutPrefix,
+		}
+	}
+
+	if check.Options.CheckSelected(CheckIDContentsDuplicateAttributes) {
+		checkOpts.DuplicateAttributes = &contents.CheckDuplicateAttributesOptions{}
+	}
+
 	doc := contents.NewDocument(path, check.Options.ProviderName)
 
-	if err := doc.Parse(); err != nil {
+	if schema, ok := check.Options.Schemas[doc.ResourceName]; ok && schema.Block != nil {
+		if check.Options.CheckSelected(CheckIDContentsSensitiveAttributes) {
+			checkOpts.SensitiveAttributes = &contents.CheckSensitiveAttributesOptions{
+				Attributes: schemaAttributeNames(schema.Block, func(attribute *tfjson.SchemaAttribute) bool { return attribute.Sensitive }),
+			}
+		}
+
+		if check.Options.RequireSchemaDescriptions && check.Options.CheckSelected(CheckIDContentsSchemaDescriptions) {
+			checkOpts.SchemaDescriptions = &contents.CheckSchemaDescriptionsOptions{
+				Descriptions: schemaAttributeDescriptions(schema.Block),
+			}
+		}
+
+		requireDescription := check.Options.CheckSelected(CheckIDContentsWriteOnlyAttributes)
+		requireDocumented := check.Options.CheckSelected(CheckIDContentsWriteOnlyAttributesMissing)
+
+		if requireDescription || requireDocumented {
+			checkOpts.WriteOnlyAttributes = &contents.CheckWriteOnlyAttributesOptions{
+				Attributes:         schemaWriteOnlyAttributes(schema.Block),
+				RequireDescription: requireDescription,
+				RequireDocumented:  requireDocumented,
+			}
+		}
+	}
+
+	if identitySchema, ok := check.Options.IdentitySchemas[doc.ResourceName]; ok && check.Options.RequireIdentity {
+		checkOpts.IdentitySection = &contents.CheckIdentitySectionOptions{
+			Attributes: identityAttributeNames(identitySchema),
+		}
+	}
+
+	if err := doc.ParseSource(content); err != nil {
 		return fmt.Errorf("error parsing file: %w", err)
 	}
 
+	check.Options.Log().Debug("Detected documentation contents format", "check", CheckIDContents, "file", path, "format", doc.Format())
+
+	if schema, ok := check.Options.Schemas[doc.ResourceName]; ok && schema.Block != nil {
+		check.Options.MissingAttributes.Add(doc.ResourceName, doc.MissingAttributes(schemaAttributeKinds(schema.Block)))
+	}
+
 	if err := doc.Check(checkOpts); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// contentsCheckID returns the check identifier for a contents check error.
+// Callout errors, duplicate attributes, example reference errors, identity
+// section errors, documented meta-arguments, missing attribute descriptions,
+// diverging schema descriptions, and undocumented write-only attributes are
+// reported separately from other contents findings.
+func contentsCheckID(err error) string {
+	var calloutErr *contents.CalloutError
+	var duplicateAttributeErr *contents.DuplicateAttributeError
+	var exampleReferenceErr *contents.ExampleReferenceError
+	var identitySectionErr *contents.IdentitySectionError
+	var metaArgumentErr *contents.MetaArgumentError
+	var missingAttributeErr *contents.MissingAttributeError
+	var missingDescriptionErr *contents.MissingDescriptionError
+	var schemaDescriptionErr *contents.SchemaDescriptionError
+
+	if errors.As(err, &calloutErr) {
+		return CheckIDContentsCallouts
+	}
+
+	if errors.As(err, &duplicateAttributeErr) {
+		return CheckIDContentsDuplicateAttributes
+	}
+
+	if errors.As(err, &exampleReferenceErr) {
+		return CheckIDContentsExampleReferences
+	}
+
+	if errors.As(err, &identitySectionErr) {
+		return CheckIDContentsIdentity
+	}
+
+	if errors.As(err, &metaArgumentErr) {
+		return CheckIDContentsMetaArguments
+	}
+
+	if errors.As(err, &missingDescriptionErr) {
+		return CheckIDContentsAttributeDescriptions
+	}
+
+	if errors.As(err, &schemaDescriptionErr) {
+		return CheckIDContentsSchemaDescriptions
+	}
+
+	if errors.As(err, &missingAttributeErr) {
+		return CheckIDContentsWriteOnlyAttributesMissing
+	}
+
+	return CheckIDContents
+}
+
+// schemaAttributeNames returns the names of attributes matching the function,
+// keyed by the nested block or nested attribute name, or an empty string for
+// the root block.
+func schemaAttributeNames(block *tfjson.SchemaBlock, fn func(*tfjson.SchemaAttribute) bool) map[string][]string {
+	result := make(map[string][]string)
+
+	walkSchemaAttributes(block, func(parent string, name string, attribute *tfjson.SchemaAttribute) {
+		if fn(attribute) {
+			result[parent] = append(result[parent], name)
+		}
+	})
+
+	for parent := range result {
+		sort.Strings(result[parent])
+	}
+
+	return result
+}
+
+// schemaAttributeDescriptions returns the non-empty attribute descriptions,
+// keyed by the nested block or nested attribute name, or an empty string for
+// the root block, then by the attribute name.
+func schemaAttributeDescriptions(block *tfjson.SchemaBlock) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+
+	walkSchemaAttributes(block, func(parent string, name string, attribute *tfjson.SchemaAttribute) {
+		if attribute.Description == "" {
+			return
+		}
+
+		if result[parent] == nil {
+			result[parent] = make(map[string]string)
+		}
+
+		result[parent][name] = attribute.Description
+	})
+
+	return result
+}
+
+// walkSchemaAttributes calls the function for each attribute of the block,
+// its nested attributes, and its nested blocks with the nested block or
+// nested attribute name, or an empty string for the root block.
+func walkSchemaAttributes(block *tfjson.SchemaBlock, fn func(parent string, name string, attribute *tfjson.SchemaAttribute)) {
+	var walkAttributes func(string, map[string]*tfjson.SchemaAttribute)
+	var walkBlock func(string, *tfjson.SchemaBlock)
+
+	walkAttributes = func(parent string, attributes map[string]*tfjson.SchemaAttribute) {
+		for name, attribute := range attributes {
+			if attribute == nil {
+				continue
+			}
+
+			fn(parent, name, attribute)
+
+			if attribute.AttributeNestedType != nil {
+				walkAttributes(name, attribute.AttributeNestedType.Attributes)
+			}
+		}
+	}
+
+	walkBlock = func(parent string, block *tfjson.SchemaBlock) {
+		walkAttributes(parent, block.Attributes)
+
+		for name, blockType := range block.NestedBlocks {
+			if blockType != nil && blockType.Block != nil {
+				walkBlock(name, blockType.Block)
+			}
+		}
+	}
+
+	walkBlock("", block)
+}
+
+// schemaWriteOnlyAttributes returns the write-only attributes, keyed by the
+// nested block or nested attribute name, or an empty string for the root
+// block. By convention, the version attribute of a write-only attribute has
+// the same name with a _version suffix (e.g. password_wo_version).
+func schemaWriteOnlyAttributes(block *tfjson.SchemaBlock) map[string][]*contents.WriteOnlyAttribute {
+	allNames := schemaAttributeNames(block, func(*tfjson.SchemaAttribute) bool { return true })
+	result := make(map[string][]*contents.WriteOnlyAttribute)
+
+	for parent, names := range schemaAttributeNames(block, func(attribute *tfjson.SchemaAttribute) bool { return attribute.WriteOnly }) {
+		for _, name := range names {
+			attribute := &contents.WriteOnlyAttribute{
+				Name: name,
+			}
+
+			if containsString(allNames[parent], name+"_version") {
+				attribute.VersionAttribute = name + "_version"
+			}
+
+			result[parent] = append(result[parent], attribute)
+		}
+	}
+
+	return result
+}
+
+// identityAttributeNames returns the sorted names of the identity attributes.
+func identityAttributeNames(schema *tfjson.IdentitySchema) []string {
+	result := make([]string, 0, len(schema.Attributes))
+
+	for name := range schema.Attributes {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -3,7 +3,16 @@ package contents
 type CheckOptions struct {
 	ArgumentsSection  *CheckArgumentsSectionOptions
 	AttributesSection *CheckAttributesSectionOptions
+	Callouts          *CheckCalloutsOptions
 	ExamplesSection   *CheckExamplesSectionOptions
+	IdentitySection   *CheckIdentitySectionOptions
+	ImportSection     *CheckImportSectionOptions
+	SchemaSection     *CheckSchemaSectionOptions
+
+	DuplicateAttributes *CheckDuplicateAttributesOptions
+	SchemaDescriptions  *CheckSchemaDescriptionsOptions
+	SensitiveAttributes *CheckSensitiveAttributesOptions
+	WriteOnlyAttributes *CheckWriteOnlyAttributesOptions
 }
 
 func (d *Document) Check(opts *CheckOptions) error {
@@ -25,6 +34,26 @@ func (d *Document) Check(opts *CheckOptions) error {
 		return err
 	}
 
+	if err := d.checkSchemaSection(); err != nil {
+		return err
+	}
+
+	if err := d.checkDuplicateAttributes(); err != nil {
+		return err
+	}
+
+	if err := d.checkSensitiveAttributes(); err != nil {
+		return err
+	}
+
+	if err := d.checkWriteOnlyAttributes(); err != nil {
+		return err
+	}
+
+	if err := d.checkSchemaDescriptions(); err != nil {
+		return err
+	}
+
 	if err := d.checkTimeoutsSection(); err != nil {
 		return err
 	}
@@ -33,5 +62,13 @@ func (d *Document) Check(opts *CheckOptions) error {
 		return err
 	}
 
+	if err := d.checkIdentitySection(); err != nil {
+		return err
+	}
+
+	if err := d.checkCallouts(); err != nil {
+		return err
+	}
+
 	return nil
 }
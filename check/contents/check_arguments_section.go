@@ -2,11 +2,32 @@ package contents
 
 import (
 	"fmt"
-	"sort"
 )
 
+// MetaArguments contains the Terraform meta-arguments, which are available
+// in all resources and should not be documented as resource arguments.
+var MetaArguments = []string{
+	"count",
+	"depends_on",
+	"for_each",
+	"lifecycle",
+	"provider",
+}
+
 type CheckArgumentsSectionOptions struct {
+	// ForbidMetaArguments reports root schema attribute list items which are
+	// Terraform meta-arguments, such as count.
+	ForbidMetaArguments bool
+
+	// RequireDescriptions requires each schema attribute list item to have a
+	// description after the name and traits.
+	RequireDescriptions bool
+
 	RequireSchemaOrdering bool
+
+	// SchemaOrderingStyle is the style of RequireSchemaOrdering. Defaults to
+	// SchemaOrderingStyleAlphabetical.
+	SchemaOrderingStyle string
 }
 
 func (d *Document) checkArgumentsSection() error {
@@ -16,6 +37,11 @@ func (d *Document) checkArgumentsSection() error {
 		checkOpts = d.CheckOptions.ArgumentsSection
 	}
 
+	// The schema section documents the arguments instead
+	if d.Format() == DocumentFormatSchema {
+		return nil
+	}
+
 	section := d.Sections.Arguments
 
 	if section == nil {
@@ -35,13 +61,40 @@ func (d *Document) checkArgumentsSection() error {
 		return fmt.Errorf("arguments section heading (%s) should be: %s", headingText, expectedHeadingText)
 	}
 
-	if checkOpts.RequireSchemaOrdering {
+	if checkOpts.ForbidMetaArguments {
 		for _, list := range section.SchemaAttributeLists {
-			if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
-				return fmt.Errorf("arguments section is not sorted by name")
+			for _, item := range list.Items {
+				if containsString(MetaArguments, item.Name) {
+					return &MetaArgumentError{
+						Name: item.Name,
+					}
+				}
 			}
 		}
 	}
 
+	if checkOpts.RequireDescriptions {
+		if err := checkSchemaAttributeSectionDescriptions("arguments", (*SchemaAttributeSection)(section), d.source); err != nil {
+			return err
+		}
+	}
+
+	if checkOpts.RequireSchemaOrdering {
+		if err := checkSchemaAttributeSectionOrdering("arguments", (*SchemaAttributeSection)(section), checkOpts.SchemaOrderingStyle, d.source); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// MetaArgumentError is returned when the arguments section documents a
+// Terraform meta-argument, so it can be reported separately from other
+// contents errors.
+type MetaArgumentError struct {
+	Name string
+}
+
+func (e *MetaArgumentError) Error() string {
+	return fmt.Sprintf("arguments section attribute (%s) is a Terraform meta-argument, which should not be documented as a resource argument", e.Name)
+}
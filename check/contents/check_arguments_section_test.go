@@ -17,6 +17,32 @@ func TestCheckArgumentsSection(t *testing.T) {
 			Path:         "testdata/arguments/passing.md",
 			ProviderName: "test",
 		},
+		{
+			Name:         "meta-argument",
+			Path:         "testdata/arguments/meta_argument.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					ForbidMetaArguments: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "meta-argument not forbidden",
+			Path:         "testdata/arguments/meta_argument.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing nested meta-argument",
+			Path:         "testdata/arguments/passing_nested_meta_argument.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					ForbidMetaArguments: true,
+				},
+			},
+		},
 		{
 			Name:         "missing heading",
 			Path:         "testdata/arguments/missing_heading.md",
@@ -51,6 +77,119 @@ func TestCheckArgumentsSection(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name:         "passing nested",
+			Path:         "testdata/arguments/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+		},
+		{
+			Name:         "wrong nested list order",
+			Path:         "testdata/arguments/wrong_nested_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "required optional separate lists alphabetical style",
+			Path:         "testdata/arguments/required_optional_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+		},
+		{
+			Name:         "required optional single list alphabetical style",
+			Path:         "testdata/arguments/required_optional_single_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "required optional single list required optional style",
+			Path:         "testdata/arguments/required_optional_single_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+					SchemaOrderingStyle:   SchemaOrderingStyleRequiredOptional,
+				},
+			},
+		},
+		{
+			Name:         "wrong list order required optional style",
+			Path:         "testdata/arguments/wrong_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireSchemaOrdering: true,
+					SchemaOrderingStyle:   SchemaOrderingStyleRequiredOptional,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "passing descriptions",
+			Path:         "testdata/arguments/passing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireDescriptions: true,
+				},
+			},
+		},
+		{
+			Name:         "missing description",
+			Path:         "testdata/arguments/missing_description.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireDescriptions: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing description after traits",
+			Path:         "testdata/arguments/missing_description_traits.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireDescriptions: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing nested description",
+			Path:         "testdata/arguments/missing_nested_description.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ArgumentsSection: &CheckArgumentsSectionOptions{
+					RequireDescriptions: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing description not required",
+			Path:         "testdata/arguments/missing_description.md",
+			ProviderName: "test",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2,11 +2,18 @@ package contents
 
 import (
 	"fmt"
-	"sort"
 )
 
 type CheckAttributesSectionOptions struct {
+	// RequireDescriptions requires each schema attribute list item to have a
+	// description after the name and traits.
+	RequireDescriptions bool
+
 	RequireSchemaOrdering bool
+
+	// SchemaOrderingStyle is the style of RequireSchemaOrdering. Defaults to
+	// SchemaOrderingStyleAlphabetical.
+	SchemaOrderingStyle string
 }
 
 func (d *Document) checkAttributesSection() error {
@@ -16,6 +23,11 @@ func (d *Document) checkAttributesSection() error {
 		checkOpts = d.CheckOptions.AttributesSection
 	}
 
+	// The schema section documents the attributes instead
+	if d.Format() == DocumentFormatSchema {
+		return nil
+	}
+
 	section := d.Sections.Attributes
 
 	if section == nil {
@@ -52,11 +64,15 @@ func (d *Document) checkAttributesSection() error {
 		}
 	}
 
+	if checkOpts.RequireDescriptions {
+		if err := checkSchemaAttributeSectionDescriptions("attributes", (*SchemaAttributeSection)(section), d.source); err != nil {
+			return err
+		}
+	}
+
 	if checkOpts.RequireSchemaOrdering {
-		for _, list := range section.SchemaAttributeLists {
-			if !sort.IsSorted(SchemaAttributeListItemByName(list.Items)) {
-				return fmt.Errorf("attributes section is not sorted by name")
-			}
+		if err := checkSchemaAttributeSectionOrdering("attributes", (*SchemaAttributeSection)(section), checkOpts.SchemaOrderingStyle, d.source); err != nil {
+			return err
 		}
 	}
 
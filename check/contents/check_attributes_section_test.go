@@ -68,6 +68,28 @@ func TestCheckAttributesSection(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name:         "wrong nested list order",
+			Path:         "testdata/attributes/wrong_nested_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing description",
+			Path:         "testdata/attributes/missing_description.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				AttributesSection: &CheckAttributesSectionOptions{
+					RequireDescriptions: true,
+				},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,125 @@
+package contents
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+var (
+	// calloutLabelRegexp matches a bold callout label, e.g. **Note:**,
+	// **NOTE**, or **Warning**:.
+	calloutLabelRegexp = regexp.MustCompile(`(?i)^\*\*(caution|important|note|tip|warning):?\*\*:?`)
+
+	// calloutPrefixRegexp matches the standard callout prefixes.
+	calloutPrefixRegexp = regexp.MustCompile(`^\*\*(Note|Warning):\*\*`)
+
+	// calloutMarkers are the Terraform Registry callout markers.
+	calloutMarkers = []string{"->", "~>", "!>"}
+)
+
+type CheckCalloutsOptions struct {
+	// RequirePrefix requires callouts to start with **Note:** or, for !>
+	// warning callouts, **Warning:** after the marker.
+	RequirePrefix bool
+}
+
+// CalloutError is returned for malformed callouts, so it can be reported
+// separately from other contents errors.
+type CalloutError struct {
+	Err error
+}
+
+func (e *CalloutError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CalloutError) Unwrap() error {
+	return e.Err
+}
+
+// checkCallouts verifies Terraform Registry callouts (->, ~>, and !>) render
+// correctly. The marker must be followed by a space (e.g. not ~>**Note:**)
+// and paragraphs starting with a callout label (e.g. **Note:**) must have a
+// marker.
+func (d *Document) checkCallouts() error {
+	if d.CheckOptions == nil || d.CheckOptions.Callouts == nil {
+		return nil
+	}
+
+	// Callouts are only rendered for top level paragraphs, e.g. not within
+	// list items.
+	for node := d.document.FirstChild(); node != nil; node = node.NextSibling() {
+		paragraph, ok := node.(*ast.Paragraph)
+
+		if !ok || paragraph.Lines().Len() == 0 {
+			continue
+		}
+
+		segment := paragraph.Lines().At(0)
+		line := strings.TrimSpace(string(segment.Value(d.source)))
+
+		if err := d.checkCallout(line); err != nil {
+			return &CalloutError{
+				Err: err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkCallout verifies the first line of a paragraph.
+func (d *Document) checkCallout(line string) error {
+	var marker string
+
+	for _, calloutMarker := range calloutMarkers {
+		if strings.HasPrefix(line, calloutMarker) {
+			marker = calloutMarker
+
+			break
+		}
+	}
+
+	if marker == "" {
+		if label := calloutLabelRegexp.FindString(line); label != "" {
+			return fmt.Errorf("callout label (%s) missing callout marker (->, ~>, or !>): %s", label, line)
+		}
+
+		return nil
+	}
+
+	rest := strings.TrimPrefix(line, marker)
+
+	if rest == "" {
+		return fmt.Errorf("empty callout: %s", line)
+	}
+
+	if rest[0] != ' ' && rest[0] != '\t' {
+		return fmt.Errorf("callout marker (%s) must be followed by a space: %s", marker, line)
+	}
+
+	if !d.CheckOptions.Callouts.RequirePrefix {
+		return nil
+	}
+
+	rest = strings.TrimSpace(rest)
+
+	if !calloutPrefixRegexp.MatchString(rest) {
+		expected := "**Note:**"
+
+		if marker == "!>" {
+			expected = "**Note:** or **Warning:**"
+		}
+
+		return fmt.Errorf("callout should start with %s after the marker (%s): %s", expected, marker, line)
+	}
+
+	if marker != "!>" && strings.HasPrefix(rest, "**Warning:**") {
+		return fmt.Errorf("callout should start with **Note:** after the marker (%s), use the !> marker for warnings: %s", marker, line)
+	}
+
+	return nil
+}
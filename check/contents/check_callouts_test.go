@@ -0,0 +1,100 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestCheckCallouts(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		CheckOptions *CheckOptions
+		ExpectError  bool
+	}{
+		{
+			Name: "disabled",
+			Path: "testdata/callouts/missing_space.md",
+		},
+		{
+			Name: "passing",
+			Path: "testdata/callouts/passing.md",
+			CheckOptions: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{},
+			},
+		},
+		{
+			Name: "passing require prefix",
+			Path: "testdata/callouts/passing.md",
+			CheckOptions: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequirePrefix: true,
+				},
+			},
+		},
+		{
+			Name: "missing space",
+			Path: "testdata/callouts/missing_space.md",
+			CheckOptions: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "missing marker",
+			Path: "testdata/callouts/missing_marker.md",
+			CheckOptions: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "nonstandard prefix",
+			Path: "testdata/callouts/nonstandard_prefix.md",
+			CheckOptions: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{},
+			},
+		},
+		{
+			Name: "nonstandard prefix require prefix",
+			Path: "testdata/callouts/nonstandard_prefix.md",
+			CheckOptions: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequirePrefix: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "warning prefix require prefix",
+			Path: "testdata/callouts/warning_prefix.md",
+			CheckOptions: &CheckOptions{
+				Callouts: &CheckCalloutsOptions{
+					RequirePrefix: true,
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.CheckOptions
+
+			got := doc.checkCallouts()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
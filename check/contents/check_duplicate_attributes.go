@@ -0,0 +1,169 @@
+package contents
+
+import (
+	"fmt"
+	"strings"
+)
+
+type CheckDuplicateAttributesOptions struct{}
+
+// DuplicateAttributeError is returned when an attribute is listed more than
+// once, such as after resolving a merge conflict, so it can be reported
+// separately from other contents errors.
+type DuplicateAttributeError struct {
+	// Section describes the section, e.g. arguments section, including any
+	// nested section heading.
+	Section string
+
+	Name string
+
+	// Conflicting is true if the attribute is listed in both the arguments
+	// and attributes sections with different descriptions.
+	Conflicting bool
+}
+
+func (e *DuplicateAttributeError) Error() string {
+	if e.Conflicting {
+		return fmt.Sprintf("attribute (%s) is documented in the %s with conflicting descriptions", e.Name, e.Section)
+	}
+
+	return fmt.Sprintf("%s contains duplicate attribute: %s", e.Section, e.Name)
+}
+
+// checkDuplicateAttributes verifies each attribute is listed once in the
+// arguments, attributes, and schema sections and each of their nested
+// sections. Root attributes listed in both the arguments and attributes
+// sections, such as optional and computed attributes, must have the same
+// description.
+func (d *Document) checkDuplicateAttributes() error {
+	if d.CheckOptions == nil || d.CheckOptions.DuplicateAttributes == nil {
+		return nil
+	}
+
+	if section := d.Sections.Arguments; section != nil {
+		if err := checkSchemaAttributeSectionDuplicates("arguments", (*SchemaAttributeSection)(section), nil, d.source); err != nil {
+			return err
+		}
+	}
+
+	if section := d.Sections.Attributes; section != nil {
+		if err := checkSchemaAttributeSectionDuplicates("attributes", (*SchemaAttributeSection)(section), nil, d.source); err != nil {
+			return err
+		}
+	}
+
+	if section := d.Sections.Schema; section != nil {
+		// terraform-plugin-docs schema sections split the root attributes
+		// into Required, Optional, and Read-Only nested sections.
+		var roots []*SchemaAttributeSection
+
+		for _, child := range section.Children {
+			if !strings.HasPrefix(string(child.Heading.Text(d.source)), "Nested Schema for") {
+				roots = append(roots, child)
+			}
+		}
+
+		if err := checkSchemaAttributeSectionDuplicates("schema", (*SchemaAttributeSection)(section), roots, d.source); err != nil {
+			return err
+		}
+	}
+
+	if d.Sections.Arguments == nil || d.Sections.Attributes == nil {
+		return nil
+	}
+
+	for _, list := range d.Sections.Attributes.SchemaAttributeLists {
+		for _, item := range list.Items {
+			if item.Name == "" {
+				continue
+			}
+
+			argument := (*SchemaAttributeSection)(d.Sections.Arguments).item(item.Name)
+
+			if argument == nil {
+				continue
+			}
+
+			if normalizeDescription(argument.Description) != normalizeDescription(item.Description) {
+				return &DuplicateAttributeError{
+					Conflicting: true,
+					Name:        item.Name,
+					Section:     "arguments and attributes sections",
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSchemaAttributeSectionDuplicates verifies the schema attribute lists of
+// the section and the roots, which list the root attributes of the section,
+// contain each name once. Each other nested section is verified separately.
+func checkSchemaAttributeSectionDuplicates(sectionName string, section *SchemaAttributeSection, roots []*SchemaAttributeSection, source []byte) error {
+	names := make(map[string]bool)
+	lists := section.SchemaAttributeLists
+
+	for _, root := range roots {
+		lists = append(lists, root.SchemaAttributeLists...)
+	}
+
+	if name := schemaAttributeListsDuplicate(lists, names); name != "" {
+		return &DuplicateAttributeError{
+			Name:    name,
+			Section: sectionName + " section",
+		}
+	}
+
+	for _, child := range section.Children {
+		if containsSection(roots, child) {
+			continue
+		}
+
+		if name := schemaAttributeListsDuplicate(child.SchemaAttributeLists, make(map[string]bool)); name != "" {
+			return &DuplicateAttributeError{
+				Name:    name,
+				Section: fmt.Sprintf("%s section nested section (%s)", sectionName, child.Heading.Text(source)),
+			}
+		}
+	}
+
+	return nil
+}
+
+// schemaAttributeListsDuplicate returns the first named item of the lists
+// which is already in names, adding each name to names.
+func schemaAttributeListsDuplicate(lists []*SchemaAttributeList, names map[string]bool) string {
+	for _, list := range lists {
+		for _, item := range list.Items {
+			if item.Name == "" {
+				continue
+			}
+
+			if names[item.Name] {
+				return item.Name
+			}
+
+			names[item.Name] = true
+		}
+	}
+
+	return ""
+}
+
+func containsSection(sections []*SchemaAttributeSection, section *SchemaAttributeSection) bool {
+	for _, s := range sections {
+		if s == section {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeDescription returns the description without case, surrounding
+// whitespace, repeated whitespace, or a trailing period, so descriptions
+// only differing in formatting are equal.
+func normalizeDescription(description string) string {
+	return strings.TrimSuffix(strings.Join(strings.Fields(strings.ToLower(description)), " "), ".")
+}
@@ -0,0 +1,87 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestCheckDuplicateAttributes(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		CheckOptions *CheckOptions
+		ExpectError  bool
+	}{
+		{
+			Name: "disabled",
+			Path: "testdata/duplicate_attributes/duplicate_argument.md",
+		},
+		{
+			Name: "passing",
+			Path: "testdata/duplicate_attributes/passing.md",
+			CheckOptions: &CheckOptions{
+				DuplicateAttributes: &CheckDuplicateAttributesOptions{},
+			},
+		},
+		{
+			Name: "passing schema",
+			Path: "testdata/duplicate_attributes/passing_schema.md",
+			CheckOptions: &CheckOptions{
+				DuplicateAttributes: &CheckDuplicateAttributesOptions{},
+			},
+		},
+		{
+			Name: "conflicting attribute",
+			Path: "testdata/duplicate_attributes/conflicting_attribute.md",
+			CheckOptions: &CheckOptions{
+				DuplicateAttributes: &CheckDuplicateAttributesOptions{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "duplicate argument",
+			Path: "testdata/duplicate_attributes/duplicate_argument.md",
+			CheckOptions: &CheckOptions{
+				DuplicateAttributes: &CheckDuplicateAttributesOptions{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "duplicate nested argument",
+			Path: "testdata/duplicate_attributes/duplicate_nested_argument.md",
+			CheckOptions: &CheckOptions{
+				DuplicateAttributes: &CheckDuplicateAttributesOptions{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "duplicate schema",
+			Path: "testdata/duplicate_attributes/duplicate_schema.md",
+			CheckOptions: &CheckOptions{
+				DuplicateAttributes: &CheckDuplicateAttributesOptions{},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.CheckOptions
+
+			got := doc.checkDuplicateAttributes()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
@@ -2,13 +2,45 @@ package contents
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/bflad/tfproviderdocs/markdown"
 )
 
+var (
+	// exampleDeclarationRegexp matches resource, data source, and variable
+	// block headers, e.g. resource "aws_vpc" "example" {.
+	exampleDeclarationRegexp = regexp.MustCompile(`(?m)^\s*(data|resource|variable)\s+"([^"]+)"(?:\s+"([^"]+)")?\s*\{`)
+
+	// exampleTraversalRegexp matches attribute traversals, e.g.
+	// aws_vpc.example.id.
+	exampleTraversalRegexp = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_-]*(?:\.[A-Za-z_][A-Za-z0-9_-]*)+`)
+)
+
 type CheckExamplesSectionOptions struct {
 	ExpectedCodeBlockLanguage string
+
+	// RequireSelfContained requires resources, data sources, and variables
+	// referenced in example code blocks to be declared in the same or an
+	// adjacent code block of the example section.
+	RequireSelfContained bool
+}
+
+// ExampleReferenceError is returned when example code blocks reference
+// undeclared resources, data sources, or variables, so it can be reported
+// separately from other contents errors.
+type ExampleReferenceError struct {
+	Err error
+}
+
+func (e *ExampleReferenceError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExampleReferenceError) Unwrap() error {
+	return e.Err
 }
 
 func (d *Document) checkExampleSection() error {
@@ -58,5 +90,242 @@ func (d *Document) checkExampleSection() error {
 		}
 	}
 
-	return nil
+	if !checkOpts.RequireSelfContained {
+		return nil
+	}
+
+	return d.checkExampleReferences(section)
+}
+
+// checkExampleReferences verifies the resources (TYPE.NAME), data sources
+// (data.TYPE.NAME), and variables (var.NAME) referenced in each example code
+// block are declared in the same code block or the previous or next code
+// block, so examples can be copied without other configuration.
+func (d *Document) checkExampleReferences(section *ExampleSection) error {
+	declarations := make([]map[string]bool, len(section.FencedCodeBlocks))
+	references := make([][]string, len(section.FencedCodeBlocks))
+
+	for i, fencedCodeBlock := range section.FencedCodeBlocks {
+		declarations[i], references[i] = exampleReferences(markdown.FencedCodeBlockText(fencedCodeBlock, d.source))
+	}
+
+	var undeclared []string
+
+	for i := range section.FencedCodeBlocks {
+		for _, reference := range references[i] {
+			if declarations[i][reference] || (i > 0 && declarations[i-1][reference]) || (i < len(declarations)-1 && declarations[i+1][reference]) {
+				continue
+			}
+
+			if !containsString(undeclared, reference) {
+				undeclared = append(undeclared, reference)
+			}
+		}
+	}
+
+	if len(undeclared) == 0 {
+		return nil
+	}
+
+	sort.Strings(undeclared)
+
+	return &ExampleReferenceError{
+		Err: fmt.Errorf("example section code blocks reference undeclared resources, data sources, or variables (%s), which should be declared in the same or an adjacent code block", strings.Join(undeclared, ", ")),
+	}
+}
+
+// exampleReferences returns the addresses of resources (TYPE.NAME), data
+// sources (data.TYPE.NAME), and variables (var.NAME) declared and referenced
+// in the Terraform configuration. Resource types are recognized by their
+// provider prefix (e.g. aws_), which other references, such as local.NAME or
+// each.value, do not have.
+func exampleReferences(text string) (map[string]bool, []string) {
+	declarations := make(map[string]bool)
+
+	for _, match := range exampleDeclarationRegexp.FindAllStringSubmatch(text, -1) {
+		switch match[1] {
+		case "data":
+			declarations["data."+match[2]+"."+match[3]] = true
+		case "resource":
+			declarations[match[2]+"."+match[3]] = true
+		case "variable":
+			declarations["var."+match[2]] = true
+		}
+	}
+
+	var references []string
+	expressions := exampleExpressionText(text)
+
+	for _, index := range exampleTraversalRegexp.FindAllStringIndex(expressions, -1) {
+		// Skip attributes of other traversals and provider functions, e.g.
+		// provider::aws::arn_parse
+		if index[0] > 0 && strings.ContainsRune(".:", rune(expressions[index[0]-1])) {
+			continue
+		}
+
+		parts := strings.Split(expressions[index[0]:index[1]], ".")
+
+		var reference string
+
+		switch {
+		case parts[0] == "data" && len(parts) >= 3:
+			reference = strings.Join(parts[:3], ".")
+		case parts[0] == "var":
+			reference = strings.Join(parts[:2], ".")
+		case strings.Contains(parts[0], "_"):
+			reference = strings.Join(parts[:2], ".")
+		default:
+			continue
+		}
+
+		if !containsString(references, reference) {
+			references = append(references, reference)
+		}
+	}
+
+	return declarations, references
+}
+
+// exampleExpressionText returns the Terraform configuration without comments
+// and string literals, keeping template interpolations and directives (e.g.
+// ${aws_vpc.example.id}), so only expressions remain.
+func exampleExpressionText(text string) string {
+	scanner := &exampleExpressionScanner{
+		src: []rune(text),
+	}
+
+	scanner.code(false)
+
+	return scanner.result.String()
+}
+
+// exampleExpressionScanner is a lenient scanner of Terraform configuration
+// expressions. Unterminated strings end at the end of the line.
+type exampleExpressionScanner struct {
+	pos    int
+	result strings.Builder
+	src    []rune
+}
+
+func (s *exampleExpressionScanner) peekAt(offset int) rune {
+	if s.pos+offset >= len(s.src) {
+		return 0
+	}
+
+	return s.src[s.pos+offset]
+}
+
+// code scans expressions until the end of the source or, within a template
+// interpolation, the closing brace.
+func (s *exampleExpressionScanner) code(interpolation bool) {
+	var depth int
+
+	for s.pos < len(s.src) {
+		switch c := s.src[s.pos]; {
+		case c == '#' || (c == '/' && s.peekAt(1) == '/'):
+			for s.pos < len(s.src) && s.src[s.pos] != '\n' {
+				s.pos++
+			}
+		case c == '/' && s.peekAt(1) == '*':
+			s.pos += 2
+
+			for s.pos < len(s.src) && !(s.src[s.pos] == '*' && s.peekAt(1) == '/') {
+				s.pos++
+			}
+
+			s.pos += 2
+		case c == '"':
+			s.pos++
+			s.result.WriteRune(' ')
+			s.template("")
+			s.result.WriteRune(' ')
+		case c == '<' && s.peekAt(1) == '<':
+			s.pos += 2
+
+			if s.peekAt(0) == '-' {
+				s.pos++
+			}
+
+			start := s.pos
+
+			for s.pos < len(s.src) && s.src[s.pos] != '\n' {
+				s.pos++
+			}
+
+			marker := strings.TrimSpace(string(s.src[start:s.pos]))
+			s.result.WriteRune(' ')
+			s.template(marker)
+		case c == '{':
+			depth++
+			s.result.WriteRune(c)
+			s.pos++
+		case c == '}':
+			s.pos++
+
+			if interpolation && depth == 0 {
+				return
+			}
+
+			depth--
+			s.result.WriteRune(c)
+		default:
+			s.result.WriteRune(c)
+			s.pos++
+		}
+	}
+}
+
+// template scans a quoted string, or a heredoc if the marker is given, and
+// its template interpolations and directives.
+func (s *exampleExpressionScanner) template(heredocMarker string) {
+	lineStart := true
+
+	for s.pos < len(s.src) {
+		if heredocMarker != "" && lineStart {
+			end := s.pos
+
+			for end < len(s.src) && s.src[end] != '\n' {
+				end++
+			}
+
+			if strings.TrimSpace(string(s.src[s.pos:end])) == heredocMarker {
+				s.pos = end
+
+				return
+			}
+		}
+
+		c := s.src[s.pos]
+		lineStart = c == '\n'
+
+		switch {
+		case c == '\n' && heredocMarker == "":
+			return
+		case c == '\\' && heredocMarker == "":
+			s.pos += 2
+		case c == '"' && heredocMarker == "":
+			s.pos++
+
+			return
+		case (c == '$' || c == '%') && s.peekAt(1) == c && s.peekAt(2) == '{':
+			s.pos += 3
+		case (c == '$' || c == '%') && s.peekAt(1) == '{':
+			s.pos += 2
+			s.result.WriteRune(' ')
+			s.code(true)
+			s.result.WriteRune(' ')
+		default:
+			s.pos++
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
 }
@@ -1,7 +1,10 @@
 package contents
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/bflad/tfproviderdocs/markdown"
 )
 
 func TestCheckExampleSection(t *testing.T) {
@@ -9,6 +12,7 @@ func TestCheckExampleSection(t *testing.T) {
 		Name         string
 		Path         string
 		ProviderName string
+		CheckOptions *CheckOptions
 		ExpectError  bool
 	}{
 		{
@@ -40,6 +44,34 @@ func TestCheckExampleSection(t *testing.T) {
 			ProviderName: "test",
 			ExpectError:  true,
 		},
+		{
+			Name:         "self contained",
+			Path:         "testdata/example/self_contained.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: markdown.FencedCodeBlockLanguageTerraform,
+					RequireSelfContained:      true,
+				},
+			},
+		},
+		{
+			Name:         "not self contained",
+			Path:         "testdata/example/not_self_contained.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ExamplesSection: &CheckExamplesSectionOptions{
+					ExpectedCodeBlockLanguage: markdown.FencedCodeBlockLanguageTerraform,
+					RequireSelfContained:      true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "not self contained not required",
+			Path:         "testdata/example/not_self_contained.md",
+			ProviderName: "test",
+		},
 		{
 			Name:         "wrong code block language",
 			Path:         "testdata/example/wrong_code_block_language.md",
@@ -56,6 +88,8 @@ func TestCheckExampleSection(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err)
 			}
 
+			doc.CheckOptions = testCase.CheckOptions
+
 			got := doc.checkExampleSection()
 
 			if got == nil && testCase.ExpectError {
@@ -68,3 +102,88 @@ func TestCheckExampleSection(t *testing.T) {
 		})
 	}
 }
+
+func TestExampleReferences(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Text               string
+		ExpectDeclarations map[string]bool
+		ExpectReferences   []string
+	}{
+		{
+			Name: "declarations",
+			Text: `
+data "test_zone" "example" {}
+
+resource "test_thing" "example" {}
+
+variable "name" {}
+`,
+			ExpectDeclarations: map[string]bool{
+				"data.test_zone.example": true,
+				"test_thing.example":     true,
+				"var.name":               true,
+			},
+		},
+		{
+			Name: "references",
+			Text: `
+resource "test_thing" "example" {
+  count      = length(var.names)
+  name       = var.names[count.index]
+  network_id = test_network.example[0].id
+  zone_ids   = data.test_zone.example[*].id
+  depends_on = [test_other.example]
+  arn        = provider::test::arn_build(local.partition)
+  template   = "${test_template.example.id}-%{ if var.enabled }on%{ endif }"
+}
+`,
+			ExpectDeclarations: map[string]bool{
+				"test_thing.example": true,
+			},
+			ExpectReferences: []string{
+				"var.names",
+				"test_network.example",
+				"data.test_zone.example",
+				"test_other.example",
+				"test_template.example",
+				"var.enabled",
+			},
+		},
+		{
+			Name: "comments and strings",
+			Text: `
+# test_comment.example.id
+// test_comment.example.id
+/* test_comment.example.id */
+resource "test_thing" "example" {
+  name = "test_string.example.id \"test_string.example\""
+
+  policy = <<-EOT
+    test_heredoc.example.id ${var.policy}
+  EOT
+}
+`,
+			ExpectDeclarations: map[string]bool{
+				"test_thing.example": true,
+			},
+			ExpectReferences: []string{
+				"var.policy",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			gotDeclarations, gotReferences := exampleReferences(testCase.Text)
+
+			if !reflect.DeepEqual(gotDeclarations, testCase.ExpectDeclarations) {
+				t.Errorf("expected declarations: %v, got: %v", testCase.ExpectDeclarations, gotDeclarations)
+			}
+
+			if !reflect.DeepEqual(gotReferences, testCase.ExpectReferences) {
+				t.Errorf("expected references: %v, got: %v", testCase.ExpectReferences, gotReferences)
+			}
+		})
+	}
+}
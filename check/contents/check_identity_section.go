@@ -0,0 +1,70 @@
+package contents
+
+import (
+	"fmt"
+	"sort"
+)
+
+type CheckIdentitySectionOptions struct {
+	// Attributes contains the names of the resource identity attributes.
+	Attributes []string
+}
+
+// IdentitySectionError is returned when the identity section is missing or
+// incomplete, so it can be reported separately from other contents errors.
+type IdentitySectionError struct {
+	Err error
+}
+
+func (e *IdentitySectionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *IdentitySectionError) Unwrap() error {
+	return e.Err
+}
+
+// checkIdentitySection verifies resources with an identity schema document
+// each identity attribute in an identity section (e.g. ### Identity Schema),
+// which is used for import blocks with the identity argument.
+func (d *Document) checkIdentitySection() error {
+	if d.CheckOptions == nil || d.CheckOptions.IdentitySection == nil || len(d.CheckOptions.IdentitySection.Attributes) == 0 {
+		return nil
+	}
+
+	section := (*SchemaAttributeSection)(d.Sections.Identity)
+
+	if section == nil {
+		return &IdentitySectionError{
+			Err: fmt.Errorf("missing identity section: ### Identity Schema"),
+		}
+	}
+
+	attributes := make([]string, len(d.CheckOptions.IdentitySection.Attributes))
+	copy(attributes, d.CheckOptions.IdentitySection.Attributes)
+	sort.Strings(attributes)
+
+	for _, attribute := range attributes {
+		if section.item(attribute) != nil {
+			continue
+		}
+
+		var found bool
+
+		for _, child := range section.Children {
+			if child.item(attribute) != nil {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return &IdentitySectionError{
+				Err: fmt.Errorf("identity section should document identity attribute: %s", attribute),
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestCheckIdentitySection(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		ProviderName string
+		CheckOptions *CheckOptions
+		ExpectError  bool
+	}{
+		{
+			Name:         "no identity attributes",
+			Path:         "testdata/identity/missing_section.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing",
+			Path:         "testdata/identity/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				IdentitySection: &CheckIdentitySectionOptions{
+					Attributes: []string{"name", "region"},
+				},
+			},
+		},
+		{
+			Name:         "passing flat",
+			Path:         "testdata/identity/passing_flat.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				IdentitySection: &CheckIdentitySectionOptions{
+					Attributes: []string{"name", "region"},
+				},
+			},
+		},
+		{
+			Name:         "missing attribute",
+			Path:         "testdata/identity/missing_attribute.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				IdentitySection: &CheckIdentitySectionOptions{
+					Attributes: []string{"name", "region"},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing section",
+			Path:         "testdata/identity/missing_section.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				IdentitySection: &CheckIdentitySectionOptions{
+					Attributes: []string{"name"},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, testCase.ProviderName)
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.CheckOptions
+
+			got := doc.checkIdentitySection()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
@@ -2,12 +2,54 @@ package contents
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/bflad/tfproviderdocs/markdown"
 )
 
+var (
+	// importArnAccountIDRegexp matches the AWS account ID of an ARN.
+	importArnAccountIDRegexp = regexp.MustCompile(`arn:[^:\s]+:[^:\s]*:[^:\s]*:(\d{12}):`)
+
+	// importUUIDRegexp matches UUIDs. Randomly generated UUIDs almost always
+	// contain hexadecimal letters, while placeholders such as
+	// 00000000-0000-0000-0000-000000000000 or
+	// 12345678-1234-1234-1234-123456789012 do not.
+	importUUIDRegexp = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+
+	// importBlockStartRegexp matches the first line of an import block.
+	importBlockStartRegexp = regexp.MustCompile(`^import\s*\{$`)
+
+	// importPlaceholderAccountIDs contains AWS account IDs commonly used in
+	// documentation, in addition to a single repeated digit.
+	importPlaceholderAccountIDs = []string{
+		"012345678901",
+		"111122223333",
+		"123456789012",
+		"444455556666",
+	}
+)
+
+type CheckImportSectionOptions struct {
+	// RequireImportBlock requires an import block (Terraform 1.5 and later)
+	// code block example in addition to any terraform import command.
+	RequireImportBlock bool
+}
+
+// importBlock represents the arguments of an import block.
+type importBlock struct {
+	ID string
+	To string
+}
+
 func (d *Document) checkImportSection() error {
+	checkOpts := &CheckImportSectionOptions{}
+
+	if d.CheckOptions != nil && d.CheckOptions.ImportSection != nil {
+		checkOpts = d.CheckOptions.ImportSection
+	}
+
 	section := d.Sections.Import
 
 	if section == nil {
@@ -27,13 +69,177 @@ func (d *Document) checkImportSection() error {
 		return fmt.Errorf("import section heading (%s) should be: %s", headingText, expectedHeadingText)
 	}
 
+	var hasImportBlock bool
+
 	for _, fencedCodeBlock := range section.FencedCodeBlocks {
 		text := markdown.FencedCodeBlockText(fencedCodeBlock, d.source)
 
 		if !strings.Contains(text, d.ResourceName) {
 			return fmt.Errorf("import section code block text should contain resource name: %s", d.ResourceName)
 		}
+
+		for _, command := range importCommands(text) {
+			if err := d.checkImportCommand(command); err != nil {
+				return err
+			}
+		}
+
+		for _, block := range importBlocks(text) {
+			hasImportBlock = true
+
+			if err := d.checkImportBlock(block); err != nil {
+				return err
+			}
+		}
+	}
+
+	if checkOpts.RequireImportBlock && !hasImportBlock {
+		return fmt.Errorf("import section should contain import block code block example: import { to = %s.example }", d.ResourceName)
+	}
+
+	return nil
+}
+
+// checkImportBlock verifies the import block to and id arguments.
+func (d *Document) checkImportBlock(block importBlock) error {
+	if block.To == "" {
+		return fmt.Errorf("import section import block should have to argument: to = %s.example", d.ResourceName)
+	}
+
+	if resourceType := importAddressResourceType(block.To); resourceType != d.ResourceName {
+		return fmt.Errorf("import section import block to address (%s) should reference resource type: %s", block.To, d.ResourceName)
+	}
+
+	return checkImportID(block.ID)
+}
+
+// checkImportCommand verifies the terraform import command arguments, which
+// are the resource address and import identifier.
+func (d *Document) checkImportCommand(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("import section terraform import command should have resource address and import identifier arguments: terraform import %s", strings.Join(args, " "))
+	}
+
+	// Quoted identifiers may contain spaces
+	address, id := args[0], strings.Join(args[1:], " ")
+
+	if resourceType := importAddressResourceType(address); resourceType != d.ResourceName {
+		return fmt.Errorf("import section terraform import resource address (%s) should reference resource type: %s", address, d.ResourceName)
+	}
+
+	return checkImportID(id)
+}
+
+// checkImportID verifies the import identifier does not contain real
+// identifiers, such as randomly generated UUIDs or AWS account IDs.
+func checkImportID(id string) error {
+	if match := importUUIDRegexp.FindString(id); match != "" && strings.ContainsAny(strings.ToLower(match), "abcdef") {
+		return fmt.Errorf("import section terraform import identifier (%s) should use a placeholder instead of a real UUID (%s), e.g. 00000000-0000-0000-0000-000000000000", id, match)
+	}
+
+	if match := importArnAccountIDRegexp.FindStringSubmatch(id); match != nil && !isPlaceholderAccountID(match[1]) {
+		return fmt.Errorf("import section terraform import identifier (%s) should use a placeholder instead of a real account ID (%s), e.g. 123456789012", id, match[1])
 	}
 
 	return nil
 }
+
+// importAddressResourceType returns the resource type of the resource
+// address, removing any module path and instance key.
+func importAddressResourceType(address string) string {
+	parts := strings.Split(address, ".")
+
+	for len(parts) > 2 && parts[0] == "module" {
+		parts = parts[2:]
+	}
+
+	return parts[0]
+}
+
+// importBlocks returns the arguments of each import block in the code block
+// text. Quotes are removed from the id argument.
+func importBlocks(text string) []importBlock {
+	var result []importBlock
+	var current *importBlock
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		if current == nil {
+			if importBlockStartRegexp.MatchString(line) {
+				current = &importBlock{}
+			}
+
+			continue
+		}
+
+		if line == "}" {
+			result = append(result, *current)
+			current = nil
+
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+
+		if !ok {
+			continue
+		}
+
+		switch strings.TrimSpace(key) {
+		case "id":
+			current.ID = strings.Trim(strings.TrimSpace(value), `"`)
+		case "to":
+			current.To = strings.TrimSpace(value)
+		}
+	}
+
+	return result
+}
+
+// importCommands returns the arguments of each terraform import command in the
+// code block text, excluding options. Shell prompts ($ or %), quotes, and line
+// continuations are removed.
+func importCommands(text string) [][]string {
+	var result [][]string
+
+	text = strings.ReplaceAll(text, "\\\n", " ")
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(strings.TrimPrefix(line, "$"), "%")
+		fields := strings.Fields(line)
+
+		if len(fields) < 2 || fields[0] != "terraform" || fields[1] != "import" {
+			continue
+		}
+
+		var args []string
+
+		for _, field := range fields[2:] {
+			if strings.HasPrefix(field, "-") {
+				continue
+			}
+
+			args = append(args, strings.Trim(field, `"'`))
+		}
+
+		result = append(result, args)
+	}
+
+	return result
+}
+
+func isPlaceholderAccountID(accountID string) bool {
+	if strings.Count(accountID, accountID[:1]) == len(accountID) {
+		return true
+	}
+
+	for _, placeholder := range importPlaceholderAccountIDs {
+		if accountID == placeholder {
+			return true
+		}
+	}
+
+	return false
+}
@@ -9,6 +9,7 @@ func TestCheckImportSection(t *testing.T) {
 		Name         string
 		Path         string
 		ProviderName string
+		CheckOptions *CheckOptions
 		ExpectError  bool
 	}{
 		{
@@ -16,6 +17,72 @@ func TestCheckImportSection(t *testing.T) {
 			Path:         "testdata/import/passing.md",
 			ProviderName: "test",
 		},
+		{
+			Name:         "passing placeholders",
+			Path:         "testdata/import/passing_placeholders.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing import block",
+			Path:         "testdata/import/passing_import_block.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing import block identity",
+			Path:         "testdata/identity/passing.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing import block required",
+			Path:         "testdata/import/passing_import_block.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ImportSection: &CheckImportSectionOptions{
+					RequireImportBlock: true,
+				},
+			},
+		},
+		{
+			Name:         "missing import block required",
+			Path:         "testdata/import/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				ImportSection: &CheckImportSectionOptions{
+					RequireImportBlock: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing import identifier",
+			Path:         "testdata/import/missing_id.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "real account ID",
+			Path:         "testdata/import/real_account_id.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "real UUID",
+			Path:         "testdata/import/real_uuid.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "wrong address resource type",
+			Path:         "testdata/import/wrong_address_resource_type.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "wrong import block address",
+			Path:         "testdata/import/wrong_import_block_address.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
 		{
 			Name:         "wrong code block resource type",
 			Path:         "testdata/import/wrong_code_block_resource_type.md",
@@ -44,6 +111,8 @@ func TestCheckImportSection(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err)
 			}
 
+			doc.CheckOptions = testCase.CheckOptions
+
 			got := doc.checkImportSection()
 
 			if got == nil && testCase.ExpectError {
@@ -0,0 +1,148 @@
+package contents
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultSchemaDescriptionSimilarity is the minimum similarity of documented
+// and schema descriptions when not configured.
+const DefaultSchemaDescriptionSimilarity = 0.5
+
+type CheckSchemaDescriptionsOptions struct {
+	// Descriptions contains the schema attribute descriptions, keyed by the
+	// nested block or nested attribute name, or an empty string for the root,
+	// then by the attribute name.
+	Descriptions map[string]map[string]string
+
+	// MinimumSimilarity is the minimum similarity, between 0 and 1, of the
+	// normalized documented and schema descriptions. Defaults to
+	// DefaultSchemaDescriptionSimilarity.
+	MinimumSimilarity float64
+}
+
+// SchemaDescriptionError is returned when documented attribute descriptions
+// diverge from the schema descriptions.
+type SchemaDescriptionError struct {
+	// Attributes contains the diverging attribute names, prefixed by the
+	// nested block or nested attribute name, if any.
+	Attributes []string
+}
+
+func (e *SchemaDescriptionError) Error() string {
+	return fmt.Sprintf("attribute documentation diverges from schema descriptions: %s", strings.Join(e.Attributes, ", "))
+}
+
+// checkSchemaDescriptions verifies the documented attribute descriptions are
+// similar to the schema descriptions, after normalizing case, punctuation,
+// and whitespace. Attributes which are not documented or without a
+// description are skipped.
+func (d *Document) checkSchemaDescriptions() error {
+	if d.CheckOptions == nil || d.CheckOptions.SchemaDescriptions == nil {
+		return nil
+	}
+
+	checkOpts := d.CheckOptions.SchemaDescriptions
+	minimumSimilarity := checkOpts.MinimumSimilarity
+
+	if minimumSimilarity == 0 {
+		minimumSimilarity = DefaultSchemaDescriptionSimilarity
+	}
+
+	blocks := make([]string, 0, len(checkOpts.Descriptions))
+
+	for block := range checkOpts.Descriptions {
+		blocks = append(blocks, block)
+	}
+
+	sort.Strings(blocks)
+
+	var diverging []string
+
+	for _, block := range blocks {
+		sections := d.schemaAttributeSections(block)
+
+		if len(sections) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(checkOpts.Descriptions[block]))
+
+		for name := range checkOpts.Descriptions[block] {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			var item *SchemaAttributeListItem
+
+			for _, section := range sections {
+				if item = section.item(name); item != nil {
+					break
+				}
+			}
+
+			if item == nil {
+				continue
+			}
+
+			schemaWords := descriptionWords(checkOpts.Descriptions[block][name])
+			documentedWords := descriptionWords(item.Description)
+
+			if len(schemaWords) == 0 || len(documentedWords) == 0 {
+				continue
+			}
+
+			if descriptionSimilarity(schemaWords, documentedWords) >= minimumSimilarity {
+				continue
+			}
+
+			if block != "" {
+				name = block + "." + name
+			}
+
+			diverging = append(diverging, name)
+		}
+	}
+
+	if len(diverging) > 0 {
+		return &SchemaDescriptionError{
+			Attributes: diverging,
+		}
+	}
+
+	return nil
+}
+
+// descriptionWords returns the unique lowercase words of the description,
+// ignoring punctuation and Markdown syntax.
+func descriptionWords(description string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '_'
+	})
+
+	result := make(map[string]struct{}, len(words))
+
+	for _, word := range words {
+		result[word] = struct{}{}
+	}
+
+	return result
+}
+
+// descriptionSimilarity returns the Sørensen–Dice coefficient of the words,
+// where 1 is identical and 0 has no words in common.
+func descriptionSimilarity(a map[string]struct{}, b map[string]struct{}) float64 {
+	var common int
+
+	for word := range a {
+		if _, ok := b[word]; ok {
+			common++
+		}
+	}
+
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
@@ -0,0 +1,162 @@
+package contents
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheckSchemaDescriptions(t *testing.T) {
+	descriptions := map[string]map[string]string{
+		"": {
+			"description":    "Description of the thing.",
+			"id":             "Identifier of the thing.",
+			"name":           "Name of the thing.",
+			"not_documented": "Not documented.",
+		},
+		"network_interface": {
+			"subnet_id": "Identifier of the subnet.",
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		Path         string
+		ProviderName string
+		CheckOptions *CheckOptions
+		Expect       []string
+	}{
+		{
+			Name:         "no options",
+			Path:         "testdata/schema_descriptions/diverging.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing",
+			Path:         "testdata/schema_descriptions/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaDescriptions: &CheckSchemaDescriptionsOptions{
+					Descriptions: descriptions,
+				},
+			},
+		},
+		{
+			Name:         "passing terraform-plugin-docs",
+			Path:         "testdata/schema_descriptions/plugin_docs.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaDescriptions: &CheckSchemaDescriptionsOptions{
+					Descriptions: descriptions,
+				},
+			},
+		},
+		{
+			Name:         "empty schema description",
+			Path:         "testdata/schema_descriptions/diverging.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaDescriptions: &CheckSchemaDescriptionsOptions{
+					Descriptions: map[string]map[string]string{
+						"": {
+							"description": "",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:         "diverging",
+			Path:         "testdata/schema_descriptions/diverging.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaDescriptions: &CheckSchemaDescriptionsOptions{
+					Descriptions: descriptions,
+				},
+			},
+			Expect: []string{"description", "network_interface.subnet_id"},
+		},
+		{
+			Name:         "minimum similarity",
+			Path:         "testdata/schema_descriptions/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaDescriptions: &CheckSchemaDescriptionsOptions{
+					Descriptions:      descriptions,
+					MinimumSimilarity: 0.9,
+				},
+			},
+			Expect: []string{"name"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, testCase.ProviderName)
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.CheckOptions
+
+			got := doc.checkSchemaDescriptions()
+
+			if got == nil {
+				if testCase.Expect != nil {
+					t.Fatalf("expected error, got no error")
+				}
+
+				return
+			}
+
+			var schemaDescriptionErr *SchemaDescriptionError
+
+			if !errors.As(got, &schemaDescriptionErr) {
+				t.Fatalf("expected *SchemaDescriptionError, got error: %s", got)
+			}
+
+			if !reflect.DeepEqual(schemaDescriptionErr.Attributes, testCase.Expect) {
+				t.Errorf("expected attributes %v, got: %v", testCase.Expect, schemaDescriptionErr.Attributes)
+			}
+		})
+	}
+}
+
+func TestDescriptionSimilarity(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		A      string
+		B      string
+		Expect float64
+	}{
+		{
+			Name:   "identical",
+			A:      "Name of the thing.",
+			B:      "name of the `thing`",
+			Expect: 1,
+		},
+		{
+			Name:   "disjoint",
+			A:      "Name of the thing.",
+			B:      "Whether to enable monitoring.",
+			Expect: 0,
+		},
+		{
+			Name:   "partial",
+			A:      "Name of the thing.",
+			B:      "Name of the resource.",
+			Expect: 0.75,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := descriptionSimilarity(descriptionWords(testCase.A), descriptionWords(testCase.B))
+
+			if got != testCase.Expect {
+				t.Errorf("expected %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
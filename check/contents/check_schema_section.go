@@ -0,0 +1,113 @@
+package contents
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SchemaSubsections contains the terraform-plugin-docs schema section
+// subsection headings of root attributes, in order.
+var SchemaSubsections = []string{
+	"Required",
+	"Optional",
+	"Read-Only",
+}
+
+type CheckSchemaSectionOptions struct {
+	// RequireDescriptions requires each schema attribute list item to have a
+	// description after the name and traits.
+	RequireDescriptions bool
+
+	RequireSchemaOrdering bool
+
+	// SchemaOrderingStyle is the style of RequireSchemaOrdering. Defaults to
+	// SchemaOrderingStyleAlphabetical.
+	SchemaOrderingStyle string
+}
+
+func (d *Document) checkSchemaSection() error {
+	checkOpts := &CheckSchemaSectionOptions{}
+
+	if d.CheckOptions != nil && d.CheckOptions.SchemaSection != nil {
+		checkOpts = d.CheckOptions.SchemaSection
+	}
+
+	section := d.Sections.Schema
+
+	// Only terraform-plugin-docs generated documentation contains the section
+	if section == nil {
+		return nil
+	}
+
+	heading := section.Heading
+
+	if heading.Level != 2 {
+		return fmt.Errorf("schema section heading level (%d) should be: 2", heading.Level)
+	}
+
+	if err := d.checkSchemaSubsections(); err != nil {
+		return err
+	}
+
+	if checkOpts.RequireDescriptions {
+		if err := checkSchemaAttributeSectionDescriptions("schema", (*SchemaAttributeSection)(section), d.source); err != nil {
+			return err
+		}
+	}
+
+	if checkOpts.RequireSchemaOrdering {
+		if err := checkSchemaAttributeSectionOrdering("schema", (*SchemaAttributeSection)(section), checkOpts.SchemaOrderingStyle, d.source); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSchemaSubsections verifies the subsections directly below the schema
+// section heading, except Nested Schema for sections, are Required, Optional,
+// and Read-Only subsections in that order.
+func (d *Document) checkSchemaSubsections() error {
+	section := d.Sections.Schema
+	previousIndex := -1
+	previousText := ""
+
+	for _, child := range section.Children {
+		if child.Heading.Level != section.Heading.Level+1 {
+			continue
+		}
+
+		headingText := string(child.Heading.Text(d.source))
+
+		if strings.HasPrefix(headingText, "Nested Schema for") {
+			continue
+		}
+
+		index := -1
+
+		for i, subsection := range SchemaSubsections {
+			if headingText == subsection {
+				index = i
+
+				break
+			}
+		}
+
+		if index == -1 {
+			return fmt.Errorf("schema section subsection heading (%s) should be one of: %s", headingText, strings.Join(SchemaSubsections, ", "))
+		}
+
+		if index == previousIndex {
+			return fmt.Errorf("schema section contains duplicate subsection: %s", headingText)
+		}
+
+		if index < previousIndex {
+			return fmt.Errorf("schema section subsection (%s) should be before: %s", headingText, previousText)
+		}
+
+		previousIndex = index
+		previousText = headingText
+	}
+
+	return nil
+}
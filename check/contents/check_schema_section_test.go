@@ -0,0 +1,92 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestCheckSchemaSection(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		ProviderName string
+		CheckOptions *CheckOptions
+		ExpectError  bool
+	}{
+		{
+			Name:         "missing section",
+			Path:         "testdata/attributes/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaSection: &CheckSchemaSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+		},
+		{
+			Name:         "passing",
+			Path:         "testdata/schema/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaSection: &CheckSchemaSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+		},
+		{
+			Name:         "unknown subsection",
+			Path:         "testdata/schema/unknown_subsection.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "wrong heading level",
+			Path:         "testdata/schema/wrong_heading_level.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "wrong subsection order",
+			Path:         "testdata/schema/wrong_subsection_order.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "wrong nested list order",
+			Path:         "testdata/schema/wrong_nested_list_order.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "wrong nested list order",
+			Path:         "testdata/schema/wrong_nested_list_order.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SchemaSection: &CheckSchemaSectionOptions{
+					RequireSchemaOrdering: true,
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, testCase.ProviderName)
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.CheckOptions
+
+			got := doc.checkSchemaSection()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
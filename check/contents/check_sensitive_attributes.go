@@ -0,0 +1,127 @@
+package contents
+
+import (
+	"fmt"
+	"strings"
+)
+
+type CheckSensitiveAttributesOptions struct {
+	// Attributes contains the names of sensitive attributes, keyed by the
+	// nested block or nested attribute name, or an empty string for the root.
+	Attributes map[string][]string
+}
+
+// checkSensitiveAttributes verifies the documentation of each sensitive
+// attribute states that it is sensitive, either in the list item (e.g.
+// (Optional, Sensitive) or "This value is sensitive.") or in a !> warning
+// callout of the same section mentioning the attribute.
+func (d *Document) checkSensitiveAttributes() error {
+	if d.CheckOptions == nil || d.CheckOptions.SensitiveAttributes == nil {
+		return nil
+	}
+
+	for block, names := range d.CheckOptions.SensitiveAttributes.Attributes {
+		for _, section := range d.schemaAttributeSections(block) {
+			for _, name := range names {
+				item := section.item(name)
+
+				if item == nil {
+					continue
+				}
+
+				if item.Sensitive || strings.Contains(strings.ToLower(item.Description), "sensitive") {
+					continue
+				}
+
+				if section.hasWarningCallout(name, d.source) {
+					continue
+				}
+
+				if block != "" {
+					name = block + "." + name
+				}
+
+				return fmt.Errorf("sensitive attribute (%s) documentation should state it is sensitive or have a !> warning callout", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// schemaAttributeSections returns the root arguments, attributes, and schema
+// sections for an empty block name, otherwise their nested sections whose
+// heading references the block (e.g. ### network_interface, ### Network
+// Interface, or ### Nested Schema for `network_interface`).
+func (d *Document) schemaAttributeSections(block string) []*SchemaAttributeSection {
+	var roots []*SchemaAttributeSection
+
+	if d.Sections.Arguments != nil {
+		roots = append(roots, (*SchemaAttributeSection)(d.Sections.Arguments))
+	}
+
+	if d.Sections.Attributes != nil {
+		roots = append(roots, (*SchemaAttributeSection)(d.Sections.Attributes))
+	}
+
+	if d.Sections.Schema != nil {
+		roots = append(roots, (*SchemaAttributeSection)(d.Sections.Schema))
+	}
+
+	if block == "" {
+		// terraform-plugin-docs schema sections split the root attributes
+		// into Required, Optional, and Read-Only nested sections.
+		result := roots
+
+		if d.Sections.Schema != nil {
+			for _, child := range d.Sections.Schema.Children {
+				if !strings.HasPrefix(string(child.Heading.Text(d.source)), "Nested Schema for") {
+					result = append(result, child)
+				}
+			}
+		}
+
+		return result
+	}
+
+	var result []*SchemaAttributeSection
+
+	for _, root := range roots {
+		for _, child := range root.Children {
+			headingText := strings.ReplaceAll(strings.ToLower(string(child.Heading.Text(d.source))), " ", "_")
+
+			if strings.Contains(headingText, block) {
+				result = append(result, child)
+			}
+		}
+	}
+
+	return result
+}
+
+// item returns the list item with the name, if found.
+func (s *SchemaAttributeSection) item(name string) *SchemaAttributeListItem {
+	for _, list := range s.SchemaAttributeLists {
+		for _, item := range list.Items {
+			if item.Name == name {
+				return item
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasWarningCallout returns true if the section contains a !> warning callout
+// paragraph mentioning the name.
+func (s *SchemaAttributeSection) hasWarningCallout(name string, source []byte) bool {
+	for _, paragraph := range s.Paragraphs {
+		text := string(paragraph.Text(source))
+
+		if strings.HasPrefix(text, "!>") && strings.Contains(text, name) {
+			return true
+		}
+	}
+
+	return false
+}
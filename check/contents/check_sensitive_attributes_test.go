@@ -0,0 +1,106 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestCheckSensitiveAttributes(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		ProviderName string
+		CheckOptions *CheckOptions
+		ExpectError  bool
+	}{
+		{
+			Name:         "no options",
+			Path:         "testdata/sensitive/missing.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing",
+			Path:         "testdata/sensitive/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SensitiveAttributes: &CheckSensitiveAttributesOptions{
+					Attributes: map[string][]string{
+						"":                  {"password", "token"},
+						"network_interface": {"private_key"},
+					},
+				},
+			},
+		},
+		{
+			Name:         "passing terraform-plugin-docs",
+			Path:         "testdata/sensitive/plugin_docs.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SensitiveAttributes: &CheckSensitiveAttributesOptions{
+					Attributes: map[string][]string{
+						"": {"password"},
+					},
+				},
+			},
+		},
+		{
+			Name:         "undocumented attribute",
+			Path:         "testdata/sensitive/missing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SensitiveAttributes: &CheckSensitiveAttributesOptions{
+					Attributes: map[string][]string{
+						"": {"not_documented"},
+					},
+				},
+			},
+		},
+		{
+			Name:         "missing",
+			Path:         "testdata/sensitive/missing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SensitiveAttributes: &CheckSensitiveAttributesOptions{
+					Attributes: map[string][]string{
+						"": {"password"},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing nested",
+			Path:         "testdata/sensitive/missing_nested.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				SensitiveAttributes: &CheckSensitiveAttributesOptions{
+					Attributes: map[string][]string{
+						"network_interface": {"private_key"},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, testCase.ProviderName)
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.CheckOptions
+
+			got := doc.checkSensitiveAttributes()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
@@ -16,6 +16,11 @@ func TestCheck(t *testing.T) {
 			Path:         "testdata/full.md",
 			ProviderName: "test",
 		},
+		{
+			Name:         "passing schema format",
+			Path:         "testdata/full_schema.md",
+			ProviderName: "test",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -20,8 +20,18 @@ func (d *Document) checkTitleSection() error {
 
 	headingText := string(heading.Text(d.source))
 
-	if !strings.HasPrefix(headingText, "Data Source: ") && !strings.HasPrefix(headingText, "Resource: ") {
-		return fmt.Errorf("title section heading (%s) should have prefix: \"Data Source: \" or \"Resource: \"", headingText)
+	hasPrefix := strings.HasPrefix(headingText, "Data Source: ") || strings.HasPrefix(headingText, "List Resource: ") || strings.HasPrefix(headingText, "Resource: ")
+
+	// terraform-plugin-docs generated titles may have a suffix instead, such
+	// as # test_thing (Resource).
+	if d.Format() == DocumentFormatSchema {
+		hasSuffix := strings.HasSuffix(headingText, " (Data Source)") || strings.HasSuffix(headingText, " (List Resource)") || strings.HasSuffix(headingText, " (Resource)")
+
+		if !hasPrefix && !hasSuffix {
+			return fmt.Errorf("title section heading (%s) should have prefix: \"Data Source: \", \"List Resource: \", or \"Resource: \", or suffix: \" (Data Source)\", \" (List Resource)\", or \" (Resource)\"", headingText)
+		}
+	} else if !hasPrefix {
+		return fmt.Errorf("title section heading (%s) should have prefix: \"Data Source: \", \"List Resource: \", or \"Resource: \"", headingText)
 	}
 
 	if len(section.FencedCodeBlocks) > 0 {
@@ -16,6 +16,16 @@ func TestCheckTitleSection(t *testing.T) {
 			Path:         "testdata/title/passing.md",
 			ProviderName: "test",
 		},
+		{
+			Name:         "passing list resource",
+			Path:         "testdata/title/passing_list_resource.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing schema suffix",
+			Path:         "testdata/title/passing_schema_suffix.md",
+			ProviderName: "test",
+		},
 		{
 			Name:         "missing heading",
 			Path:         "testdata/title/missing_heading.md",
@@ -34,6 +44,12 @@ func TestCheckTitleSection(t *testing.T) {
 			ProviderName: "test",
 			ExpectError:  true,
 		},
+		{
+			Name:         "wrong schema suffix",
+			Path:         "testdata/title/wrong_schema_suffix.md",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
 		{
 			Name:         "wrong resource in heading",
 			Path:         "testdata/title/wrong_resource_in_heading.md",
@@ -0,0 +1,120 @@
+package contents
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WriteOnlyAttribute represents a write-only attribute, whose value is not
+// persisted in the plan or state.
+type WriteOnlyAttribute struct {
+	Name string
+
+	// VersionAttribute is the companion attribute which triggers updates of
+	// the write-only attribute (e.g. password_wo_version), if present.
+	VersionAttribute string
+}
+
+type CheckWriteOnlyAttributesOptions struct {
+	// Attributes contains the write-only attributes, keyed by the nested
+	// block or nested attribute name, or an empty string for the root.
+	Attributes map[string][]*WriteOnlyAttribute
+
+	// RequireDescription requires the documentation of write-only attributes
+	// to state they are write-only and mention any version attribute.
+	RequireDescription bool
+
+	// RequireDocumented requires write-only attributes to be documented.
+	// Undocumented attributes return a *MissingAttributeError.
+	RequireDocumented bool
+}
+
+// MissingAttributeError is returned when a schema attribute is not documented.
+type MissingAttributeError struct {
+	// Block is the nested block or nested attribute name, or an empty string
+	// for the root.
+	Block string
+
+	Name string
+
+	// Kind describes the attribute, such as write-only, if any.
+	Kind string
+}
+
+func (e *MissingAttributeError) Error() string {
+	name := e.Name
+
+	if e.Block != "" {
+		name = e.Block + "." + name
+	}
+
+	if e.Kind == "" {
+		return fmt.Sprintf("missing attribute documentation: %s", name)
+	}
+
+	return fmt.Sprintf("missing %s attribute documentation: %s", e.Kind, name)
+}
+
+// checkWriteOnlyAttributes verifies the documentation of write-only
+// attributes. Root attributes are missing if not found in any arguments,
+// attributes, or schema section, while nested attributes are only missing if
+// their nested section is found.
+func (d *Document) checkWriteOnlyAttributes() error {
+	if d.CheckOptions == nil || d.CheckOptions.WriteOnlyAttributes == nil {
+		return nil
+	}
+
+	checkOpts := d.CheckOptions.WriteOnlyAttributes
+
+	for block, attributes := range checkOpts.Attributes {
+		sections := d.schemaAttributeSections(block)
+
+		if len(sections) == 0 {
+			continue
+		}
+
+		for _, attribute := range attributes {
+			var item *SchemaAttributeListItem
+
+			for _, section := range sections {
+				if item = section.item(attribute.Name); item != nil {
+					break
+				}
+			}
+
+			if item == nil {
+				if checkOpts.RequireDocumented {
+					return &MissingAttributeError{
+						Block: block,
+						Kind:  "write-only",
+						Name:  attribute.Name,
+					}
+				}
+
+				continue
+			}
+
+			if !checkOpts.RequireDescription {
+				continue
+			}
+
+			name := attribute.Name
+
+			if block != "" {
+				name = block + "." + name
+			}
+
+			description := strings.ToLower(item.Description)
+
+			if !item.WriteOnly && !strings.Contains(description, "write-only") && !strings.Contains(description, "write only") {
+				return fmt.Errorf("write-only attribute (%s) documentation should state it is write-only", name)
+			}
+
+			if attribute.VersionAttribute != "" && !strings.Contains(item.Description, attribute.VersionAttribute) {
+				return fmt.Errorf("write-only attribute (%s) documentation should mention its version attribute: %s", name, attribute.VersionAttribute)
+			}
+		}
+	}
+
+	return nil
+}
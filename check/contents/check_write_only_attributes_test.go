@@ -0,0 +1,139 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestCheckWriteOnlyAttributes(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		ProviderName string
+		CheckOptions *CheckOptions
+		ExpectError  bool
+	}{
+		{
+			Name:         "no options",
+			Path:         "testdata/write_only/missing_description.md",
+			ProviderName: "test",
+		},
+		{
+			Name:         "passing",
+			Path:         "testdata/write_only/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				WriteOnlyAttributes: &CheckWriteOnlyAttributesOptions{
+					Attributes: map[string][]*WriteOnlyAttribute{
+						"": {{Name: "password_wo", VersionAttribute: "password_wo_version"}},
+					},
+					RequireDescription: true,
+					RequireDocumented:  true,
+				},
+			},
+		},
+		{
+			Name:         "passing terraform-plugin-docs",
+			Path:         "testdata/write_only/plugin_docs.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				WriteOnlyAttributes: &CheckWriteOnlyAttributesOptions{
+					Attributes: map[string][]*WriteOnlyAttribute{
+						"": {{Name: "password_wo", VersionAttribute: "password_wo_version"}},
+					},
+					RequireDescription: true,
+					RequireDocumented:  true,
+				},
+			},
+		},
+		{
+			Name:         "missing description",
+			Path:         "testdata/write_only/missing_description.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				WriteOnlyAttributes: &CheckWriteOnlyAttributesOptions{
+					Attributes: map[string][]*WriteOnlyAttribute{
+						"": {{Name: "password_wo", VersionAttribute: "password_wo_version"}},
+					},
+					RequireDescription: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing description not required",
+			Path:         "testdata/write_only/missing_description.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				WriteOnlyAttributes: &CheckWriteOnlyAttributesOptions{
+					Attributes: map[string][]*WriteOnlyAttribute{
+						"": {{Name: "password_wo", VersionAttribute: "password_wo_version"}},
+					},
+					RequireDocumented: true,
+				},
+			},
+		},
+		{
+			Name:         "missing version attribute",
+			Path:         "testdata/write_only/missing_version.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				WriteOnlyAttributes: &CheckWriteOnlyAttributesOptions{
+					Attributes: map[string][]*WriteOnlyAttribute{
+						"": {{Name: "password_wo", VersionAttribute: "password_wo_version"}},
+					},
+					RequireDescription: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing attribute",
+			Path:         "testdata/write_only/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				WriteOnlyAttributes: &CheckWriteOnlyAttributesOptions{
+					Attributes: map[string][]*WriteOnlyAttribute{
+						"": {{Name: "token_wo"}},
+					},
+					RequireDocumented: true,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:         "missing attribute not required",
+			Path:         "testdata/write_only/passing.md",
+			ProviderName: "test",
+			CheckOptions: &CheckOptions{
+				WriteOnlyAttributes: &CheckWriteOnlyAttributesOptions{
+					Attributes: map[string][]*WriteOnlyAttribute{
+						"": {{Name: "token_wo"}},
+					},
+					RequireDescription: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, testCase.ProviderName)
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			doc.CheckOptions = testCase.CheckOptions
+
+			got := doc.checkWriteOnlyAttributes()
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
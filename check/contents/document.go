@@ -10,6 +10,17 @@ import (
 	"github.com/yuin/goldmark/ast"
 )
 
+const (
+	// DocumentFormatReference is the format of documentation with Argument
+	// Reference and Attributes Reference sections.
+	DocumentFormatReference = "reference"
+
+	// DocumentFormatSchema is the format of terraform-plugin-docs generated
+	// documentation, with a Schema section containing Required, Optional,
+	// and Read-Only sections.
+	DocumentFormatSchema = "schema"
+)
+
 type Document struct {
 	CheckOptions *CheckOptions
 	ProviderName string
@@ -31,14 +42,21 @@ func NewDocument(path string, providerName string) *Document {
 }
 
 func (d *Document) Parse() error {
-	var err error
-
-	d.source, err = os.ReadFile(d.path)
+	source, err := os.ReadFile(d.path)
 
 	if err != nil {
 		return fmt.Errorf("error reading file (%s): %w", d.path, err)
 	}
 
+	return d.ParseSource(source)
+}
+
+// ParseSource parses the document from the given contents instead of reading
+// the file, such as when the file is read from an fs.FS.
+func (d *Document) ParseSource(source []byte) error {
+	var err error
+
+	d.source = source
 	d.document, d.metadata = markdown.Parse(d.source)
 
 	// d.document.Dump(d.source, 1)
@@ -55,6 +73,17 @@ func (d *Document) Parse() error {
 	return nil
 }
 
+// Format returns the detected format of the parsed document. Documents with a
+// Schema section and without Argument Reference and Attributes Reference
+// sections are DocumentFormatSchema, otherwise DocumentFormatReference.
+func (d *Document) Format() string {
+	if d.Sections != nil && d.Sections.Schema != nil && d.Sections.Arguments == nil && d.Sections.Attributes == nil {
+		return DocumentFormatSchema
+	}
+
+	return DocumentFormatReference
+}
+
 func resourceName(providerName string, fileName string) string {
 	return providerName + "_" + fileName[:strings.IndexByte(fileName, '.')]
 }
@@ -71,3 +71,41 @@ func TestDocumentParse(t *testing.T) {
 		})
 	}
 }
+
+func TestDocumentFormat(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Path     string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Path:     "testdata/empty.md",
+			Expected: DocumentFormatReference,
+		},
+		{
+			Name:     "reference",
+			Path:     "testdata/full.md",
+			Expected: DocumentFormatReference,
+		},
+		{
+			Name:     "schema",
+			Path:     "testdata/full_schema.md",
+			Expected: DocumentFormatSchema,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := doc.Format(); got != testCase.Expected {
+				t.Errorf("expected format %s, got: %s", testCase.Expected, got)
+			}
+		})
+	}
+}
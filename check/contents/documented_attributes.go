@@ -0,0 +1,192 @@
+package contents
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AttributeChange represents a documented attribute whose documentation
+// materially changed.
+type AttributeChange struct {
+	// Name is the attribute name prefixed by the nested block or nested
+	// attribute name, if any.
+	Name string `json:"name"`
+
+	// Changes describes each change, e.g. now required or description changed.
+	Changes []string `json:"changes"`
+}
+
+func (c *AttributeChange) String() string {
+	return fmt.Sprintf("%s (%s)", c.Name, strings.Join(c.Changes, ", "))
+}
+
+// AttributeComparison contains the differences between two sets of
+// documented attributes, with names sorted.
+type AttributeComparison struct {
+	Added   []string           `json:"added,omitempty"`
+	Removed []string           `json:"removed,omitempty"`
+	Changed []*AttributeChange `json:"changed,omitempty"`
+}
+
+// HasChanges returns true if any attribute was added, removed, or changed.
+func (c *AttributeComparison) HasChanges() bool {
+	return len(c.Added) > 0 || len(c.Removed) > 0 || len(c.Changed) > 0
+}
+
+// CompareAttributes returns the differences between the old and new
+// documented attributes. Attributes are changed if their description differs
+// other than in formatting or if their type, required, optional, forces new,
+// sensitive, or write-only traits differ.
+func CompareAttributes(old map[string]*SchemaAttributeListItem, new map[string]*SchemaAttributeListItem) *AttributeComparison {
+	result := &AttributeComparison{}
+
+	for name, newItem := range new {
+		oldItem, ok := old[name]
+
+		if !ok {
+			result.Added = append(result.Added, name)
+
+			continue
+		}
+
+		if changes := attributeChanges(oldItem, newItem); len(changes) > 0 {
+			result.Changed = append(result.Changed, &AttributeChange{
+				Changes: changes,
+				Name:    name,
+			})
+		}
+	}
+
+	for name := range old {
+		if _, ok := new[name]; !ok {
+			result.Removed = append(result.Removed, name)
+		}
+	}
+
+	sort.Strings(result.Added)
+	sort.Strings(result.Removed)
+	sort.Slice(result.Changed, func(i, j int) bool {
+		return result.Changed[i].Name < result.Changed[j].Name
+	})
+
+	return result
+}
+
+// attributeChanges returns the descriptions of the material changes between
+// the old and new attribute documentation.
+func attributeChanges(old *SchemaAttributeListItem, new *SchemaAttributeListItem) []string {
+	var result []string
+
+	if old.Type != new.Type && old.Type != "" && new.Type != "" {
+		result = append(result, fmt.Sprintf("type changed from %s to %s", old.Type, new.Type))
+	}
+
+	traits := []struct {
+		Name string
+		Old  bool
+		New  bool
+	}{
+		{Name: "required", Old: old.Required, New: new.Required},
+		{Name: "optional", Old: old.Optional, New: new.Optional},
+		{Name: "forces new", Old: old.ForceNew, New: new.ForceNew},
+		{Name: "sensitive", Old: old.Sensitive, New: new.Sensitive},
+		{Name: "write-only", Old: old.WriteOnly, New: new.WriteOnly},
+	}
+
+	for _, trait := range traits {
+		switch {
+		case trait.New && !trait.Old:
+			result = append(result, "now "+trait.Name)
+		case trait.Old && !trait.New:
+			result = append(result, "no longer "+trait.Name)
+		}
+	}
+
+	if normalizeDescription(old.Description) != normalizeDescription(new.Description) {
+		result = append(result, "description changed")
+	}
+
+	return result
+}
+
+// DocumentedAttributes returns the documented attributes of the arguments,
+// attributes, and schema sections, keyed by the attribute name prefixed by
+// the nested block or nested attribute name, if any (e.g. setting.key).
+// Attributes documented more than once are returned with their first
+// documentation.
+func (d *Document) DocumentedAttributes() map[string]*SchemaAttributeListItem {
+	result := make(map[string]*SchemaAttributeListItem)
+
+	if d.Sections == nil {
+		return result
+	}
+
+	var roots []*SchemaAttributeSection
+
+	if d.Sections.Arguments != nil {
+		roots = append(roots, (*SchemaAttributeSection)(d.Sections.Arguments))
+	}
+
+	if d.Sections.Attributes != nil {
+		roots = append(roots, (*SchemaAttributeSection)(d.Sections.Attributes))
+	}
+
+	if d.Sections.Schema != nil {
+		roots = append(roots, (*SchemaAttributeSection)(d.Sections.Schema))
+	}
+
+	for _, root := range roots {
+		d.documentedSectionAttributes(root, "", result)
+	}
+
+	return result
+}
+
+// documentedSectionAttributes adds the attributes of the section and its
+// children to the result. terraform-plugin-docs Required, Optional, and
+// Read-Only sections contain the attributes of the parent block, while other
+// nested sections are named by the first word of their heading (e.g.
+// network_interface for ### network_interface Configuration Block) or the
+// nested schema name (e.g. setting.key for ### Nested Schema for
+// `setting.key`).
+func (d *Document) documentedSectionAttributes(section *SchemaAttributeSection, block string, result map[string]*SchemaAttributeListItem) {
+	for _, list := range section.SchemaAttributeLists {
+		for _, item := range list.Items {
+			if item.Name == "" {
+				continue
+			}
+
+			name := item.Name
+
+			if block != "" {
+				name = block + "." + name
+			}
+
+			if _, ok := result[name]; !ok {
+				result[name] = item
+			}
+		}
+	}
+
+	for _, child := range section.Children {
+		childBlock := block
+
+		if child.Heading != nil {
+			headingText := string(child.Heading.Text(d.source))
+
+			switch {
+			case strings.HasPrefix(headingText, "Nested Schema for"):
+				childBlock = strings.Trim(strings.TrimPrefix(headingText, "Nested Schema for"), " `")
+			case section == (*SchemaAttributeSection)(d.Sections.Schema):
+				// Required, Optional, and Read-Only sections
+			default:
+				if fields := strings.Fields(headingText); len(fields) > 0 {
+					childBlock = strings.Trim(fields[0], "`")
+				}
+			}
+		}
+
+		d.documentedSectionAttributes(child, childBlock, result)
+	}
+}
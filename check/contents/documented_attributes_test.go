@@ -0,0 +1,100 @@
+package contents
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDocumentDocumentedAttributes(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Path   string
+		Expect []string
+	}{
+		{
+			Name:   "reference",
+			Path:   "testdata/missing_attributes/example.md",
+			Expect: []string{"id", "name", "setting", "setting.key"},
+		},
+		{
+			Name:   "schema",
+			Path:   "testdata/schema/passing.md",
+			Expect: []string{"id", "name", "network_interface", "network_interface.description", "network_interface.private_ip", "network_interface.subnet_id", "tags"},
+		},
+		{
+			Name: "empty",
+			Path: "testdata/empty.md",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+
+			for name := range doc.DocumentedAttributes() {
+				got = append(got, name)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %q, got: %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestCompareAttributes(t *testing.T) {
+	old := map[string]*SchemaAttributeListItem{
+		"description": {Name: "description", Description: " Description of thing."},
+		"name":        {Name: "name", Description: "Name.", Optional: true},
+		"setting.key": {Name: "key", Description: "Key.", Required: true},
+		"tags":        {Name: "tags", Description: "Tags."},
+	}
+	new := map[string]*SchemaAttributeListItem{
+		"description": {Name: "description", Description: "description of  thing"},
+		"name":        {Name: "name", Description: "Name.", ForceNew: true, Required: true},
+		"setting.key": {Name: "key", Description: "Key of the setting.", Required: true},
+		"type":        {Name: "type", Description: "Type."},
+	}
+
+	got := CompareAttributes(old, new)
+
+	if !got.HasChanges() {
+		t.Fatalf("expected changes, got none")
+	}
+
+	if expected := []string{"type"}; !reflect.DeepEqual(got.Added, expected) {
+		t.Errorf("expected added: %q, got: %q", expected, got.Added)
+	}
+
+	if expected := []string{"tags"}; !reflect.DeepEqual(got.Removed, expected) {
+		t.Errorf("expected removed: %q, got: %q", expected, got.Removed)
+	}
+
+	var changed []string
+
+	for _, change := range got.Changed {
+		changed = append(changed, change.String())
+	}
+
+	expected := []string{
+		"name (now required, no longer optional, now forces new)",
+		"setting.key (description changed)",
+	}
+
+	if !reflect.DeepEqual(changed, expected) {
+		t.Errorf("expected changed: %q, got: %q", expected, changed)
+	}
+
+	if CompareAttributes(old, old).HasChanges() {
+		t.Errorf("expected no changes comparing the same attributes")
+	}
+}
@@ -0,0 +1,53 @@
+package contents
+
+import (
+	"sort"
+)
+
+// MissingAttributes returns the schema attributes which are not documented,
+// sorted by block and name. The attributes contain the kind of each attribute
+// name (e.g. write-only) or an empty string, keyed by the nested block or
+// nested attribute name, or an empty string for the root. Root attributes are
+// missing if not found in any arguments, attributes, or schema section, while
+// nested attributes are only missing if their nested section is found.
+func (d *Document) MissingAttributes(attributes map[string]map[string]string) []*MissingAttributeError {
+	var result []*MissingAttributeError
+
+	for block, kinds := range attributes {
+		sections := d.schemaAttributeSections(block)
+
+		if len(sections) == 0 {
+			continue
+		}
+
+		for name, kind := range kinds {
+			var item *SchemaAttributeListItem
+
+			for _, section := range sections {
+				if item = section.item(name); item != nil {
+					break
+				}
+			}
+
+			if item != nil {
+				continue
+			}
+
+			result = append(result, &MissingAttributeError{
+				Block: block,
+				Kind:  kind,
+				Name:  name,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Block != result[j].Block {
+			return result[i].Block < result[j].Block
+		}
+
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
@@ -0,0 +1,65 @@
+package contents
+
+import (
+	"testing"
+)
+
+func TestDocumentMissingAttributes(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Path       string
+		Attributes map[string]map[string]string
+		Expect     []string
+	}{
+		{
+			Name: "none missing",
+			Path: "testdata/missing_attributes/example.md",
+			Attributes: map[string]map[string]string{
+				"":        {"id": "", "name": "", "setting": ""},
+				"setting": {"key": ""},
+			},
+		},
+		{
+			Name: "missing",
+			Path: "testdata/missing_attributes/example.md",
+			Attributes: map[string]map[string]string{
+				"":        {"id": "", "name": "", "password_wo": "write-only", "token": "sensitive"},
+				"setting": {"key": "", "value": ""},
+			},
+			Expect: []string{
+				"missing write-only attribute documentation: password_wo",
+				"missing sensitive attribute documentation: token",
+				"missing attribute documentation: setting.value",
+			},
+		},
+		{
+			Name: "nested section not found",
+			Path: "testdata/missing_attributes/example.md",
+			Attributes: map[string]map[string]string{
+				"timeouts": {"create": ""},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := NewDocument(testCase.Path, "test")
+
+			if err := doc.Parse(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := doc.MissingAttributes(testCase.Attributes)
+
+			if len(got) != len(testCase.Expect) {
+				t.Fatalf("expected %d missing attributes, got %d: %v", len(testCase.Expect), len(got), got)
+			}
+
+			for i, err := range got {
+				if err.Error() != testCase.Expect[i] {
+					t.Errorf("expected missing attribute %d %q, got: %s", i, testCase.Expect[i], err)
+				}
+			}
+		})
+	}
+}
@@ -1,11 +1,30 @@
 package contents
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/yuin/goldmark/ast"
 )
 
+const (
+	// SchemaOrderingStyleAlphabetical requires schema attribute lists to be
+	// sorted by name.
+	SchemaOrderingStyleAlphabetical = "alphabetical"
+
+	// SchemaOrderingStyleRequiredOptional requires schema attribute lists to
+	// contain required arguments sorted by name, then optional arguments
+	// sorted by name.
+	SchemaOrderingStyleRequiredOptional = "required-optional"
+)
+
+// SchemaOrderingStyles contains all available schema ordering styles.
+var SchemaOrderingStyles = []string{
+	SchemaOrderingStyleAlphabetical,
+	SchemaOrderingStyleRequiredOptional,
+}
+
 // SchemaAttributeList represents a schema attribute list
 //
 // This may represent root or nested lists of arguments or attributes
@@ -22,7 +41,9 @@ type SchemaAttributeListItem struct {
 	Name        string
 	Optional    bool
 	Required    bool
+	Sensitive   bool
 	Type        string
+	WriteOnly   bool
 }
 
 type SchemaAttributeListItemByName []*SchemaAttributeListItem
@@ -31,6 +52,108 @@ func (item SchemaAttributeListItemByName) Len() int           { return len(item)
 func (item SchemaAttributeListItemByName) Swap(i, j int)      { item[i], item[j] = item[j], item[i] }
 func (item SchemaAttributeListItemByName) Less(i, j int) bool { return item[i].Name < item[j].Name }
 
+type SchemaAttributeListItemByRequiredName []*SchemaAttributeListItem
+
+func (item SchemaAttributeListItemByRequiredName) Len() int      { return len(item) }
+func (item SchemaAttributeListItemByRequiredName) Swap(i, j int) { item[i], item[j] = item[j], item[i] }
+func (item SchemaAttributeListItemByRequiredName) Less(i, j int) bool {
+	if item[i].Required != item[j].Required {
+		return item[i].Required
+	}
+
+	return item[i].Name < item[j].Name
+}
+
+// MissingDescriptionError is returned when a schema attribute list item has no
+// description, such as placeholder items in generated documentation stubs.
+type MissingDescriptionError struct {
+	// Section describes the section, e.g. arguments section, including any
+	// nested section heading.
+	Section string
+
+	Name string
+}
+
+func (e *MissingDescriptionError) Error() string {
+	return fmt.Sprintf("%s attribute (%s) is missing a description", e.Section, e.Name)
+}
+
+// checkSchemaAttributeSectionDescriptions verifies the schema attribute list
+// items of the section and its nested sections have a description.
+func checkSchemaAttributeSectionDescriptions(sectionName string, section *SchemaAttributeSection, source []byte) error {
+	for _, list := range section.SchemaAttributeLists {
+		if item := schemaAttributeListMissingDescription(list); item != nil {
+			return &MissingDescriptionError{
+				Name:    item.Name,
+				Section: sectionName + " section",
+			}
+		}
+	}
+
+	for _, child := range section.Children {
+		for _, list := range child.SchemaAttributeLists {
+			if item := schemaAttributeListMissingDescription(list); item != nil {
+				return &MissingDescriptionError{
+					Name:    item.Name,
+					Section: fmt.Sprintf("%s section nested section (%s)", sectionName, child.Heading.Text(source)),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// schemaAttributeListMissingDescription returns the first named item of the
+// list without a description.
+func schemaAttributeListMissingDescription(list *SchemaAttributeList) *SchemaAttributeListItem {
+	for _, item := range list.Items {
+		if item.Name != "" && strings.TrimSpace(item.Description) == "" {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// checkSchemaAttributeSectionOrdering verifies the schema attribute lists of
+// the section and its nested sections are sorted with the style.
+func checkSchemaAttributeSectionOrdering(sectionName string, section *SchemaAttributeSection, style string, source []byte) error {
+	for _, list := range section.SchemaAttributeLists {
+		if !schemaAttributeListSorted(list, style) {
+			return fmt.Errorf("%s section is not sorted by %s", sectionName, schemaOrderingStyleDescription(style))
+		}
+	}
+
+	for _, child := range section.Children {
+		for _, list := range child.SchemaAttributeLists {
+			if !schemaAttributeListSorted(list, style) {
+				return fmt.Errorf("%s section nested section (%s) is not sorted by %s", sectionName, child.Heading.Text(source), schemaOrderingStyleDescription(style))
+			}
+		}
+	}
+
+	return nil
+}
+
+// schemaAttributeListSorted returns true if the list is sorted with the style.
+// An empty style is SchemaOrderingStyleAlphabetical.
+func schemaAttributeListSorted(list *SchemaAttributeList, style string) bool {
+	if style == SchemaOrderingStyleRequiredOptional {
+		return sort.IsSorted(SchemaAttributeListItemByRequiredName(list.Items))
+	}
+
+	return sort.IsSorted(SchemaAttributeListItemByName(list.Items))
+}
+
+func schemaOrderingStyleDescription(style string) string {
+	if style == SchemaOrderingStyleRequiredOptional {
+		return "required then optional, each by name"
+	}
+
+	return "name"
+}
+
 func schemaAttributeListWalker(list *ast.List, source []byte) (*SchemaAttributeList, error) {
 	result := &SchemaAttributeList{}
 
@@ -62,6 +185,7 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 	result := &SchemaAttributeListItem{}
 
 	// Expected format: `Name` - (Required/Optional[, ForceNew]) Description
+	// or terraform-plugin-docs format: `Name` (Type[, Traits]) Description
 
 	err := ast.Walk(listItem, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
 		if !entering {
@@ -71,10 +195,25 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 		switch node := node.(type) {
 		case *ast.TextBlock:
 			text := string(node.Text(source))
+
+			// Placeholder items, e.g. `name` -, have no description
+			if name, ok := strings.CutSuffix(text, " -"); ok && name != "" && !strings.Contains(name, " ") {
+				result.Name = name
+
+				return ast.WalkStop, nil
+			}
+
 			itemParts := strings.SplitN(text, " - ", 2)
 
 			if len(itemParts) != 2 {
-				return ast.WalkContinue, nil
+				// terraform-plugin-docs format names are followed by the traits
+				name, traits, ok := strings.Cut(text, " (")
+
+				if !ok || name == "" || strings.Contains(name, " ") || !strings.Contains(traits, ")") {
+					return ast.WalkContinue, nil
+				}
+
+				itemParts = []string{name, "(" + traits}
 			}
 
 			result.Name = itemParts[0]
@@ -102,6 +241,10 @@ func schemaAttributeListItemWalker(listItem *ast.ListItem, source []byte) (*Sche
 					result.Optional = true
 				case "Required":
 					result.Required = true
+				case "Sensitive":
+					result.Sensitive = true
+				case "Write-only", "Write-Only":
+					result.WriteOnly = true
 				}
 			}
 
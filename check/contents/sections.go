@@ -14,6 +14,8 @@ const (
 	walkerSectionAttributes
 	walkerSectionTimeouts
 	walkerSectionImport
+	walkerSectionSchema
+	walkerSectionIdentity
 )
 
 // Sections represents all expected sections of a resource documentation page
@@ -21,7 +23,9 @@ type Sections struct {
 	Attributes *AttributesSection
 	Arguments  *ArgumentsSection
 	Example    *ExampleSection
+	Identity   *IdentitySection
 	Import     *ImportSection
+	Schema     *SchemaSection
 	Timeouts   *TimeoutsSection
 	Title      *TitleSection
 }
@@ -42,6 +46,10 @@ type ExampleSection struct {
 	Paragraphs       []*ast.Paragraph
 }
 
+// IdentitySection represents a resource identity schema section (e.g. ###
+// Identity Schema), which may contain Required and Optional children.
+type IdentitySection SchemaAttributeSection
+
 // ImportSection represents a resource import section.
 type ImportSection struct {
 	FencedCodeBlocks []*ast.FencedCodeBlock
@@ -49,11 +57,18 @@ type ImportSection struct {
 	Paragraphs       []*ast.Paragraph
 }
 
+// SchemaSection represents a terraform-plugin-docs generated schema section,
+// which contains Required, Optional, Read-Only, and Nested Schema for children.
+type SchemaSection SchemaAttributeSection
+
 // SchemaAttributeSection represents a schema attribute section
 //
 // This may represent root or nested lists of arguments or attributes
 type SchemaAttributeSection struct {
-	// Children contains further nested sections below this section
+	// Children contains further nested sections below this section, such as
+	// nested block (### network_interface) or terraform-plugin-docs nested
+	// schema (### Nested Schema for `network_interface`) sections, in
+	// document order.
 	Children []*SchemaAttributeSection
 
 	// FencedCodeBlocks contains any found code blocks
@@ -98,6 +113,10 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 
 	var walkerSectionStartingLevel, walkerSection int
 
+	// walkerSchemaAttributeSection is the current root or nested section
+	// within the arguments, attributes, or schema section.
+	var walkerSchemaAttributeSection *SchemaAttributeSection
+
 	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
 		if !entering {
 			return ast.WalkContinue, nil
@@ -110,10 +129,8 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				result.Title.FencedCodeBlocks = append(result.Title.FencedCodeBlocks, node)
 			case walkerSectionExample:
 				result.Example.FencedCodeBlocks = append(result.Example.FencedCodeBlocks, node)
-			case walkerSectionArguments:
-				result.Arguments.FencedCodeBlocks = append(result.Arguments.FencedCodeBlocks, node)
-			case walkerSectionAttributes:
-				result.Attributes.FencedCodeBlocks = append(result.Attributes.FencedCodeBlocks, node)
+			case walkerSectionArguments, walkerSectionAttributes, walkerSectionIdentity, walkerSectionSchema:
+				walkerSchemaAttributeSection.FencedCodeBlocks = append(walkerSchemaAttributeSection.FencedCodeBlocks, node)
 			case walkerSectionTimeouts:
 				result.Timeouts.FencedCodeBlocks = append(result.Timeouts.FencedCodeBlocks, node)
 			case walkerSectionImport:
@@ -159,6 +176,7 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 
 				walkerSection = walkerSectionArguments
 				walkerSectionStartingLevel = node.Level
+				walkerSchemaAttributeSection = (*SchemaAttributeSection)(result.Arguments)
 
 				return ast.WalkContinue, nil
 			}
@@ -170,6 +188,7 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 
 				walkerSection = walkerSectionAttributes
 				walkerSectionStartingLevel = node.Level
+				walkerSchemaAttributeSection = (*SchemaAttributeSection)(result.Attributes)
 
 				return ast.WalkContinue, nil
 			}
@@ -196,24 +215,55 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				return ast.WalkContinue, nil
 			}
 
-			//fmt.Printf("(walker section level: %d) unknown heading level %d: %s\n", walkerSectionStartingLevel, node.Level, headingText)
-			walkerSection = walkerSectionUnknown
+			if result.Identity == nil && strings.HasPrefix(headingText, "Identity") {
+				result.Identity = &IdentitySection{
+					Heading: node,
+				}
 
-			return ast.WalkSkipChildren, nil
-		case *ast.List:
+				walkerSection = walkerSectionIdentity
+				walkerSectionStartingLevel = node.Level
+				walkerSchemaAttributeSection = (*SchemaAttributeSection)(result.Identity)
+
+				return ast.WalkContinue, nil
+			}
+
+			if result.Schema == nil && headingText == "Schema" {
+				result.Schema = &SchemaSection{
+					Heading: node,
+				}
+
+				walkerSection = walkerSectionSchema
+				walkerSectionStartingLevel = node.Level
+				walkerSchemaAttributeSection = (*SchemaAttributeSection)(result.Schema)
+
+				return ast.WalkContinue, nil
+			}
+
+			// Nested sections below the arguments, attributes, identity, or
+			// schema section heading level are children of the section
 			switch walkerSection {
-			case walkerSectionArguments:
-				result.Arguments.Lists = append(result.Arguments.Lists, node)
+			case walkerSectionArguments, walkerSectionAttributes, walkerSectionIdentity, walkerSectionSchema:
+				if node.Level > walkerSectionStartingLevel {
+					child := &SchemaAttributeSection{
+						Heading: node,
+					}
 
-				schemaAttributeList, err := schemaAttributeListWalker(node, source)
+					root := schemaAttributeSectionRoot(result, walkerSection)
+					root.Children = append(root.Children, child)
+					walkerSchemaAttributeSection = child
 
-				if err != nil {
-					return ast.WalkStop, err
+					return ast.WalkContinue, nil
 				}
+			}
 
-				result.Arguments.SchemaAttributeLists = append(result.Arguments.SchemaAttributeLists, schemaAttributeList)
-			case walkerSectionAttributes:
-				result.Attributes.Lists = append(result.Attributes.Lists, node)
+			//fmt.Printf("(walker section level: %d) unknown heading level %d: %s\n", walkerSectionStartingLevel, node.Level, headingText)
+			walkerSection = walkerSectionUnknown
+
+			return ast.WalkSkipChildren, nil
+		case *ast.List:
+			switch walkerSection {
+			case walkerSectionArguments, walkerSectionAttributes, walkerSectionIdentity, walkerSectionSchema:
+				walkerSchemaAttributeSection.Lists = append(walkerSchemaAttributeSection.Lists, node)
 
 				schemaAttributeList, err := schemaAttributeListWalker(node, source)
 
@@ -221,7 +271,7 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 					return ast.WalkStop, err
 				}
 
-				result.Attributes.SchemaAttributeLists = append(result.Attributes.SchemaAttributeLists, schemaAttributeList)
+				walkerSchemaAttributeSection.SchemaAttributeLists = append(walkerSchemaAttributeSection.SchemaAttributeLists, schemaAttributeList)
 			case walkerSectionTimeouts:
 				result.Timeouts.Lists = append(result.Timeouts.Lists, node)
 			}
@@ -233,10 +283,8 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 				result.Title.Paragraphs = append(result.Title.Paragraphs, node)
 			case walkerSectionExample:
 				result.Example.Paragraphs = append(result.Example.Paragraphs, node)
-			case walkerSectionArguments:
-				result.Arguments.Paragraphs = append(result.Arguments.Paragraphs, node)
-			case walkerSectionAttributes:
-				result.Attributes.Paragraphs = append(result.Attributes.Paragraphs, node)
+			case walkerSectionArguments, walkerSectionAttributes, walkerSectionIdentity, walkerSectionSchema:
+				walkerSchemaAttributeSection.Paragraphs = append(walkerSchemaAttributeSection.Paragraphs, node)
 			case walkerSectionTimeouts:
 				result.Timeouts.Paragraphs = append(result.Timeouts.Paragraphs, node)
 			case walkerSectionImport:
@@ -251,3 +299,20 @@ func sectionsWalker(document ast.Node, source []byte, resourceName string) (*Sec
 
 	return result, err
 }
+
+// schemaAttributeSectionRoot returns the root arguments, attributes, identity,
+// or schema section for the walker section.
+func schemaAttributeSectionRoot(sections *Sections, walkerSection int) *SchemaAttributeSection {
+	switch walkerSection {
+	case walkerSectionArguments:
+		return (*SchemaAttributeSection)(sections.Arguments)
+	case walkerSectionAttributes:
+		return (*SchemaAttributeSection)(sections.Attributes)
+	case walkerSectionIdentity:
+		return (*SchemaAttributeSection)(sections.Identity)
+	case walkerSectionSchema:
+		return (*SchemaAttributeSection)(sections.Schema)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check/contents"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestSchemaAttributeNames(t *testing.T) {
+	block := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"name": {},
+			"password": {
+				Sensitive: true,
+			},
+			"settings": {
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"secret": {
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"token": {
+				Sensitive: true,
+			},
+		},
+		NestedBlocks: map[string]*tfjson.SchemaBlockType{
+			"network_interface": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"private_key": {
+							Sensitive: true,
+						},
+						"subnet_id": {},
+					},
+				},
+			},
+		},
+	}
+
+	got := schemaAttributeNames(block, func(attribute *tfjson.SchemaAttribute) bool { return attribute.Sensitive })
+	expected := map[string][]string{
+		"":                  {"password", "token"},
+		"network_interface": {"private_key"},
+		"settings":          {"secret"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestSchemaAttributeDescriptions(t *testing.T) {
+	block := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"id": {},
+			"name": {
+				Description: "Name of the thing.",
+			},
+		},
+		NestedBlocks: map[string]*tfjson.SchemaBlockType{
+			"network_interface": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"subnet_id": {
+							Description: "Identifier of the subnet.",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := schemaAttributeDescriptions(block)
+	expected := map[string]map[string]string{
+		"": {
+			"name": "Name of the thing.",
+		},
+		"network_interface": {
+			"subnet_id": "Identifier of the subnet.",
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestSchemaWriteOnlyAttributes(t *testing.T) {
+	block := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"password_wo": {
+				WriteOnly: true,
+			},
+			"password_wo_version": {},
+			"token_wo": {
+				WriteOnly: true,
+			},
+		},
+	}
+
+	got := schemaWriteOnlyAttributes(block)
+	expected := map[string][]*contents.WriteOnlyAttribute{
+		"": {
+			{Name: "password_wo", VersionAttribute: "password_wo_version"},
+			{Name: "token_wo"},
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestContentsCheckID(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Err    error
+		Expect string
+	}{
+		{
+			Name:   "contents",
+			Err:    errors.New("test"),
+			Expect: CheckIDContents,
+		},
+		{
+			Name:   "callout",
+			Err:    fmt.Errorf("test: %w", &contents.CalloutError{Err: errors.New("test")}),
+			Expect: CheckIDContentsCallouts,
+		},
+		{
+			Name:   "duplicate attribute",
+			Err:    fmt.Errorf("test: %w", &contents.DuplicateAttributeError{Name: "test"}),
+			Expect: CheckIDContentsDuplicateAttributes,
+		},
+		{
+			Name:   "example reference",
+			Err:    fmt.Errorf("test: %w", &contents.ExampleReferenceError{Err: errors.New("test")}),
+			Expect: CheckIDContentsExampleReferences,
+		},
+		{
+			Name:   "identity section",
+			Err:    fmt.Errorf("test: %w", &contents.IdentitySectionError{Err: errors.New("test")}),
+			Expect: CheckIDContentsIdentity,
+		},
+		{
+			Name:   "meta-argument",
+			Err:    fmt.Errorf("test: %w", &contents.MetaArgumentError{Name: "count"}),
+			Expect: CheckIDContentsMetaArguments,
+		},
+		{
+			Name:   "missing attribute",
+			Err:    fmt.Errorf("test: %w", &contents.MissingAttributeError{Name: "password_wo"}),
+			Expect: CheckIDContentsWriteOnlyAttributesMissing,
+		},
+		{
+			Name:   "missing description",
+			Err:    fmt.Errorf("test: %w", &contents.MissingDescriptionError{Name: "name", Section: "arguments section"}),
+			Expect: CheckIDContentsAttributeDescriptions,
+		},
+		{
+			Name:   "schema description",
+			Err:    fmt.Errorf("test: %w", &contents.SchemaDescriptionError{Attributes: []string{"name"}}),
+			Expect: CheckIDContentsSchemaDescriptions,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := contentsCheckID(testCase.Err); got != testCase.Expect {
+				t.Errorf("expected: %s, got: %s", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package check
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// registryDocumentationTypes maps the Terraform Registry documentation URL
+// path elements (e.g. /providers/hashicorp/aws/latest/docs/resources/instance)
+// and legacy terraform.io documentation URL path elements (e.g.
+// /docs/providers/aws/r/instance.html) to their documentation type.
+var registryDocumentationTypes = map[string]string{
+	LegacyDataSourcesDirectory:     DocumentationTypeDataSource,
+	LegacyResourcesDirectory:       DocumentationTypeResource,
+	RegistryActionsDirectory:       DocumentationTypeAction,
+	RegistryDataSourcesDirectory:   DocumentationTypeDataSource,
+	RegistryFunctionsDirectory:     DocumentationTypeFunction,
+	RegistryGuidesDirectory:        DocumentationTypeGuide,
+	RegistryListResourcesDirectory: DocumentationTypeListResource,
+	RegistryResourcesDirectory:     DocumentationTypeResource,
+}
+
+// CrossReferencesOptions represents configuration options for CrossReferences.
+type CrossReferencesOptions struct {
+	*FileOptions
+
+	// Enable enables the cross-references check.
+	Enable bool
+
+	// IgnoreGuideLinks skips links from index and guide documentation to
+	// guides, which are verified by the guide-links check.
+	IgnoreGuideLinks bool
+
+	ProviderName string
+
+	// ProviderSource, if set, limits the verified Terraform Registry links to
+	// the provider namespace. Otherwise links of any namespace with the
+	// provider name are verified.
+	ProviderSource string
+}
+
+// CrossReferencesCheck verifies links between documentation of the same
+// provider.
+type CrossReferencesCheck struct {
+	Options *CrossReferencesOptions
+}
+
+func NewCrossReferencesCheck(opts *CrossReferencesOptions) *CrossReferencesCheck {
+	check := &CrossReferencesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &CrossReferencesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the links of each documentation file to other documentation of
+// the provider, either relative paths or Terraform Registry URLs (e.g.
+// /providers/hashicorp/aws/latest/docs/resources/instance), resolve to
+// existing documentation.
+func (check *CrossReferencesCheck) Run(directories map[string][]string) error {
+	documents := documentationNames(directories)
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryDocumentationType(directory) == DocumentationTypeUnknown {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file, documents); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the links of the documentation file against the existing
+// documentation names, keyed by documentation type.
+func (check *CrossReferencesCheck) RunFile(path string, documents map[string]map[string]bool) error {
+	check.Options.Log().Debug("Checking documentation file cross-references", "check", CheckIDCrossReferences, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	ignoreGuideLinks := check.Options.IgnoreGuideLinks && isGuideLinkSource(path)
+
+	var result *multierror.Error
+
+	for _, destination := range linkDestinations(content) {
+		u, err := url.Parse(destination)
+
+		if err != nil {
+			continue
+		}
+
+		if documentationType, name, ok := registryReference(u, check.Options.ProviderName, check.Options.ProviderSource); ok {
+			if ignoreGuideLinks && documentationType == DocumentationTypeGuide {
+				continue
+			}
+
+			if documentationType != DocumentationTypeIndex && !documents[documentationType][name] {
+				result = multierror.Append(result, fmt.Errorf("link (%s) %s documentation not found: %s", destination, documentationType, name))
+			}
+
+			continue
+		}
+
+		// Absolute paths of other documentation, such as /docs/language, are
+		// served by the Terraform website rather than the provider.
+		if !isLocalReference(destination) || u.Path == "" || strings.HasPrefix(u.Path, "/") {
+			continue
+		}
+
+		if documentationType, _, ok := relativeReference(path, u); ok && ignoreGuideLinks && documentationType == DocumentationTypeGuide {
+			continue
+		}
+
+		referencePath, err := localReferencePath(path, destination, check.Options.FileOptions)
+
+		if err != nil {
+			continue
+		}
+
+		if !localDocumentationExists(check.Options.FileOptions, referencePath) {
+			result = multierror.Append(result, fmt.Errorf("link (%s) file not found", destination))
+		}
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		return NewFinding(CheckIDCrossReferences, path, fmt.Errorf("%s: error checking cross-references: %w", path, err))
+	}
+
+	return nil
+}
+
+// documentationNames returns the names, without file extensions, of the
+// documentation files keyed by documentation type. CDKTF documentation is
+// omitted.
+func documentationNames(directories map[string][]string) map[string]map[string]bool {
+	result := make(map[string]map[string]bool)
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		documentationType := DirectoryDocumentationType(directory)
+
+		if result[documentationType] == nil {
+			result[documentationType] = make(map[string]bool)
+		}
+
+		for _, file := range files {
+			result[documentationType][TrimFileExtension(file)] = true
+		}
+	}
+
+	return result
+}
+
+// registryReference returns the documentation type and name of a Terraform
+// Registry or legacy terraform.io documentation URL of the provider, e.g.
+// resource and instance. Provider index URLs return DocumentationTypeIndex.
+// If the provider source is set, Terraform Registry URLs must match its
+// namespace.
+func registryReference(u *url.URL, providerName string, providerSource string) (string, string, bool) {
+	if providerName == "" {
+		return "", "", false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	switch {
+	case (u.Host == "" || u.Host == defaultProviderRegistryHostname) && len(parts) >= 5 && parts[0] == "providers" && parts[4] == "docs":
+		if !strings.EqualFold(parts[2], providerName) {
+			return "", "", false
+		}
+
+		if strings.Contains(providerSource, "/") {
+			sourceParts := strings.Split(normalizeProviderSource(providerSource), "/")
+
+			if !strings.EqualFold(parts[1], sourceParts[len(sourceParts)-2]) {
+				return "", "", false
+			}
+		}
+
+		parts = parts[5:]
+	case (u.Host == "" || u.Host == "terraform.io" || u.Host == "www.terraform.io") && len(parts) >= 3 && parts[0] == "docs" && parts[1] == "providers":
+		if !strings.EqualFold(parts[2], providerName) {
+			return "", "", false
+		}
+
+		parts = parts[3:]
+	default:
+		return "", "", false
+	}
+
+	if len(parts) == 0 || (len(parts) == 1 && TrimFileExtension(parts[0]) == "index") {
+		return DocumentationTypeIndex, "", true
+	}
+
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	documentationType, ok := registryDocumentationTypes[parts[0]]
+
+	if !ok {
+		return "", "", false
+	}
+
+	return documentationType, TrimFileExtension(parts[1]), true
+}
+
+// relativeReference returns the documentation type and name of a relative
+// link from the documentation file to a file in a documentation directory,
+// e.g. guide and getting_started for ../guides/getting_started.md.
+func relativeReference(file string, u *url.URL) (string, string, bool) {
+	if u.Scheme != "" || u.Host != "" || u.Path == "" || strings.HasPrefix(u.Path, "/") {
+		return "", "", false
+	}
+
+	target := path.Join(path.Dir(filepath.ToSlash(file)), u.Path)
+	documentationType := DirectoryDocumentationType(path.Dir(target))
+
+	if documentationType == DocumentationTypeUnknown {
+		return "", "", false
+	}
+
+	return documentationType, TrimFileExtension(target), true
+}
+
+// linkDestinations returns all Markdown link and autolink destinations in the
+// source.
+func linkDestinations(source []byte) []string {
+	document, _ := markdown.Parse(source)
+
+	var result []string
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.AutoLink:
+			result = append(result, string(node.URL(source)))
+		case *ast.Link:
+			result = append(result, string(node.Destination))
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
+
+// localDocumentationExists returns true if the path, relative to the base path,
+// exists, either as is or with a documentation file extension. Links commonly
+// omit the extension or use the .html extension of the published page.
+func localDocumentationExists(opts *FileOptions, referencePath string) bool {
+	if _, err := opts.Stat(referencePath); err == nil {
+		return true
+	}
+
+	candidates := []string{referencePath}
+
+	if trimmed := strings.TrimSuffix(referencePath, path.Ext(referencePath)); trimmed != referencePath {
+		candidates = append(candidates, trimmed)
+	}
+
+	for _, candidate := range candidates {
+		for _, extension := range ValidLegacyFileExtensions {
+			if _, err := opts.Stat(candidate + extension); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
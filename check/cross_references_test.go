@@ -0,0 +1,178 @@
+package check
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCrossReferencesCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Options      *CrossReferencesOptions
+		ExpectPaths  []string
+		ExpectErrors map[string][]string
+	}{
+		{
+			Name: "unknown provider name",
+			Options: &CrossReferencesOptions{
+				ProviderSource: "example/test",
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/thing.md",
+				"docs/guides/getting_started.md",
+			},
+			ExpectErrors: map[string][]string{
+				"docs/data-sources/thing.md": {
+					"link (../guides/missing.html) file not found",
+				},
+				"docs/guides/getting_started.md": {
+					"link (advanced.md) file not found",
+				},
+			},
+		},
+		{
+			Name: "provider source",
+			Options: &CrossReferencesOptions{
+				ProviderName:   "test",
+				ProviderSource: "registry.terraform.io/example/test",
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/thing.md",
+				"docs/guides/getting_started.md",
+			},
+			ExpectErrors: map[string][]string{
+				"docs/data-sources/thing.md": {
+					"link (https://registry.terraform.io/providers/example/test/latest/docs/resources/missing) resource documentation not found: missing",
+					"link (../guides/missing.html) file not found",
+				},
+			},
+		},
+		{
+			Name: "provider name",
+			Options: &CrossReferencesOptions{
+				ProviderName: "test",
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/thing.md",
+				"docs/guides/getting_started.md",
+			},
+			ExpectErrors: map[string][]string{
+				"docs/data-sources/thing.md": {
+					"link (https://registry.terraform.io/providers/example/test/latest/docs/resources/missing) resource documentation not found: missing",
+					"link (../guides/missing.html) file not found",
+					"link (https://registry.terraform.io/providers/other/test/latest/docs/resources/missing) resource documentation not found: missing",
+				},
+			},
+		},
+		{
+			Name: "ignore guide links",
+			Options: &CrossReferencesOptions{
+				IgnoreGuideLinks: true,
+				ProviderName:     "test",
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/thing.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/cross-references",
+			}
+
+			findings := testCheckFindings(t, testCase.Options.BasePath, NewCrossReferencesCheck(testCase.Options).Run, CheckIDCrossReferences)
+
+			var got []string
+
+			for _, finding := range findings {
+				for _, expectError := range testCase.ExpectErrors[finding.Path] {
+					if !strings.Contains(finding.Error(), expectError) {
+						t.Errorf("expected error to contain %q, got: %s", expectError, finding)
+					}
+				}
+
+				got = append(got, finding.Path)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
+
+func TestRegistryReference(t *testing.T) {
+	testCases := []struct {
+		URL          string
+		ExpectType   string
+		ExpectName   string
+		ExpectNotRef bool
+	}{
+		{
+			URL:        "/providers/example/test/latest/docs/resources/thing",
+			ExpectType: DocumentationTypeResource,
+			ExpectName: "thing",
+		},
+		{
+			URL:        "https://registry.terraform.io/providers/example/test/1.2.3/docs/data-sources/thing#argument-reference",
+			ExpectType: DocumentationTypeDataSource,
+			ExpectName: "thing",
+		},
+		{
+			URL:        "https://registry.terraform.io/providers/example/test/latest/docs",
+			ExpectType: DocumentationTypeIndex,
+		},
+		{
+			URL:        "https://www.terraform.io/docs/providers/test/d/thing.html",
+			ExpectType: DocumentationTypeDataSource,
+			ExpectName: "thing",
+		},
+		{
+			URL:        "/docs/providers/test/index.html",
+			ExpectType: DocumentationTypeIndex,
+		},
+		{
+			URL:          "https://registry.terraform.io/providers/example/other/latest/docs/resources/thing",
+			ExpectNotRef: true,
+		},
+		{
+			URL:          "https://registry.terraform.io/providers/example/test/latest/docs/unknown/thing",
+			ExpectNotRef: true,
+		},
+		{
+			URL:          "https://example.com/providers/example/test/latest/docs/resources/thing",
+			ExpectNotRef: true,
+		},
+		{
+			URL:          "/docs/language/resources/index.html",
+			ExpectNotRef: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.URL, func(t *testing.T) {
+			u, err := url.Parse(testCase.URL)
+
+			if err != nil {
+				t.Fatalf("unexpected error parsing URL: %s", err)
+			}
+
+			gotType, gotName, ok := registryReference(u, "test", "example/test")
+
+			if ok == testCase.ExpectNotRef {
+				t.Fatalf("expected reference %t, got %t", !testCase.ExpectNotRef, ok)
+			}
+
+			if gotType != testCase.ExpectType || gotName != testCase.ExpectName {
+				t.Errorf("expected %s %s, got %s %s", testCase.ExpectType, testCase.ExpectName, gotType, gotName)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// DeprecatedOptions represents configuration options for Deprecated.
+type DeprecatedOptions struct {
+	*FileOptions
+
+	DataSourceSchemas map[string]*tfjson.Schema
+
+	ProviderName string
+
+	// RenamedDataSources and RenamedResources map previous names to new
+	// names. Their documentation is verified by the renamed check instead.
+	RenamedDataSources map[string]string
+	RenamedResources   map[string]string
+
+	// RequireReplacement, if set, requires the documentation to also link to
+	// the documentation of another data source or resource.
+	RequireReplacement bool
+
+	ResourceSchemas map[string]*tfjson.Schema
+}
+
+// DeprecatedCheck verifies the documentation of data sources and resources
+// marked deprecated in the providers schema.
+type DeprecatedCheck struct {
+	Options *DeprecatedOptions
+}
+
+func NewDeprecatedCheck(opts *DeprecatedOptions) *DeprecatedCheck {
+	check := &DeprecatedCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &DeprecatedOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each data source and resource documentation file for a
+// deprecated schema contains a deprecation callout (e.g. ~> This resource is
+// deprecated).
+func (check *DeprecatedCheck) Run(directories map[string][]string) error {
+	if len(check.Options.DataSourceSchemas) == 0 && len(check.Options.ResourceSchemas) == 0 {
+		check.Options.Log().Debug("Skipping deprecated checks due to missing schemas", "check", CheckIDDeprecated)
+
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		var renames map[string]string
+		var resourceType string
+		var schemas map[string]*tfjson.Schema
+
+		switch DirectoryDocumentationType(directory) {
+		case DocumentationTypeDataSource:
+			renames = check.Options.RenamedDataSources
+			resourceType = ResourceTypeDataSource
+			schemas = check.Options.DataSourceSchemas
+		case DocumentationTypeResource:
+			renames = check.Options.RenamedResources
+			resourceType = ResourceTypeResource
+			schemas = check.Options.ResourceSchemas
+		default:
+			continue
+		}
+
+		for _, file := range files {
+			name := fileResourceName(check.Options.ProviderName, file)
+
+			if _, ok := renames[name]; ok {
+				continue
+			}
+
+			schema, ok := schemas[name]
+
+			if !ok || schema == nil || schema.Block == nil || !schema.Block.Deprecated {
+				continue
+			}
+
+			if err := check.RunFile(file, resourceType); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the documentation file of a deprecated data source or
+// resource.
+func (check *DeprecatedCheck) RunFile(path string, resourceType string) error {
+	check.Options.Log().Debug("Checking deprecated documentation file", "check", CheckIDDeprecated, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	var result *multierror.Error
+
+	if !hasDeprecationCallout(content) {
+		result = multierror.Append(result, fmt.Errorf("missing deprecation callout (e.g. ~> This %s is deprecated.)", resourceType))
+	}
+
+	if check.Options.RequireReplacement && !check.hasReplacementLink(content, path) {
+		result = multierror.Append(result, fmt.Errorf("missing link to replacement data source or resource documentation"))
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		return NewFinding(CheckIDDeprecated, path, fmt.Errorf("%s: error checking deprecated %s documentation: %w", path, resourceType, err))
+	}
+
+	return nil
+}
+
+// hasReplacementLink returns true if the source links to the documentation of
+// a data source or resource in the providers schema, other than itself.
+func (check *DeprecatedCheck) hasReplacementLink(source []byte, path string) bool {
+	name := fileResourceName(check.Options.ProviderName, path)
+
+	for _, linkName := range documentationLinkNames(source) {
+		if !strings.HasPrefix(linkName, check.Options.ProviderName+"_") {
+			linkName = check.Options.ProviderName + "_" + linkName
+		}
+
+		if linkName == name {
+			continue
+		}
+
+		if _, ok := check.Options.DataSourceSchemas[linkName]; ok {
+			return true
+		}
+
+		if _, ok := check.Options.ResourceSchemas[linkName]; ok {
+			return true
+		}
+	}
+
+	return false
+}
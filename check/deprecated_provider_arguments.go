@@ -0,0 +1,306 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark/ast"
+)
+
+var (
+	// deprecatedProviderArgumentNameRegexp matches the argument name of a
+	// list item, e.g. region - (Optional) or region (String, Deprecated),
+	// after code span backticks are removed.
+	deprecatedProviderArgumentNameRegexp = regexp.MustCompile(`^([A-Za-z0-9_]+)\s*(?:-|\()`)
+
+	// deprecatedProviderArgumentReplacementRegexp matches wording referring
+	// to a replacement, e.g. Use endpoints instead.
+	deprecatedProviderArgumentReplacementRegexp = regexp.MustCompile(`(?i)\b(?:instead|replaced|replacement|supersede[ds]?|use)\b`)
+)
+
+// DeprecatedProviderArgumentsOptions represents configuration options for
+// DeprecatedProviderArguments.
+type DeprecatedProviderArgumentsOptions struct {
+	*FileOptions
+
+	// RequireReplacement, if set, requires the documentation of each
+	// deprecated argument to also refer to a replacement (e.g. Use endpoints
+	// instead).
+	RequireReplacement bool
+
+	// Schema is the provider configuration schema.
+	Schema *tfjson.Schema
+}
+
+// DeprecatedProviderArgumentsCheck verifies the provider index documentation
+// of arguments and blocks marked deprecated in the provider configuration
+// schema notes the deprecation.
+type DeprecatedProviderArgumentsCheck struct {
+	Options *DeprecatedProviderArgumentsOptions
+}
+
+// deprecatedProviderArgumentItem represents an argument list item or callout
+// paragraph of the provider index documentation.
+type deprecatedProviderArgumentItem struct {
+	// Heading is the text of the closest preceding heading.
+	Heading string
+
+	Line int
+
+	// Name is the argument name of a list item or empty for a callout.
+	Name string
+
+	Text string
+}
+
+func NewDeprecatedProviderArgumentsCheck(opts *DeprecatedProviderArgumentsOptions) *DeprecatedProviderArgumentsCheck {
+	check := &DeprecatedProviderArgumentsCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &DeprecatedProviderArgumentsOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the provider index documentation of each directory structure,
+// except CDKTF and localized documentation, if the provider configuration
+// schema contains deprecated arguments or blocks.
+func (check *DeprecatedProviderArgumentsCheck) Run(directories map[string][]string) error {
+	if check.Options.Schema == nil || check.Options.Schema.Block == nil {
+		check.Options.Log().Debug("Skipping deprecated provider arguments checks due to missing schema", "check", CheckIDDeprecatedProviderArguments)
+
+		return nil
+	}
+
+	if len(schemaDeprecatedNames(check.Options.Schema.Block)) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		if DirectoryDocumentationType(directory) != DocumentationTypeIndex {
+			continue
+		}
+
+		for _, file := range files {
+			if !strings.HasPrefix(filepath.Base(file), "index.") || !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies each documented deprecated argument or block of the
+// provider index documentation file notes the deprecation, either in its list
+// item (e.g. (Optional, Deprecated) or "This argument is deprecated.") or in a
+// callout of the same section mentioning the argument. Undocumented arguments
+// are not reported.
+func (check *DeprecatedProviderArgumentsCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking provider index documentation deprecated arguments", "check", CheckIDDeprecatedProviderArguments, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	items := deprecatedProviderArgumentItems(content)
+	deprecatedNames := schemaDeprecatedNames(check.Options.Schema.Block)
+	parents := make([]string, 0, len(deprecatedNames))
+
+	for parent := range deprecatedNames {
+		parents = append(parents, parent)
+	}
+
+	sort.Strings(parents)
+
+	var firstLine int
+	var messages []string
+
+	for _, parent := range parents {
+		for _, name := range deprecatedNames[parent] {
+			var documented []*deprecatedProviderArgumentItem
+			var texts []string
+
+			for _, item := range items {
+				if parent != "" && !strings.Contains(strings.ReplaceAll(strings.ToLower(item.Heading), " ", "_"), parent) {
+					continue
+				}
+
+				switch item.Name {
+				case name:
+					documented = append(documented, item)
+					texts = append(texts, item.Text)
+				case "":
+					if strings.Contains(item.Text, name) {
+						texts = append(texts, item.Text)
+					}
+				}
+			}
+
+			if len(documented) == 0 {
+				continue
+			}
+
+			fullName := name
+
+			if parent != "" {
+				fullName = parent + "." + name
+			}
+
+			text := strings.Join(texts, "\n")
+
+			if len(messages) == 0 {
+				firstLine = documented[0].Line
+			}
+
+			if !strings.Contains(strings.ToLower(text), "deprecat") {
+				messages = append(messages, fmt.Sprintf("argument (%s) should note its deprecation (line %d)", fullName, documented[0].Line))
+
+				continue
+			}
+
+			if check.Options.RequireReplacement && !deprecatedProviderArgumentReplacementRegexp.MatchString(text) {
+				messages = append(messages, fmt.Sprintf("argument (%s) should note its replacement (line %d)", fullName, documented[0].Line))
+			}
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err = fmt.Errorf("%s: error checking deprecated provider arguments: %s", path, strings.Join(messages, ", "))
+	err = WithLine(err, firstLine)
+	err = WithSuggestion(err, "add the deprecation and replacement to the argument description (e.g. Deprecated: Use `new_argument` instead.) or a ~> callout mentioning the argument")
+
+	return NewFinding(CheckIDDeprecatedProviderArguments, path, err)
+}
+
+// deprecatedProviderArgumentItems returns the argument list items and callout
+// paragraphs of the Markdown source. The text of list items excludes their
+// nested lists.
+func deprecatedProviderArgumentItems(source []byte) []*deprecatedProviderArgumentItem {
+	document, _ := markdown.Parse(source)
+
+	var heading string
+	var result []*deprecatedProviderArgumentItem
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			return ast.WalkSkipChildren, nil
+		case *ast.Heading:
+			heading = string(node.Text(source))
+
+			return ast.WalkSkipChildren, nil
+		case *ast.Paragraph:
+			text := string(node.Text(source))
+
+			if !strings.HasPrefix(text, "->") && !strings.HasPrefix(text, "~>") && !strings.HasPrefix(text, "!>") {
+				return ast.WalkSkipChildren, nil
+			}
+
+			result = append(result, &deprecatedProviderArgumentItem{
+				Heading: heading,
+				Line:    strings.Count(string(source[:node.Lines().At(0).Start]), "\n") + 1,
+				Text:    text,
+			})
+
+			return ast.WalkSkipChildren, nil
+		case *ast.ListItem:
+			var texts []string
+			line := 0
+
+			for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+				if _, ok := child.(*ast.List); ok {
+					continue
+				}
+
+				if line == 0 && child.Lines().Len() > 0 {
+					line = strings.Count(string(source[:child.Lines().At(0).Start]), "\n") + 1
+				}
+
+				texts = append(texts, string(child.Text(source)))
+			}
+
+			if len(texts) == 0 {
+				return ast.WalkContinue, nil
+			}
+
+			if match := deprecatedProviderArgumentNameRegexp.FindStringSubmatch(texts[0]); match != nil {
+				result = append(result, &deprecatedProviderArgumentItem{
+					Heading: heading,
+					Line:    line,
+					Name:    match[1],
+					Text:    strings.Join(texts, "\n"),
+				})
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
+
+// schemaDeprecatedNames returns the names of deprecated attributes and nested
+// blocks, keyed by the nested block or nested attribute name, or an empty
+// string for the root block.
+func schemaDeprecatedNames(block *tfjson.SchemaBlock) map[string][]string {
+	result := schemaAttributeNames(block, func(attribute *tfjson.SchemaAttribute) bool { return attribute.Deprecated })
+
+	var walkBlock func(string, *tfjson.SchemaBlock)
+
+	walkBlock = func(parent string, block *tfjson.SchemaBlock) {
+		for name, blockType := range block.NestedBlocks {
+			if blockType == nil || blockType.Block == nil {
+				continue
+			}
+
+			if blockType.Block.Deprecated {
+				result[parent] = append(result[parent], name)
+			}
+
+			walkBlock(name, blockType.Block)
+		}
+	}
+
+	walkBlock("", block)
+
+	for parent := range result {
+		sort.Strings(result[parent])
+	}
+
+	return result
+}
@@ -0,0 +1,92 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestDeprecatedProviderArgumentsCheck(t *testing.T) {
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"endpoint": {Deprecated: true},
+				"insecure": {Deprecated: true},
+				"profile":  {Deprecated: true},
+				"region":   {},
+				"shared":   {Deprecated: true},
+				"token":    {Deprecated: true},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"assume_role": {
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"role_arn":     {},
+							"session_name": {Deprecated: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name    string
+		Options *DeprecatedProviderArgumentsOptions
+		Expect  []string
+	}{
+		{
+			Name:    "no schema",
+			Options: &DeprecatedProviderArgumentsOptions{},
+		},
+		{
+			Name: "no deprecated arguments",
+			Options: &DeprecatedProviderArgumentsOptions{
+				Schema: &tfjson.Schema{
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"region": {},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "deprecated arguments",
+			Options: &DeprecatedProviderArgumentsOptions{
+				Schema: schema,
+			},
+			Expect: []string{
+				"docs/index.md: error checking deprecated provider arguments: argument (insecure) should note its deprecation (line 22), argument (assume_role.session_name) should note its deprecation (line 32)",
+			},
+		},
+		{
+			Name: "require replacement",
+			Options: &DeprecatedProviderArgumentsOptions{
+				RequireReplacement: true,
+				Schema:             schema,
+			},
+			Expect: []string{
+				"docs/index.md: error checking deprecated provider arguments: argument (insecure) should note its deprecation (line 22), argument (profile) should note its replacement (line 23), argument (token) should note its replacement (line 25), argument (assume_role.session_name) should note its deprecation (line 32)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/deprecated-provider-arguments",
+			}
+
+			got := testFindingErrors(testCheckFindings(t, testCase.Options.BasePath, NewDeprecatedProviderArgumentsCheck(testCase.Options).Run, CheckIDDeprecatedProviderArguments))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected errors: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
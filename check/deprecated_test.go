@@ -0,0 +1,115 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestDeprecatedCheck(t *testing.T) {
+	deprecatedSchema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Deprecated: true,
+		},
+	}
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{},
+	}
+
+	testCases := []struct {
+		Name        string
+		Options     *DeprecatedOptions
+		ExpectPaths []string
+	}{
+		{
+			Name: "no schemas",
+			Options: &DeprecatedOptions{
+				ProviderName: "test",
+			},
+		},
+		{
+			Name: "no deprecated schemas",
+			Options: &DeprecatedOptions{
+				ProviderName: "test",
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_silent": schema,
+					"test_thing":  schema,
+				},
+			},
+		},
+		{
+			Name: "passing",
+			Options: &DeprecatedOptions{
+				DataSourceSchemas: map[string]*tfjson.Schema{
+					"test_old_thing": deprecatedSchema,
+				},
+				ProviderName: "test",
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_old_thing": deprecatedSchema,
+					"test_thing":     schema,
+				},
+			},
+		},
+		{
+			Name: "missing callout",
+			Options: &DeprecatedOptions{
+				ProviderName: "test",
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_old_thing": deprecatedSchema,
+					"test_silent":    deprecatedSchema,
+				},
+			},
+			ExpectPaths: []string{
+				"docs/resources/silent.md",
+			},
+		},
+		{
+			Name: "missing callout renamed",
+			Options: &DeprecatedOptions{
+				ProviderName: "test",
+				RenamedResources: map[string]string{
+					"test_silent": "test_thing",
+				},
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_silent": deprecatedSchema,
+				},
+			},
+		},
+		{
+			Name: "require replacement",
+			Options: &DeprecatedOptions{
+				DataSourceSchemas: map[string]*tfjson.Schema{
+					"test_old_thing": deprecatedSchema,
+					"test_thing":     schema,
+				},
+				ProviderName:       "test",
+				RequireReplacement: true,
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_old_thing": deprecatedSchema,
+					"test_thing":     schema,
+				},
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/old_thing.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/deprecated",
+			}
+
+			got := testFindingPaths(testCheckFindings(t, testCase.Options.BasePath, NewDeprecatedCheck(testCase.Options).Run, CheckIDDeprecated))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
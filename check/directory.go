@@ -1,29 +1,50 @@
 package check
 
 import (
+	"errors"
 	"fmt"
-	"log"
+	"io/fs"
+	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/bmatcuk/doublestar"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
 )
 
 const (
 	CdktfIndexDirectory = `cdktf`
 
-	DocumentationGlobPattern = `{docs/index.md,docs/{,cdktf/}{data-sources,guides,resources},website/docs}/**/*`
+	DirectoryStructureLegacy   = `legacy`
+	DirectoryStructureRegistry = `registry`
+
+	DocumentationGlobPattern = `{docs/index.md,docs/{,cdktf/*/}{data-sources,guides,resources}/**/*,docs/{actions,functions,list-resources}/**/*,docs/[a-z][a-z]{,-*,_*}/index.md,docs/[a-z][a-z]{,-*,_*}/{actions,data-sources,functions,guides,list-resources,resources}/**/*,website/docs/**/*}`
 
 	LegacyIndexDirectory       = `website/docs`
 	LegacyDataSourcesDirectory = `d`
 	LegacyGuidesDirectory      = `guides`
 	LegacyResourcesDirectory   = `r`
 
-	RegistryIndexDirectory       = `docs`
-	RegistryDataSourcesDirectory = `data-sources`
-	RegistryGuidesDirectory      = `guides`
-	RegistryResourcesDirectory   = `resources`
+	RegistryIndexDirectory         = `docs`
+	RegistryActionsDirectory       = `actions`
+	RegistryDataSourcesDirectory   = `data-sources`
+	RegistryFunctionsDirectory     = `functions`
+	RegistryGuidesDirectory        = `guides`
+	RegistryListResourcesDirectory = `list-resources`
+	RegistryResourcesDirectory     = `resources`
 )
 
+// DirectoryStructures contains the supported documentation directory
+// structures.
+var DirectoryStructures = []string{
+	DirectoryStructureLegacy,
+	DirectoryStructureRegistry,
+}
+
 var ValidLegacyDirectories = []string{
 	LegacyIndexDirectory,
 	LegacyIndexDirectory + "/" + LegacyDataSourcesDirectory,
@@ -33,8 +54,11 @@ var ValidLegacyDirectories = []string{
 
 var ValidRegistryDirectories = []string{
 	RegistryIndexDirectory,
+	RegistryIndexDirectory + "/" + RegistryActionsDirectory,
 	RegistryIndexDirectory + "/" + RegistryDataSourcesDirectory,
+	RegistryIndexDirectory + "/" + RegistryFunctionsDirectory,
 	RegistryIndexDirectory + "/" + RegistryGuidesDirectory,
+	RegistryIndexDirectory + "/" + RegistryListResourcesDirectory,
 	RegistryIndexDirectory + "/" + RegistryResourcesDirectory,
 }
 
@@ -46,6 +70,10 @@ var ValidCdktfLanguages = []string{
 	"typescript",
 }
 
+// localeRegexp matches locale directory names of localized documentation,
+// such as ja, pt-BR, or zh_CN.
+var localeRegexp = regexp.MustCompile(`^[a-z]{2}(?:[-_][A-Za-z0-9]{2,8})*$`)
+
 var ValidLegacySubdirectories = []string{
 	LegacyDataSourcesDirectory,
 	LegacyGuidesDirectory,
@@ -58,6 +86,39 @@ var ValidRegistrySubdirectories = []string{
 	RegistryResourcesDirectory,
 }
 
+// DirectoryOptions represents configuration options for a documentation directory.
+type DirectoryOptions struct {
+	// Exclude contains glob patterns of files and directories, relative to
+	// the base path (e.g. docs/drafts/**), which are not checked, such as
+	// work in progress or vendored content.
+	Exclude []string
+
+	// FileExtensions overrides the valid documentation file extensions.
+	FileExtensions []string
+
+	// OtherFileExtensions are extensions of additional files, such as images,
+	// which are allowed in the directory but not checked as documentation.
+	OtherFileExtensions []string
+
+	// FollowSymlinks enables following symbolic links to directories within
+	// the documentation directories. Links to a directory containing the link
+	// are skipped to prevent cycles. Symbolic links to files are always
+	// checked at each location they appear, regardless of this setting.
+	FollowSymlinks bool
+
+	// Logger, if set, receives the diagnostic logging of documentation
+	// directory discovery. Otherwise, the default hclog logger is used.
+	Logger hclog.Logger
+}
+
+func (opts *DirectoryOptions) log() hclog.Logger {
+	if opts == nil || opts.Logger == nil {
+		return hclog.L()
+	}
+
+	return opts.Logger
+}
+
 func InvalidDirectoriesCheck(directories map[string][]string) error {
 	for directory := range directories {
 		if IsValidRegistryDirectory(directory) {
@@ -72,6 +133,10 @@ func InvalidDirectoriesCheck(directories map[string][]string) error {
 			continue
 		}
 
+		if IsValidLocaleDirectory(directory) {
+			continue
+		}
+
 		return fmt.Errorf("invalid Terraform Provider documentation directory found: %s", directory)
 	}
 
@@ -81,11 +146,16 @@ func InvalidDirectoriesCheck(directories map[string][]string) error {
 func MixedDirectoriesCheck(directories map[string][]string) error {
 	var legacyDirectoryFound bool
 	var registryDirectoryFound bool
-	err := fmt.Errorf("mixed Terraform Provider documentation directory layouts found, must use only legacy or registry layout")
+	err := WithSuggestion(
+		fmt.Errorf("mixed Terraform Provider documentation directory layouts found, must use only legacy or registry layout"),
+		"move documentation to one layout, or disable the directory-mixed check to only require each documentation file in one layout",
+	)
 
 	for directory := range directories {
+		localeDirectory := IsValidLocaleDirectory(directory)
+
 		// Allow docs/ with other files
-		if IsValidRegistryDirectory(directory) && directory != RegistryIndexDirectory {
+		if (IsValidRegistryDirectory(directory) && directory != RegistryIndexDirectory) || (localeDirectory && DirectoryStructure(directory) == DirectoryStructureRegistry) {
 			registryDirectoryFound = true
 
 			if legacyDirectoryFound {
@@ -93,7 +163,7 @@ func MixedDirectoriesCheck(directories map[string][]string) error {
 			}
 		}
 
-		if IsValidLegacyDirectory(directory) {
+		if IsValidLegacyDirectory(directory) || (localeDirectory && DirectoryStructure(directory) == DirectoryStructureLegacy) {
 			legacyDirectoryFound = true
 
 			if registryDirectoryFound {
@@ -105,10 +175,183 @@ func MixedDirectoriesCheck(directories map[string][]string) error {
 	return nil
 }
 
+// OverlappingDirectoriesCheck verifies that the same documentation is not
+// present in both the legacy and registry directory structures.
+func OverlappingDirectoriesCheck(directories map[string][]string) error {
+	legacyFiles := make(map[string]string)
+	registryFiles := make(map[string]string)
+
+	for directory, files := range directories {
+		for _, file := range files {
+			switch DirectoryStructure(directory) {
+			case DirectoryStructureLegacy:
+				legacyFiles[structureIndependentPath(file)] = file
+			case DirectoryStructureRegistry:
+				registryFiles[structureIndependentPath(file)] = file
+			}
+		}
+	}
+
+	var result *multierror.Error
+
+	for key, registryFile := range registryFiles {
+		if legacyFile, ok := legacyFiles[key]; ok {
+			err := fmt.Errorf("overlapping Terraform Provider documentation found in legacy (%s) and registry (%s) layouts", legacyFile, registryFile)
+			result = multierror.Append(result, WithSuggestion(err, fmt.Sprintf("remove %s, as the Terraform Registry publishes the registry layout when it is present", legacyFile)))
+		}
+	}
+
+	if result != nil {
+		sort.Sort(result)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RequiredDirectoryStructureCheck verifies that all documentation directories
+// use the given directory structure (legacy or registry).
+func RequiredDirectoryStructureCheck(directories map[string][]string, structure string) error {
+	var unexpectedDirectories []string
+
+	for directory := range directories {
+		if directoryStructure := DirectoryStructure(directory); directoryStructure != "" && directoryStructure != structure {
+			unexpectedDirectories = append(unexpectedDirectories, directory)
+		}
+	}
+
+	if len(unexpectedDirectories) == 0 {
+		return nil
+	}
+
+	sort.Strings(unexpectedDirectories)
+
+	return fmt.Errorf("non-%s Terraform Provider documentation directories found, must use only %s layout: %s", structure, structure, strings.Join(unexpectedDirectories, ", "))
+}
+
+// RemoveOtherFiles returns the directories without files that match the
+// configured other file extensions of their directory. Directories that only
+// contained other files are removed entirely.
+func RemoveOtherFiles(directories map[string][]string, directoryOpts map[string]*DirectoryOptions) map[string][]string {
+	return removeOtherFiles(hclog.L(), directories, directoryOpts)
+}
+
+func removeOtherFiles(logger hclog.Logger, directories map[string][]string, directoryOpts map[string]*DirectoryOptions) map[string][]string {
+	if len(directoryOpts) == 0 {
+		return directories
+	}
+
+	result := make(map[string][]string, len(directories))
+
+	for directory, files := range directories {
+		opts, ok := directoryOpts[directory]
+
+		if !ok || len(opts.OtherFileExtensions) == 0 {
+			result[directory] = files
+			continue
+		}
+
+		var documentationFiles []string
+
+		for _, file := range files {
+			if FilePathEndsWithExtensionFrom(file, opts.OtherFileExtensions) {
+				logger.Trace("Skipping other allowed file", "file", file)
+				continue
+			}
+
+			documentationFiles = append(documentationFiles, file)
+		}
+
+		if len(documentationFiles) == 0 {
+			continue
+		}
+
+		result[directory] = documentationFiles
+	}
+
+	return result
+}
+
+// ExcludeFiles returns the directories without files matching any of the glob
+// patterns (e.g. docs/drafts/**), either the file or one of its parent
+// directories. Directories which only contained excluded files are removed.
+func ExcludeFiles(directories map[string][]string, patterns []string) (map[string][]string, error) {
+	return excludeFiles(hclog.L(), directories, patterns)
+}
+
+func excludeFiles(logger hclog.Logger, directories map[string][]string, patterns []string) (map[string][]string, error) {
+	if len(patterns) == 0 {
+		return directories, nil
+	}
+
+	result := make(map[string][]string, len(directories))
+
+	for directory, files := range directories {
+		var includedFiles []string
+
+		for _, file := range files {
+			excluded, err := excludedFile(file, patterns)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if excluded {
+				logger.Debug("Skipping excluded file", "file", file)
+				continue
+			}
+
+			includedFiles = append(includedFiles, file)
+		}
+
+		if len(includedFiles) == 0 {
+			continue
+		}
+
+		result[directory] = includedFiles
+	}
+
+	return result, nil
+}
+
+// excludedFile returns true if the file or one of its parent directories
+// matches any of the glob patterns.
+func excludedFile(file string, patterns []string) (bool, error) {
+	for _, pattern := range patterns {
+		for name := filepath.ToSlash(file); name != "." && name != "/"; name = path.Dir(name) {
+			matched, err := doublestar.Match(pattern, name)
+
+			if err != nil {
+				return false, fmt.Errorf("invalid exclude pattern (%s): %w", pattern, err)
+			}
+
+			if matched {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
 // NumberOfFilesCheck verifies that documentation is below the Terraform Registry storage limit.
 // This check presumes that all provided directories are valid, e.g. that directory checking
-// for invalid or mixed directory structures was previously completed.
-func NumberOfFilesCheck(directories map[string][]string) error {
+// for invalid or mixed directory structures was previously completed. A warning is logged
+// when the number of files reaches the warning number, before the maximum is reached.
+// The maximum and warning number default to RegistryMaximumNumberOfFiles and
+// RegistryWarningNumberOfFiles if zero.
+func NumberOfFilesCheck(directories map[string][]string, maximum int, warning int) error {
+	return numberOfFilesCheck(hclog.L(), directories, maximum, warning)
+}
+
+func numberOfFilesCheck(logger hclog.Logger, directories map[string][]string, maximum int, warning int) error {
+	if maximum <= 0 {
+		maximum = RegistryMaximumNumberOfFiles
+	}
+
+	if warning <= 0 {
+		warning = RegistryWarningNumberOfFiles
+	}
+
 	var numberOfFiles int
 
 	for directory, files := range directories {
@@ -118,26 +361,61 @@ func NumberOfFilesCheck(directories map[string][]string) error {
 		}
 
 		directoryNumberOfFiles := len(files)
-		log.Printf("[TRACE] Found %d documentation files in directory: %s", directoryNumberOfFiles, directory)
+		logger.Trace("Found documentation files in directory", "check", CheckIDNumberOfFiles, "directory", directory, "count", directoryNumberOfFiles)
 		numberOfFiles = numberOfFiles + directoryNumberOfFiles
 	}
 
-	log.Printf("[DEBUG] Found %d documentation files with limit of %d", numberOfFiles, RegistryMaximumNumberOfFiles)
-	if numberOfFiles >= RegistryMaximumNumberOfFiles {
-		return fmt.Errorf("exceeded maximum (%d) number of documentation files for Terraform Registry: %d", RegistryMaximumNumberOfFiles, numberOfFiles)
+	logger.Debug("Found documentation files", "check", CheckIDNumberOfFiles, "count", numberOfFiles, "limit", maximum)
+	if numberOfFiles >= maximum {
+		return fmt.Errorf("exceeded maximum (%d) number of documentation files for Terraform Registry: %d", maximum, numberOfFiles)
+	}
+
+	if numberOfFiles >= warning {
+		logger.Warn("Number of documentation files is approaching the maximum for Terraform Registry", "check", CheckIDNumberOfFiles, "count", numberOfFiles, "limit", maximum)
+	}
+
+	return nil
+}
+
+// NumberOfGuidesCheck verifies that the number of guides, in both the legacy
+// and Terraform Registry directory structures, is below the maximum. The
+// maximum defaults to RegistryMaximumNumberOfGuides if zero.
+func NumberOfGuidesCheck(directories map[string][]string, maximum int) error {
+	return numberOfGuidesCheck(hclog.L(), directories, maximum)
+}
+
+func numberOfGuidesCheck(logger hclog.Logger, directories map[string][]string, maximum int) error {
+	if maximum <= 0 {
+		maximum = RegistryMaximumNumberOfGuides
+	}
+
+	var numberOfGuides int
+
+	for _, directory := range []string{
+		LegacyIndexDirectory + "/" + LegacyGuidesDirectory,
+		RegistryIndexDirectory + "/" + RegistryGuidesDirectory,
+	} {
+		numberOfGuides += len(directories[directory])
+	}
+
+	logger.Debug("Found guides", "check", CheckIDNumberOfGuides, "count", numberOfGuides, "limit", maximum)
+	if numberOfGuides > maximum {
+		err := fmt.Errorf("exceeded maximum (%d) number of guides for Terraform Registry: %d", maximum, numberOfGuides)
+
+		return WithSuggestion(err, "combine or remove guides, or increase the limit with -maximum-guides if the Terraform Registry limit changed")
 	}
 
 	return nil
 }
 
-func GetDirectories(basepath string) (map[string][]string, error) {
+func GetDirectories(basepath string, opts *DirectoryOptions) (map[string][]string, error) {
 	globPattern := DocumentationGlobPattern
 
 	if basepath != "" {
 		globPattern = fmt.Sprintf("%s/%s", basepath, globPattern)
 	}
 
-	files, err := doublestar.Glob(globPattern)
+	files, err := doublestar.GlobOS(newSymlinkOS(basepath, opts), globPattern)
 
 	if err != nil {
 		return nil, fmt.Errorf("error globbing Terraform Provider documentation directories: %w", err)
@@ -152,8 +430,12 @@ func GetDirectories(basepath string) (map[string][]string, error) {
 	directories := make(map[string][]string)
 
 	for _, file := range files {
+		if fi, err := os.Stat(filepath.Join(basepath, file)); err == nil && fi.IsDir() {
+			continue
+		}
+
 		// Simple skip of glob matches that are known directories
-		if IsValidRegistryDirectory(file) || IsValidLegacyDirectory(file) || IsValidCdktfDirectory(file) {
+		if IsValidRegistryDirectory(file) || IsValidLegacyDirectory(file) || IsValidCdktfDirectory(file) || IsValidLocaleDirectory(file) {
 			continue
 		}
 
@@ -169,9 +451,232 @@ func GetDirectories(basepath string) (map[string][]string, error) {
 		directories[directory] = append(directories[directory], file)
 	}
 
+	if opts != nil {
+		return excludeFiles(opts.log(), directories, opts.Exclude)
+	}
+
 	return directories, nil
 }
 
+// GetDirectoriesFS returns the documentation directories and files of the
+// file system, such as embedded fixtures or an in-memory tree, whose root is
+// the Terraform Provider codebase. Symbolic links are not followed.
+func GetDirectoriesFS(fsys fs.FS) (map[string][]string, error) {
+	directories := make(map[string][]string)
+
+	for _, root := range []string{RegistryIndexDirectory, LegacyIndexDirectory} {
+		err := fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+			if path == root && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			matched, err := doublestar.Match(DocumentationGlobPattern, path)
+
+			if err != nil || !matched {
+				return err
+			}
+
+			file := filepath.FromSlash(path)
+			directory := filepath.Dir(file)
+			directories[directory] = append(directories[directory], file)
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error walking Terraform Provider documentation directories: %w", err)
+		}
+	}
+
+	return directories, nil
+}
+
+// GetRootDirectories returns the documentation directories of the given
+// documentation root directories, relative to the base path, instead of the
+// conventional docs and website/docs directories. Each root directory is
+// treated as the legacy (website/docs) directory if it is named so or
+// contains legacy data source or resource directories, otherwise as the
+// Terraform Registry (docs) directory. The directories are keyed by their
+// conventional directory (e.g. docs/resources) while the files keep their
+// actual paths, so files are checked like conventional documentation.
+func GetRootDirectories(basepath string, roots []string, opts *DirectoryOptions) (map[string][]string, error) {
+	directories := make(map[string][]string)
+
+	for _, root := range roots {
+		root = filepath.ToSlash(filepath.Clean(root))
+		rootPath := filepath.Join(basepath, root)
+
+		if fi, err := os.Stat(rootPath); err != nil || !fi.IsDir() {
+			return nil, fmt.Errorf("documentation directory (%s) not found", root)
+		}
+
+		indexDirectory := RegistryIndexDirectory
+
+		if root == LegacyIndexDirectory || isDirectory(filepath.Join(rootPath, LegacyDataSourcesDirectory)) || isDirectory(filepath.Join(rootPath, LegacyResourcesDirectory)) {
+			indexDirectory = LegacyIndexDirectory
+		}
+
+		opts.log().Debug("Found documentation directory", "directory", root, "structure", DirectoryStructure(indexDirectory))
+
+		err := walkFiles(newSymlinkOS(basepath, opts), rootPath, func(path string) error {
+			relPath, err := filepath.Rel(rootPath, path)
+
+			if err != nil {
+				return err
+			}
+
+			relPath = filepath.ToSlash(relPath)
+			directory := indexDirectory
+
+			if relDirectory := filepath.ToSlash(filepath.Dir(relPath)); relDirectory != "." {
+				directory = directory + "/" + relDirectory
+			} else if indexDirectory == RegistryIndexDirectory && relPath != "index.md" {
+				// Only the index is checked in the Terraform Registry directory
+				return nil
+			}
+
+			directories[directory] = append(directories[directory], root+"/"+relPath)
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error walking documentation directory (%s): %w", root, err)
+		}
+	}
+
+	if opts != nil {
+		return excludeFiles(opts.log(), directories, opts.Exclude)
+	}
+
+	return directories, nil
+}
+
+// walkFiles calls the function with the path of each file in the directory
+// tree, following symbolic links to directories according to the OS.
+func walkFiles(vos symlinkOS, root string, fn func(path string) error) error {
+	// The trailing separator resolves the root if it is a symbolic link,
+	// which filepath.WalkDir would otherwise treat as a file.
+	return filepath.WalkDir(root+string(os.PathSeparator), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 && isDirectory(path) {
+			if fi, err := vos.Stat(path); err == nil && fi.IsDir() {
+				return walkFiles(vos, path, fn)
+			}
+
+			return nil
+		}
+
+		return fn(filepath.Clean(path))
+	})
+}
+
+// symlinkOS is a doublestar.OS which only follows symbolic links to
+// directories within the base path if enabled and the link does not create a
+// cycle. Unfollowed links are reported as the link itself.
+type symlinkOS struct {
+	basepath       string
+	followSymlinks bool
+	logger         hclog.Logger
+}
+
+func newSymlinkOS(basepath string, opts *DirectoryOptions) symlinkOS {
+	if basepath == "" {
+		basepath = "."
+	}
+
+	return symlinkOS{
+		basepath:       filepath.Clean(basepath),
+		followSymlinks: opts != nil && opts.FollowSymlinks,
+		logger:         opts.log(),
+	}
+}
+
+func (o symlinkOS) Lstat(name string) (os.FileInfo, error) { return os.Lstat(name) }
+func (o symlinkOS) Open(name string) (*os.File, error)     { return os.Open(name) }
+func (o symlinkOS) PathSeparator() rune                    { return os.PathSeparator }
+
+func (o symlinkOS) Stat(name string) (os.FileInfo, error) {
+	fi, err := os.Lstat(name)
+
+	if err != nil || fi.Mode()&os.ModeSymlink == 0 || !o.isWithinBasePath(name) || !isDirectory(name) {
+		return os.Stat(name)
+	}
+
+	if !o.followSymlinks {
+		o.logger.Warn("Symbolic link to directory not followed", "path", name)
+
+		return fi, nil
+	}
+
+	if o.isSymlinkCycle(name) {
+		o.logger.Warn("Symbolic link to parent directory not followed", "path", name)
+
+		return fi, nil
+	}
+
+	return os.Stat(name)
+}
+
+// isWithinBasePath returns true if the path is below the base path, such as
+// in the documentation directories, rather than the base path itself or its
+// parent directories, which are always followed.
+func (o symlinkOS) isWithinBasePath(path string) bool {
+	relPath, err := filepath.Rel(o.basepath, path)
+
+	return err == nil && relPath != "." && relPath != ".." && !strings.HasPrefix(relPath, ".."+string(os.PathSeparator))
+}
+
+// isSymlinkCycle returns true if the symbolic link resolves to a directory
+// containing the link, up to the base path.
+func (o symlinkOS) isSymlinkCycle(path string) bool {
+	target, err := filepath.EvalSymlinks(path)
+
+	if err != nil {
+		return false
+	}
+
+	for directory := filepath.Dir(path); ; directory = filepath.Dir(directory) {
+		if realDirectory, err := filepath.EvalSymlinks(directory); err == nil && realDirectory == target {
+			return true
+		}
+
+		if directory == o.basepath || directory == filepath.Dir(directory) {
+			return false
+		}
+	}
+}
+
+// isDirectory returns true if the path exists and is a directory.
+func isDirectory(path string) bool {
+	fi, err := os.Stat(path)
+
+	return err == nil && fi.IsDir()
+}
+
+// DirectoryStructure returns the directory structure (legacy or registry) of
+// the documentation directory, otherwise an empty string.
+func DirectoryStructure(directory string) string {
+	directory = filepath.ToSlash(directory)
+
+	if directory == LegacyIndexDirectory || strings.HasPrefix(directory, LegacyIndexDirectory+"/") {
+		return DirectoryStructureLegacy
+	}
+
+	if directory == RegistryIndexDirectory || strings.HasPrefix(directory, RegistryIndexDirectory+"/") {
+		return DirectoryStructureRegistry
+	}
+
+	return ""
+}
+
 func IsValidLegacyDirectory(directory string) bool {
 	for _, validLegacyDirectory := range ValidLegacyDirectories {
 		if directory == validLegacyDirectory {
@@ -192,6 +697,31 @@ func IsValidRegistryDirectory(directory string) bool {
 	return false
 }
 
+// IsValidLocaleDirectory returns true if the directory is the index
+// directory or a documentation directory of localized documentation, e.g.
+// docs/ja, docs/ja/resources, or website/docs/ja/r.
+func IsValidLocaleDirectory(directory string) bool {
+	locale := DirectoryLocale(directory)
+
+	if locale == "" {
+		return false
+	}
+
+	for _, validRegistryDirectory := range ValidRegistryDirectories {
+		if directory == strings.Replace(validRegistryDirectory, RegistryIndexDirectory, RegistryIndexDirectory+"/"+locale, 1) {
+			return true
+		}
+	}
+
+	for _, validLegacyDirectory := range ValidLegacyDirectories {
+		if directory == strings.Replace(validLegacyDirectory, LegacyIndexDirectory, LegacyIndexDirectory+"/"+locale, 1) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func IsValidCdktfDirectory(directory string) bool {
 	if directory == fmt.Sprintf("%s/%s", LegacyIndexDirectory, CdktfIndexDirectory) {
 		return true
@@ -226,3 +756,32 @@ func IsValidCdktfDirectory(directory string) bool {
 
 	return false
 }
+
+// structureIndependentPath returns the documentation file path without the
+// directory structure specific prefix, subdirectory names, or file extension,
+// so the same documentation can be compared across legacy and registry layouts.
+func structureIndependentPath(file string) string {
+	file = filepath.ToSlash(file)
+
+	var relativeDirectory string
+
+	switch {
+	case strings.HasPrefix(file, LegacyIndexDirectory+"/"):
+		relativeDirectory = strings.TrimPrefix(filepath.ToSlash(filepath.Dir(file)), LegacyIndexDirectory)
+	case strings.HasPrefix(file, RegistryIndexDirectory+"/"):
+		relativeDirectory = strings.TrimPrefix(filepath.ToSlash(filepath.Dir(file)), RegistryIndexDirectory)
+	}
+
+	directoryParts := strings.Split(strings.TrimPrefix(relativeDirectory, "/"), "/")
+
+	for index, directoryPart := range directoryParts {
+		switch directoryPart {
+		case LegacyDataSourcesDirectory:
+			directoryParts[index] = RegistryDataSourcesDirectory
+		case LegacyResourcesDirectory:
+			directoryParts[index] = RegistryResourcesDirectory
+		}
+	}
+
+	return strings.Join(append(directoryParts, TrimFileExtension(file)), "/")
+}
@@ -2,6 +2,10 @@ package check
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -9,12 +13,27 @@ func TestNumberOfFilesCheck(t *testing.T) {
 	testCases := []struct {
 		Name        string
 		Directories map[string][]string
+		Maximum     int
+		Warning     int
 		ExpectError bool
 	}{
 		{
 			Name:        "under limit",
 			Directories: testGenerateDirectories(RegistryMaximumNumberOfFiles - 1),
 		},
+		{
+			Name:        "under configured limit",
+			Directories: testGenerateDirectories(9),
+			Maximum:     10,
+			Warning:     5,
+		},
+		{
+			Name:        "at configured limit",
+			Directories: testGenerateDirectories(10),
+			Maximum:     10,
+			Warning:     5,
+			ExpectError: true,
+		},
 		{
 			Name:        "at limit",
 			Directories: testGenerateDirectories(RegistryMaximumNumberOfFiles),
@@ -29,7 +48,7 @@ func TestNumberOfFilesCheck(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			got := NumberOfFilesCheck(testCase.Directories)
+			got := NumberOfFilesCheck(testCase.Directories, testCase.Maximum, testCase.Warning)
 
 			if got == nil && testCase.ExpectError {
 				t.Errorf("expected error, got no error")
@@ -42,6 +61,275 @@ func TestNumberOfFilesCheck(t *testing.T) {
 	}
 }
 
+func TestNumberOfGuidesCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Directories map[string][]string
+		Maximum     int
+		ExpectError bool
+	}{
+		{
+			Name: "under default limit",
+			Directories: map[string][]string{
+				"docs/guides": make([]string, RegistryMaximumNumberOfGuides),
+			},
+		},
+		{
+			Name: "over default limit",
+			Directories: map[string][]string{
+				"docs/guides": make([]string, RegistryMaximumNumberOfGuides+1),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "under configured limit",
+			Directories: map[string][]string{
+				"docs/guides":    make([]string, 2),
+				"docs/resources": make([]string, 10),
+			},
+			Maximum: 2,
+		},
+		{
+			Name: "over configured limit",
+			Directories: map[string][]string{
+				"docs/guides": make([]string, 3),
+			},
+			Maximum:     2,
+			ExpectError: true,
+		},
+		{
+			Name: "over configured limit across directory structures",
+			Directories: map[string][]string{
+				"docs/guides":         make([]string, 2),
+				"website/docs/guides": make([]string, 1),
+			},
+			Maximum:     2,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NumberOfGuidesCheck(testCase.Directories, testCase.Maximum)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestGetRootDirectories(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Roots       []string
+		Expect      map[string][]string
+		ExpectError bool
+	}{
+		{
+			Name:  "registry",
+			Roots: []string{"documentation"},
+			Expect: map[string][]string{
+				"docs":           {"documentation/index.md"},
+				"docs/guides":    {"documentation/guides/example.md"},
+				"docs/resources": {"documentation/resources/thing.md"},
+			},
+		},
+		{
+			Name:  "legacy",
+			Roots: []string{"legacy"},
+			Expect: map[string][]string{
+				"website/docs":   {"legacy/test.html.markdown"},
+				"website/docs/d": {"legacy/d/thing.html.markdown"},
+				"website/docs/r": {"legacy/r/thing.html.markdown"},
+			},
+		},
+		{
+			Name:  "multiple",
+			Roots: []string{"documentation/", "legacy"},
+			Expect: map[string][]string{
+				"docs":           {"documentation/index.md"},
+				"docs/guides":    {"documentation/guides/example.md"},
+				"docs/resources": {"documentation/resources/thing.md"},
+				"website/docs":   {"legacy/test.html.markdown"},
+				"website/docs/d": {"legacy/d/thing.html.markdown"},
+				"website/docs/r": {"legacy/r/thing.html.markdown"},
+			},
+		},
+		{
+			Name:        "not found",
+			Roots:       []string{"does-not-exist"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := GetRootDirectories("testdata/root-directories", testCase.Roots, nil)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestGetDirectoriesFS(t *testing.T) {
+	for _, basePath := range []string{
+		"testdata/valid-legacy-directories",
+		"testdata/valid-legacy-directories-with-cdktf",
+		"testdata/valid-legacy-directories-with-locales",
+		"testdata/valid-mixed-directories",
+		"testdata/valid-registry-directories",
+		"testdata/valid-registry-directories-with-cdktf",
+		"testdata/valid-registry-directories-with-locales",
+		"testdata/valid-registry-directories-with-other-files",
+	} {
+		t.Run(basePath, func(t *testing.T) {
+			got, err := GetDirectoriesFS(os.DirFS(basePath))
+
+			if err != nil {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			expected, err := GetDirectories(basePath, nil)
+
+			if err != nil {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			for _, files := range expected {
+				sort.Strings(files)
+			}
+
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected %v, got %v", expected, got)
+			}
+		})
+	}
+}
+
+func TestGetDirectories_symlinks(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		FollowSymlinks bool
+		Roots          []string
+		Expect         map[string][]string
+	}{
+		{
+			Name: "not followed",
+			Expect: map[string][]string{
+				"docs":        {"docs/index.md"},
+				"docs/guides": {"docs/guides/linked.md"},
+			},
+		},
+		{
+			Name:           "followed",
+			FollowSymlinks: true,
+			Expect: map[string][]string{
+				"docs":               {"docs/index.md"},
+				"docs/guides":        {"docs/guides/linked.md"},
+				"docs/guides/shared": {"docs/guides/shared/guide.md"},
+			},
+		},
+		{
+			Name:  "root directories not followed",
+			Roots: []string{"docs"},
+			Expect: map[string][]string{
+				"docs":        {"docs/index.md"},
+				"docs/guides": {"docs/guides/linked.md"},
+			},
+		},
+		{
+			Name:           "root directories followed",
+			FollowSymlinks: true,
+			Roots:          []string{"docs"},
+			Expect: map[string][]string{
+				"docs":               {"docs/index.md"},
+				"docs/guides":        {"docs/guides/linked.md"},
+				"docs/guides/shared": {"docs/guides/shared/guide.md"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			basepath := testSymlinkDirectories(t)
+			opts := &DirectoryOptions{
+				FollowSymlinks: testCase.FollowSymlinks,
+			}
+
+			var got map[string][]string
+			var err error
+
+			if testCase.Roots == nil {
+				got, err = GetDirectories(basepath, opts)
+			} else {
+				got, err = GetRootDirectories(basepath, testCase.Roots, opts)
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+// testSymlinkDirectories returns a temporary Terraform Provider codebase with
+// a guide linked to a file, a guides subdirectory linked to a directory
+// outside the documentation, and a link within it creating a cycle.
+func testSymlinkDirectories(t *testing.T) string {
+	t.Helper()
+
+	basepath := t.TempDir()
+
+	for _, directory := range []string{"docs/guides", "shared"} {
+		if err := os.MkdirAll(filepath.Join(basepath, directory), 0o755); err != nil {
+			t.Fatalf("unexpected error creating directory: %s", err)
+		}
+	}
+
+	for _, file := range []string{"docs/index.md", "shared/guide.md"} {
+		if err := os.WriteFile(filepath.Join(basepath, file), []byte("# Test\n"), 0o644); err != nil {
+			t.Fatalf("unexpected error creating file: %s", err)
+		}
+	}
+
+	links := map[string]string{
+		"docs/guides/linked.md": "../../shared/guide.md",
+		"docs/guides/shared":    "../../shared",
+		"shared/loop":           "../shared",
+	}
+
+	for link, target := range links {
+		if err := os.Symlink(target, filepath.Join(basepath, link)); err != nil {
+			t.Skipf("unable to create symbolic link: %s", err)
+		}
+	}
+
+	return basepath
+}
+
 func testGenerateDirectories(numberOfFiles int) map[string][]string {
 	files := make([]string, numberOfFiles)
 
@@ -55,3 +343,312 @@ func testGenerateDirectories(numberOfFiles int) map[string][]string {
 
 	return directories
 }
+
+func TestExcludeFiles(t *testing.T) {
+	directories := map[string][]string{
+		"docs/guides":        {"docs/guides/guide.md", "docs/guides/draft-guide.md"},
+		"docs/guides/drafts": {"docs/guides/drafts/guide.md"},
+		"docs/resources":     {"docs/resources/thing.md", "docs/resources/vendored.md"},
+	}
+
+	testCases := []struct {
+		Name        string
+		Patterns    []string
+		Expect      map[string][]string
+		ExpectError bool
+	}{
+		{
+			Name:     "no patterns",
+			Patterns: nil,
+			Expect:   directories,
+		},
+		{
+			Name:     "directory contents",
+			Patterns: []string{"docs/guides/drafts/**"},
+			Expect: map[string][]string{
+				"docs/guides":    {"docs/guides/guide.md", "docs/guides/draft-guide.md"},
+				"docs/resources": {"docs/resources/thing.md", "docs/resources/vendored.md"},
+			},
+		},
+		{
+			Name:     "directory",
+			Patterns: []string{"docs/guides/drafts"},
+			Expect: map[string][]string{
+				"docs/guides":    {"docs/guides/guide.md", "docs/guides/draft-guide.md"},
+				"docs/resources": {"docs/resources/thing.md", "docs/resources/vendored.md"},
+			},
+		},
+		{
+			Name:     "multiple patterns",
+			Patterns: []string{"docs/**/draft-*.md", "docs/resources/vendored.md"},
+			Expect: map[string][]string{
+				"docs/guides":        {"docs/guides/guide.md"},
+				"docs/guides/drafts": {"docs/guides/drafts/guide.md"},
+				"docs/resources":     {"docs/resources/thing.md"},
+			},
+		},
+		{
+			Name:        "invalid pattern",
+			Patterns:    []string{"docs/[guides"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ExcludeFiles(directories, testCase.Patterns)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestGetDirectories_exclude(t *testing.T) {
+	opts := &DirectoryOptions{
+		Exclude: []string{"docs/resources/**"},
+	}
+
+	got, err := GetDirectories("testdata/valid-registry-directories", opts)
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if _, ok := got["docs/resources"]; ok {
+		t.Errorf("expected docs/resources to be excluded, got: %v", got)
+	}
+
+	if _, ok := got["docs/data-sources"]; !ok {
+		t.Errorf("expected docs/data-sources, got: %v", got)
+	}
+}
+
+func TestRemoveOtherFiles(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Directories   map[string][]string
+		DirectoryOpts map[string]*DirectoryOptions
+		Expect        map[string][]string
+	}{
+		{
+			Name: "no directory options",
+			Directories: map[string][]string{
+				"docs/guides": {"docs/guides/guide.md", "docs/guides/diagram.png"},
+			},
+			Expect: map[string][]string{
+				"docs/guides": {"docs/guides/guide.md", "docs/guides/diagram.png"},
+			},
+		},
+		{
+			Name: "other files removed",
+			Directories: map[string][]string{
+				"docs/guides":    {"docs/guides/guide.md", "docs/guides/diagram.png"},
+				"docs/resources": {"docs/resources/thing.md", "docs/resources/diagram.png"},
+			},
+			DirectoryOpts: map[string]*DirectoryOptions{
+				"docs/guides": {
+					OtherFileExtensions: []string{".png"},
+				},
+			},
+			Expect: map[string][]string{
+				"docs/guides":    {"docs/guides/guide.md"},
+				"docs/resources": {"docs/resources/thing.md", "docs/resources/diagram.png"},
+			},
+		},
+		{
+			Name: "directory with only other files removed",
+			Directories: map[string][]string{
+				"docs/guides":        {"docs/guides/guide.md"},
+				"docs/guides/images": {"docs/guides/images/diagram.png", "docs/guides/images/diagram.svg"},
+			},
+			DirectoryOpts: map[string]*DirectoryOptions{
+				"docs/guides/images": {
+					OtherFileExtensions: []string{".png", ".svg"},
+				},
+			},
+			Expect: map[string][]string{
+				"docs/guides": {"docs/guides/guide.md"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := RemoveOtherFiles(testCase.Directories, testCase.DirectoryOpts)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestDirectoryStructure(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Directory string
+		Expect    string
+	}{
+		{
+			Name:      "legacy index",
+			Directory: "website/docs",
+			Expect:    DirectoryStructureLegacy,
+		},
+		{
+			Name:      "legacy cdktf",
+			Directory: "website/docs/cdktf/python/r",
+			Expect:    DirectoryStructureLegacy,
+		},
+		{
+			Name:      "registry index",
+			Directory: "docs",
+			Expect:    DirectoryStructureRegistry,
+		},
+		{
+			Name:      "registry resources",
+			Directory: "docs/resources",
+			Expect:    DirectoryStructureRegistry,
+		},
+		{
+			Name:      "unknown",
+			Directory: "documentation",
+			Expect:    "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := DirectoryStructure(testCase.Directory)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestOverlappingDirectoriesCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Directories map[string][]string
+		ExpectError bool
+	}{
+		{
+			Name: "registry only",
+			Directories: map[string][]string{
+				"docs":           {"docs/index.md"},
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+		},
+		{
+			Name: "different documentation",
+			Directories: map[string][]string{
+				"docs":           {"docs/index.md"},
+				"website/docs/r": {"website/docs/r/thing.html.markdown"},
+			},
+		},
+		{
+			Name: "overlapping index",
+			Directories: map[string][]string{
+				"docs":         {"docs/index.md"},
+				"website/docs": {"website/docs/index.html.markdown"},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "overlapping resources",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+				"website/docs/r": {"website/docs/r/thing.html.markdown"},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "different resource types",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+				"website/docs/d": {"website/docs/d/thing.html.markdown"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := OverlappingDirectoriesCheck(testCase.Directories)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestRequiredDirectoryStructureCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Directories map[string][]string
+		Structure   string
+		ExpectError bool
+	}{
+		{
+			Name: "registry required and found",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+			Structure: DirectoryStructureRegistry,
+		},
+		{
+			Name: "registry required and legacy found",
+			Directories: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+				"website/docs":   {"website/docs/index.html.markdown"},
+			},
+			Structure:   DirectoryStructureRegistry,
+			ExpectError: true,
+		},
+		{
+			Name: "legacy required and found",
+			Directories: map[string][]string{
+				"website/docs/r": {"website/docs/r/thing.html.markdown"},
+			},
+			Structure: DirectoryStructureLegacy,
+		},
+		{
+			Name: "legacy required and registry found",
+			Directories: map[string][]string{
+				"docs":           {"docs/index.md"},
+				"website/docs/r": {"website/docs/r/thing.html.markdown"},
+			},
+			Structure:   DirectoryStructureLegacy,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := RequiredDirectoryStructureCheck(testCase.Directories, testCase.Structure)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
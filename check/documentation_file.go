@@ -0,0 +1,248 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	DocumentationTypeAction       = ResourceTypeAction
+	DocumentationTypeDataSource   = ResourceTypeDataSource
+	DocumentationTypeFunction     = "function"
+	DocumentationTypeGuide        = "guide"
+	DocumentationTypeIndex        = "index"
+	DocumentationTypeListResource = ResourceTypeListResource
+	DocumentationTypeResource     = ResourceTypeResource
+	DocumentationTypeUnknown      = "unknown"
+)
+
+// DocumentationTypes contains the documentation types which documentation
+// files are classified as, except DocumentationTypeUnknown.
+var DocumentationTypes = []string{
+	DocumentationTypeAction,
+	DocumentationTypeDataSource,
+	DocumentationTypeFunction,
+	DocumentationTypeGuide,
+	DocumentationTypeIndex,
+	DocumentationTypeListResource,
+	DocumentationTypeResource,
+}
+
+// DocumentationFile represents a discovered documentation file and its classification.
+type DocumentationFile struct {
+	// CdktfLanguage is the CDK for Terraform language, if a CDKTF documentation file.
+	CdktfLanguage string `json:"cdktf_language,omitempty"`
+
+	// Locale is the locale, if a localized documentation file.
+	Locale string `json:"locale,omitempty"`
+
+	// PageTitle is the YAML frontmatter page_title, if present.
+	PageTitle string `json:"page_title,omitempty"`
+
+	// Path is the file path relative to the Terraform Provider codebase.
+	Path string `json:"path"`
+
+	// ResourceName is the inferred data source or resource name, if applicable.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// Structure is the directory structure (legacy or registry).
+	Structure string `json:"structure"`
+
+	// Subcategory is the YAML frontmatter subcategory, if present.
+	Subcategory string `json:"subcategory,omitempty"`
+
+	// Type is the documentation type (action, data source, function, guide,
+	// index, list resource, resource, or unknown for files in invalid documentation directories).
+	Type string `json:"type"`
+}
+
+// Classification returns a human readable description of the documentation
+// file structure and type, e.g. "registry cdktf (typescript) resource" or
+// "registry locale (ja) resource".
+func (file *DocumentationFile) Classification() string {
+	var parts []string
+
+	if file.Structure != "" {
+		parts = append(parts, file.Structure)
+	}
+
+	if file.CdktfLanguage != "" {
+		parts = append(parts, fmt.Sprintf("cdktf (%s)", file.CdktfLanguage))
+	}
+
+	if file.Locale != "" {
+		parts = append(parts, fmt.Sprintf("locale (%s)", file.Locale))
+	}
+
+	parts = append(parts, file.Type)
+
+	return strings.Join(parts, " ")
+}
+
+// NewDocumentationFile returns the classification of a documentation file
+// within the given directory. The YAML frontmatter is read to determine the
+// page title and subcategory, which are left empty if the frontmatter cannot
+// be parsed.
+func NewDocumentationFile(directory string, path string, opts *FileOptions, providerName string) (*DocumentationFile, error) {
+	if opts == nil {
+		opts = &FileOptions{}
+	}
+
+	directory = filepath.ToSlash(directory)
+
+	file := &DocumentationFile{
+		CdktfLanguage: DirectoryCdktfLanguage(directory),
+		Locale:        DirectoryLocale(directory),
+		Path:          path,
+		Structure:     DirectoryStructure(directory),
+		Type:          DirectoryDocumentationType(directory),
+	}
+
+	if providerName != "" && (file.Type == DocumentationTypeAction || file.Type == DocumentationTypeDataSource || file.Type == DocumentationTypeListResource || file.Type == DocumentationTypeResource) {
+		file.ResourceName = fileResourceName(providerName, path)
+	}
+
+	content, err := opts.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	frontMatter, err := ParseFrontMatter(content)
+
+	if err != nil {
+		opts.Log().Warn("Unable to parse YAML frontmatter", "file", path, "error", err)
+
+		return file, nil
+	}
+
+	if frontMatter.PageTitle != nil {
+		file.PageTitle = *frontMatter.PageTitle
+	}
+
+	if frontMatter.Subcategory != nil {
+		file.Subcategory = *frontMatter.Subcategory
+	}
+
+	return file, nil
+}
+
+// DocumentationFiles returns the classification of all files in the
+// documentation directories, sorted by path.
+func DocumentationFiles(directories map[string][]string, opts *FileOptions, providerName string) ([]*DocumentationFile, error) {
+	var result []*DocumentationFile
+
+	for directory, files := range directories {
+		for _, path := range files {
+			file, err := NewDocumentationFile(directory, path, opts, providerName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, file)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result, nil
+}
+
+// DirectoryClassification returns a human readable description of the
+// documentation directory structure and type, e.g. "registry resource".
+func DirectoryClassification(directory string) string {
+	file := &DocumentationFile{
+		CdktfLanguage: DirectoryCdktfLanguage(directory),
+		Locale:        DirectoryLocale(directory),
+		Structure:     DirectoryStructure(directory),
+		Type:          DirectoryDocumentationType(directory),
+	}
+
+	return file.Classification()
+}
+
+// DirectoryCdktfLanguage returns the CDK for Terraform language of the
+// documentation directory, otherwise an empty string.
+func DirectoryCdktfLanguage(directory string) string {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimPrefix(filepath.ToSlash(directory), LegacyIndexDirectory), RegistryIndexDirectory), "/")
+
+	if len(parts) < 3 || parts[1] != CdktfIndexDirectory {
+		return ""
+	}
+
+	return parts[2]
+}
+
+// DirectoryLocale returns the locale of the localized documentation
+// directory (e.g. ja for docs/ja/resources), otherwise an empty string.
+func DirectoryLocale(directory string) string {
+	directory = filepath.ToSlash(directory)
+
+	if DirectoryStructure(directory) == "" {
+		return ""
+	}
+
+	parts := strings.Split(strings.TrimPrefix(strings.TrimPrefix(directory, LegacyIndexDirectory), RegistryIndexDirectory), "/")
+
+	if len(parts) < 2 || !localeRegexp.MatchString(parts[1]) {
+		return ""
+	}
+
+	return parts[1]
+}
+
+// DirectoryDocumentationType returns the documentation type of the valid
+// documentation directory, otherwise DocumentationTypeUnknown.
+func DirectoryDocumentationType(directory string) string {
+	directory = filepath.ToSlash(directory)
+
+	if directory == LegacyIndexDirectory || directory == RegistryIndexDirectory {
+		return DocumentationTypeIndex
+	}
+
+	if !IsValidLegacyDirectory(directory) && !IsValidRegistryDirectory(directory) && !IsValidCdktfDirectory(directory) && !IsValidLocaleDirectory(directory) {
+		return DocumentationTypeUnknown
+	}
+
+	if language := DirectoryCdktfLanguage(directory); language != "" && filepath.Base(directory) == language {
+		return DocumentationTypeIndex
+	}
+
+	if locale := DirectoryLocale(directory); locale != "" && filepath.Base(directory) == locale {
+		return DocumentationTypeIndex
+	}
+
+	switch DirectoryStructure(directory) {
+	case DirectoryStructureLegacy:
+		switch filepath.Base(directory) {
+		case LegacyDataSourcesDirectory:
+			return DocumentationTypeDataSource
+		case LegacyGuidesDirectory:
+			return DocumentationTypeGuide
+		case LegacyResourcesDirectory:
+			return DocumentationTypeResource
+		}
+	case DirectoryStructureRegistry:
+		switch filepath.Base(directory) {
+		case RegistryActionsDirectory:
+			return DocumentationTypeAction
+		case RegistryDataSourcesDirectory:
+			return DocumentationTypeDataSource
+		case RegistryFunctionsDirectory:
+			return DocumentationTypeFunction
+		case RegistryGuidesDirectory:
+			return DocumentationTypeGuide
+		case RegistryListResourcesDirectory:
+			return DocumentationTypeListResource
+		case RegistryResourcesDirectory:
+			return DocumentationTypeResource
+		}
+	}
+
+	return DocumentationTypeUnknown
+}
@@ -0,0 +1,309 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDirectoryCdktfLanguage(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Directory string
+		Expect    string
+	}{
+		{
+			Name:      "legacy",
+			Directory: "website/docs/r",
+			Expect:    "",
+		},
+		{
+			Name:      "legacy cdktf",
+			Directory: "website/docs/cdktf/python/r",
+			Expect:    "python",
+		},
+		{
+			Name:      "registry",
+			Directory: "docs/resources",
+			Expect:    "",
+		},
+		{
+			Name:      "registry cdktf",
+			Directory: "docs/cdktf/typescript/resources",
+			Expect:    "typescript",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := DirectoryCdktfLanguage(testCase.Directory)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestDirectoryLocale(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Directory string
+		Expect    string
+	}{
+		{
+			Name:      "legacy",
+			Directory: "website/docs/r",
+			Expect:    "",
+		},
+		{
+			Name:      "legacy locale",
+			Directory: "website/docs/ja/r",
+			Expect:    "ja",
+		},
+		{
+			Name:      "registry",
+			Directory: "docs/resources",
+			Expect:    "",
+		},
+		{
+			Name:      "registry cdktf",
+			Directory: "docs/cdktf/typescript/resources",
+			Expect:    "",
+		},
+		{
+			Name:      "registry locale index",
+			Directory: "docs/pt-BR",
+			Expect:    "pt-BR",
+		},
+		{
+			Name:      "registry locale",
+			Directory: "docs/zh_CN/resources",
+			Expect:    "zh_CN",
+		},
+		{
+			Name:      "other",
+			Directory: "examples/ja",
+			Expect:    "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := DirectoryLocale(testCase.Directory)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestDirectoryDocumentationType(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Directory string
+		Expect    string
+	}{
+		{
+			Name:      "legacy index",
+			Directory: "website/docs",
+			Expect:    DocumentationTypeIndex,
+		},
+		{
+			Name:      "legacy data sources",
+			Directory: "website/docs/d",
+			Expect:    DocumentationTypeDataSource,
+		},
+		{
+			Name:      "legacy guides",
+			Directory: "website/docs/guides",
+			Expect:    DocumentationTypeGuide,
+		},
+		{
+			Name:      "legacy cdktf resources",
+			Directory: "website/docs/cdktf/go/r",
+			Expect:    DocumentationTypeResource,
+		},
+		{
+			Name:      "registry index",
+			Directory: "docs",
+			Expect:    DocumentationTypeIndex,
+		},
+		{
+			Name:      "registry cdktf index",
+			Directory: "docs/cdktf/java",
+			Expect:    DocumentationTypeIndex,
+		},
+		{
+			Name:      "registry actions",
+			Directory: "docs/actions",
+			Expect:    DocumentationTypeAction,
+		},
+		{
+			Name:      "registry data sources",
+			Directory: "docs/data-sources",
+			Expect:    DocumentationTypeDataSource,
+		},
+		{
+			Name:      "registry functions",
+			Directory: "docs/functions",
+			Expect:    DocumentationTypeFunction,
+		},
+		{
+			Name:      "registry list resources",
+			Directory: "docs/list-resources",
+			Expect:    DocumentationTypeListResource,
+		},
+		{
+			Name:      "registry resources",
+			Directory: "docs/resources",
+			Expect:    DocumentationTypeResource,
+		},
+		{
+			Name:      "registry locale index",
+			Directory: "docs/ja",
+			Expect:    DocumentationTypeIndex,
+		},
+		{
+			Name:      "registry locale resources",
+			Directory: "docs/ja/resources",
+			Expect:    DocumentationTypeResource,
+		},
+		{
+			Name:      "legacy locale data sources",
+			Directory: "website/docs/ja/d",
+			Expect:    DocumentationTypeDataSource,
+		},
+		{
+			Name:      "invalid",
+			Directory: "docs/resources/invalid",
+			Expect:    DocumentationTypeUnknown,
+		},
+		{
+			Name:      "invalid locale",
+			Directory: "docs/ja/invalid",
+			Expect:    DocumentationTypeUnknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := DirectoryDocumentationType(testCase.Directory)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestDocumentationFiles(t *testing.T) {
+	basePath := "testdata/valid-registry-directories-with-cdktf"
+	directories, err := GetDirectories(basePath, nil)
+
+	if err != nil {
+		t.Fatalf("error getting directories: %s", err)
+	}
+
+	got, err := DocumentationFiles(directories, &FileOptions{BasePath: basePath}, "example")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []*DocumentationFile{
+		{
+			CdktfLanguage: "typescript",
+			PageTitle:     "Example: example_thing",
+			Path:          "docs/cdktf/typescript/data-sources/thing.md",
+			ResourceName:  "example_thing",
+			Structure:     DirectoryStructureRegistry,
+			Subcategory:   "Example",
+			Type:          DocumentationTypeDataSource,
+		},
+		{
+			CdktfLanguage: "typescript",
+			PageTitle:     "Example: example_thing",
+			Path:          "docs/cdktf/typescript/resources/thing.md",
+			ResourceName:  "example_thing",
+			Structure:     DirectoryStructureRegistry,
+			Subcategory:   "Example",
+			Type:          DocumentationTypeResource,
+		},
+		{
+			PageTitle:    "Example: example_thing",
+			Path:         "docs/data-sources/thing.md",
+			ResourceName: "example_thing",
+			Structure:    DirectoryStructureRegistry,
+			Subcategory:  "Example",
+			Type:         DocumentationTypeDataSource,
+		},
+		{
+			PageTitle: "Example Provider",
+			Path:      "docs/index.md",
+			Structure: DirectoryStructureRegistry,
+			Type:      DocumentationTypeIndex,
+		},
+		{
+			PageTitle:    "Example: example_thing",
+			Path:         "docs/resources/thing.md",
+			ResourceName: "example_thing",
+			Structure:    DirectoryStructureRegistry,
+			Subcategory:  "Example",
+			Type:         DocumentationTypeResource,
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		for _, file := range got {
+			t.Logf("got: %#v", file)
+		}
+
+		t.Errorf("unexpected documentation files")
+	}
+}
+
+func TestDocumentationFileClassification(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		File   *DocumentationFile
+		Expect string
+	}{
+		{
+			Name: "legacy guide",
+			File: &DocumentationFile{
+				Structure: DirectoryStructureLegacy,
+				Type:      DocumentationTypeGuide,
+			},
+			Expect: "legacy guide",
+		},
+		{
+			Name: "registry cdktf resource",
+			File: &DocumentationFile{
+				CdktfLanguage: "typescript",
+				Structure:     DirectoryStructureRegistry,
+				Type:          DocumentationTypeResource,
+			},
+			Expect: "registry cdktf (typescript) resource",
+		},
+		{
+			Name: "registry locale resource",
+			File: &DocumentationFile{
+				Locale:    "ja",
+				Structure: DirectoryStructureRegistry,
+				Type:      DocumentationTypeResource,
+			},
+			Expect: "registry locale (ja) resource",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.File.Classification()
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
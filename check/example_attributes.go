@@ -0,0 +1,485 @@
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hcldec"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark/ast"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// exampleMetaArguments contains the Terraform meta-arguments, which are not
+// part of the resource schema.
+var exampleMetaArguments = []string{
+	"count",
+	"depends_on",
+	"for_each",
+	"provider",
+}
+
+// exampleMetaBlocks contains the Terraform meta-argument blocks, which are
+// not part of the resource schema.
+var exampleMetaBlocks = []string{
+	"connection",
+	"lifecycle",
+	"provisioner",
+}
+
+// exampleUnknownFunction is the implementation of functions called by
+// example configurations, which returns an unknown value of any type, since
+// only the argument types are verified.
+var exampleUnknownFunction = function.New(&function.Spec{
+	VarParam: &function.Parameter{
+		Name:             "args",
+		Type:             cty.DynamicPseudoType,
+		AllowDynamicType: true,
+		AllowMarked:      true,
+		AllowNull:        true,
+		AllowUnknown:     true,
+	},
+	Type: function.StaticReturnType(cty.DynamicPseudoType),
+	Impl: func(_ []cty.Value, _ cty.Type) (cty.Value, error) {
+		return cty.DynamicVal, nil
+	},
+})
+
+// ExampleAttributesOptions represents configuration options for
+// ExampleAttributes.
+type ExampleAttributesOptions struct {
+	ProviderName string
+
+	// ResourceType is ResourceTypeDataSource or ResourceTypeResource, which
+	// determines the block type (data or resource) of examples.
+	ResourceType string
+
+	Schemas map[string]*tfjson.Schema
+}
+
+// ExampleAttributesCheck verifies the arguments and nested blocks of example
+// configurations are valid for the data source or resource schema.
+type ExampleAttributesCheck struct {
+	Options *ExampleAttributesOptions
+}
+
+func NewExampleAttributesCheck(opts *ExampleAttributesOptions) *ExampleAttributesCheck {
+	check := &ExampleAttributesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExampleAttributesOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each data or resource block of the documented data source or
+// resource in terraform (or hcl) code blocks of the documentation source only
+// configures arguments and nested blocks found in the schema. Arguments which
+// are read-only in the schema are also reported, as are type errors Terraform
+// would report: values which cannot be converted to the argument type,
+// arguments configured with block syntax, nested block labels, and too many
+// nested blocks. Values are only verified in code blocks which are valid HCL
+// syntax, e.g. without ... placeholders. Documentation without a schema and
+// CDK for Terraform documentation are skipped.
+func (check *ExampleAttributesCheck) Run(path string, source []byte) error {
+	if isCdktfPath(path) {
+		return nil
+	}
+
+	resourceName := fileResourceName(check.Options.ProviderName, path)
+	schema, ok := check.Options.Schemas[resourceName]
+
+	if !ok || schema == nil || schema.Block == nil {
+		return nil
+	}
+
+	blockType := "resource"
+
+	if check.Options.ResourceType == ResourceTypeDataSource {
+		blockType = "data"
+	}
+
+	result := &exampleAttributesResult{}
+	document, _ := markdown.Parse(source)
+	var parseErr error
+
+	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		switch markdown.FencedCodeBlockLanguage(fencedCodeBlock, source) {
+		case markdown.FencedCodeBlockLanguageHcl, markdown.FencedCodeBlockLanguageTerraform:
+		default:
+			return ast.WalkSkipChildren, nil
+		}
+
+		text := markdown.FencedCodeBlockText(fencedCodeBlock, source)
+		configuration, err := parseExampleConfiguration(text)
+
+		if err != nil {
+			parseErr = fmt.Errorf("error parsing example configuration: %w", err)
+
+			return ast.WalkStop, nil
+		}
+
+		for _, block := range configuration.Blocks {
+			if block.Type != blockType || len(block.Labels) == 0 || block.Labels[0] != resourceName {
+				continue
+			}
+
+			result.checkBody(block.Body, schema.Block, "", true)
+		}
+
+		file, diags := hclsyntax.ParseConfig([]byte(text), path, hcl.InitialPos)
+
+		if diags.HasErrors() {
+			return ast.WalkSkipChildren, nil
+		}
+
+		for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+			if block.Type != blockType || len(block.Labels) == 0 || block.Labels[0] != resourceName {
+				continue
+			}
+
+			result.checkValues(block.Body, schema.Block, "", true)
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error walking documentation: %w", err)
+	}
+
+	if parseErr != nil {
+		return parseErr
+	}
+
+	return result.err(resourceName)
+}
+
+// exampleAttributesResult contains the invalid argument and nested block
+// names of example configurations.
+type exampleAttributesResult struct {
+	ReadOnly []string
+
+	// TypeErrors contains the argument or nested block names with a
+	// description of their type error.
+	TypeErrors []string
+
+	Unknown []string
+}
+
+// checkBody verifies the arguments and nested blocks of the body against the
+// schema block. Meta-arguments are only allowed in the root body.
+func (r *exampleAttributesResult) checkBody(body *exampleBody, block *tfjson.SchemaBlock, prefix string, root bool) {
+	for _, name := range body.Attributes {
+		if root && containsString(exampleMetaArguments, name) {
+			continue
+		}
+
+		if attribute, ok := block.Attributes[name]; ok {
+			if attribute != nil && attribute.Computed && !attribute.Optional && !attribute.Required {
+				r.ReadOnly = appendUnique(r.ReadOnly, prefix+name)
+			}
+
+			continue
+		}
+
+		// Nested blocks can be configured with argument syntax.
+		if _, ok := block.NestedBlocks[name]; ok {
+			continue
+		}
+
+		r.Unknown = appendUnique(r.Unknown, prefix+name)
+	}
+
+	// Dynamic blocks are not counted, as they may generate any number of
+	// nested blocks.
+	blockCounts := make(map[string]int)
+
+	for _, nestedBlock := range body.Blocks {
+		name := nestedBlock.Type
+		nestedBody := nestedBlock.Body
+		dynamic := name == "dynamic"
+
+		if root && containsString(exampleMetaBlocks, name) {
+			continue
+		}
+
+		// Dynamic blocks are labeled with the nested block name and configure
+		// it in the content block.
+		if dynamic {
+			if len(nestedBlock.Labels) == 0 {
+				continue
+			}
+
+			name = nestedBlock.Labels[0]
+			nestedBody = nil
+
+			for _, contentBlock := range nestedBlock.Body.Blocks {
+				if contentBlock.Type == "content" {
+					nestedBody = contentBlock.Body
+				}
+			}
+		}
+
+		blockType, ok := block.NestedBlocks[name]
+
+		if !ok {
+			// Nested attributes can be mistakenly configured with block
+			// syntax, which is reported as a type error instead of an unknown
+			// name.
+			if attribute, ok := block.Attributes[name]; ok {
+				r.TypeErrors = appendUnique(r.TypeErrors, fmt.Sprintf("%s%s (argument of type %s, got block)", prefix, name, exampleAttributeTypeName(attribute)))
+			} else {
+				r.Unknown = appendUnique(r.Unknown, prefix+name)
+			}
+
+			continue
+		}
+
+		if !dynamic && blockType != nil {
+			blockCounts[name]++
+
+			// Only map nested blocks are labeled, with the map key.
+			expectedLabels := 0
+
+			if blockType.NestingMode == tfjson.SchemaNestingModeMap {
+				expectedLabels = 1
+			}
+
+			if len(nestedBlock.Labels) != expectedLabels {
+				r.TypeErrors = appendUnique(r.TypeErrors, fmt.Sprintf("%s%s (%d block labels, expected %d)", prefix, name, len(nestedBlock.Labels), expectedLabels))
+			}
+		}
+
+		if blockType != nil && blockType.Block != nil && nestedBody != nil {
+			r.checkBody(nestedBody, blockType.Block, prefix+name+".", false)
+		}
+	}
+
+	for name, count := range blockCounts {
+		blockType := block.NestedBlocks[name]
+		maxItems := blockType.MaxItems
+
+		switch blockType.NestingMode {
+		case tfjson.SchemaNestingModeGroup, tfjson.SchemaNestingModeSingle:
+			maxItems = 1
+		}
+
+		if maxItems > 0 && uint64(count) > maxItems {
+			r.TypeErrors = appendUnique(r.TypeErrors, fmt.Sprintf("%s%s (%d blocks, at most %d allowed)", prefix, name, count, maxItems))
+		}
+	}
+}
+
+func (r *exampleAttributesResult) err(resourceName string) error {
+	var messages []string
+	var suggestions []string
+
+	if len(r.Unknown) > 0 {
+		sort.Strings(r.Unknown)
+		messages = append(messages, fmt.Sprintf("arguments not found in %s schema: %s", resourceName, strings.Join(r.Unknown, ", ")))
+		suggestions = append(suggestions, "remove or correct the spelling of arguments not found in the schema")
+	}
+
+	if len(r.ReadOnly) > 0 {
+		sort.Strings(r.ReadOnly)
+		messages = append(messages, fmt.Sprintf("read-only attributes of %s schema: %s", resourceName, strings.Join(r.ReadOnly, ", ")))
+		suggestions = append(suggestions, "remove read-only attributes, which cannot be configured")
+	}
+
+	if len(r.TypeErrors) > 0 {
+		sort.Strings(r.TypeErrors)
+		messages = append(messages, fmt.Sprintf("type errors for %s schema: %s", resourceName, strings.Join(r.TypeErrors, ", ")))
+		suggestions = append(suggestions, "correct argument values and nested blocks to match the schema types")
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err := fmt.Errorf("example configuration contains %s", strings.Join(messages, "; and "))
+
+	return WithSuggestion(err, strings.Join(suggestions, "; "))
+}
+
+// checkValues verifies the argument values of the body can be converted to
+// the attribute types of the schema block, by decoding each argument with an
+// hcldec specification of its attribute. References and function calls are
+// unknown values, which can be converted to any type. Unknown arguments and
+// nested blocks are skipped, as they are reported by checkBody.
+func (r *exampleAttributesResult) checkValues(body *hclsyntax.Body, block *tfjson.SchemaBlock, prefix string, root bool) {
+	for name, attr := range body.Attributes {
+		if root && containsString(exampleMetaArguments, name) {
+			continue
+		}
+
+		attribute, ok := block.Attributes[name]
+
+		if !ok || attribute == nil {
+			continue
+		}
+
+		attributeType := exampleAttributeType(attribute)
+
+		if attributeType == cty.NilType {
+			continue
+		}
+
+		spec := &hcldec.AttrSpec{
+			Name: name,
+			Type: attributeType,
+		}
+
+		_, _, diags := hcldec.PartialDecode(body, spec, exampleEvalContext(attr.Expr))
+
+		for _, diag := range diags {
+			if diag.Summary != "Incorrect attribute value type" {
+				continue
+			}
+
+			value, _ := attr.Expr.Value(diag.EvalContext)
+
+			r.TypeErrors = appendUnique(r.TypeErrors, fmt.Sprintf("%s%s (%s required, got %s)", prefix, name, exampleAttributeTypeName(attribute), value.Type().FriendlyName()))
+		}
+	}
+
+	for _, nestedBlock := range body.Blocks {
+		name := nestedBlock.Type
+		nestedBody := nestedBlock.Body
+
+		if root && containsString(exampleMetaBlocks, name) {
+			continue
+		}
+
+		if name == "dynamic" {
+			if len(nestedBlock.Labels) == 0 {
+				continue
+			}
+
+			name = nestedBlock.Labels[0]
+			nestedBody = nil
+
+			for _, contentBlock := range nestedBlock.Body.Blocks {
+				if contentBlock.Type == "content" {
+					nestedBody = contentBlock.Body
+				}
+			}
+		}
+
+		blockType, ok := block.NestedBlocks[name]
+
+		if !ok || blockType == nil || blockType.Block == nil || nestedBody == nil {
+			continue
+		}
+
+		r.checkValues(nestedBody, blockType.Block, prefix+name+".", false)
+	}
+}
+
+// exampleEvalContext returns the evaluation context of the expression, where
+// referenced variables, such as var, local, data sources, and resources, are
+// unknown values and called functions return unknown values.
+func exampleEvalContext(expression hclsyntax.Expression) *hcl.EvalContext {
+	ctx := &hcl.EvalContext{
+		Functions: make(map[string]function.Function),
+		Variables: make(map[string]cty.Value),
+	}
+
+	for _, traversal := range expression.Variables() {
+		ctx.Variables[traversal.RootName()] = cty.DynamicVal
+	}
+
+	hclsyntax.VisitAll(expression, func(node hclsyntax.Node) hcl.Diagnostics {
+		if call, ok := node.(*hclsyntax.FunctionCallExpr); ok {
+			ctx.Functions[call.Name] = exampleUnknownFunction
+		}
+
+		return nil
+	})
+
+	return ctx
+}
+
+// exampleAttributeType returns the type of the attribute, where nested
+// attributes are objects with optional attributes, since only the argument
+// types are verified. Otherwise cty.NilType is returned if the attribute has
+// no type.
+func exampleAttributeType(attribute *tfjson.SchemaAttribute) cty.Type {
+	if attribute.AttributeNestedType == nil {
+		return attribute.AttributeType
+	}
+
+	attributeTypes := make(map[string]cty.Type)
+	var optional []string
+
+	for name, nestedAttribute := range attribute.AttributeNestedType.Attributes {
+		attributeType := cty.DynamicPseudoType
+
+		if nestedAttribute != nil {
+			if nestedType := exampleAttributeType(nestedAttribute); nestedType != cty.NilType {
+				attributeType = nestedType
+			}
+		}
+
+		attributeTypes[name] = attributeType
+		optional = append(optional, name)
+	}
+
+	objectType := cty.ObjectWithOptionalAttrs(attributeTypes, optional)
+
+	switch attribute.AttributeNestedType.NestingMode {
+	case tfjson.SchemaNestingModeList:
+		return cty.List(objectType)
+	case tfjson.SchemaNestingModeMap:
+		return cty.Map(objectType)
+	case tfjson.SchemaNestingModeSet:
+		return cty.Set(objectType)
+	}
+
+	return objectType
+}
+
+// exampleAttributeTypeName returns the friendly name of the attribute type,
+// e.g. list of string.
+func exampleAttributeTypeName(attribute *tfjson.SchemaAttribute) string {
+	if attribute == nil {
+		return "unknown"
+	}
+
+	if attribute.AttributeNestedType != nil {
+		switch mode := attribute.AttributeNestedType.NestingMode; mode {
+		case tfjson.SchemaNestingModeList, tfjson.SchemaNestingModeMap, tfjson.SchemaNestingModeSet:
+			return fmt.Sprintf("%s of object", mode)
+		default:
+			return "object"
+		}
+	}
+
+	if attribute.AttributeType == cty.NilType {
+		return "unknown"
+	}
+
+	return attribute.AttributeType.FriendlyName()
+}
+
+func appendUnique(values []string, value string) []string {
+	if containsString(values, value) {
+		return values
+	}
+
+	return append(values, value)
+}
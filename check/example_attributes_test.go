@@ -0,0 +1,284 @@
+package check
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestExampleAttributesCheck(t *testing.T) {
+	schemas := map[string]*tfjson.Schema{
+		"test_thing": {
+			Block: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"id": {
+						Computed: true,
+					},
+					"name": {
+						Required: true,
+					},
+					"tags": {
+						Computed: true,
+						Optional: true,
+					},
+				},
+				NestedBlocks: map[string]*tfjson.SchemaBlockType{
+					"network_interface": {
+						Block: &tfjson.SchemaBlock{
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"subnet_id": {
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		Path         string
+		Source       string
+		ResourceType string
+		ExpectError  bool
+	}{
+		{
+			Name:   "valid",
+			Path:   "docs/resources/thing.md",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  count = 1\n  name  = \"example\"\n  tags  = {}\n\n  network_interface {\n    subnet_id = \"example\"\n  }\n\n  lifecycle {\n    ignore_changes = [tags]\n  }\n}\n```\n",
+		},
+		{
+			Name:   "valid dynamic block",
+			Path:   "docs/resources/thing.md",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  name = \"example\"\n\n  dynamic \"network_interface\" {\n    for_each = var.subnet_ids\n\n    content {\n      subnet_id = network_interface.value\n    }\n  }\n}\n```\n",
+		},
+		{
+			Name:   "other resource",
+			Path:   "docs/resources/thing.md",
+			Source: "```terraform\nresource \"test_other\" \"example\" {\n  not_found = true\n}\n```\n",
+		},
+		{
+			Name:   "other language",
+			Path:   "docs/resources/thing.md",
+			Source: "```typescript\nresource \"test_thing\" \"example\" {\n  not_found = true\n}\n```\n",
+		},
+		{
+			Name:   "no schema",
+			Path:   "docs/resources/not_found.md",
+			Source: "```terraform\nresource \"test_not_found\" \"example\" {\n  not_found = true\n}\n```\n",
+		},
+		{
+			Name:   "cdktf",
+			Path:   "docs/cdktf/typescript/resources/thing.md",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  not_found = true\n}\n```\n",
+		},
+		{
+			Name:        "unknown argument",
+			Path:        "docs/resources/thing.md",
+			Source:      "```terraform\nresource \"test_thing\" \"example\" {\n  name      = \"example\"\n  not_found = true\n}\n```\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown nested argument",
+			Path:        "docs/resources/thing.md",
+			Source:      "```hcl\nresource \"test_thing\" \"example\" {\n  name = \"example\"\n\n  network_interface {\n    subnet = \"example\"\n  }\n}\n```\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown block",
+			Path:        "docs/resources/thing.md",
+			Source:      "```terraform\nresource \"test_thing\" \"example\" {\n  name = \"example\"\n\n  not_found {}\n}\n```\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "read-only attribute",
+			Path:        "docs/resources/thing.md",
+			Source:      "```terraform\nresource \"test_thing\" \"example\" {\n  id   = \"example\"\n  name = \"example\"\n}\n```\n",
+			ExpectError: true,
+		},
+		{
+			Name:         "data source",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\ndata \"test_thing\" \"example\" {\n  not_found = true\n}\n\nresource \"test_thing\" \"example\" {\n  also_not_found = true\n}\n```\n",
+			ResourceType: ResourceTypeDataSource,
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &ExampleAttributesOptions{
+				ProviderName: "test",
+				ResourceType: testCase.ResourceType,
+				Schemas:      schemas,
+			}
+
+			got := NewExampleAttributesCheck(opts).Run(testCase.Path, []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestExampleAttributesCheckError(t *testing.T) {
+	opts := &ExampleAttributesOptions{
+		ProviderName: "test",
+		Schemas: map[string]*tfjson.Schema{
+			"test_thing": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"id": {
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	source := "```terraform\nresource \"test_thing\" \"example\" {\n  nmae = \"example\"\n  id   = \"example\"\n}\n```\n"
+
+	got := NewExampleAttributesCheck(opts).Run("docs/resources/thing.md", []byte(source))
+
+	if got == nil {
+		t.Fatalf("expected error, got no error")
+	}
+
+	expected := "example configuration contains arguments not found in test_thing schema: nmae; and read-only attributes of test_thing schema: id"
+
+	if got.Error() != expected {
+		t.Errorf("expected error %q, got: %q", expected, got.Error())
+	}
+
+	if ErrorSuggestion(got) == "" {
+		t.Errorf("expected suggestion, got none")
+	}
+}
+
+func TestExampleAttributesCheckTypes(t *testing.T) {
+	opts := &ExampleAttributesOptions{
+		ProviderName: "test",
+		Schemas: map[string]*tfjson.Schema{
+			"test_thing": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"description": {
+							AttributeType: cty.String,
+							Optional:      true,
+						},
+						"enabled": {
+							AttributeType: cty.Bool,
+							Optional:      true,
+						},
+						"instance_count": {
+							AttributeType: cty.Number,
+							Optional:      true,
+						},
+						"rules": {
+							AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"port": {
+										AttributeType: cty.Number,
+										Required:      true,
+									},
+								},
+								NestingMode: tfjson.SchemaNestingModeList,
+							},
+							Optional: true,
+						},
+						"security_groups": {
+							AttributeType: cty.Set(cty.String),
+							Optional:      true,
+						},
+						"tags": {
+							AttributeType: cty.Map(cty.String),
+							Optional:      true,
+						},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"root_block_device": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"volume_size": {
+										AttributeType: cty.Number,
+										Optional:      true,
+									},
+								},
+							},
+							MaxItems:    1,
+							NestingMode: tfjson.SchemaNestingModeList,
+						},
+						"setting": {
+							Block:       &tfjson.SchemaBlock{},
+							NestingMode: tfjson.SchemaNestingModeMap,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name   string
+		Source string
+		Expect string
+	}{
+		{
+			Name:   "valid",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  description     = 1\n  enabled         = \"true\"\n  instance_count  = \"3\"\n  rules           = [{ port = 443 }]\n  security_groups = [aws_security_group.example.id]\n  tags            = {\n    Name = \"example\"\n  }\n\n  root_block_device {}\n\n  setting \"example\" {}\n}\n```\n",
+		},
+		{
+			Name:   "references and templates",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  description     = [\"a\", \"b\"][0]\n  enabled         = \"${var.enabled}\"\n  instance_count  = \"${var.count}0\"\n  security_groups = concat([\"a\"], var.security_groups)\n  tags            = merge({}, var.tags)\n}\n```\n",
+		},
+		{
+			Name:   "functions and expressions",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  count = var.create ? 1 : 0\n\n  description     = \"${upper(var.name)}-${count.index}\"\n  enabled         = tobool(var.enabled)\n  instance_count  = length(var.instances) + 1\n  rules           = [for port in var.ports : { port = port }]\n  security_groups = [for sg in aws_security_group.example : sg.id]\n  tags            = { for k, v in var.tags : k => v }\n\n  dynamic \"root_block_device\" {\n    for_each = var.volumes\n\n    content {\n      volume_size = root_block_device.value.size\n    }\n  }\n}\n```\n",
+		},
+		{
+			Name:   "placeholders",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  enabled = 1\n  ...\n}\n```\n",
+		},
+		{
+			Name:   "unterminated string",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  description = \"\n}\n```\n",
+		},
+		{
+			Name:   "invalid values",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  description     = [\"example\"]\n  enabled         = 1\n  instance_count  = \"three\"\n  rules           = { port = 443 }\n  security_groups = \"sg-12345678\"\n  tags            = [\"example\"]\n}\n```\n",
+			Expect: "example configuration contains type errors for test_thing schema: description (string required, got tuple), enabled (bool required, got number), instance_count (number required, got string), rules (list of object required, got object), security_groups (set of string required, got string), tags (map of string required, got tuple)",
+		},
+		{
+			Name:   "invalid nested values",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  instance_count = length(var.instances) > 1\n  rules          = [{ port = \"https\" }]\n\n  root_block_device {\n    volume_size = \"large\"\n  }\n}\n```\n",
+			Expect: "example configuration contains type errors for test_thing schema: instance_count (number required, got bool), root_block_device.volume_size (number required, got string), rules (list of object required, got tuple)",
+		},
+		{
+			Name:   "invalid nesting",
+			Source: "```terraform\nresource \"test_thing\" \"example\" {\n  tags {\n    Name = \"example\"\n  }\n\n  root_block_device {}\n  root_block_device {}\n\n  setting {}\n}\n```\n",
+			Expect: "example configuration contains type errors for test_thing schema: root_block_device (2 blocks, at most 1 allowed), setting (0 block labels, expected 1), tags (argument of type map of string, got block)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewExampleAttributesCheck(opts).Run("docs/resources/thing.md", []byte(testCase.Source))
+
+			if got == nil && testCase.Expect != "" {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if got != nil && got.Error() != testCase.Expect {
+				t.Errorf("expected error %q, got: %q", testCase.Expect, got.Error())
+			}
+		})
+	}
+}
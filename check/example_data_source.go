@@ -0,0 +1,114 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/yuin/goldmark/ast"
+)
+
+// ExampleDataSourceCheck verifies data source example configurations
+// (terraform or hcl code blocks) declare the data source with a data block,
+// e.g. data "aws_ami" "example", rather than a resource block, and reference
+// it with the data. prefix, e.g. data.aws_ami.example.id. Resource blocks of
+// the same type are allowed if a data block is also declared, and references
+// to those resource blocks are allowed. CDK for Terraform documentation is
+// skipped.
+func ExampleDataSourceCheck(path string, source []byte, providerName string) error {
+	if providerName == "" || isCdktfPath(path) {
+		return nil
+	}
+
+	dataSourceName := fileResourceName(providerName, path)
+	referenceRegexp := regexp.MustCompile(`(^|[^\w.])` + regexp.QuoteMeta(dataSourceName) + `\.([A-Za-z_][\w-]*)\.`)
+	document, _ := markdown.Parse(source)
+
+	var dataBlock bool
+	var parseErr error
+	var references []string
+	resourceNames := make(map[string]bool)
+
+	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		switch markdown.FencedCodeBlockLanguage(fencedCodeBlock, source) {
+		case markdown.FencedCodeBlockLanguageHcl, markdown.FencedCodeBlockLanguageTerraform:
+		default:
+			return ast.WalkSkipChildren, nil
+		}
+
+		text := markdown.FencedCodeBlockText(fencedCodeBlock, source)
+
+		configuration, err := parseExampleConfiguration(text)
+
+		if err != nil {
+			parseErr = fmt.Errorf("error parsing example configuration: %w", err)
+
+			return ast.WalkStop, nil
+		}
+
+		for _, block := range configuration.Blocks {
+			if len(block.Labels) < 2 || block.Labels[0] != dataSourceName {
+				continue
+			}
+
+			switch block.Type {
+			case "data":
+				dataBlock = true
+			case "resource":
+				resourceNames[block.Labels[1]] = true
+			}
+		}
+
+		for _, match := range referenceRegexp.FindAllStringSubmatch(text, -1) {
+			references = append(references, match[2])
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error walking documentation: %w", err)
+	}
+
+	if parseErr != nil {
+		return parseErr
+	}
+
+	if len(resourceNames) > 0 && !dataBlock {
+		err := fmt.Errorf("example configuration declares data source with resource block: resource %q", dataSourceName)
+
+		return WithSuggestion(err, fmt.Sprintf("replace resource %q with data %q", dataSourceName, dataSourceName))
+	}
+
+	var invalidReferences []string
+
+	for _, name := range references {
+		if resourceNames[name] {
+			continue
+		}
+
+		reference := fmt.Sprintf("%s.%s", dataSourceName, name)
+
+		if !containsString(invalidReferences, reference) {
+			invalidReferences = append(invalidReferences, reference)
+		}
+	}
+
+	if len(invalidReferences) > 0 {
+		sort.Strings(invalidReferences)
+
+		err := fmt.Errorf("example configuration references data source without data. prefix: %s", strings.Join(invalidReferences, ", "))
+
+		return WithSuggestion(err, fmt.Sprintf("reference data source attributes with data.%s.<name>.<attribute>", dataSourceName))
+	}
+
+	return nil
+}
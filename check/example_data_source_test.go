@@ -0,0 +1,98 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestExampleDataSourceCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		Source       string
+		ProviderName string
+		ExpectError  bool
+	}{
+		{
+			Name:         "no provider name",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\nresource \"test_thing\" \"example\" {}\n```\n",
+			ProviderName: "",
+		},
+		{
+			Name:         "valid",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\ndata \"test_thing\" \"example\" {\n  name = \"example\"\n}\n\noutput \"id\" {\n  value = data.test_thing.example.id\n}\n```\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "valid interpolation",
+			Path:         "website/docs/d/thing.html.markdown",
+			Source:       "```hcl\ndata \"test_thing\" \"example\" {}\n\nresource \"test_other\" \"example\" {\n  name = \"${data.test_thing.example.name}-other\"\n}\n```\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "valid resource of same type",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\nresource \"test_thing\" \"example\" {}\n\ndata \"test_thing\" \"example\" {\n  id = test_thing.example.id\n}\n```\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "unterminated block comment",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\ndata \"test_thing\" \"example\" {}\n\n/* comment\n```\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "other language",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```shell\nresource \"test_thing\" \"example\" {}\n```\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "cdktf",
+			Path:         "docs/cdktf/python/data-sources/thing.md",
+			Source:       "```terraform\nresource \"test_thing\" \"example\" {}\n```\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "resource block",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\nresource \"test_thing\" \"example\" {\n  name = \"example\"\n}\n```\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "reference without data prefix",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\ndata \"test_thing\" \"example\" {}\n\noutput \"id\" {\n  value = test_thing.example.id\n}\n```\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "reference without data prefix in interpolation",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "```terraform\ndata \"test_thing\" \"example\" {}\n```\n\n```terraform\nresource \"test_other\" \"example\" {\n  name = \"${test_thing.example.name}\"\n}\n```\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := ExampleDataSourceCheck(testCase.Path, []byte(testCase.Source), testCase.ProviderName)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got != nil && ErrorSuggestion(got) == "" {
+				t.Errorf("expected suggestion, got none")
+			}
+		})
+	}
+}
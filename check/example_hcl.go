@@ -0,0 +1,360 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// exampleBody represents the arguments and nested blocks of a Terraform
+// configuration body in an example code block. Only the structure is parsed,
+// expressions are kept as their source text.
+type exampleBody struct {
+	Attributes []string
+	Blocks     []*exampleBlock
+
+	// Expressions contains the trimmed source text of argument expressions,
+	// keyed by the argument name.
+	Expressions map[string]string
+}
+
+// exampleBlock represents a Terraform configuration block in an example code
+// block, such as resource "aws_instance" "example" { ... }.
+type exampleBlock struct {
+	Body   *exampleBody
+	Labels []string
+	Type   string
+}
+
+// exampleParser is a lenient parser of Terraform configuration structure.
+// Unexpected syntax, such as ... placeholders, is skipped to the end of the
+// line instead of returning an error. Only unterminated block comments and
+// block labels, which would hide the remaining configuration, are errors.
+type exampleParser struct {
+	err error
+	pos int
+	src []rune
+}
+
+// parseExampleConfiguration returns the root body of the configuration.
+func parseExampleConfiguration(src string) (*exampleBody, error) {
+	parser := &exampleParser{
+		src: []rune(src),
+	}
+
+	body := parser.body()
+
+	return body, parser.err
+}
+
+func (p *exampleParser) body() *exampleBody {
+	result := &exampleBody{}
+
+	for {
+		p.skipSpaceAndComments()
+
+		if p.eof() {
+			return result
+		}
+
+		if p.peek() == '}' {
+			p.pos++
+
+			return result
+		}
+
+		// Object expression items may be separated by commas.
+		if p.peek() == ',' {
+			p.pos++
+			continue
+		}
+
+		name := p.identifier()
+
+		if name == "" {
+			p.skipLine()
+			continue
+		}
+
+		p.skipInlineSpace()
+
+		if p.peek() == '=' && p.peekAt(1) != '=' {
+			p.pos++
+			start := p.pos
+			p.skipExpression()
+			result.Attributes = append(result.Attributes, name)
+
+			if result.Expressions == nil {
+				result.Expressions = make(map[string]string)
+			}
+
+			result.Expressions[name] = strings.TrimSpace(string(p.src[start:p.pos]))
+
+			continue
+		}
+
+		block := &exampleBlock{
+			Type: name,
+		}
+
+		for !p.eof() && p.peek() != '{' {
+			if p.peek() == '"' {
+				start := p.pos
+
+				if !p.skipString() {
+					p.fail(`terminate the block label with "`, "unterminated block label starting on line %d", p.line(start))
+
+					return result
+				}
+
+				block.Labels = append(block.Labels, string(p.src[start+1:p.pos-1]))
+			} else if label := p.identifier(); label != "" {
+				block.Labels = append(block.Labels, label)
+			} else {
+				break
+			}
+
+			p.skipInlineSpace()
+		}
+
+		if p.peek() != '{' {
+			p.skipLine()
+			continue
+		}
+
+		p.pos++
+		block.Body = p.body()
+		result.Blocks = append(result.Blocks, block)
+	}
+}
+
+func (p *exampleParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+// fail records the first parse error, with the remediation hint, and stops
+// parsing at the end of the source.
+func (p *exampleParser) fail(suggestion string, format string, a ...interface{}) {
+	if p.err == nil {
+		p.err = WithSuggestion(fmt.Errorf(format, a...), suggestion)
+	}
+
+	p.pos = len(p.src)
+}
+
+func (p *exampleParser) identifier() string {
+	start := p.pos
+
+	for !p.eof() {
+		r := p.peek()
+
+		if unicode.IsLetter(r) || r == '_' || (p.pos > start && (unicode.IsDigit(r) || r == '-')) {
+			p.pos++
+			continue
+		}
+
+		break
+	}
+
+	return string(p.src[start:p.pos])
+}
+
+// line returns the line number of the position.
+func (p *exampleParser) line(pos int) int {
+	return strings.Count(string(p.src[:pos]), "\n") + 1
+}
+
+func (p *exampleParser) peek() rune {
+	return p.peekAt(0)
+}
+
+func (p *exampleParser) peekAt(offset int) rune {
+	if p.pos+offset >= len(p.src) {
+		return 0
+	}
+
+	return p.src[p.pos+offset]
+}
+
+// skipComment skips a comment at the current position, returning false if
+// there is no comment.
+func (p *exampleParser) skipComment() bool {
+	switch {
+	case p.peek() == '#', p.peek() == '/' && p.peekAt(1) == '/':
+		for !p.eof() && p.peek() != '\n' {
+			p.pos++
+		}
+
+		return true
+	case p.peek() == '/' && p.peekAt(1) == '*':
+		start := p.pos
+		p.pos += 2
+
+		for !p.eof() && !(p.peek() == '*' && p.peekAt(1) == '/') {
+			p.pos++
+		}
+
+		if p.eof() {
+			p.fail("terminate the block comment with */", "unterminated block comment starting on line %d", p.line(start))
+
+			return true
+		}
+
+		p.pos += 2
+
+		return true
+	}
+
+	return false
+}
+
+// skipExpression skips an argument expression, which ends at a newline, a
+// comma, or the closing brace of the body outside of brackets, strings, and
+// heredocs.
+func (p *exampleParser) skipExpression() {
+	var depth int
+
+	for !p.eof() {
+		switch r := p.peek(); {
+		case r == '"':
+			p.skipString()
+			continue
+		case r == '<' && p.peekAt(1) == '<':
+			p.skipHeredoc()
+			continue
+		case p.skipComment():
+			continue
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']' || r == '}':
+			if depth == 0 {
+				return
+			}
+
+			depth--
+		case (r == '\n' || r == ',') && depth == 0:
+			return
+		}
+
+		p.pos++
+	}
+}
+
+// skipHeredoc skips a heredoc (<<EOT or <<-EOT) through its closing marker.
+func (p *exampleParser) skipHeredoc() {
+	p.pos += 2
+
+	if p.peek() == '-' {
+		p.pos++
+	}
+
+	marker := p.identifier()
+
+	if marker == "" {
+		return
+	}
+
+	for !p.eof() {
+		p.skipLine()
+
+		lineStart := p.pos
+
+		for !p.eof() && p.peek() != '\n' {
+			p.pos++
+		}
+
+		if strings.TrimSpace(string(p.src[lineStart:p.pos])) == marker {
+			return
+		}
+
+		p.pos = lineStart
+	}
+}
+
+func (p *exampleParser) skipInlineSpace() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+// skipLine skips through the next newline.
+func (p *exampleParser) skipLine() {
+	for !p.eof() && p.peek() != '\n' {
+		p.pos++
+	}
+
+	if !p.eof() {
+		p.pos++
+	}
+}
+
+func (p *exampleParser) skipSpaceAndComments() {
+	for !p.eof() {
+		if unicode.IsSpace(p.peek()) {
+			p.pos++
+			continue
+		}
+
+		if !p.skipComment() {
+			return
+		}
+	}
+}
+
+// skipString skips a quoted string, including template interpolations and
+// directives which may contain nested strings, returning false if it is not
+// terminated on the same line.
+func (p *exampleParser) skipString() bool {
+	p.pos++
+
+	for !p.eof() {
+		switch r := p.peek(); {
+		case r == '\\':
+			p.pos++
+
+			if !p.eof() {
+				p.pos++
+			}
+
+			continue
+		case r == '"':
+			p.pos++
+			return true
+		case r == '\n':
+			return false
+		case (r == '$' || r == '%') && p.peekAt(1) == '{':
+			p.pos += 2
+			p.skipTemplate()
+			continue
+		}
+
+		p.pos++
+	}
+
+	return false
+}
+
+// skipTemplate skips a template interpolation or directive through its
+// closing brace.
+func (p *exampleParser) skipTemplate() {
+	var depth int
+
+	for !p.eof() {
+		switch p.peek() {
+		case '"':
+			p.skipString()
+			continue
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				p.pos++
+				return
+			}
+
+			depth--
+		}
+
+		p.pos++
+	}
+}
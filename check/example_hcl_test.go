@@ -0,0 +1,230 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExampleConfiguration(t *testing.T) {
+	src := `# Comment
+resource "test_thing" "example" {
+  name   = "example-${var.suffix}"
+  count  = 2 // comment
+  tags   = {
+    Name = "example"
+  }
+  policy = <<-EOT
+    {
+      "key" = "value"
+    }
+  EOT
+  list   = [
+    "a",
+    "b",
+  ]
+
+  /* multi-line
+  comment = true
+  */
+  network_interface {
+    subnet_id = "${data.test_subnet.example.id}"
+  }
+
+  ...
+}
+
+data test_other example { filter { name = "a" } }
+`
+
+	body, err := parseExampleConfiguration(src)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := withoutExpressions(body)
+	expected := &exampleBody{
+		Blocks: []*exampleBlock{
+			{
+				Body: &exampleBody{
+					Attributes: []string{"name", "count", "tags", "policy", "list"},
+					Blocks: []*exampleBlock{
+						{
+							Body: &exampleBody{
+								Attributes: []string{"subnet_id"},
+							},
+							Type: "network_interface",
+						},
+					},
+				},
+				Labels: []string{"test_thing", "example"},
+				Type:   "resource",
+			},
+			{
+				Body: &exampleBody{
+					Blocks: []*exampleBlock{
+						{
+							Body: &exampleBody{
+								Attributes: []string{"name"},
+							},
+							Type: "filter",
+						},
+					},
+				},
+				Labels: []string{"test_other", "example"},
+				Type:   "data",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %s, got: %s", exampleBodyString(expected), exampleBodyString(got))
+	}
+}
+
+func TestParseExampleConfigurationExpressions(t *testing.T) {
+	src := `terraform {
+  required_providers {
+    test = {
+      source  = "hashicorp/test" # comment
+      version = "~> 1.0"
+    }
+    other = { source = "example/other", version = ">= 2.0" }
+  }
+}
+`
+
+	body, err := parseExampleConfiguration(src)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	requiredProviders := body.Blocks[0].Body.Blocks[0].Body
+
+	if got, expected := requiredProviders.Expressions["test"], "{\n      source  = \"hashicorp/test\" # comment\n      version = \"~> 1.0\"\n    }"; got != expected {
+		t.Errorf("expected expression %q, got: %q", expected, got)
+	}
+
+	other := requiredProviders.Expressions["other"]
+	object, err := parseExampleConfiguration(other[1 : len(other)-1])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := object.Expressions
+	expected := map[string]string{
+		"source":  `"example/other"`,
+		"version": `">= 2.0"`,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected expressions %v, got: %v", expected, got)
+	}
+}
+
+func TestParseExampleConfigurationErrors(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Source      string
+		ExpectError string
+	}{
+		{
+			Name:        "unterminated block comment",
+			Source:      "resource \"test_thing\" \"example\" {\n  name = \"example\"\n}\n\n/* comment",
+			ExpectError: "unterminated block comment starting on line 5",
+		},
+		{
+			Name:        "unterminated block comment in expression",
+			Source:      "resource \"test_thing\" \"example\" {\n  name = /* comment",
+			ExpectError: "unterminated block comment starting on line 2",
+		},
+		{
+			Name:        "unterminated block label",
+			Source:      "resource \"test_thing",
+			ExpectError: "unterminated block label starting on line 1",
+		},
+		{
+			Name:   "unterminated string escape",
+			Source: "resource \"test_thing\" \"example\" {\n  name = \"\\",
+		},
+		{
+			Name:   "unterminated heredoc",
+			Source: "resource \"test_thing\" \"example\" {\n  policy = <<EOT\n  {}",
+		},
+		{
+			Name:   "lone quote",
+			Source: "name = \"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := parseExampleConfiguration(testCase.Source)
+
+			if testCase.ExpectError == "" {
+				if err != nil {
+					t.Errorf("expected no error, got error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err.Error() != testCase.ExpectError {
+				t.Errorf("expected error %q, got: %q", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
+// withoutExpressions removes the expressions of the body and its nested
+// blocks, to compare only the structure.
+func withoutExpressions(body *exampleBody) *exampleBody {
+	if body == nil {
+		return nil
+	}
+
+	body.Expressions = nil
+
+	for _, block := range body.Blocks {
+		withoutExpressions(block.Body)
+	}
+
+	return body
+}
+
+func exampleBodyString(body *exampleBody) string {
+	if body == nil {
+		return "<nil>"
+	}
+
+	result := "{attributes: ["
+
+	for i, attribute := range body.Attributes {
+		if i > 0 {
+			result += " "
+		}
+
+		result += attribute
+	}
+
+	result += "], blocks: ["
+
+	for _, block := range body.Blocks {
+		result += block.Type
+		result += "("
+
+		for _, label := range block.Labels {
+			result += label + " "
+		}
+
+		result += ")" + exampleBodyString(block.Body) + " "
+	}
+
+	return result + "]}"
+}
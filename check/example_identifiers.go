@@ -0,0 +1,249 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// ExampleIdentifierPattern is a pattern of real-looking cloud identifiers,
+// such as account IDs, which should be placeholders in examples.
+type ExampleIdentifierPattern struct {
+	Name string
+
+	// Pattern matches the identifier. If the pattern has a capture group, the
+	// first group is the identifier value compared with allowed values, e.g.
+	// the account ID of an ARN.
+	Pattern *regexp.Regexp
+}
+
+// DefaultExampleIdentifierPatterns are the default patterns of real-looking
+// cloud identifiers, in order of precedence for overlapping matches.
+var DefaultExampleIdentifierPatterns = []*ExampleIdentifierPattern{
+	{
+		Name:    "aws_arn",
+		Pattern: regexp.MustCompile(`\barn:aws[a-z-]*:[a-z0-9-]*:[a-z0-9-]*:([0-9]{12}):`),
+	},
+	{
+		Name:    "gcp_project_number",
+		Pattern: regexp.MustCompile(`\bprojects/([0-9]{6,})\b`),
+	},
+	{
+		Name:    "aws_account_id",
+		Pattern: regexp.MustCompile(`\b[0-9]{12}\b`),
+	},
+	{
+		Name:    "guid",
+		Pattern: regexp.MustCompile(`\b[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}\b`),
+	},
+}
+
+// DefaultExampleIdentifierAllowedValues are the default sanctioned example
+// identifier values, such as the AWS documentation example account IDs.
+// Values of only one repeated character, such as 000000000000, are always
+// allowed.
+var DefaultExampleIdentifierAllowedValues = []string{
+	"111122223333",
+	"123456789012",
+	"444455556666",
+	"12345678-1234-1234-1234-123456789012",
+}
+
+// ExampleIdentifiersOptions represents configuration options for
+// ExampleIdentifiers.
+type ExampleIdentifiersOptions struct {
+	*FileOptions
+
+	// AllowedValues contains sanctioned example identifier values, which are
+	// not reported.
+	AllowedValues []string
+
+	// Patterns contains the identifier patterns, in order of precedence for
+	// overlapping matches. The check is disabled if empty.
+	Patterns []*ExampleIdentifierPattern
+}
+
+// ExampleIdentifiersCheck verifies documentation code blocks do not contain
+// real-looking cloud identifiers, such as account IDs, ARNs, subscription
+// GUIDs, and project numbers, instead of placeholders, e.g. 123456789012.
+type ExampleIdentifiersCheck struct {
+	Options *ExampleIdentifiersOptions
+}
+
+func NewExampleIdentifiersCheck(opts *ExampleIdentifiersOptions) *ExampleIdentifiersCheck {
+	check := &ExampleIdentifiersCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExampleIdentifiersOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the code blocks of each documentation file, except CDKTF
+// documentation, which is generated from the same examples.
+func (check *ExampleIdentifiersCheck) Run(directories map[string][]string) error {
+	if len(check.Options.Patterns) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the code blocks of the documentation file.
+func (check *ExampleIdentifiersCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file example identifiers", "check", CheckIDExampleIdentifiers, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	// Identifiers are reported once with all of their lines, in order of
+	// first appearance.
+	var identifiers []string
+	lines := make(map[string][]string)
+	document, _ := markdown.Parse(content)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node.(type) {
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		segments := node.Lines()
+
+		for i := 0; i < segments.Len(); i++ {
+			segment := segments.At(i)
+			line := fmt.Sprint(strings.Count(string(content[:segment.Start]), "\n") + 1)
+
+			for _, identifier := range check.identifiers(string(segment.Value(content))) {
+				identifierLines, ok := lines[identifier]
+
+				if !ok {
+					identifiers = append(identifiers, identifier)
+				}
+
+				if n := len(identifierLines); n == 0 || identifierLines[n-1] != line {
+					lines[identifier] = append(identifierLines, line)
+				}
+			}
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if len(identifiers) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(identifiers))
+
+	for index, identifier := range identifiers {
+		if len(lines[identifier]) == 1 {
+			messages[index] = fmt.Sprintf("%s (line %s)", identifier, lines[identifier][0])
+		} else {
+			messages[index] = fmt.Sprintf("%s (lines %s)", identifier, strings.Join(lines[identifier], ", "))
+		}
+	}
+
+	err = fmt.Errorf("%s: real-looking identifiers in example code: %s", path, strings.Join(messages, ", "))
+
+	// The first identifier appears first.
+	if line, lineErr := strconv.Atoi(lines[identifiers[0]][0]); lineErr == nil {
+		err = WithLine(err, line)
+	}
+	err = WithSuggestion(err, "replace identifiers with placeholders, e.g. 123456789012 or YOUR_PROJECT, or add sanctioned values to the example_identifiers allowed_values configuration")
+
+	return NewFinding(CheckIDExampleIdentifiers, path, err)
+}
+
+// identifiers returns the pattern name and value of each real-looking
+// identifier in the line of example code. Matches overlapping a match of a
+// previous pattern are ignored, e.g. the account ID of an ARN.
+func (check *ExampleIdentifiersCheck) identifiers(line string) []string {
+	var result []string
+	var matched [][]int
+
+	for _, pattern := range check.Options.Patterns {
+		for _, match := range pattern.Pattern.FindAllStringSubmatchIndex(line, -1) {
+			if exampleIdentifierOverlaps(matched, match[0], match[1]) {
+				continue
+			}
+
+			matched = append(matched, match[:2])
+			value := line[match[0]:match[1]]
+
+			if len(match) >= 4 && match[2] >= 0 {
+				value = line[match[2]:match[3]]
+			}
+
+			if check.allowed(value) {
+				continue
+			}
+
+			result = append(result, fmt.Sprintf("%s %s", pattern.Name, value))
+		}
+	}
+
+	return result
+}
+
+// allowed returns true if the identifier value is a sanctioned example value
+// or only one repeated character, ignoring separators.
+func (check *ExampleIdentifiersCheck) allowed(value string) bool {
+	for _, allowedValue := range check.Options.AllowedValues {
+		if strings.EqualFold(value, allowedValue) {
+			return true
+		}
+	}
+
+	characters := strings.NewReplacer("-", "", ":", "", "/", "").Replace(value)
+
+	return characters != "" && strings.Count(characters, characters[:1]) == len(characters)
+}
+
+func exampleIdentifierOverlaps(matched [][]int, start int, end int) bool {
+	for _, match := range matched {
+		if start < match[1] && match[0] < end {
+			return true
+		}
+	}
+
+	return false
+}
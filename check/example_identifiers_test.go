@@ -0,0 +1,72 @@
+package check
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+)
+
+func TestExampleIdentifiersCheck(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		AllowedValues []string
+		Patterns      []*ExampleIdentifierPattern
+		ExpectErrors  []string
+	}{
+		{
+			Name:          "no patterns",
+			AllowedValues: DefaultExampleIdentifierAllowedValues,
+		},
+		{
+			Name:          "default patterns",
+			AllowedValues: DefaultExampleIdentifierAllowedValues,
+			Patterns:      DefaultExampleIdentifierPatterns,
+			ExpectErrors: []string{
+				"docs/resources/thing.md: real-looking identifiers in example code: aws_account_id 210987654321 (line 16), aws_arn 210987654321 (line 17), guid 8f2d1c3e-4b5a-4c6d-9e7f-0a1b2c3d4e5f (lines 18, 26), gcp_project_number 918273645546 (line 19)",
+			},
+		},
+		{
+			Name:          "allowed values",
+			AllowedValues: []string{"210987654321", "8F2D1C3E-4B5A-4C6D-9E7F-0A1B2C3D4E5F"},
+			Patterns:      DefaultExampleIdentifierPatterns,
+			ExpectErrors: []string{
+				"docs/guides/getting_started.md: real-looking identifiers in example code: aws_account_id 123456789012 (line 9), aws_arn 111122223333 (line 10)",
+				"docs/resources/thing.md: real-looking identifiers in example code: gcp_project_number 918273645546 (line 19)",
+			},
+		},
+		{
+			Name: "custom pattern",
+			Patterns: []*ExampleIdentifierPattern{
+				{
+					Name:    "iam_role",
+					Pattern: regexp.MustCompile(`:role/([a-z]+)`),
+				},
+			},
+			ExpectErrors: []string{
+				"docs/guides/getting_started.md: real-looking identifiers in example code: iam_role example (line 10)",
+				"docs/resources/thing.md: real-looking identifiers in example code: iam_role example (line 17)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &ExampleIdentifiersOptions{
+				AllowedValues: testCase.AllowedValues,
+				FileOptions: &FileOptions{
+					BasePath: "testdata/example-identifiers",
+				},
+				Patterns: testCase.Patterns,
+			}
+
+			got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewExampleIdentifiersCheck(opts).Run, CheckIDExampleIdentifiers))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectErrors) {
+				t.Errorf("expected errors: %v, got: %v", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
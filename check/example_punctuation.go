@@ -0,0 +1,171 @@
+package check
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// examplePunctuationReplacements maps non-ASCII quotes and dashes, which word
+// processors substitute for ASCII quotes and hyphens, to their replacements.
+var examplePunctuationReplacements = map[rune]string{
+	'‐': "-", // hyphen
+	'‑': "-", // non-breaking hyphen
+	'‒': "-", // figure dash
+	'–': "-", // en dash
+	'—': "-", // em dash
+	'―': "-", // horizontal bar
+	'‘': "'", // left single quotation mark
+	'’': "'", // right single quotation mark
+	'‚': "'", // single low-9 quotation mark
+	'‛': "'", // single high-reversed-9 quotation mark
+	'“': `"`, // left double quotation mark
+	'”': `"`, // right double quotation mark
+	'„': `"`, // double low-9 quotation mark
+	'‟': `"`, // double high-reversed-9 quotation mark
+	'′': "'", // prime
+	'″': `"`, // double prime
+	'−': "-", // minus sign
+	'＂': `"`, // fullwidth quotation mark
+	'＇': "'", // fullwidth apostrophe
+	'－': "-", // fullwidth hyphen-minus
+}
+
+// ExamplePunctuationOptions represents configuration options for
+// ExamplePunctuation.
+type ExamplePunctuationOptions struct {
+	*FileOptions
+}
+
+// ExamplePunctuationCheck verifies documentation code blocks do not contain
+// non-ASCII quotes and dashes, such as “smart quotes” and en dashes copied
+// from word processors, which break examples when they are copied into
+// configurations.
+type ExamplePunctuationCheck struct {
+	Options *ExamplePunctuationOptions
+}
+
+func NewExamplePunctuationCheck(opts *ExamplePunctuationOptions) *ExamplePunctuationCheck {
+	check := &ExamplePunctuationCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExamplePunctuationOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the code blocks of each documentation file, except CDKTF
+// documentation, which is generated from the same examples.
+func (check *ExamplePunctuationCheck) Run(directories map[string][]string) error {
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the code blocks of the documentation file.
+func (check *ExamplePunctuationCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file example punctuation", "check", CheckIDExamplePunctuation, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	// Characters are reported once with all of their lines, in order of
+	// first appearance.
+	var characters []rune
+	lines := make(map[rune][]string)
+	document, _ := markdown.Parse(content)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node.(type) {
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		codeLines := node.Lines()
+
+		for i := 0; i < codeLines.Len(); i++ {
+			segment := codeLines.At(i)
+			line := fmt.Sprint(strings.Count(string(content[:segment.Start]), "\n") + 1)
+
+			for _, character := range string(segment.Value(content)) {
+				if _, ok := examplePunctuationReplacements[character]; !ok {
+					continue
+				}
+
+				characterLines, ok := lines[character]
+
+				if !ok {
+					characters = append(characters, character)
+				}
+
+				if n := len(characterLines); n == 0 || characterLines[n-1] != line {
+					lines[character] = append(characterLines, line)
+				}
+			}
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if len(characters) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(characters))
+	var replacements []string
+
+	for index, character := range characters {
+		if len(lines[character]) == 1 {
+			messages[index] = fmt.Sprintf("%c %U (line %s)", character, character, lines[character][0])
+		} else {
+			messages[index] = fmt.Sprintf("%c %U (lines %s)", character, character, strings.Join(lines[character], ", "))
+		}
+
+		replacements = append(replacements, fmt.Sprintf("%c with %s", character, examplePunctuationReplacements[character]))
+	}
+
+	err = fmt.Errorf("%s: non-ASCII punctuation in example code: %s", path, strings.Join(messages, ", "))
+
+	// The first character appears first.
+	if line, lineErr := strconv.Atoi(lines[characters[0]][0]); lineErr == nil {
+		err = WithLine(err, line)
+	}
+	err = WithSuggestion(err, fmt.Sprintf("replace %s", strings.Join(replacements, ", ")))
+
+	return NewFinding(CheckIDExamplePunctuation, path, err)
+}
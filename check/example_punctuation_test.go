@@ -0,0 +1,24 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExamplePunctuationCheck(t *testing.T) {
+	opts := &ExamplePunctuationOptions{
+		FileOptions: &FileOptions{
+			BasePath: "testdata/example-punctuation",
+		},
+	}
+
+	got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewExamplePunctuationCheck(opts).Run, CheckIDExamplePunctuation))
+
+	expected := []string{
+		"docs/resources/thing.md: non-ASCII punctuation in example code: “ U+201C (lines 16, 19), ” U+201D (lines 16, 19), – U+2013 (line 17), ‘ U+2018 (line 26), ’ U+2019 (line 26)",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected errors: %v, got: %v", expected, got)
+	}
+}
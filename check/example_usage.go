@@ -0,0 +1,106 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/yuin/goldmark/ast"
+)
+
+// ExampleUsageSectionHeading is the heading text of the example section.
+const ExampleUsageSectionHeading = "Example Usage"
+
+// ExampleUsageOptions represents configuration options for ExampleUsage.
+type ExampleUsageOptions struct {
+	Enable bool
+
+	// Ignore contains the data source or resource names, e.g. aws_instance,
+	// whose documentation does not require an example.
+	Ignore []string
+
+	ProviderName string
+}
+
+// ExampleUsageCheck verifies data source and resource documentation contains
+// an Example Usage section with a Terraform configuration code block.
+type ExampleUsageCheck struct {
+	Options *ExampleUsageOptions
+}
+
+func NewExampleUsageCheck(opts *ExampleUsageOptions) *ExampleUsageCheck {
+	check := &ExampleUsageCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExampleUsageOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the documentation source contains a level 2 Example Usage
+// heading followed by at least one terraform (or hcl) fenced code block
+// before the next level 2 heading. CDK for Terraform documentation is skipped
+// as its examples are converted to other languages.
+func (check *ExampleUsageCheck) Run(path string, source []byte) error {
+	if !check.Options.Enable || isCdktfPath(path) {
+		return nil
+	}
+
+	resourceName := fileResourceName(check.Options.ProviderName, path)
+
+	if containsString(check.Options.Ignore, resourceName) {
+		return nil
+	}
+
+	document, _ := markdown.Parse(source)
+	suggestion := fmt.Sprintf("add a ```%s code block with an example configuration of %s", markdown.FencedCodeBlockLanguageTerraform, resourceName)
+
+	for node := document.FirstChild(); node != nil; node = node.NextSibling() {
+		heading, ok := node.(*ast.Heading)
+
+		if !ok || heading.Level != 2 || string(heading.Text(source)) != ExampleUsageSectionHeading {
+			continue
+		}
+
+		for sectionNode := node.NextSibling(); sectionNode != nil; sectionNode = sectionNode.NextSibling() {
+			if sectionHeading, ok := sectionNode.(*ast.Heading); ok && sectionHeading.Level <= 2 {
+				break
+			}
+
+			fencedCodeBlock, ok := sectionNode.(*ast.FencedCodeBlock)
+
+			if !ok {
+				continue
+			}
+
+			switch markdown.FencedCodeBlockLanguage(fencedCodeBlock, source) {
+			case markdown.FencedCodeBlockLanguageHcl, markdown.FencedCodeBlockLanguageTerraform:
+				if markdown.FencedCodeBlockText(fencedCodeBlock, source) != "" {
+					return nil
+				}
+			}
+		}
+
+		return WithSuggestion(fmt.Errorf("%s section should contain a %s code block", ExampleUsageSectionHeading, markdown.FencedCodeBlockLanguageTerraform), suggestion)
+	}
+
+	return WithSuggestion(fmt.Errorf("missing %s section", ExampleUsageSectionHeading), fmt.Sprintf("add a ## %s section and %s", ExampleUsageSectionHeading, strings.TrimPrefix(suggestion, "add ")))
+}
+
+// isCdktfPath returns true if the path is within a CDK for Terraform
+// documentation directory.
+func isCdktfPath(path string) bool {
+	path = filepath.ToSlash(path)
+
+	for _, indexDirectory := range []string{LegacyIndexDirectory, RegistryIndexDirectory} {
+		if strings.HasPrefix(path, indexDirectory+"/"+CdktfIndexDirectory+"/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestExampleUsageCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Source      string
+		Options     *ExampleUsageOptions
+		ExpectError bool
+	}{
+		{
+			Name:   "not enabled",
+			Path:   "docs/resources/thing.md",
+			Source: "# Resource: test_thing\n",
+		},
+		{
+			Name:   "terraform code block",
+			Path:   "docs/resources/thing.md",
+			Source: "# Resource: test_thing\n\n## Example Usage\n\n```terraform\nresource \"test_thing\" \"example\" {}\n```\n\n## Argument Reference\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+		},
+		{
+			Name:   "hcl code block in nested section",
+			Path:   "website/docs/d/thing.html.markdown",
+			Source: "# Data Source: test_thing\n\n## Example Usage\n\n### Basic\n\n```hcl\ndata \"test_thing\" \"example\" {}\n```\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+		},
+		{
+			Name:   "missing section",
+			Path:   "docs/resources/thing.md",
+			Source: "# Resource: test_thing\n\n## Argument Reference\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:   "empty section",
+			Path:   "docs/resources/thing.md",
+			Source: "# Resource: test_thing\n\n## Example Usage\n\n## Argument Reference\n\n```terraform\nresource \"test_thing\" \"example\" {}\n```\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:   "empty code block",
+			Path:   "docs/resources/thing.md",
+			Source: "# Resource: test_thing\n\n## Example Usage\n\n```terraform\n```\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:   "other language code block",
+			Path:   "docs/resources/thing.md",
+			Source: "# Resource: test_thing\n\n## Example Usage\n\n```shell\nterraform apply\n```\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:   "ignored",
+			Path:   "docs/resources/thing.md",
+			Source: "# Resource: test_thing\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				Ignore:       []string{"test_thing"},
+				ProviderName: "test",
+			},
+		},
+		{
+			Name:   "cdktf",
+			Path:   "docs/cdktf/typescript/resources/thing.md",
+			Source: "# Resource: test_thing\n",
+			Options: &ExampleUsageOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewExampleUsageCheck(testCase.Options).Run(testCase.Path, []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got != nil && ErrorSuggestion(got) == "" {
+				t.Errorf("expected suggestion, got none")
+			}
+		})
+	}
+}
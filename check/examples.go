@@ -0,0 +1,170 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+const (
+	// ExamplesDirectory is the terraform-plugin-docs examples directory.
+	ExamplesDirectory = `examples`
+
+	ExamplesDataSourcesDirectory = `data-sources`
+	ExamplesResourcesDirectory   = `resources`
+
+	ExampleDataSourceFile = `data-source.tf`
+	ExampleResourceFile   = `resource.tf`
+)
+
+// ExamplesOptions represents configuration options for Examples.
+type ExamplesOptions struct {
+	*FileOptions
+
+	// DataSourceSchemas, if set, is used to determine orphaned data source
+	// example directories instead of the documentation files.
+	DataSourceSchemas map[string]*tfjson.Schema
+
+	Enable       bool
+	ProviderName string
+
+	// ResourceSchemas, if set, is used to determine orphaned resource example
+	// directories instead of the documentation files.
+	ResourceSchemas map[string]*tfjson.Schema
+}
+
+// ExamplesCheck verifies the terraform-plugin-docs examples directory matches
+// the data source and resource documentation.
+type ExamplesCheck struct {
+	Options *ExamplesOptions
+}
+
+func NewExamplesCheck(opts *ExamplesOptions) *ExamplesCheck {
+	check := &ExamplesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ExamplesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each documented data source and resource has an example file
+// (e.g. examples/resources/<name>/resource.tf) and that each example directory
+// matches a known data source or resource.
+func (check *ExamplesCheck) Run(directories map[string][]string) error {
+	if !check.Options.Enable {
+		return nil
+	}
+
+	documented := map[string]map[string]string{
+		ResourceTypeDataSource: make(map[string]string),
+		ResourceTypeResource:   make(map[string]string),
+	}
+
+	for directory, files := range directories {
+		// CDKTF and localized documentation use the same examples
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		resourceType := DirectoryDocumentationType(directory)
+
+		if _, ok := documented[resourceType]; !ok {
+			continue
+		}
+
+		for _, file := range files {
+			documented[resourceType][fileResourceName(check.Options.ProviderName, file)] = file
+		}
+	}
+
+	var result *multierror.Error
+
+	for _, resourceType := range []string{ResourceTypeDataSource, ResourceTypeResource} {
+		examplesDirectory, exampleFile := exampleLocation(resourceType)
+
+		for _, name := range sortedKeys(documented[resourceType]) {
+			exampleFilePath := path.Join(examplesDirectory, name, exampleFile)
+
+			check.Options.Log().Debug("Checking example file", "check", CheckIDExamples, "file", exampleFilePath, "resource_type", resourceType)
+
+			if _, err := check.Options.Stat(exampleFilePath); err != nil {
+				err := fmt.Errorf("%s: missing %s example file: %s", documented[resourceType][name], resourceType, exampleFilePath)
+				result = multierror.Append(result, NewFinding(CheckIDExamples, documented[resourceType][name], err))
+			}
+		}
+
+		entries, err := check.Options.ReadDir(examplesDirectory)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("error reading examples directory (%s): %w", examplesDirectory, err))
+			continue
+		}
+
+		schemas := check.Options.ResourceSchemas
+
+		if resourceType == ResourceTypeDataSource {
+			schemas = check.Options.DataSourceSchemas
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+
+			name := entry.Name()
+
+			if len(schemas) > 0 {
+				if _, ok := schemas[name]; ok {
+					continue
+				}
+			} else if _, ok := documented[resourceType][name]; ok {
+				continue
+			}
+
+			exampleDirectory := path.Join(examplesDirectory, name)
+			err := fmt.Errorf("orphaned %s example directory (%s), %s not found", resourceType, exampleDirectory, name)
+			result = multierror.Append(result, NewFinding(CheckIDExamples, exampleDirectory, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// exampleLocation returns the examples directory and example file name for
+// the resource type.
+func exampleLocation(resourceType string) (string, string) {
+	if resourceType == ResourceTypeDataSource {
+		return path.Join(ExamplesDirectory, ExamplesDataSourcesDirectory), ExampleDataSourceFile
+	}
+
+	return path.Join(ExamplesDirectory, ExamplesResourcesDirectory), ExampleResourceFile
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,66 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestExamplesCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Options     *ExamplesOptions
+		ExpectPaths []string
+	}{
+		{
+			Name: "disabled",
+			Options: &ExamplesOptions{
+				ProviderName: "test",
+			},
+		},
+		{
+			Name: "documentation",
+			Options: &ExamplesOptions{
+				Enable:       true,
+				ProviderName: "test",
+			},
+			ExpectPaths: []string{
+				"docs/resources/missing.md",
+				"examples/resources/test_orphan",
+			},
+		},
+		{
+			Name: "schemas",
+			Options: &ExamplesOptions{
+				DataSourceSchemas: map[string]*tfjson.Schema{
+					"test_thing": {},
+				},
+				Enable:       true,
+				ProviderName: "test",
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_missing": {},
+					"test_orphan":  {},
+					"test_thing":   {},
+				},
+			},
+			ExpectPaths: []string{
+				"docs/resources/missing.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/examples",
+			}
+
+			got := testFindingPaths(testCheckFindings(t, testCase.Options.BasePath, NewExamplesCheck(testCase.Options).Run, CheckIDExamples))
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
@@ -2,9 +2,13 @@ package check
 
 import (
 	"fmt"
-	"log"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
 )
 
 type FileCheck interface {
@@ -13,7 +17,88 @@ type FileCheck interface {
 }
 
 type FileOptions struct {
+	// AllowCRLFLineEndings allows files to consistently use CRLF line endings
+	// instead of LF, if RequireLFLineEndings is enabled.
+	AllowCRLFLineEndings bool
+
 	BasePath string
+
+	// Checks contains the checks selected by identifier. All checks are
+	// selected if nil.
+	Checks *CheckSelection
+
+	Directories map[string]*DirectoryOptions
+
+	// EnableFileNameCheck enables the file-name check, which verifies data
+	// source, list resource, and resource file names only contain lowercase
+	// letters, numbers, and underscores.
+	EnableFileNameCheck bool
+
+	// EnableFrontMatterPageTitleCheck enables the frontmatter-page-title check,
+	// which verifies action, data source, list resource, and resource YAML
+	// frontmatter page_title resource names match the file name.
+	EnableFrontMatterPageTitleCheck bool
+
+	// EnableTitleHeadingCheck enables the title-heading check, which verifies
+	// action, data source, list resource, and resource title headings agree
+	// with the YAML frontmatter page_title resource name and documentation type.
+	EnableTitleHeadingCheck bool
+
+	// Logger, if set, receives the diagnostic logging of checks, such as to
+	// capture, redirect, or silence it. Otherwise, the default hclog logger
+	// is used.
+	Logger hclog.Logger
+
+	// FS, if set, is the file system documentation is read from, such as
+	// embedded fixtures or an in-memory tree, with BasePath relative to its
+	// root. Otherwise, documentation is read from the operating system.
+	FS fs.FS
+
+	// Progress, if set, is called with the path of each documentation file
+	// before it is checked.
+	Progress func(path string)
+
+	// RequireFileExtension, if set, is the only valid documentation file
+	// extension of directory structures which support it (e.g. .html.markdown
+	// for legacy directories), unless overridden by directory options.
+	RequireFileExtension string
+
+	// RequireLFLineEndings enables the file-line-endings check, which verifies
+	// files use LF line endings.
+	RequireLFLineEndings bool
+
+	// Timings, if set, records the time of each check.
+	Timings *Timings
+
+	WarnLocalImages bool
+}
+
+// CheckSelected returns true if the check is selected.
+func (opts *FileOptions) CheckSelected(id string) bool {
+	if opts == nil {
+		return true
+	}
+
+	return opts.Checks.Selected(id)
+}
+
+// Log returns the logger of the options, otherwise the default hclog
+// logger.
+func (opts *FileOptions) Log() hclog.Logger {
+	if opts == nil || opts.Logger == nil {
+		return hclog.L()
+	}
+
+	return opts.Logger
+}
+
+// TimeCheck runs the check function, recording its time if Timings is set.
+func (opts *FileOptions) TimeCheck(id string, f func() error) error {
+	if opts == nil {
+		return f()
+	}
+
+	return opts.Timings.Check(id, f)
 }
 
 func (opts *FileOptions) FullPath(path string) string {
@@ -24,6 +109,145 @@ func (opts *FileOptions) FullPath(path string) string {
 	return path
 }
 
+// Glob returns the paths, relative to the base path, of files matching the
+// pattern from FS if set, otherwise the operating system.
+func (opts *FileOptions) Glob(pattern string) ([]string, error) {
+	if opts == nil || opts.FS == nil {
+		matches, err := filepath.Glob(opts.osPath(pattern))
+
+		if err != nil || opts == nil || opts.BasePath == "" {
+			return matches, err
+		}
+
+		for index, match := range matches {
+			matches[index], _ = filepath.Rel(opts.BasePath, match)
+		}
+
+		return matches, nil
+	}
+
+	matches, err := fs.Glob(opts.FS, opts.fsPath(pattern))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if basePath := opts.fsPath(""); basePath != "." {
+		for index, match := range matches {
+			matches[index] = strings.TrimPrefix(match, basePath+"/")
+		}
+	}
+
+	return matches, nil
+}
+
+// ReadFile returns the contents of the file, relative to the base path, from
+// FS if set, otherwise the operating system.
+func (opts *FileOptions) ReadFile(path string) ([]byte, error) {
+	if opts == nil || opts.FS == nil {
+		return os.ReadFile(opts.osPath(path))
+	}
+
+	return fs.ReadFile(opts.FS, opts.fsPath(path))
+}
+
+// ReadDir returns the entries of the directory, relative to the base path,
+// from FS if set, otherwise the operating system.
+func (opts *FileOptions) ReadDir(path string) ([]fs.DirEntry, error) {
+	if opts == nil || opts.FS == nil {
+		return os.ReadDir(opts.osPath(path))
+	}
+
+	return fs.ReadDir(opts.FS, opts.fsPath(path))
+}
+
+// Stat returns the file information of the file, relative to the base path,
+// from FS if set, otherwise the operating system.
+func (opts *FileOptions) Stat(path string) (fs.FileInfo, error) {
+	if opts == nil || opts.FS == nil {
+		return os.Stat(opts.osPath(path))
+	}
+
+	return fs.Stat(opts.FS, opts.fsPath(path))
+}
+
+// fsPath returns the slash separated path within FS of the path, relative to
+// the base path.
+func (opts *FileOptions) fsPath(name string) string {
+	return path.Clean(path.Join(filepath.ToSlash(opts.BasePath), filepath.ToSlash(name)))
+}
+
+// osPath returns the operating system path of the path, relative to the base
+// path.
+func (opts *FileOptions) osPath(path string) string {
+	if opts == nil {
+		return path
+	}
+
+	return opts.FullPath(path)
+}
+
+// ReportProgress calls the Progress function, if set.
+func (opts *FileOptions) ReportProgress(path string) {
+	if opts == nil || opts.Progress == nil {
+		return
+	}
+
+	opts.Progress(path)
+}
+
+// FileExtensionCheck verifies the path ends with a valid documentation file
+// extension for its directory. If RequireFileExtension is set and is one of
+// the default extensions, the path must use exactly that extension.
+func (opts *FileOptions) FileExtensionCheck(path string, defaultExtensions []string) error {
+	validExtensions := opts.ValidFileExtensions(path, defaultExtensions)
+
+	if err := FileExtensionCheck(path, validExtensions); err != nil {
+		return err
+	}
+
+	if len(validExtensions) != 1 || validExtensions[0] != opts.RequireFileExtension {
+		return nil
+	}
+
+	// Suffix matching alone allows longer extensions, such as .html.markdown
+	// when .markdown is required.
+	if extension := fileExtensionFrom(path, defaultExtensions); extension != opts.RequireFileExtension {
+		err := fmt.Errorf("file extension (%s) does not match required extension: %s", extension, opts.RequireFileExtension)
+
+		return WithSuggestion(err, fmt.Sprintf("rename %s to %s", path, filepath.Join(filepath.Dir(path), TrimFileExtension(path)+opts.RequireFileExtension)))
+	}
+
+	return nil
+}
+
+// ValidFileExtensions returns the configured documentation file extensions for
+// the directory of the path, otherwise the required extension if it is one of
+// the given default extensions, otherwise the given default extensions.
+func (opts *FileOptions) ValidFileExtensions(path string, defaultExtensions []string) []string {
+	if directoryOpts, ok := opts.Directories[filepath.ToSlash(filepath.Dir(path))]; ok && len(directoryOpts.FileExtensions) > 0 {
+		return directoryOpts.FileExtensions
+	}
+
+	if opts.RequireFileExtension != "" && containsString(defaultExtensions, opts.RequireFileExtension) {
+		return []string{opts.RequireFileExtension}
+	}
+
+	return defaultExtensions
+}
+
+// FileSizeCheck verifies that documentation file, relative to the base path,
+// is below the Terraform Registry storage limit.
+func (opts *FileOptions) FileSizeCheck(path string) error {
+	fi, err := opts.Stat(path)
+
+	if err != nil {
+		return err
+	}
+
+	return fileSizeCheck(opts.Log(), path, fi)
+}
+
 // FileSizeCheck verifies that documentation file is below the Terraform Registry storage limit.
 func FileSizeCheck(fullpath string) error {
 	fi, err := os.Stat(fullpath)
@@ -32,7 +256,11 @@ func FileSizeCheck(fullpath string) error {
 		return err
 	}
 
-	log.Printf("[DEBUG] File %s size: %d (limit: %d)", fullpath, fi.Size(), RegistryMaximumSizeOfFile)
+	return fileSizeCheck(hclog.L(), fullpath, fi)
+}
+
+func fileSizeCheck(logger hclog.Logger, path string, fi fs.FileInfo) error {
+	logger.Debug("Found file size", "check", CheckIDFileSize, "file", path, "size", fi.Size(), "limit", RegistryMaximumSizeOfFile)
 	if fi.Size() >= int64(RegistryMaximumSizeOfFile) {
 		return fmt.Errorf("exceeded maximum (%d) size of documentation file for Terraform Registry: %d", RegistryMaximumSizeOfFile, fi.Size())
 	}
@@ -0,0 +1,66 @@
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	// ByteOrderMarkUtf8 is the UTF-8 encoded byte order mark (BOM).
+	ByteOrderMarkUtf8 = []byte{0xEF, 0xBB, 0xBF}
+)
+
+// FileEncodingCheck verifies that documentation file contents are UTF-8 encoded without a byte order mark.
+// The Terraform Registry renderer does not handle other encodings or a leading BOM.
+func FileEncodingCheck(content []byte) error {
+	if bytes.HasPrefix(content, ByteOrderMarkUtf8) {
+		return WithSuggestion(fmt.Errorf("file starts with UTF-8 byte order mark (BOM), which should be removed"), "save the file as UTF-8 without BOM")
+	}
+
+	if !utf8.Valid(content) {
+		return WithSuggestion(fmt.Errorf("file contains invalid UTF-8 at byte offset %d", invalidUtf8Offset(content)), "save the file with UTF-8 encoding")
+	}
+
+	return nil
+}
+
+// FileLineEndingsCheck verifies that documentation file contents consistently use LF line endings.
+// If allowCRLF is enabled, files may instead consistently use CRLF line endings.
+func FileLineEndingsCheck(content []byte, allowCRLF bool) error {
+	crlfCount := bytes.Count(content, []byte("\r\n"))
+	crCount := bytes.Count(content, []byte("\r"))
+	lfCount := bytes.Count(content, []byte("\n"))
+
+	if crCount > crlfCount {
+		return WithSuggestion(fmt.Errorf("file contains carriage return (CR) line endings, expected LF"), "convert the line endings to LF")
+	}
+
+	if crlfCount == 0 {
+		return nil
+	}
+
+	if !allowCRLF {
+		return WithSuggestion(fmt.Errorf("file contains %d CRLF line endings, expected LF", crlfCount), "convert the line endings to LF (e.g. with dos2unix) or use the -allow-crlf-line-endings flag")
+	}
+
+	if lfCount > crlfCount {
+		return WithSuggestion(fmt.Errorf("file contains mixed CRLF and LF line endings"), "convert the line endings to consistently use LF")
+	}
+
+	return nil
+}
+
+func invalidUtf8Offset(content []byte) int {
+	for offset := 0; offset < len(content); {
+		r, size := utf8.DecodeRune(content[offset:])
+
+		if r == utf8.RuneError && size == 1 {
+			return offset
+		}
+
+		offset += size
+	}
+
+	return -1
+}
@@ -0,0 +1,119 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestFileEncodingCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Content     []byte
+		ExpectError bool
+	}{
+		{
+			Name:    "empty",
+			Content: []byte{},
+		},
+		{
+			Name:    "valid ASCII",
+			Content: []byte("# Resource: test_thing\n"),
+		},
+		{
+			Name:    "valid UTF-8",
+			Content: []byte("# Resource: test_thing\n\nCafé ✓\n"),
+		},
+		{
+			Name:        "byte order mark",
+			Content:     append([]byte{0xEF, 0xBB, 0xBF}, []byte("# Resource: test_thing\n")...),
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid UTF-8",
+			Content:     []byte("# Resource: test_thing\n\nCaf\xe9\n"),
+			ExpectError: true,
+		},
+		{
+			Name:        "UTF-16",
+			Content:     []byte{0xFF, 0xFE, '#', 0x00, ' ', 0x00},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FileEncodingCheck(testCase.Content)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestFileLineEndingsCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Content     string
+		AllowCRLF   bool
+		ExpectError bool
+	}{
+		{
+			Name:    "no line endings",
+			Content: "# Resource: test_thing",
+		},
+		{
+			Name:    "LF",
+			Content: "# Resource: test_thing\n\nDescription\n",
+		},
+		{
+			Name:        "CRLF",
+			Content:     "# Resource: test_thing\r\n\r\nDescription\r\n",
+			ExpectError: true,
+		},
+		{
+			Name:      "CRLF allowed",
+			Content:   "# Resource: test_thing\r\n\r\nDescription\r\n",
+			AllowCRLF: true,
+		},
+		{
+			Name:        "mixed",
+			Content:     "# Resource: test_thing\r\n\nDescription\n",
+			ExpectError: true,
+		},
+		{
+			Name:        "mixed with CRLF allowed",
+			Content:     "# Resource: test_thing\r\n\nDescription\n",
+			AllowCRLF:   true,
+			ExpectError: true,
+		},
+		{
+			Name:        "CR",
+			Content:     "# Resource: test_thing\r\rDescription\r",
+			ExpectError: true,
+		},
+		{
+			Name:        "CR with CRLF allowed",
+			Content:     "# Resource: test_thing\r\rDescription\r",
+			AllowCRLF:   true,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FileLineEndingsCheck([]byte(testCase.Content), testCase.AllowCRLF)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
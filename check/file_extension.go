@@ -24,20 +24,26 @@ var ValidRegistryFileExtensions = []string{
 	FileExtensionMd,
 }
 
-func LegacyFileExtensionCheck(path string) error {
-	if !FilePathEndsWithExtensionFrom(path, ValidLegacyFileExtensions) {
-		return fmt.Errorf("file does not end with a valid extension, valid extensions: %v", ValidLegacyFileExtensions)
+func FileExtensionCheck(path string, validExtensions []string) error {
+	if !FilePathEndsWithExtensionFrom(path, validExtensions) {
+		err := fmt.Errorf("file does not end with a valid extension, valid extensions: %v", validExtensions)
+
+		if len(validExtensions) == 0 {
+			return err
+		}
+
+		return WithSuggestion(err, fmt.Sprintf("rename %s to %s", path, filepath.Join(filepath.Dir(path), TrimFileExtension(path)+validExtensions[0])))
 	}
 
 	return nil
 }
 
-func RegistryFileExtensionCheck(path string) error {
-	if !FilePathEndsWithExtensionFrom(path, ValidRegistryFileExtensions) {
-		return fmt.Errorf("file does not end with a valid extension, valid extensions: %v", ValidRegistryFileExtensions)
-	}
+func LegacyFileExtensionCheck(path string) error {
+	return FileExtensionCheck(path, ValidLegacyFileExtensions)
+}
 
-	return nil
+func RegistryFileExtensionCheck(path string) error {
+	return FileExtensionCheck(path, ValidRegistryFileExtensions)
 }
 
 func FilePathEndsWithExtensionFrom(path string, validExtensions []string) bool {
@@ -50,6 +56,20 @@ func FilePathEndsWithExtensionFrom(path string, validExtensions []string) bool {
 	return false
 }
 
+// fileExtensionFrom returns the longest of the extensions which the path ends
+// with, otherwise an empty string.
+func fileExtensionFrom(path string, extensions []string) string {
+	var result string
+
+	for _, extension := range extensions {
+		if strings.HasSuffix(path, extension) && len(extension) > len(result) {
+			result = extension
+		}
+	}
+
+	return result
+}
+
 // TrimFileExtension removes file extensions including those with multiple periods.
 func TrimFileExtension(path string) string {
 	filename := filepath.Base(path)
@@ -0,0 +1,101 @@
+package check
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// ImageReferencesCheck verifies that local images embedded in documentation exist.
+// Remote images (e.g. https:// URLs) are not checked.
+func ImageReferencesCheck(path string, content []byte, opts *FileOptions) error {
+	if opts == nil {
+		opts = &FileOptions{}
+	}
+
+	var result *multierror.Error
+
+	for _, destination := range imageDestinations(content) {
+		if !isLocalReference(destination) {
+			continue
+		}
+
+		if opts.WarnLocalImages {
+			opts.Log().Warn("Local image is not served by the Terraform Registry and will render as broken", "check", CheckIDImageReferences, "file", path, "image", destination)
+		}
+
+		imagePath, err := localReferencePath(path, destination, opts)
+
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid image path (%s): %w", destination, err))
+			continue
+		}
+
+		if _, err := opts.Stat(imagePath); err != nil {
+			result = multierror.Append(result, fmt.Errorf("image (%s) not found", destination))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// imageDestinations returns all Markdown image destinations in the source.
+func imageDestinations(source []byte) []string {
+	var destinations []string
+
+	document, _ := markdown.Parse(source)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if image, ok := node.(*ast.Image); ok {
+			destinations = append(destinations, string(image.Destination))
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return destinations
+}
+
+// isLocalReference returns true if the link or image destination does not
+// reference a remote location or only an anchor within the same page.
+func isLocalReference(destination string) bool {
+	if destination == "" || strings.HasPrefix(destination, "#") || strings.HasPrefix(destination, "//") {
+		return false
+	}
+
+	u, err := url.Parse(destination)
+
+	if err != nil {
+		return true
+	}
+
+	return u.Scheme == "" && u.Host == ""
+}
+
+// localReferencePath returns the path, relative to the base path, of a local
+// link or image destination. Relative destinations are resolved against the directory of the
+// documentation file and absolute destinations against the base path.
+func localReferencePath(path string, destination string, opts *FileOptions) (string, error) {
+	u, err := url.Parse(destination)
+
+	if err != nil {
+		return "", err
+	}
+
+	referencePath := filepath.FromSlash(u.Path)
+
+	if strings.HasPrefix(u.Path, "/") {
+		return strings.TrimPrefix(referencePath, string(filepath.Separator)), nil
+	}
+
+	return filepath.Join(filepath.Dir(path), referencePath), nil
+}
@@ -0,0 +1,106 @@
+package check
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestImageReferencesCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		BasePath    string
+		Path        string
+		ExpectError bool
+	}{
+		{
+			Name:     "existing images",
+			BasePath: "testdata/images",
+			Path:     "docs/guides/existing.md",
+		},
+		{
+			Name:        "missing image",
+			BasePath:    "testdata/images",
+			Path:        "docs/guides/missing.md",
+			ExpectError: true,
+		},
+		{
+			Name:     "remote images",
+			BasePath: "testdata/images",
+			Path:     "docs/guides/remote.md",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &FileOptions{
+				BasePath: testCase.BasePath,
+			}
+
+			content, err := os.ReadFile(filepath.Join(testCase.BasePath, testCase.Path))
+
+			if err != nil {
+				t.Fatalf("error reading file: %s", err)
+			}
+
+			got := ImageReferencesCheck(testCase.Path, content, opts)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestIsLocalReference(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Destination string
+		Expect      bool
+	}{
+		{
+			Name:        "empty",
+			Destination: "",
+			Expect:      false,
+		},
+		{
+			Name:        "anchor",
+			Destination: "#example-usage",
+			Expect:      false,
+		},
+		{
+			Name:        "https URL",
+			Destination: "https://example.com/image.png",
+			Expect:      false,
+		},
+		{
+			Name:        "protocol relative URL",
+			Destination: "//example.com/image.png",
+			Expect:      false,
+		},
+		{
+			Name:        "relative path",
+			Destination: "./images/diagram.png",
+			Expect:      true,
+		},
+		{
+			Name:        "absolute path",
+			Destination: "/docs/images/diagram.png",
+			Expect:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := isLocalReference(testCase.Destination)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %t, got %t", testCase.Expect, got)
+			}
+		})
+	}
+}
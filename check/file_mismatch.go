@@ -2,8 +2,8 @@ package check
 
 import (
 	"fmt"
-	"log"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	tfjson "github.com/hashicorp/terraform-json"
@@ -12,12 +12,25 @@ import (
 type FileMismatchOptions struct {
 	*FileOptions
 
+	// Files maps names to documentation file names, without file extension,
+	// which differ from the name without provider prefix, such as aliases or
+	// a combined page documenting multiple closely related resources.
+	Files map[string]string
+
 	IgnoreFileMismatch []string
 
 	IgnoreFileMissing []string
 
 	ProviderName string
 
+	// Removed maps removed names to the provider version which removed them.
+	// Documentation files for removed names are not extraneous.
+	Removed map[string]string
+
+	// Renamed maps previous names to new names. Documentation files for
+	// previous names are not extraneous.
+	Renamed map[string]string
+
 	ResourceType string
 
 	Schemas map[string]*tfjson.Schema
@@ -25,6 +38,10 @@ type FileMismatchOptions struct {
 
 type FileMismatchCheck struct {
 	Options *FileMismatchOptions
+
+	// IgnoredFindings is the number of findings ignored by the
+	// IgnoreFileMismatch and IgnoreFileMissing options during Run.
+	IgnoredFindings int
 }
 
 func NewFileMismatchCheck(opts *FileMismatchOptions) *FileMismatchCheck {
@@ -45,57 +62,124 @@ func NewFileMismatchCheck(opts *FileMismatchOptions) *FileMismatchCheck {
 
 func (check *FileMismatchCheck) Run(files []string) error {
 	if len(files) == 0 {
-		log.Printf("[DEBUG] Skipping %s file mismatch checks due to missing file list", check.Options.ResourceType)
+		check.Options.Log().Debug("Skipping file mismatch checks due to missing file list", "check", CheckIDFileMismatch, "resource_type", check.Options.ResourceType)
 		return nil
 	}
 
 	if len(check.Options.Schemas) == 0 {
-		log.Printf("[DEBUG] Skipping %s file mismatch checks due to missing schemas", check.Options.ResourceType)
+		check.Options.Log().Debug("Skipping file mismatch checks due to missing schemas", "check", CheckIDFileMismatch, "resource_type", check.Options.ResourceType)
 		return nil
 	}
 
 	var extraFiles []string
 	var missingFiles []string
 
-	for _, file := range files {
-		if fileHasResource(check.Options.Schemas, check.Options.ProviderName, file) {
-			continue
+	for resourceName := range check.Options.Files {
+		if _, ok := check.Options.Schemas[resourceName]; !ok {
+			check.Options.Log().Warn("Mapped documentation file resource not found in providers schema", "check", CheckIDFileMismatch, "resource_type", check.Options.ResourceType, "resource", resourceName)
 		}
+	}
 
-		if check.IgnoreFileMismatch(file) {
-			continue
+	for resourceName := range check.Options.Removed {
+		if _, ok := check.Options.Schemas[resourceName]; ok {
+			check.Options.Log().Warn("Removed resource found in providers schema", "check", CheckIDFileMismatch, "resource_type", check.Options.ResourceType, "resource", resourceName)
 		}
-
-		extraFiles = append(extraFiles, file)
 	}
 
-	for _, resourceName := range resourceNames(check.Options.Schemas) {
-		if resourceHasFile(files, check.Options.ProviderName, resourceName) {
-			continue
-		}
+	if check.Options.CheckSelected(CheckIDFileMismatch) {
+		for _, file := range check.ExtraFiles(files) {
+			if check.IgnoreFileMismatch(file) {
+				check.IgnoredFindings++
+				continue
+			}
 
-		if check.IgnoreFileMissing(resourceName) {
-			continue
+			extraFiles = append(extraFiles, file)
 		}
+	}
+
+	if check.Options.CheckSelected(CheckIDFileMissing) {
+		for _, resourceName := range check.MissingResources(files) {
+			if check.IgnoreFileMissing(resourceName) {
+				check.IgnoredFindings++
+				continue
+			}
 
-		missingFiles = append(missingFiles, resourceName)
+			missingFiles = append(missingFiles, resourceName)
+		}
 	}
 
 	var result *multierror.Error
 
 	for _, extraFile := range extraFiles {
 		err := fmt.Errorf("matching %s for documentation file (%s) not found, file is extraneous or incorrectly named", check.Options.ResourceType, extraFile)
-		result = multierror.Append(result, err)
+		err = WithSuggestion(err, fmt.Sprintf("rename the file to match a %s name in the providers schema, or remove it if the %s no longer exists", check.Options.ResourceType, check.Options.ResourceType))
+		result = multierror.Append(result, NewFinding(CheckIDFileMismatch, extraFile, err))
 	}
 
 	for _, missingFile := range missingFiles {
 		err := fmt.Errorf("missing documentation file for %s: %s", check.Options.ResourceType, missingFile)
-		result = multierror.Append(result, err)
+		err = WithSuggestion(err, fmt.Sprintf("add a documentation file, e.g. %s%s", resourceFileName(check.Options.ProviderName, missingFile), FileExtensionMd))
+		result = multierror.Append(result, NewFinding(CheckIDFileMissing, "", err))
 	}
 
 	return result.ErrorOrNil()
 }
 
+// ExtraFiles returns the documentation files without a matching, mapped,
+// removed, or renamed name, regardless of the ignore options.
+func (check *FileMismatchCheck) ExtraFiles(files []string) []string {
+	var result []string
+
+	for _, file := range files {
+		if fileHasResource(check.Options.Schemas, check.Options.ProviderName, file) {
+			continue
+		}
+
+		if fileHasMappedResource(check.Options.Schemas, check.Options.Files, file) {
+			continue
+		}
+
+		if _, ok := check.Options.Removed[fileResourceName(check.Options.ProviderName, file)]; ok {
+			continue
+		}
+
+		if _, ok := check.Options.Renamed[fileResourceName(check.Options.ProviderName, file)]; ok {
+			continue
+		}
+
+		result = append(result, file)
+	}
+
+	return result
+}
+
+// MissingResources returns the sorted names in the schemas without a
+// documentation file, regardless of the ignore options.
+func (check *FileMismatchCheck) MissingResources(files []string) []string {
+	var result []string
+
+	for _, resourceName := range resourceNames(check.Options.Schemas) {
+		if resourceHasFile(files, check.Options.ProviderName, resourceName) {
+			continue
+		}
+
+		if resourceHasMappedFile(files, check.Options.Files, resourceName) {
+			continue
+		}
+
+		result = append(result, resourceName)
+	}
+
+	return result
+}
+
+// FileResourceName returns the name of the documentation file, which is the
+// file name without extension prefixed by the provider name, as expected by
+// the IgnoreFileMismatch option.
+func (check *FileMismatchCheck) FileResourceName(file string) string {
+	return fileResourceName(check.Options.ProviderName, file)
+}
+
 func (check *FileMismatchCheck) IgnoreFileMismatch(file string) bool {
 	for _, ignoreResourceName := range check.Options.IgnoreFileMismatch {
 		if ignoreResourceName == fileResourceName(check.Options.ProviderName, file) {
@@ -124,12 +208,36 @@ func fileHasResource(schemaResources map[string]*tfjson.Schema, providerName, fi
 	return false
 }
 
+// fileHasMappedResource returns true if the file is the mapped documentation
+// file of any resource in the schemas.
+func fileHasMappedResource(schemaResources map[string]*tfjson.Schema, mappedFiles map[string]string, file string) bool {
+	fileName := TrimFileExtension(file)
+
+	for resourceName, mappedFile := range mappedFiles {
+		if mappedFile != fileName {
+			continue
+		}
+
+		if _, ok := schemaResources[resourceName]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 func fileResourceName(providerName, fileName string) string {
 	resourceSuffix := TrimFileExtension(fileName)
 
 	return fmt.Sprintf("%s_%s", providerName, resourceSuffix)
 }
 
+// resourceFileName returns the documentation file name, without extension,
+// of the resource, which omits the provider name prefix.
+func resourceFileName(providerName, resourceName string) string {
+	return strings.TrimPrefix(resourceName, providerName+"_")
+}
+
 func resourceHasFile(files []string, providerName, resourceName string) bool {
 	var found bool
 
@@ -143,6 +251,24 @@ func resourceHasFile(files []string, providerName, resourceName string) bool {
 	return found
 }
 
+// resourceHasMappedFile returns true if the resource has a mapped
+// documentation file in the files.
+func resourceHasMappedFile(files []string, mappedFiles map[string]string, resourceName string) bool {
+	mappedFile, ok := mappedFiles[resourceName]
+
+	if !ok {
+		return false
+	}
+
+	for _, file := range files {
+		if TrimFileExtension(file) == mappedFile {
+			return true
+		}
+	}
+
+	return false
+}
+
 func resourceNames(resources map[string]*tfjson.Schema) []string {
 	names := make([]string, 0, len(resources))
 
@@ -88,10 +88,11 @@ func TestFileResourceName(t *testing.T) {
 
 func TestFileMismatchCheck(t *testing.T) {
 	testCases := []struct {
-		Name        string
-		Files       []string
-		Options     *FileMismatchOptions
-		ExpectError bool
+		Name                  string
+		Files                 []string
+		Options               *FileMismatchOptions
+		ExpectError           bool
+		ExpectIgnoredFindings int
 	}{
 		{
 			Name: "all found",
@@ -123,6 +124,59 @@ func TestFileMismatchCheck(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		{
+			Name: "mapped files",
+			Files: []string{
+				"combined.md",
+				"resource1.md",
+			},
+			Options: &FileMismatchOptions{
+				Files: map[string]string{
+					"test_resource2": "combined",
+					"test_resource3": "combined",
+				},
+				ProviderName: "test",
+				Schemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+					"test_resource2": {},
+					"test_resource3": {},
+				},
+			},
+		},
+		{
+			Name: "mapped file missing",
+			Files: []string{
+				"resource1.md",
+			},
+			Options: &FileMismatchOptions{
+				Files: map[string]string{
+					"test_resource2": "alias",
+				},
+				ProviderName: "test",
+				Schemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+					"test_resource2": {},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "mapped file resource not in schema",
+			Files: []string{
+				"alias.md",
+				"resource1.md",
+			},
+			Options: &FileMismatchOptions{
+				Files: map[string]string{
+					"test_resource2": "alias",
+				},
+				ProviderName: "test",
+				Schemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+				},
+			},
+			ExpectError: true,
+		},
 		{
 			Name: "ignore extra file",
 			Files: []string{
@@ -138,6 +192,63 @@ func TestFileMismatchCheck(t *testing.T) {
 					"test_resource2": {},
 				},
 			},
+			ExpectIgnoredFindings: 1,
+		},
+		{
+			Name: "removed extra file",
+			Files: []string{
+				"resource1.md",
+				"resource2.md",
+				"resource3.md",
+			},
+			Options: &FileMismatchOptions{
+				ProviderName: "test",
+				Removed: map[string]string{
+					"test_resource3": "2.0.0",
+				},
+				Schemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+					"test_resource2": {},
+				},
+			},
+		},
+		{
+			Name: "renamed extra file",
+			Files: []string{
+				"resource1.md",
+				"resource2.md",
+				"resource3.md",
+			},
+			Options: &FileMismatchOptions{
+				ProviderName: "test",
+				Renamed: map[string]string{
+					"test_resource3": "test_resource2",
+				},
+				Schemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+					"test_resource2": {},
+				},
+			},
+		},
+		{
+			Name: "disabled extra file check",
+			Files: []string{
+				"resource1.md",
+				"resource2.md",
+				"resource3.md",
+			},
+			Options: &FileMismatchOptions{
+				FileOptions: &FileOptions{
+					Checks: &CheckSelection{
+						Disable: []string{CheckIDFileMismatch},
+					},
+				},
+				ProviderName: "test",
+				Schemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+					"test_resource2": {},
+				},
+			},
 		},
 		{
 			Name: "missing file",
@@ -166,6 +277,25 @@ func TestFileMismatchCheck(t *testing.T) {
 					"test_resource2": {},
 				},
 			},
+			ExpectIgnoredFindings: 1,
+		},
+		{
+			Name: "disabled missing file check",
+			Files: []string{
+				"resource1.md",
+			},
+			Options: &FileMismatchOptions{
+				FileOptions: &FileOptions{
+					Checks: &CheckSelection{
+						Enable: []string{CheckIDFileMismatch},
+					},
+				},
+				ProviderName: "test",
+				Schemas: map[string]*tfjson.Schema{
+					"test_resource1": {},
+					"test_resource2": {},
+				},
+			},
 		},
 		{
 			Name: "no files",
@@ -190,7 +320,8 @@ func TestFileMismatchCheck(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			got := NewFileMismatchCheck(testCase.Options).Run(testCase.Files)
+			fileMismatchCheck := NewFileMismatchCheck(testCase.Options)
+			got := fileMismatchCheck.Run(testCase.Files)
 
 			if got == nil && testCase.ExpectError {
 				t.Errorf("expected error, got no error")
@@ -199,10 +330,52 @@ func TestFileMismatchCheck(t *testing.T) {
 			if got != nil && !testCase.ExpectError {
 				t.Errorf("expected no error, got error: %s", got)
 			}
+
+			if fileMismatchCheck.IgnoredFindings != testCase.ExpectIgnoredFindings {
+				t.Errorf("expected %d ignored findings, got %d", testCase.ExpectIgnoredFindings, fileMismatchCheck.IgnoredFindings)
+			}
 		})
 	}
 }
 
+func TestFileMismatchCheckExtraFilesMissingResources(t *testing.T) {
+	check := NewFileMismatchCheck(&FileMismatchOptions{
+		Files: map[string]string{
+			"test_resource3": "combined",
+		},
+		IgnoreFileMismatch: []string{"test_extra"},
+		IgnoreFileMissing:  []string{"test_resource4"},
+		ProviderName:       "test",
+		Removed: map[string]string{
+			"test_removed": "2.0.0",
+		},
+		Schemas: map[string]*tfjson.Schema{
+			"test_resource1": {},
+			"test_resource2": {},
+			"test_resource3": {},
+			"test_resource4": {},
+		},
+	})
+	files := []string{
+		"combined.md",
+		"extra.md",
+		"removed.md",
+		"resource1.md",
+	}
+
+	if got, expect := check.ExtraFiles(files), []string{"extra.md"}; !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected extra files %v, got: %v", expect, got)
+	}
+
+	if got, expect := check.MissingResources(files), []string{"test_resource2", "test_resource4"}; !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected missing resources %v, got: %v", expect, got)
+	}
+
+	if got, expect := check.FileResourceName("extra.md"), "test_extra"; got != expect {
+		t.Errorf("expected file resource name %q, got: %q", expect, got)
+	}
+}
+
 func TestResourceHasFile(t *testing.T) {
 	testCases := []struct {
 		Name         string
@@ -0,0 +1,57 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// fileNameRegexp matches valid data source and resource documentation file
+	// names without the file extension, which are the data source or resource
+	// name without the provider name prefix.
+	fileNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+	// fileNameCamelCaseRegexp matches the word boundaries of camelCase names.
+	fileNameCamelCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+	// fileNameCopySuffixRegexp matches the suffixes of copied files, e.g.
+	// instance (1) or instance copy 2.
+	fileNameCopySuffixRegexp = regexp.MustCompile(`(?i)(\s*\(\d+\)|[\s_-]+copy(\s*\d+)?)$`)
+
+	// fileNameInvalidRegexp matches runs of invalid file name characters.
+	fileNameInvalidRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+)
+
+// FileNameCheck verifies the data source or resource documentation file name,
+// without the file extension, contains only lowercase letters, numbers,
+// and underscores. Copies (e.g. instance (1).md), camelCase names, and stray
+// suffixes (e.g. instance.old.md) cannot always be attributed to a data source
+// or resource by the file mismatch check.
+func FileNameCheck(path string) error {
+	filename := filepath.Base(path)
+	name := strings.TrimSuffix(filename, fileExtensionFrom(filename, ValidLegacyFileExtensions))
+
+	if fileNameRegexp.MatchString(name) {
+		return nil
+	}
+
+	err := fmt.Errorf("file name (%s) should only contain lowercase letters, numbers, and underscores", name)
+
+	if suggestion := fileNameSuggestion(name); suggestion != "" {
+		return WithSuggestion(err, fmt.Sprintf("rename %s to %s", path, filepath.Join(filepath.Dir(path), suggestion+strings.TrimPrefix(filename, name))))
+	}
+
+	return err
+}
+
+// fileNameSuggestion returns the valid file name for the invalid file name,
+// without copy suffixes and with camelCase words and other characters
+// separated by underscores, e.g. ec2_instance for ec2Instance (1).
+func fileNameSuggestion(name string) string {
+	name = fileNameCopySuffixRegexp.ReplaceAllString(name, "")
+	name = strings.ToLower(fileNameCamelCaseRegexp.ReplaceAllString(name, "${1}_${2}"))
+
+	return strings.Trim(fileNameInvalidRegexp.ReplaceAllString(name, "_"), "_")
+}
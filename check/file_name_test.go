@@ -0,0 +1,76 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestFileNameCheck(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Path             string
+		ExpectError      bool
+		ExpectSuggestion string
+	}{
+		{
+			Name: "valid registry file",
+			Path: "docs/resources/thing_2.md",
+		},
+		{
+			Name: "valid legacy file",
+			Path: "website/docs/r/thing.html.markdown",
+		},
+		{
+			Name:             "copy",
+			Path:             "docs/resources/thing (1).md",
+			ExpectError:      true,
+			ExpectSuggestion: "rename docs/resources/thing (1).md to docs/resources/thing.md",
+		},
+		{
+			Name:             "copy suffix",
+			Path:             "website/docs/d/thing copy.html.markdown",
+			ExpectError:      true,
+			ExpectSuggestion: "rename website/docs/d/thing copy.html.markdown to website/docs/d/thing.html.markdown",
+		},
+		{
+			Name:             "camelCase",
+			Path:             "docs/data-sources/ec2Instance.md",
+			ExpectError:      true,
+			ExpectSuggestion: "rename docs/data-sources/ec2Instance.md to docs/data-sources/ec2_instance.md",
+		},
+		{
+			Name:             "stray suffix",
+			Path:             "docs/resources/thing.old.md",
+			ExpectError:      true,
+			ExpectSuggestion: "rename docs/resources/thing.old.md to docs/resources/thing_old.md",
+		},
+		{
+			Name:             "dashes",
+			Path:             "docs/resources/other-thing.md",
+			ExpectError:      true,
+			ExpectSuggestion: "rename docs/resources/other-thing.md to docs/resources/other_thing.md",
+		},
+		{
+			Name:        "no valid characters",
+			Path:        "docs/resources/(1).md",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FileNameCheck(testCase.Path)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if suggestion := ErrorSuggestion(got); suggestion != testCase.ExpectSuggestion {
+				t.Errorf("expected suggestion %q, got %q", testCase.ExpectSuggestion, suggestion)
+			}
+		})
+	}
+}
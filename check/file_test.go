@@ -1,8 +1,12 @@
 package check
 
 import (
+	"errors"
+	"io/fs"
 	"os"
+	"reflect"
 	"testing"
+	"testing/fstest"
 )
 
 func TestFileSizeCheck(t *testing.T) {
@@ -88,3 +92,211 @@ func TestFullPath(t *testing.T) {
 		})
 	}
 }
+
+func TestFileOptionsFS(t *testing.T) {
+	fileOpts := &FileOptions{
+		BasePath: "provider",
+		FS: fstest.MapFS{
+			"provider/docs/index.md":           {Data: []byte("# Provider\n")},
+			"provider/docs/resources/thing.md": {Data: []byte("# Resource\n")},
+			"provider/website/test.erb":        {Data: []byte("<ul></ul>\n")},
+		},
+	}
+
+	content, err := fileOpts.ReadFile("docs/index.md")
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if got, want := string(content), "# Provider\n"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	if _, err := fileOpts.ReadFile("docs/missing.md"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected not exist error, got: %v", err)
+	}
+
+	fi, err := fileOpts.Stat("docs/resources/thing.md")
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if got, want := fi.Size(), int64(len("# Resource\n")); got != want {
+		t.Errorf("expected size %d, got %d", want, got)
+	}
+
+	entries, err := fileOpts.ReadDir("docs")
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	var names []string
+
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	if want := []string{"index.md", "resources"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("expected %v, got %v", want, names)
+	}
+
+	matches, err := fileOpts.Glob(LegacyNavigationGlobPattern)
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if want := []string{"website/test.erb"}; !reflect.DeepEqual(matches, want) {
+		t.Errorf("expected %v, got %v", want, matches)
+	}
+
+	if err := fileOpts.FileSizeCheck("docs/index.md"); err != nil {
+		t.Errorf("expected no error, got error: %s", err)
+	}
+}
+
+func TestValidFileExtensions(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		FileOptions *FileOptions
+		Path        string
+		Expect      []string
+	}{
+		{
+			Name:        "without directory options",
+			FileOptions: &FileOptions{},
+			Path:        "docs/guides/guide.md",
+			Expect:      ValidRegistryFileExtensions,
+		},
+		{
+			Name: "with other directory options",
+			FileOptions: &FileOptions{
+				Directories: map[string]*DirectoryOptions{
+					"docs/resources": {
+						FileExtensions: []string{".markdown"},
+					},
+				},
+			},
+			Path:   "docs/guides/guide.md",
+			Expect: ValidRegistryFileExtensions,
+		},
+		{
+			Name: "with directory options",
+			FileOptions: &FileOptions{
+				Directories: map[string]*DirectoryOptions{
+					"docs/guides": {
+						FileExtensions: []string{".markdown"},
+					},
+				},
+			},
+			Path:   "docs/guides/guide.md",
+			Expect: []string{".markdown"},
+		},
+		{
+			Name: "with directory options without file extensions",
+			FileOptions: &FileOptions{
+				Directories: map[string]*DirectoryOptions{
+					"docs/guides": {
+						OtherFileExtensions: []string{".png"},
+					},
+				},
+			},
+			Path:   "docs/guides/guide.md",
+			Expect: ValidRegistryFileExtensions,
+		},
+		{
+			Name: "with required file extension",
+			FileOptions: &FileOptions{
+				RequireFileExtension: FileExtensionMd,
+			},
+			Path:   "docs/guides/guide.md",
+			Expect: []string{FileExtensionMd},
+		},
+		{
+			Name: "with required file extension not valid",
+			FileOptions: &FileOptions{
+				RequireFileExtension: FileExtensionHtmlMarkdown,
+			},
+			Path:   "docs/guides/guide.md",
+			Expect: ValidRegistryFileExtensions,
+		},
+		{
+			Name: "with required file extension and directory options",
+			FileOptions: &FileOptions{
+				Directories: map[string]*DirectoryOptions{
+					"docs/guides": {
+						FileExtensions: []string{".markdown"},
+					},
+				},
+				RequireFileExtension: FileExtensionMd,
+			},
+			Path:   "docs/guides/guide.md",
+			Expect: []string{".markdown"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.FileOptions.ValidFileExtensions(testCase.Path, ValidRegistryFileExtensions)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestFileOptionsFileExtensionCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		FileOptions *FileOptions
+		Path        string
+		ExpectError bool
+	}{
+		{
+			Name:        "without required file extension",
+			FileOptions: &FileOptions{},
+			Path:        "website/docs/r/thing.markdown",
+		},
+		{
+			Name: "required file extension",
+			FileOptions: &FileOptions{
+				RequireFileExtension: FileExtensionHtmlMarkdown,
+			},
+			Path: "website/docs/r/thing.html.markdown",
+		},
+		{
+			Name: "required file extension not matching",
+			FileOptions: &FileOptions{
+				RequireFileExtension: FileExtensionHtmlMarkdown,
+			},
+			Path:        "website/docs/r/thing.md",
+			ExpectError: true,
+		},
+		{
+			Name: "required file extension suffix of longer extension",
+			FileOptions: &FileOptions{
+				RequireFileExtension: FileExtensionMarkdown,
+			},
+			Path:        "website/docs/r/thing.html.markdown",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.FileOptions.FileExtensionCheck(testCase.Path, ValidLegacyFileExtensions)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
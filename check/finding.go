@@ -0,0 +1,191 @@
+package check
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Finding is a documentation issue reported by a check. Errors returned while
+// checking which are not findings are operational errors, such as unreadable
+// files.
+type Finding struct {
+	// CheckID is the identifier of the check which reported the finding.
+	CheckID string
+
+	// Path is the documentation file or directory path, if applicable.
+	Path string
+
+	// Line is the line number of the documentation file, if known, otherwise
+	// 0. Findings of multiple lines have the first line.
+	Line int
+
+	// Err is the underlying error describing the finding.
+	Err error
+
+	// Suggestion is a human readable remediation hint, if available.
+	Suggestion string
+}
+
+// SuggestionError is an error with a human readable remediation hint, such as
+// the expected file name or frontmatter field.
+type SuggestionError struct {
+	Err        error
+	Suggestion string
+}
+
+// WithSuggestion returns the error with a remediation hint. Findings created
+// with the error or an error wrapping it include the hint.
+func WithSuggestion(err error, suggestion string) error {
+	if err == nil {
+		return nil
+	}
+
+	return &SuggestionError{
+		Err:        err,
+		Suggestion: suggestion,
+	}
+}
+
+func (e *SuggestionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SuggestionError) Unwrap() error {
+	return e.Err
+}
+
+// LineError is an error with the line number of the documentation file it
+// applies to.
+type LineError struct {
+	Err  error
+	Line int
+}
+
+// WithLine returns the error with the line number of the documentation file.
+// Findings created with the error or an error wrapping it include the line.
+func WithLine(err error, line int) error {
+	if err == nil {
+		return nil
+	}
+
+	return &LineError{
+		Err:  err,
+		Line: line,
+	}
+}
+
+func (e *LineError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorLine returns the line number of the error, if available, otherwise 0.
+func ErrorLine(err error) int {
+	var lineErr *LineError
+
+	if errors.As(err, &lineErr) {
+		return lineErr.Line
+	}
+
+	return 0
+}
+
+// ErrorSuggestion returns the remediation hint of the error, if available.
+func ErrorSuggestion(err error) string {
+	var suggestionErr *SuggestionError
+
+	if errors.As(err, &suggestionErr) {
+		return suggestionErr.Suggestion
+	}
+
+	return ""
+}
+
+// NewFinding returns a Finding for the check and path, including any line
+// number and remediation hint of the error.
+func NewFinding(checkID string, path string, err error) *Finding {
+	return &Finding{
+		CheckID:    checkID,
+		Path:       path,
+		Line:       ErrorLine(err),
+		Err:        err,
+		Suggestion: ErrorSuggestion(err),
+	}
+}
+
+// newFindings returns a Finding for each error within the error, which may be
+// a *multierror.Error.
+func newFindings(checkID string, path string, err error) error {
+	merr, ok := err.(*multierror.Error)
+
+	if !ok {
+		return NewFinding(checkID, path, err)
+	}
+
+	var result *multierror.Error
+
+	for _, e := range merr.Errors {
+		result = multierror.Append(result, NewFinding(checkID, path, e))
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (f *Finding) Error() string {
+	return f.Err.Error()
+}
+
+func (f *Finding) Unwrap() error {
+	return f.Err
+}
+
+// Findings splits the error, which may be a *multierror.Error, into findings
+// and operational errors. Findings are sorted by path, then line, then check
+// identifier, so output does not depend on directory or map iteration order.
+// Findings without a line sort before findings with a line of the same path.
+// Findings of the same path, line, and check keep their reported order, which
+// follows the file contents.
+func Findings(err error) ([]*Finding, []error) {
+	if err == nil {
+		return nil, nil
+	}
+
+	var findings []*Finding
+	var errs []error
+
+	merr, ok := err.(*multierror.Error)
+
+	if !ok {
+		merr = &multierror.Error{Errors: []error{err}}
+	}
+
+	for _, e := range merr.Errors {
+		var finding *Finding
+
+		if errors.As(e, &finding) {
+			findings = append(findings, finding)
+			continue
+		}
+
+		errs = append(errs, e)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Path != findings[j].Path {
+			return findings[i].Path < findings[j].Path
+		}
+
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+
+		return findings[i].CheckID < findings[j].CheckID
+	})
+
+	return findings, errs
+}
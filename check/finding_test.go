@@ -0,0 +1,267 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+func TestFindings(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Err            error
+		ExpectFindings int
+		ExpectErrors   int
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:           "finding",
+			Err:            NewFinding(CheckIDFileSize, "docs/index.md", errors.New("test")),
+			ExpectFindings: 1,
+		},
+		{
+			Name:           "wrapped finding",
+			Err:            fmt.Errorf("wrapped: %w", NewFinding(CheckIDFileSize, "docs/index.md", errors.New("test"))),
+			ExpectFindings: 1,
+		},
+		{
+			Name:         "operational error",
+			Err:          errors.New("test"),
+			ExpectErrors: 1,
+		},
+		{
+			Name:           "finding wrapping multierror",
+			Err:            NewFinding(CheckIDDirectoryOverlapping, "", multierror.Append(nil, errors.New("test1"), errors.New("test2"))),
+			ExpectFindings: 1,
+		},
+		{
+			Name: "multierror",
+			Err: multierror.Append(nil,
+				NewFinding(CheckIDFileSize, "docs/index.md", errors.New("test")),
+				NewFinding(CheckIDFrontMatter, "docs/index.md", errors.New("test")),
+				errors.New("test"),
+			),
+			ExpectFindings: 2,
+			ExpectErrors:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			findings, errs := Findings(testCase.Err)
+
+			if len(findings) != testCase.ExpectFindings {
+				t.Errorf("expected %d findings, got %d", testCase.ExpectFindings, len(findings))
+			}
+
+			if len(errs) != testCase.ExpectErrors {
+				t.Errorf("expected %d errors, got %d", testCase.ExpectErrors, len(errs))
+			}
+		})
+	}
+}
+
+func TestNewFindings(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Err            error
+		ExpectFindings int
+	}{
+		{
+			Name:           "error",
+			Err:            errors.New("test"),
+			ExpectFindings: 1,
+		},
+		{
+			Name:           "multierror",
+			Err:            multierror.Append(nil, errors.New("test1"), errors.New("test2")),
+			ExpectFindings: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			findings, errs := Findings(newFindings(CheckIDDirectoryOverlapping, "", testCase.Err))
+
+			if len(findings) != testCase.ExpectFindings {
+				t.Errorf("expected %d findings, got %d", testCase.ExpectFindings, len(findings))
+			}
+
+			if len(errs) != 0 {
+				t.Errorf("expected no errors, got %d", len(errs))
+			}
+		})
+	}
+}
+
+func TestNewFindingSuggestion(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Err              error
+		ExpectSuggestion string
+	}{
+		{
+			Name: "no suggestion",
+			Err:  errors.New("test"),
+		},
+		{
+			Name:             "suggestion",
+			Err:              WithSuggestion(errors.New("test"), "fix it"),
+			ExpectSuggestion: "fix it",
+		},
+		{
+			Name:             "wrapped suggestion",
+			Err:              fmt.Errorf("docs/index.md: error checking file frontmatter: %w", WithSuggestion(errors.New("test"), "fix it")),
+			ExpectSuggestion: "fix it",
+		},
+		{
+			Name:             "file extension",
+			Err:              LegacyFileExtensionCheck("website/docs/r/thing.txt"),
+			ExpectSuggestion: "rename website/docs/r/thing.txt to website/docs/r/thing.html.markdown",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			finding := NewFinding(CheckIDFrontMatter, "docs/index.md", testCase.Err)
+
+			if finding.Suggestion != testCase.ExpectSuggestion {
+				t.Errorf("expected suggestion %q, got: %q", testCase.ExpectSuggestion, finding.Suggestion)
+			}
+
+			if finding.Error() != testCase.Err.Error() {
+				t.Errorf("expected error %q, got: %q", testCase.Err.Error(), finding.Error())
+			}
+		})
+	}
+}
+
+func TestNewFindingLine(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Err        error
+		ExpectLine int
+	}{
+		{
+			Name: "no line",
+			Err:  errors.New("test"),
+		},
+		{
+			Name:       "line",
+			Err:        WithLine(errors.New("test"), 3),
+			ExpectLine: 3,
+		},
+		{
+			Name:       "wrapped line with suggestion",
+			Err:        fmt.Errorf("docs/index.md: error checking file frontmatter: %w", WithSuggestion(WithLine(errors.New("test"), 3), "fix it")),
+			ExpectLine: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			finding := NewFinding(CheckIDFrontMatter, "docs/index.md", testCase.Err)
+
+			if finding.Line != testCase.ExpectLine {
+				t.Errorf("expected line %d, got: %d", testCase.ExpectLine, finding.Line)
+			}
+		})
+	}
+}
+
+func TestFindings_order(t *testing.T) {
+	err := multierror.Append(nil,
+		NewFinding(CheckIDFrontMatter, "docs/resources/thing.md", errors.New("first")),
+		NewFinding(CheckIDFileMissing, "", errors.New("missing")),
+		NewFinding(CheckIDFileSize, "docs/resources/thing.md", errors.New("size")),
+		NewFinding(CheckIDFrontMatter, "docs/index.md", errors.New("index")),
+		NewFinding(CheckIDFrontMatter, "docs/resources/thing.md", errors.New("second")),
+		NewFinding(CheckIDForbiddenWords, "docs/resources/thing.md", WithLine(errors.New("line 12"), 12)),
+		NewFinding(CheckIDExampleSecrets, "docs/resources/thing.md", WithLine(errors.New("line 3"), 3)),
+		NewFinding(CheckIDRawHTML, "docs/resources/thing.md", WithLine(errors.New("line 12 raw"), 12)),
+	)
+
+	findings, _ := Findings(err)
+
+	var got []string
+
+	for _, finding := range findings {
+		got = append(got, finding.Path+": "+finding.Error())
+	}
+
+	expected := []string{
+		": missing",
+		"docs/index.md: index",
+		"docs/resources/thing.md: size",
+		"docs/resources/thing.md: first",
+		"docs/resources/thing.md: second",
+		"docs/resources/thing.md: line 3",
+		"docs/resources/thing.md: line 12",
+		"docs/resources/thing.md: line 12 raw",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+// testCheckFindings runs a check against the documentation directories under
+// basePath and returns its findings, as with testFindings.
+func testCheckFindings(t *testing.T, basePath string, run func(map[string][]string) error, checkID string) []*Finding {
+	t.Helper()
+
+	directories, err := GetDirectories(basePath, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error getting directories: %s", err)
+	}
+
+	return testFindings(t, run(directories), checkID)
+}
+
+// testFindings returns the findings of a check result, failing the test on
+// operational errors or findings from any check other than checkID.
+func testFindings(t *testing.T, err error, checkID string) []*Finding {
+	t.Helper()
+
+	findings, errs := Findings(err)
+
+	if len(errs) > 0 {
+		t.Fatalf("unexpected operational errors: %v", errs)
+	}
+
+	for _, finding := range findings {
+		if finding.CheckID != checkID {
+			t.Errorf("expected check identifier %s, got: %s", checkID, finding.CheckID)
+		}
+	}
+
+	return findings
+}
+
+// testFindingPaths returns the paths of findings.
+func testFindingPaths(findings []*Finding) []string {
+	var paths []string
+
+	for _, finding := range findings {
+		paths = append(paths, finding.Path)
+	}
+
+	return paths
+}
+
+// testFindingErrors returns the error messages of findings.
+func testFindingErrors(findings []*Finding) []string {
+	var errs []string
+
+	for _, finding := range findings {
+		errs = append(errs, finding.Error())
+	}
+
+	return errs
+}
@@ -0,0 +1,266 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// ForbiddenWord is a word or phrase which should not be used in
+// documentation prose, such as a deprecated product name.
+type ForbiddenWord struct {
+	Phrase string
+
+	// Replacement is the suggested word or phrase to use instead, if any.
+	Replacement string
+
+	pattern *regexp.Regexp
+}
+
+// NewForbiddenWord returns a ForbiddenWord, which matches the phrase case
+// insensitively, as whole words, and with any whitespace between its words.
+func NewForbiddenWord(phrase string, replacement string) (*ForbiddenWord, error) {
+	words := strings.Fields(phrase)
+
+	if len(words) == 0 {
+		return nil, fmt.Errorf("empty forbidden word")
+	}
+
+	quoted := make([]string, len(words))
+
+	for index, word := range words {
+		quoted[index] = regexp.QuoteMeta(word)
+	}
+
+	expr := strings.Join(quoted, `\s+`)
+
+	// Word boundaries only apply next to ASCII word characters, so phrases
+	// such as "C++" still match.
+	if r, _ := utf8.DecodeRuneInString(words[0]); isASCIIWordRune(r) {
+		expr = `\b` + expr
+	}
+
+	if r, _ := utf8.DecodeLastRuneInString(words[len(words)-1]); isASCIIWordRune(r) {
+		expr = expr + `\b`
+	}
+
+	pattern, err := regexp.Compile(`(?i)` + expr)
+
+	if err != nil {
+		return nil, fmt.Errorf("error compiling forbidden word (%s): %w", phrase, err)
+	}
+
+	return &ForbiddenWord{
+		Phrase:      strings.Join(words, " "),
+		Replacement: strings.TrimSpace(replacement),
+		pattern:     pattern,
+	}, nil
+}
+
+// ForbiddenWordsOptions represents configuration options for ForbiddenWords.
+type ForbiddenWordsOptions struct {
+	*FileOptions
+
+	Words []*ForbiddenWord
+}
+
+// ForbiddenWordsCheck verifies documentation prose does not contain forbidden
+// words or phrases, such as deprecated product names or non-inclusive terms.
+// Code blocks, code spans, and HTML are not prose and are not checked.
+type ForbiddenWordsCheck struct {
+	Options *ForbiddenWordsOptions
+}
+
+func NewForbiddenWordsCheck(opts *ForbiddenWordsOptions) *ForbiddenWordsCheck {
+	check := &ForbiddenWordsCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &ForbiddenWordsOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the prose of each documentation file, except CDKTF
+// documentation, which is generated from the same documentation.
+func (check *ForbiddenWordsCheck) Run(directories map[string][]string) error {
+	if len(check.Options.Words) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the prose of the documentation file.
+func (check *ForbiddenWordsCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file forbidden words", "check", CheckIDForbiddenWords, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	lines := make(map[*ForbiddenWord][]int)
+
+	for _, block := range proseBlocks(content) {
+		for _, word := range check.Options.Words {
+			for _, match := range word.pattern.FindAllStringIndex(block.text, -1) {
+				line := block.line(content, match[0])
+
+				if n := len(lines[word]); n == 0 || lines[word][n-1] != line {
+					lines[word] = append(lines[word], line)
+				}
+			}
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	var firstLine int
+	var words []string
+	var suggestions []string
+
+	for _, word := range check.Options.Words {
+		wordLines, ok := lines[word]
+
+		if !ok {
+			continue
+		}
+
+		sort.Ints(wordLines)
+
+		if firstLine == 0 || wordLines[0] < firstLine {
+			firstLine = wordLines[0]
+		}
+
+		lineStrings := make([]string, len(wordLines))
+
+		for index, line := range wordLines {
+			lineStrings[index] = fmt.Sprint(line)
+		}
+
+		if len(wordLines) == 1 {
+			words = append(words, fmt.Sprintf("%s (line %s)", word.Phrase, lineStrings[0]))
+		} else {
+			words = append(words, fmt.Sprintf("%s (lines %s)", word.Phrase, strings.Join(lineStrings, ", ")))
+		}
+
+		if word.Replacement != "" {
+			suggestions = append(suggestions, fmt.Sprintf("replace %q with %q", word.Phrase, word.Replacement))
+		}
+	}
+
+	err = fmt.Errorf("%s: forbidden words: %s", path, strings.Join(words, ", "))
+	err = WithLine(err, firstLine)
+
+	if len(suggestions) > 0 {
+		err = WithSuggestion(err, strings.Join(suggestions, ", "))
+	}
+
+	return NewFinding(CheckIDForbiddenWords, path, err)
+}
+
+// proseBlock is the text of a Markdown block, such as a paragraph or heading,
+// without code spans and HTML.
+type proseBlock struct {
+	text string
+
+	// offsets contains the source offset of each text segment, keyed by its
+	// start index in text.
+	offsets []proseOffset
+}
+
+type proseOffset struct {
+	index  int
+	source int
+}
+
+// line returns the source line number of the text index.
+func (b *proseBlock) line(source []byte, index int) int {
+	offset := 0
+
+	for _, o := range b.offsets {
+		if o.index > index {
+			break
+		}
+
+		offset = o.source + index - o.index
+	}
+
+	return strings.Count(string(source[:offset]), "\n") + 1
+}
+
+// proseBlocks returns the prose of the Markdown source, excluding the YAML
+// frontmatter, code blocks, code spans, autolinks, and HTML.
+func proseBlocks(source []byte) []*proseBlock {
+	document, _ := markdown.Parse(source)
+
+	var blocks []*proseBlock
+	var current *proseBlock
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch node := node.(type) {
+		case *ast.AutoLink, *ast.CodeBlock, *ast.CodeSpan, *ast.FencedCodeBlock, *ast.HTMLBlock, *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		case *ast.Heading, *ast.Paragraph, *ast.TextBlock:
+			if entering {
+				current = &proseBlock{}
+			} else if current != nil && current.text != "" {
+				blocks = append(blocks, current)
+				current = nil
+			}
+		case *ast.Text:
+			if !entering || current == nil {
+				return ast.WalkContinue, nil
+			}
+
+			current.offsets = append(current.offsets, proseOffset{index: len(current.text), source: node.Segment.Start})
+			current.text += string(node.Segment.Value(source))
+
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				current.text += " "
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return blocks
+}
+
+func isASCIIWordRune(r rune) bool {
+	return r == '_' || (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z')
+}
@@ -0,0 +1,139 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewForbiddenWord(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Phrase      string
+		Matches     []string
+		NotMatches  []string
+		ExpectError bool
+	}{
+		{
+			Name:       "word",
+			Phrase:     "master",
+			Matches:    []string{"master", "the Master branch"},
+			NotMatches: []string{"mastering", "webmaster"},
+		},
+		{
+			Name:       "phrase",
+			Phrase:     " Legacy  Product ",
+			Matches:    []string{"legacy product", "Legacy\n  Product"},
+			NotMatches: []string{"legacy products"},
+		},
+		{
+			Name:    "non-word characters",
+			Phrase:  "C++",
+			Matches: []string{"use C++.", "c++"},
+		},
+		{
+			Name:        "empty",
+			Phrase:      " ",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := NewForbiddenWord(testCase.Phrase, "")
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil {
+				if !testCase.ExpectError {
+					t.Fatalf("expected no error, got error: %s", err)
+				}
+
+				return
+			}
+
+			for _, text := range testCase.Matches {
+				if !got.pattern.MatchString(text) {
+					t.Errorf("expected match: %q", text)
+				}
+			}
+
+			for _, text := range testCase.NotMatches {
+				if got.pattern.MatchString(text) {
+					t.Errorf("expected no match: %q", text)
+				}
+			}
+		})
+	}
+}
+
+func TestForbiddenWordsCheck(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Words             [][2]string
+		ExpectErrors      []string
+		ExpectSuggestions []string
+	}{
+		{
+			Name: "no words",
+		},
+		{
+			Name:  "word with replacement",
+			Words: [][2]string{{"whitelist", "allowlist"}},
+			ExpectErrors: []string{
+				"docs/resources/thing.md: forbidden words: whitelist (lines 10, 24)",
+			},
+			ExpectSuggestions: []string{
+				`replace "whitelist" with "allowlist"`,
+			},
+		},
+		{
+			Name:  "phrase across lines and word boundaries",
+			Words: [][2]string{{"legacy product", ""}, {"master", ""}},
+			ExpectErrors: []string{
+				"docs/resources/thing.md: forbidden words: legacy product (line 11)",
+			},
+			ExpectSuggestions: []string{
+				"",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &ForbiddenWordsOptions{
+				FileOptions: &FileOptions{
+					BasePath: "testdata/forbidden-words",
+				},
+			}
+
+			for _, word := range testCase.Words {
+				forbiddenWord, err := NewForbiddenWord(word[0], word[1])
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				opts.Words = append(opts.Words, forbiddenWord)
+			}
+
+			findings := testCheckFindings(t, opts.BasePath, NewForbiddenWordsCheck(opts).Run, CheckIDForbiddenWords)
+
+			var gotErrors, gotSuggestions []string
+
+			for _, finding := range findings {
+				gotErrors = append(gotErrors, finding.Error())
+				gotSuggestions = append(gotSuggestions, finding.Suggestion)
+			}
+
+			if !reflect.DeepEqual(gotErrors, testCase.ExpectErrors) {
+				t.Errorf("expected errors: %v, got: %v", testCase.ExpectErrors, gotErrors)
+			}
+
+			if !reflect.DeepEqual(gotSuggestions, testCase.ExpectSuggestions) {
+				t.Errorf("expected suggestions: %v, got: %v", testCase.ExpectSuggestions, gotSuggestions)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+var (
+	// formatVerbRegexp matches Go format verbs, such as %s, %-10d, %[1]s, or
+	// %.2f. The space flag is not matched, so percentages followed by words,
+	// such as "50% off", are not reported.
+	formatVerbRegexp = regexp.MustCompile(`%(?:\[[0-9]+\])?[-+#0]*(?:[0-9]+|\*)?(?:\.(?:[0-9]+|\*)?)?(?:\[[0-9]+\])?[vTtbcdoOqxXUeEfFgGsp]`)
+
+	// formatVerbURLEncodingRegexp matches URL encoded characters, such as
+	// %2F, which are also valid format verbs.
+	formatVerbURLEncodingRegexp = regexp.MustCompile(`^%[0-9A-Fa-f]{2}`)
+)
+
+// FormatVerbsOptions represents configuration options for FormatVerbs.
+type FormatVerbsOptions struct {
+	*FileOptions
+
+	// Enable enables the format-verbs check.
+	Enable bool
+}
+
+// FormatVerbsCheck verifies documentation prose and YAML frontmatter do not
+// contain Go format verbs, such as %s or %[1]q, which are left by templating
+// errors in generated documentation. Code blocks, code spans, and HTML are
+// not checked.
+type FormatVerbsCheck struct {
+	Options *FormatVerbsOptions
+}
+
+func NewFormatVerbsCheck(opts *FormatVerbsOptions) *FormatVerbsCheck {
+	check := &FormatVerbsCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &FormatVerbsOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each documentation file, except CDKTF documentation, which is
+// generated from the same documentation.
+func (check *FormatVerbsCheck) Run(directories map[string][]string) error {
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the prose and YAML frontmatter of the documentation file.
+func (check *FormatVerbsCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file format verbs", "check", CheckIDFormatVerbs, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	// Format verbs are reported once with all of their lines, in order of
+	// first appearance.
+	var verbs []string
+	lines := make(map[string][]string)
+
+	addVerb := func(verb string, line int) {
+		verbLines, ok := lines[verb]
+
+		if !ok {
+			verbs = append(verbs, verb)
+		}
+
+		if n := len(verbLines); n == 0 || verbLines[n-1] != fmt.Sprint(line) {
+			lines[verb] = append(verbLines, fmt.Sprint(line))
+		}
+	}
+
+	// Frontmatter lines follow the opening --- delimiter line.
+	for index, text := range frontMatterLines(content) {
+		for _, match := range formatVerbs(text) {
+			addVerb(text[match[0]:match[1]], index+2)
+		}
+	}
+
+	for _, block := range proseBlocks(content) {
+		for _, match := range formatVerbs(block.text) {
+			addVerb(block.text[match[0]:match[1]], block.line(content, match[0]))
+		}
+	}
+
+	if len(verbs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(verbs))
+
+	for index, verb := range verbs {
+		if len(lines[verb]) == 1 {
+			messages[index] = fmt.Sprintf("%s (line %s)", verb, lines[verb][0])
+		} else {
+			messages[index] = fmt.Sprintf("%s (lines %s)", verb, strings.Join(lines[verb], ", "))
+		}
+	}
+
+	err = fmt.Errorf("%s: format verbs outside code blocks: %s", path, strings.Join(messages, ", "))
+
+	// The first verb appears first.
+	if line, lineErr := strconv.Atoi(lines[verbs[0]][0]); lineErr == nil {
+		err = WithLine(err, line)
+	}
+	err = WithSuggestion(err, "fix the documentation generation, e.g. missing fmt.Sprintf arguments, or use a code span for intended format verbs")
+
+	return NewFinding(CheckIDFormatVerbs, path, err)
+}
+
+// formatVerbs returns the start and end indexes of each format verb in the
+// text. Escaped percent signs (%%) and URL encoded characters are ignored.
+func formatVerbs(text string) [][]int {
+	var result [][]int
+
+	for _, match := range formatVerbRegexp.FindAllStringIndex(text, -1) {
+		percents := 0
+
+		for i := match[0] - 1; i >= 0 && text[i] == '%'; i-- {
+			percents++
+		}
+
+		if percents%2 == 1 || formatVerbURLEncodingRegexp.MatchString(text[match[0]:]) {
+			continue
+		}
+
+		result = append(result, match)
+	}
+
+	return result
+}
+
+// frontMatterLines returns the lines of the YAML frontmatter of the Markdown
+// source, excluding its --- delimiters, or nil if there is no frontmatter.
+func frontMatterLines(source []byte) []string {
+	lines := strings.Split(string(source), "\n")
+
+	if len(lines) == 0 || strings.TrimRight(lines[0], " \t\r") != "---" {
+		return nil
+	}
+
+	for index, line := range lines[1:] {
+		if strings.TrimRight(line, " \t\r") == "---" {
+			return lines[1 : index+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormatVerbsCheck(t *testing.T) {
+	opts := &FormatVerbsOptions{
+		FileOptions: &FileOptions{
+			BasePath: "testdata/format-verbs",
+		},
+	}
+
+	got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewFormatVerbsCheck(opts).Run, CheckIDFormatVerbs))
+
+	expected := []string{
+		"docs/resources/thing.md: format verbs outside code blocks: %s (lines 3, 8), %[1]s (line 5), %d (line 10), %-10d (line 23), %q (line 23), %v (line 24)",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected errors: %v, got: %v", expected, got)
+	}
+}
+
+func TestFormatVerbs(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Text   string
+		Expect []string
+	}{
+		{
+			Name: "none",
+			Text: "Manages a thing.",
+		},
+		{
+			Name:   "verbs",
+			Text:   "%s %d %[1]s %q %v %.2f %-10s %+v %#v %T",
+			Expect: []string{"%s", "%d", "%[1]s", "%q", "%v", "%.2f", "%-10s", "%+v", "%#v", "%T"},
+		},
+		{
+			Name: "escaped percent signs",
+			Text: "%%s and 100%%",
+		},
+		{
+			Name:   "escaped percent sign followed by verb",
+			Text:   "%%%s",
+			Expect: []string{"%s"},
+		},
+		{
+			Name: "url encoding",
+			Text: "path%2Fto%20file",
+		},
+		{
+			Name: "percentages",
+			Text: "50% off and 100% of the time",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []string
+
+			for _, match := range formatVerbs(testCase.Text) {
+				got = append(got, testCase.Text[match[0]:match[1]])
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -1,13 +1,27 @@
 package check
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
 	"gopkg.in/yaml.v2"
 )
 
+// frontMatterDuplicateKeyRegexp matches the strict YAML parsing error of
+// duplicate keys, capturing the line and key.
+var frontMatterDuplicateKeyRegexp = regexp.MustCompile(`^line ([0-9]+): key (.+) already set in map$`)
+
 type FrontMatterCheck struct {
 	Options *FrontMatterOptions
+
+	// Logger, if set, receives the diagnostic logging of the check.
+	// Otherwise, the default hclog logger is used.
+	Logger hclog.Logger
 }
 
 // FrontMatterData represents the YAML frontmatter of Terraform Provider documentation.
@@ -17,6 +31,18 @@ type FrontMatterData struct {
 	PageTitle      *string `yaml:"page_title,omitempty"`
 	SidebarCurrent *string `yaml:"sidebar_current,omitempty"`
 	Subcategory    *string `yaml:"subcategory,omitempty"`
+
+	// Tfproviderdocs contains per-file configuration of this tool, such as
+	// the checks to skip.
+	Tfproviderdocs *FrontMatterTfproviderdocs `yaml:"tfproviderdocs,omitempty"`
+}
+
+// FrontMatterTfproviderdocs represents the tfproviderdocs YAML frontmatter
+// map, e.g. tfproviderdocs: { skip: [contents] }.
+type FrontMatterTfproviderdocs struct {
+	// Skip contains the identifiers of checks whose findings are ignored for
+	// the file.
+	Skip []string `yaml:"skip,omitempty"`
 }
 
 // FrontMatterOptions represents configuration options for FrontMatter.
@@ -31,10 +57,34 @@ type FrontMatterOptions struct {
 	RequireLayout        bool
 	RequirePageTitle     bool
 	RequireSubcategory   bool
+
+	// ForbidKeys contains additional YAML frontmatter keys which must not be
+	// present, such as legacy keys after migrating directory structures.
+	ForbidKeys []string
+
+	// RequireKeys contains additional YAML frontmatter keys which must be
+	// present, such as organization specific keys.
+	RequireKeys []string
+
+	// Schema is a JSON Schema which the YAML frontmatter must satisfy, such
+	// as for organization specific keys. Frontmatter is not validated if nil.
+	Schema *FrontMatterSchema
+
+	// NormalizeSubcategories matches AllowedSubcategories case insensitively
+	// and after trimming surrounding whitespace. A warning is logged when the
+	// subcategory only matches after normalization.
+	NormalizeSubcategories bool
 }
 
 func NewFrontMatterCheck(opts *FrontMatterOptions) *FrontMatterCheck {
+	return newFrontMatterCheck(opts, nil)
+}
+
+// newFrontMatterCheck returns the frontmatter check with the logger of the
+// documentation file check running it.
+func newFrontMatterCheck(opts *FrontMatterOptions, logger hclog.Logger) *FrontMatterCheck {
 	check := &FrontMatterCheck{
+		Logger:  logger,
 		Options: opts,
 	}
 
@@ -45,57 +95,250 @@ func NewFrontMatterCheck(opts *FrontMatterOptions) *FrontMatterCheck {
 	return check
 }
 
+func (check *FrontMatterCheck) log() hclog.Logger {
+	if check.Logger == nil {
+		return hclog.L()
+	}
+
+	return check.Logger
+}
+
+// ParseFrontMatter returns the YAML frontmatter of the documentation source.
+func ParseFrontMatter(src []byte) (*FrontMatterData, error) {
+	frontMatter := &FrontMatterData{}
+
+	if err := yaml.Unmarshal(src, frontMatter); err != nil {
+		return nil, fmt.Errorf("error parsing YAML frontmatter: %w", err)
+	}
+
+	return frontMatter, nil
+}
+
 func (check *FrontMatterCheck) Run(src []byte) error {
-	frontMatter := FrontMatterData{}
+	if err := checkFrontMatterSyntax(src); err != nil {
+		return err
+	}
+
+	frontMatter, err := ParseFrontMatter(src)
 
-	err := yaml.Unmarshal([]byte(src), &frontMatter)
 	if err != nil {
-		return fmt.Errorf("error parsing YAML frontmatter: %w", err)
+		return err
 	}
 
 	if check.Options.NoDescription && frontMatter.Description != nil {
-		return fmt.Errorf("YAML frontmatter should not contain description")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter should not contain description"), "remove `description` from the YAML frontmatter")
 	}
 
 	if check.Options.NoLayout && frontMatter.Layout != nil {
-		return fmt.Errorf("YAML frontmatter should not contain layout")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter should not contain layout"), "remove `layout` from the YAML frontmatter")
 	}
 
 	if check.Options.NoPageTitle && frontMatter.PageTitle != nil {
-		return fmt.Errorf("YAML frontmatter should not contain page_title")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter should not contain page_title"), "remove `page_title` from the YAML frontmatter")
 	}
 
 	if check.Options.NoSidebarCurrent && frontMatter.SidebarCurrent != nil {
-		return fmt.Errorf("YAML frontmatter should not contain sidebar_current")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter should not contain sidebar_current"), "remove `sidebar_current` from the YAML frontmatter")
 	}
 
-	if check.Options.NoSubcategory && frontMatter.Subcategory != nil {
-		return fmt.Errorf("YAML frontmatter should not contain subcategory")
+	// An empty subcategory, such as the one tfplugindocs generates for the
+	// provider index page, is equivalent to no subcategory.
+	if check.Options.NoSubcategory && frontMatter.Subcategory != nil && strings.TrimSpace(*frontMatter.Subcategory) != "" {
+		return WithSuggestion(fmt.Errorf("YAML frontmatter should not contain subcategory"), "remove `subcategory` from the YAML frontmatter")
+	}
+
+	if len(check.Options.ForbidKeys) > 0 {
+		keys := frontMatterKeys(src)
+
+		var forbiddenKeys []string
+
+		for _, key := range check.Options.ForbidKeys {
+			if keys[key] {
+				forbiddenKeys = append(forbiddenKeys, key)
+			}
+		}
+
+		if len(forbiddenKeys) > 0 {
+			return WithSuggestion(fmt.Errorf("YAML frontmatter should not contain forbidden key(s): %s", strings.Join(forbiddenKeys, ", ")), fmt.Sprintf("remove `%s` from the YAML frontmatter", strings.Join(forbiddenKeys, "`, `")))
+		}
+	}
+
+	if frontMatter.Tfproviderdocs != nil {
+		for _, id := range frontMatter.Tfproviderdocs.Skip {
+			if !IsValidCheckID(id) {
+				return WithSuggestion(fmt.Errorf("YAML frontmatter tfproviderdocs skip contains invalid check identifier: %s", id), "run the checks command for valid identifiers")
+			}
+		}
+	}
+
+	if check.Options.Schema != nil {
+		if errs := check.Options.Schema.Validate(src); len(errs) > 0 {
+			var result *multierror.Error
+
+			for _, err := range errs {
+				result = multierror.Append(result, err)
+			}
+
+			return WithSuggestion(fmt.Errorf("YAML frontmatter does not match schema: %w", result), "update the YAML frontmatter to satisfy the -frontmatter-schema JSON Schema")
+		}
 	}
 
 	if check.Options.RequireDescription && frontMatter.Description == nil {
-		return fmt.Errorf("YAML frontmatter missing required description")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter missing required description"), "add `description: |-\n  ...` to the YAML frontmatter")
 	}
 
 	if check.Options.RequireLayout && frontMatter.Layout == nil {
-		return fmt.Errorf("YAML frontmatter missing required layout")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter missing required layout"), "add `layout: \"...\"` to the YAML frontmatter")
 	}
 
 	if check.Options.RequirePageTitle && frontMatter.PageTitle == nil {
-		return fmt.Errorf("YAML frontmatter missing required page_title")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter missing required page_title"), "add `page_title: \"...\"` to the YAML frontmatter")
 	}
 
 	if check.Options.RequireSubcategory && frontMatter.Subcategory == nil {
-		return fmt.Errorf("YAML frontmatter missing required subcategory")
+		return WithSuggestion(fmt.Errorf("YAML frontmatter missing required subcategory"), missingSubcategorySuggestion(check.Options.AllowedSubcategories))
 	}
 
-	if len(check.Options.AllowedSubcategories) > 0 && frontMatter.Subcategory != nil && !isAllowedSubcategory(*frontMatter.Subcategory, check.Options.AllowedSubcategories) {
-		return fmt.Errorf("YAML frontmatter subcategory (%s) does not match allowed subcategories (%#v)", *frontMatter.Subcategory, check.Options.AllowedSubcategories)
+	if len(check.Options.RequireKeys) > 0 {
+		keys := frontMatterKeys(src)
+
+		var missingKeys []string
+
+		for _, key := range check.Options.RequireKeys {
+			if !keys[key] {
+				missingKeys = append(missingKeys, key)
+			}
+		}
+
+		if len(missingKeys) > 0 {
+			return WithSuggestion(fmt.Errorf("YAML frontmatter missing required key(s): %s", strings.Join(missingKeys, ", ")), fmt.Sprintf("add `%s: \"...\"` to the YAML frontmatter", missingKeys[0]))
+		}
+	}
+
+	if len(check.Options.AllowedSubcategories) > 0 && frontMatter.Subcategory != nil {
+		subcategory := *frontMatter.Subcategory
+
+		if isAllowedSubcategory(subcategory, check.Options.AllowedSubcategories) {
+			return nil
+		}
+
+		if !check.Options.NormalizeSubcategories {
+			err := fmt.Errorf("YAML frontmatter subcategory (%s) does not match allowed subcategories (%#v)", subcategory, check.Options.AllowedSubcategories)
+
+			if allowedSubcategory, ok := normalizedAllowedSubcategory(subcategory, check.Options.AllowedSubcategories); ok {
+				return WithSuggestion(err, fmt.Sprintf("change the YAML frontmatter to `subcategory: %q` or use the -normalize-subcategories flag", allowedSubcategory))
+			}
+
+			return WithSuggestion(err, allowedSubcategorySuggestion(check.Options.AllowedSubcategories))
+		}
+
+		allowedSubcategory, ok := normalizedAllowedSubcategory(subcategory, check.Options.AllowedSubcategories)
+
+		if !ok {
+			return WithSuggestion(fmt.Errorf("YAML frontmatter subcategory (%s) does not match allowed subcategories (%#v), ignoring case and surrounding whitespace", subcategory, check.Options.AllowedSubcategories), allowedSubcategorySuggestion(check.Options.AllowedSubcategories))
+		}
+
+		check.log().Warn("YAML frontmatter subcategory should be normalized to allowed subcategory", "check", CheckIDFrontMatter, "subcategory", subcategory, "allowed_subcategory", allowedSubcategory)
 	}
 
 	return nil
 }
 
+// checkFrontMatterSyntax verifies the YAML frontmatter of the documentation
+// source is terminated, is not indented with tabs, and does not contain
+// duplicate keys, which YAML parsing either rejects with an error that does
+// not explain the problem or silently resolves to the last value.
+func checkFrontMatterSyntax(src []byte) error {
+	lines := strings.Split(string(src), "\n")
+
+	if strings.TrimRight(lines[0], " \t\r") != "---" {
+		return nil
+	}
+
+	frontMatter := frontMatterLines(src)
+
+	if frontMatter == nil {
+		return WithSuggestion(WithLine(errors.New("YAML frontmatter opened on line 1 is missing the closing --- delimiter"), 1), "add a `---` line after the YAML frontmatter")
+	}
+
+	var tabLines []string
+
+	// Frontmatter lines follow the opening --- delimiter line.
+	for index, line := range frontMatter {
+		indentation := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+
+		if strings.Contains(indentation, "\t") {
+			tabLines = append(tabLines, strconv.Itoa(index+2))
+		}
+	}
+
+	if len(tabLines) > 0 {
+		firstLine, _ := strconv.Atoi(tabLines[0])
+		err := fmt.Errorf("YAML frontmatter indented with tabs (lines %s)", strings.Join(tabLines, ", "))
+
+		if len(tabLines) == 1 {
+			err = fmt.Errorf("YAML frontmatter indented with tabs (line %s)", tabLines[0])
+		}
+
+		return WithSuggestion(WithLine(err, firstLine), "indent the YAML frontmatter with spaces")
+	}
+
+	var value map[interface{}]interface{}
+	var typeErr *yaml.TypeError
+
+	// Other parsing errors are reported by ParseFrontMatter.
+	if err := yaml.UnmarshalStrict([]byte(strings.Join(lines[:len(frontMatter)+2], "\n")), &value); !errors.As(err, &typeErr) {
+		return nil
+	}
+
+	var duplicateKeys []string
+	var firstLine int
+
+	for _, typeError := range typeErr.Errors {
+		match := frontMatterDuplicateKeyRegexp.FindStringSubmatch(typeError)
+
+		if match == nil {
+			continue
+		}
+
+		key := match[2]
+
+		if unquoted, err := strconv.Unquote(key); err == nil {
+			key = unquoted
+		}
+
+		if line, err := strconv.Atoi(match[1]); err == nil && (firstLine == 0 || line < firstLine) {
+			firstLine = line
+		}
+
+		duplicateKeys = append(duplicateKeys, fmt.Sprintf("%s (line %s)", key, match[1]))
+	}
+
+	if len(duplicateKeys) == 0 {
+		return nil
+	}
+
+	return WithSuggestion(WithLine(fmt.Errorf("YAML frontmatter contains duplicate key(s): %s", strings.Join(duplicateKeys, ", ")), firstLine), "remove the duplicate keys from the YAML frontmatter, since only the last value is used")
+}
+
+// frontMatterKeys returns the keys of the YAML frontmatter of the
+// documentation source.
+func frontMatterKeys(src []byte) map[string]bool {
+	var frontMatter map[string]interface{}
+
+	if err := yaml.Unmarshal(src, &frontMatter); err != nil {
+		return nil
+	}
+
+	result := make(map[string]bool, len(frontMatter))
+
+	for key := range frontMatter {
+		result[key] = true
+	}
+
+	return result
+}
+
 func isAllowedSubcategory(subcategory string, allowedSubcategories []string) bool {
 	for _, allowedSubcategory := range allowedSubcategories {
 		if subcategory == allowedSubcategory {
@@ -105,3 +348,33 @@ func isAllowedSubcategory(subcategory string, allowedSubcategories []string) boo
 
 	return false
 }
+
+// normalizedAllowedSubcategory returns the allowed subcategory matching the
+// subcategory case insensitively and after trimming surrounding whitespace.
+func normalizedAllowedSubcategory(subcategory string, allowedSubcategories []string) (string, bool) {
+	subcategory = strings.TrimSpace(subcategory)
+
+	for _, allowedSubcategory := range allowedSubcategories {
+		if strings.EqualFold(subcategory, strings.TrimSpace(allowedSubcategory)) {
+			return allowedSubcategory, true
+		}
+	}
+
+	return "", false
+}
+
+// missingSubcategorySuggestion returns a remediation hint for a missing
+// frontmatter subcategory, using the first allowed subcategory as an example.
+func missingSubcategorySuggestion(allowedSubcategories []string) string {
+	if len(allowedSubcategories) == 0 {
+		return "add `subcategory: \"...\"` to the YAML frontmatter"
+	}
+
+	return fmt.Sprintf("add an allowed subcategory to the YAML frontmatter, e.g. `subcategory: %q`", allowedSubcategories[0])
+}
+
+// allowedSubcategorySuggestion returns a remediation hint for a frontmatter
+// subcategory not matching the allowed subcategories.
+func allowedSubcategorySuggestion(allowedSubcategories []string) string {
+	return fmt.Sprintf("change the YAML frontmatter to an allowed subcategory, e.g. `subcategory: %q`", allowedSubcategories[0])
+}
@@ -0,0 +1,51 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// FrontMatterPageTitleCheck verifies the resource name in the YAML
+// frontmatter page_title, e.g. "aws_instance", matches the resource name of
+// the documentation file name. This catches YAML frontmatter copied from a
+// neighboring file. Page titles without a resource name are skipped.
+func FrontMatterPageTitleCheck(path string, src []byte, providerName string) error {
+	if providerName == "" {
+		return nil
+	}
+
+	frontMatter, err := ParseFrontMatter(src)
+
+	if err != nil {
+		return err
+	}
+
+	if frontMatter.PageTitle == nil {
+		return nil
+	}
+
+	pageTitle := *frontMatter.PageTitle
+	resourceNames := pageTitleResourceNameRegexp(providerName).FindAllString(pageTitle, -1)
+
+	if len(resourceNames) == 0 {
+		return nil
+	}
+
+	expectedResourceName := fileResourceName(providerName, path)
+
+	for _, resourceName := range resourceNames {
+		if resourceName == expectedResourceName {
+			return nil
+		}
+	}
+
+	err = fmt.Errorf("YAML frontmatter page_title (%q) resource name (%s) does not match file resource name: %s", pageTitle, resourceNames[0], expectedResourceName)
+
+	return WithSuggestion(err, fmt.Sprintf("update page_title to reference %s", expectedResourceName))
+}
+
+// pageTitleResourceNameRegexp returns a regular expression matching resource
+// names of the provider, e.g. aws_instance.
+func pageTitleResourceNameRegexp(providerName string) *regexp.Regexp {
+	return regexp.MustCompile(`\b` + regexp.QuoteMeta(providerName) + `_[A-Za-z0-9_]+`)
+}
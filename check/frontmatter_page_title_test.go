@@ -0,0 +1,95 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestFrontMatterPageTitleCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		Source       string
+		ProviderName string
+		ExpectError  bool
+	}{
+		{
+			Name:         "no provider name",
+			Path:         "thing.md",
+			Source:       "page_title: \"Test: test_other\"",
+			ProviderName: "",
+		},
+		{
+			Name:         "no page_title",
+			Path:         "thing.md",
+			Source:       "subcategory: Example",
+			ProviderName: "test",
+		},
+		{
+			Name:         "page_title without resource name",
+			Path:         "thing.md",
+			Source:       "page_title: \"Test Thing\"",
+			ProviderName: "test",
+		},
+		{
+			Name:         "legacy page_title",
+			Path:         "thing.html.markdown",
+			Source:       "page_title: \"Test: test_thing\"",
+			ProviderName: "test",
+		},
+		{
+			Name:         "terraform-plugin-docs page_title",
+			Path:         "thing.md",
+			Source:       "page_title: \"test_thing Resource - terraform-provider-test\"",
+			ProviderName: "test",
+		},
+		{
+			Name:         "page_title with multiple resource names",
+			Path:         "thing.md",
+			Source:       "page_title: \"test_thing (formerly test_old_thing)\"",
+			ProviderName: "test",
+		},
+		{
+			Name:         "mismatched resource name",
+			Path:         "thing.md",
+			Source:       "page_title: \"test_other Resource - terraform-provider-test\"",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "mismatched resource name prefix",
+			Path:         "thing.md",
+			Source:       "page_title: \"Test: test_thing_other\"",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "invalid YAML",
+			Path:         "thing.md",
+			Source:       "page_title: [",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FrontMatterPageTitleCheck(testCase.Path, []byte(testCase.Source), testCase.ProviderName)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestFrontMatterPageTitleCheckSuggestion(t *testing.T) {
+	err := FrontMatterPageTitleCheck("thing.md", []byte("page_title: \"test_other Resource - terraform-provider-test\""), "test")
+
+	if got, expected := ErrorSuggestion(err), "update page_title to reference test_thing"; got != expected {
+		t.Errorf("expected suggestion %q, got: %q", expected, got)
+	}
+}
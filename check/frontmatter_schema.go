@@ -0,0 +1,399 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+)
+
+// frontMatterSchemaUnsupportedKeywords are JSON Schema keywords which are not
+// supported by FrontMatterSchema. They are rejected, rather than ignored, so
+// a schema is never silently weaker than written.
+var frontMatterSchemaUnsupportedKeywords = []string{
+	"$ref",
+	"allOf",
+	"anyOf",
+	"dependentRequired",
+	"dependentSchemas",
+	"else",
+	"if",
+	"not",
+	"oneOf",
+	"patternProperties",
+	"then",
+}
+
+// FrontMatterSchema represents the subset of JSON Schema used to validate
+// YAML frontmatter, which supports the type, enum, const, required,
+// properties, additionalProperties, items, pattern, minimum, maximum,
+// minLength, maxLength, minItems, and maxItems keywords and boolean schemas.
+type FrontMatterSchema struct {
+	// Boolean is set for the true and false schemas, which allow any value
+	// and no values.
+	Boolean *bool
+
+	AdditionalProperties *FrontMatterSchema
+	Const                *interface{}
+	Enum                 []interface{}
+	Items                *FrontMatterSchema
+	Maximum              *float64
+	MaxItems             *int
+	MaxLength            *int
+	Minimum              *float64
+	MinItems             *int
+	MinLength            *int
+	Pattern              *regexp.Regexp
+	Properties           map[string]*FrontMatterSchema
+	Required             []string
+	Types                []string
+}
+
+// frontMatterSchemaJSON is the JSON representation of FrontMatterSchema.
+type frontMatterSchemaJSON struct {
+	AdditionalProperties *FrontMatterSchema            `json:"additionalProperties"`
+	Const                *interface{}                  `json:"const"`
+	Enum                 []interface{}                 `json:"enum"`
+	Items                *FrontMatterSchema            `json:"items"`
+	Maximum              *float64                      `json:"maximum"`
+	MaxItems             *int                          `json:"maxItems"`
+	MaxLength            *int                          `json:"maxLength"`
+	Minimum              *float64                      `json:"minimum"`
+	MinItems             *int                          `json:"minItems"`
+	MinLength            *int                          `json:"minLength"`
+	Pattern              *string                       `json:"pattern"`
+	Properties           map[string]*FrontMatterSchema `json:"properties"`
+	Required             []string                      `json:"required"`
+	Type                 json.RawMessage               `json:"type"`
+}
+
+// ParseFrontMatterSchema returns the JSON Schema of the content.
+func ParseFrontMatterSchema(content []byte) (*FrontMatterSchema, error) {
+	var schema FrontMatterSchema
+
+	if err := json.Unmarshal(content, &schema); err != nil {
+		return nil, fmt.Errorf("error parsing JSON Schema: %w", err)
+	}
+
+	return &schema, nil
+}
+
+func (s *FrontMatterSchema) UnmarshalJSON(data []byte) error {
+	var boolean bool
+
+	if err := json.Unmarshal(data, &boolean); err == nil {
+		s.Boolean = &boolean
+
+		return nil
+	}
+
+	var keywords map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &keywords); err != nil {
+		return err
+	}
+
+	for _, keyword := range frontMatterSchemaUnsupportedKeywords {
+		if _, ok := keywords[keyword]; ok {
+			return fmt.Errorf("unsupported JSON Schema keyword: %s", keyword)
+		}
+	}
+
+	var raw frontMatterSchemaJSON
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	s.AdditionalProperties = raw.AdditionalProperties
+	s.Const = raw.Const
+	s.Enum = raw.Enum
+	s.Items = raw.Items
+	s.Maximum = raw.Maximum
+	s.MaxItems = raw.MaxItems
+	s.MaxLength = raw.MaxLength
+	s.Minimum = raw.Minimum
+	s.MinItems = raw.MinItems
+	s.MinLength = raw.MinLength
+	s.Properties = raw.Properties
+	s.Required = raw.Required
+
+	if raw.Pattern != nil {
+		pattern, err := regexp.Compile(*raw.Pattern)
+
+		if err != nil {
+			return fmt.Errorf("invalid pattern (%s): %w", *raw.Pattern, err)
+		}
+
+		s.Pattern = pattern
+	}
+
+	if len(raw.Type) > 0 {
+		var schemaType string
+
+		if err := json.Unmarshal(raw.Type, &schemaType); err == nil {
+			s.Types = []string{schemaType}
+		} else if err := json.Unmarshal(raw.Type, &s.Types); err != nil {
+			return fmt.Errorf("invalid type, should be a string or array of strings: %s", raw.Type)
+		}
+
+		for _, schemaType := range s.Types {
+			switch schemaType {
+			case "array", "boolean", "integer", "null", "number", "object", "string":
+			default:
+				return fmt.Errorf("invalid type: %s", schemaType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error for each value of the YAML frontmatter, including
+// nested values, which does not match the schema.
+func (s *FrontMatterSchema) Validate(src []byte) []error {
+	var value interface{}
+
+	if err := yaml.Unmarshal(src, &value); err != nil {
+		return []error{fmt.Errorf("error parsing YAML frontmatter: %w", err)}
+	}
+
+	// Frontmatter without keys is an empty object.
+	if value == nil {
+		value = map[string]interface{}{}
+	}
+
+	return s.validate(frontMatterSchemaValue(value), "")
+}
+
+// frontMatterSchemaValue converts the YAML value into its JSON equivalent,
+// e.g. map[string]interface{} objects and float64 numbers, for comparison
+// with enum and const values.
+func frontMatterSchemaValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(value))
+
+		for key, v := range value {
+			result[fmt.Sprint(key)] = frontMatterSchemaValue(v)
+		}
+
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+
+		for index, v := range value {
+			result[index] = frontMatterSchemaValue(v)
+		}
+
+		return result
+	case int:
+		return float64(value)
+	case int64:
+		return float64(value)
+	case uint64:
+		return float64(value)
+	default:
+		return value
+	}
+}
+
+// validate returns an error for each value, including nested values, which
+// does not match the schema. The path is the key path of the value.
+func (s *FrontMatterSchema) validate(value interface{}, path string) []error {
+	if s == nil {
+		return nil
+	}
+
+	if s.Boolean != nil {
+		if *s.Boolean {
+			return nil
+		}
+
+		return []error{frontMatterSchemaError(path, "not allowed")}
+	}
+
+	if len(s.Types) > 0 && !frontMatterSchemaTypeMatches(s.Types, value) {
+		return []error{frontMatterSchemaError(path, fmt.Sprintf("expected %s, got %s", strings.Join(s.Types, " or "), frontMatterSchemaType(value)))}
+	}
+
+	var errs []error
+
+	if s.Const != nil && !reflect.DeepEqual(*s.Const, value) {
+		errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected %s, got %s", frontMatterSchemaJSONValue(*s.Const), frontMatterSchemaJSONValue(value))))
+	}
+
+	if len(s.Enum) > 0 && !frontMatterSchemaEnumContains(s.Enum, value) {
+		allowed := make([]string, 0, len(s.Enum))
+
+		for _, v := range s.Enum {
+			allowed = append(allowed, frontMatterSchemaJSONValue(v))
+		}
+
+		errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected one of %s, got %s", strings.Join(allowed, ", "), frontMatterSchemaJSONValue(value))))
+	}
+
+	switch value := value.(type) {
+	case []interface{}:
+		if s.MinItems != nil && len(value) < *s.MinItems {
+			errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected at least %d items, got %d", *s.MinItems, len(value))))
+		}
+
+		if s.MaxItems != nil && len(value) > *s.MaxItems {
+			errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected at most %d items, got %d", *s.MaxItems, len(value))))
+		}
+
+		for index, item := range value {
+			errs = append(errs, s.Items.validate(item, fmt.Sprintf("%s[%d]", path, index))...)
+		}
+	case float64:
+		if s.Minimum != nil && value < *s.Minimum {
+			errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected at least %v, got %v", *s.Minimum, value)))
+		}
+
+		if s.Maximum != nil && value > *s.Maximum {
+			errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected at most %v, got %v", *s.Maximum, value)))
+		}
+	case map[string]interface{}:
+		errs = append(errs, s.validateObject(value, path)...)
+	case string:
+		length := utf8.RuneCountInString(value)
+
+		if s.MinLength != nil && length < *s.MinLength {
+			errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected at least %d characters, got %d", *s.MinLength, length)))
+		}
+
+		if s.MaxLength != nil && length > *s.MaxLength {
+			errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("expected at most %d characters, got %d", *s.MaxLength, length)))
+		}
+
+		if s.Pattern != nil && !s.Pattern.MatchString(value) {
+			errs = append(errs, frontMatterSchemaError(path, fmt.Sprintf("%q does not match pattern: %s", value, s.Pattern)))
+		}
+	}
+
+	return errs
+}
+
+// validateObject returns an error for each missing required key, unknown key,
+// or invalid value of the object, in key order.
+func (s *FrontMatterSchema) validateObject(values map[string]interface{}, path string) []error {
+	var errs []error
+
+	required := append([]string(nil), s.Required...)
+	sort.Strings(required)
+
+	for _, key := range required {
+		if _, ok := values[key]; !ok {
+			errs = append(errs, frontMatterSchemaError(frontMatterSchemaKeyPath(path, key), "missing required key"))
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyPath := frontMatterSchemaKeyPath(path, key)
+
+		if propertySchema, ok := s.Properties[key]; ok {
+			errs = append(errs, propertySchema.validate(values[key], keyPath)...)
+			continue
+		}
+
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Boolean != nil && !*s.AdditionalProperties.Boolean {
+			errs = append(errs, frontMatterSchemaError(keyPath, "unknown key"))
+			continue
+		}
+
+		errs = append(errs, s.AdditionalProperties.validate(values[key], keyPath)...)
+	}
+
+	return errs
+}
+
+func frontMatterSchemaEnumContains(enum []interface{}, value interface{}) bool {
+	for _, v := range enum {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func frontMatterSchemaError(path string, message string) error {
+	if path == "" {
+		path = "(root)"
+	}
+
+	return fmt.Errorf("%s: %s", path, message)
+}
+
+// frontMatterSchemaJSONValue returns the value as JSON for error messages.
+func frontMatterSchemaJSONValue(value interface{}) string {
+	var b bytes.Buffer
+
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func frontMatterSchemaKeyPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func frontMatterSchemaType(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if value == math.Trunc(value) {
+			return "integer"
+		}
+
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func frontMatterSchemaTypeMatches(types []string, value interface{}) bool {
+	actual := frontMatterSchemaType(value)
+
+	for _, expected := range types {
+		if expected == actual || (expected == "number" && actual == "integer") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,165 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+const testFrontMatterSchema = `{
+  "type": "object",
+  "required": ["page_title", "owner"],
+  "properties": {
+    "owner": {
+      "type": "string",
+      "pattern": "^@[a-z-]+/[a-z-]+$"
+    },
+    "page_title": {"type": "string", "minLength": 1},
+    "stability": {"enum": ["alpha", "beta", "stable"]},
+    "tags": {
+      "type": "array",
+      "items": {"type": "string"},
+      "maxItems": 2
+    },
+    "version": {"type": ["integer", "null"], "minimum": 1}
+  },
+  "additionalProperties": false
+}`
+
+func TestParseFrontMatterSchema(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Content     string
+		ExpectError string
+	}{
+		{
+			Name:    "valid",
+			Content: testFrontMatterSchema,
+		},
+		{
+			Name:    "boolean",
+			Content: `true`,
+		},
+		{
+			Name:        "invalid JSON",
+			Content:     `{`,
+			ExpectError: "error parsing JSON Schema: unexpected end of JSON input",
+		},
+		{
+			Name:        "invalid pattern",
+			Content:     `{"properties": {"owner": {"pattern": "("}}}`,
+			ExpectError: "invalid pattern (():",
+		},
+		{
+			Name:        "invalid type",
+			Content:     `{"type": "map"}`,
+			ExpectError: "invalid type: map",
+		},
+		{
+			Name:        "unsupported keyword",
+			Content:     `{"properties": {"owner": {"anyOf": [{"type": "string"}]}}}`,
+			ExpectError: "unsupported JSON Schema keyword: anyOf",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := ParseFrontMatterSchema([]byte(testCase.Content))
+
+			if err == nil && testCase.ExpectError != "" {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && (testCase.ExpectError == "" || !strings.Contains(err.Error(), testCase.ExpectError)) {
+				t.Fatalf("expected error %q, got: %s", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
+func TestFrontMatterSchemaValidate(t *testing.T) {
+	schema, err := ParseFrontMatterSchema([]byte(testFrontMatterSchema))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name         string
+		Source       string
+		ExpectErrors []string
+	}{
+		{
+			Name: "valid",
+			Source: `
+owner: "@example/team"
+page_title: "Example"
+stability: beta
+tags:
+  - networking
+version: 2
+`,
+		},
+		{
+			Name: "valid null",
+			Source: `
+owner: "@example/team"
+page_title: "Example"
+version: null
+`,
+		},
+		{
+			Name:   "empty",
+			Source: ``,
+			ExpectErrors: []string{
+				"owner: missing required key",
+				"page_title: missing required key",
+			},
+		},
+		{
+			Name: "invalid values",
+			Source: `
+layout: "example"
+owner: "example"
+page_title: ""
+stability: deprecated
+tags:
+  - networking
+  - 1
+  - storage
+version: 0.5
+`,
+			ExpectErrors: []string{
+				"layout: unknown key",
+				`owner: "example" does not match pattern: ^@[a-z-]+/[a-z-]+$`,
+				"page_title: expected at least 1 characters, got 0",
+				`stability: expected one of "alpha", "beta", "stable", got "deprecated"`,
+				"tags: expected at most 2 items, got 3",
+				"tags[1]: expected string, got integer",
+				"version: expected integer or null, got number",
+			},
+		},
+		{
+			Name:   "not an object",
+			Source: `- owner`,
+			ExpectErrors: []string{
+				"(root): expected object, got array",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			errs := schema.Validate([]byte(testCase.Source))
+
+			var got []string
+
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.ExpectErrors, "\n") {
+				t.Errorf("expected errors:\n%s\n\ngot:\n%s", strings.Join(testCase.ExpectErrors, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package check
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// FileSkippedChecks returns the identifiers of checks skipped by the
+// tfproviderdocs skip YAML frontmatter of the documentation source, if any.
+func FileSkippedChecks(src []byte) []string {
+	frontMatter, err := ParseFrontMatter(src)
+
+	if err != nil || frontMatter.Tfproviderdocs == nil {
+		return nil
+	}
+
+	return frontMatter.Tfproviderdocs.Skip
+}
+
+// isSkippedCheck returns true if the check is skipped. Skipping a check also
+// skips the checks prefixed with its identifier, e.g. contents also skips
+// contents-schema-ordering.
+func isSkippedCheck(skipped []string, id string) bool {
+	for _, skip := range skipped {
+		if id == skip || strings.HasPrefix(id, skip+"-") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeSkippedFindings returns the result without the findings of checks
+// skipped by the YAML frontmatter of their documentation file, which are
+// counted as ignored findings in the summary. Files are read relative to the
+// base path.
+func (check *Check) removeSkippedFindings(result *multierror.Error) *multierror.Error {
+	if result == nil {
+		return nil
+	}
+
+	skippedChecks := make(map[string][]string)
+	var filtered *multierror.Error
+
+	for _, err := range result.Errors {
+		var finding *Finding
+
+		if !errors.As(err, &finding) || finding.Path == "" {
+			filtered = multierror.Append(filtered, err)
+			continue
+		}
+
+		skipped, ok := skippedChecks[finding.Path]
+
+		if !ok {
+			fileOpts := &FileOptions{
+				BasePath: check.Options.BasePath,
+				FS:       check.Options.FS,
+			}
+
+			// Findings may be reported for directories or unreadable files,
+			// which cannot skip checks.
+			if src, err := fileOpts.ReadFile(finding.Path); err == nil {
+				skipped = FileSkippedChecks(src)
+			}
+
+			skippedChecks[finding.Path] = skipped
+		}
+
+		if isSkippedCheck(skipped, finding.CheckID) {
+			check.Options.Log().Debug("Ignoring finding of check skipped by YAML frontmatter", "check", finding.CheckID, "file", finding.Path)
+			check.Summary.IgnoredFindings++
+			continue
+		}
+
+		filtered = multierror.Append(filtered, err)
+	}
+
+	return filtered
+}
@@ -0,0 +1,146 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFileSkippedChecks(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Source string
+		Expect []string
+	}{
+		{
+			Name: "no frontmatter",
+			Source: `
+# Resource: example_thing
+`,
+		},
+		{
+			Name: "no tfproviderdocs",
+			Source: `
+---
+subcategory: Example
+---
+`,
+		},
+		{
+			Name: "flow style",
+			Source: `
+---
+subcategory: Example
+tfproviderdocs: { skip: [contents, frontmatter] }
+---
+`,
+			Expect: []string{CheckIDContents, CheckIDFrontMatter},
+		},
+		{
+			Name: "block style",
+			Source: `
+---
+tfproviderdocs:
+  skip:
+    - contents-schema-ordering
+---
+`,
+			Expect: []string{CheckIDContentsSchemaOrdering},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FileSkippedChecks([]byte(testCase.Source))
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestIsSkippedCheck(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Skipped []string
+		ID      string
+		Expect  bool
+	}{
+		{
+			Name: "none skipped",
+			ID:   CheckIDContents,
+		},
+		{
+			Name:    "exact",
+			Skipped: []string{CheckIDContentsSchemaOrdering},
+			ID:      CheckIDContentsSchemaOrdering,
+			Expect:  true,
+		},
+		{
+			Name:    "prefix",
+			Skipped: []string{CheckIDContents},
+			ID:      CheckIDContentsSchemaOrdering,
+			Expect:  true,
+		},
+		{
+			Name:    "other check",
+			Skipped: []string{CheckIDContentsSchemaOrdering},
+			ID:      CheckIDContents,
+		},
+		{
+			Name:    "frontmatter prefix",
+			Skipped: []string{CheckIDFrontMatter},
+			ID:      CheckIDFrontMatterPageTitle,
+			Expect:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isSkippedCheck(testCase.Skipped, testCase.ID); got != testCase.Expect {
+				t.Errorf("expected %t, got %t", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestCheck_frontMatterSkip(t *testing.T) {
+	basePath := "testdata/frontmatter-skip"
+	fileOpts := &FileOptions{
+		BasePath: basePath,
+	}
+
+	opts := &CheckOptions{
+		BasePath: basePath,
+		Checks: &CheckSelection{
+			Enable: []string{CheckIDFrontMatter},
+		},
+		RegistryResourceFile: &RegistryResourceFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &FrontMatterOptions{
+				RequireSubcategory: true,
+			},
+		},
+	}
+
+	directories, err := GetDirectories(basePath, nil)
+
+	if err != nil {
+		t.Fatalf("error getting directories for path (%s): %s", basePath, err)
+	}
+
+	docsCheck := NewCheck(opts)
+	findings, errs := Findings(docsCheck.Run(directories))
+
+	if len(errs) > 0 {
+		t.Fatalf("expected only findings, got errors: %v", errs)
+	}
+
+	if len(findings) != 1 || findings[0].Path != "docs/resources/thing.md" {
+		t.Errorf("expected docs/resources/thing.md finding, got: %v", findings)
+	}
+
+	if docsCheck.Summary.IgnoredFindings != 1 {
+		t.Errorf("expected 1 ignored finding, got: %d", docsCheck.Summary.IgnoredFindings)
+	}
+}
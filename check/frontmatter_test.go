@@ -48,6 +48,47 @@ Extraneous newline
 `,
 			ExpectError: true,
 		},
+		{
+			Name: "tfproviderdocs skip",
+			Source: `
+subcategory: Example Subcategory
+tfproviderdocs: { skip: [contents, contents-schema-ordering] }
+`,
+		},
+		{
+			Name: "tfproviderdocs skip invalid check identifier",
+			Source: `
+subcategory: Example Subcategory
+tfproviderdocs:
+  skip:
+    - schema-ordering
+`,
+			ExpectError: true,
+		},
+		{
+			Name: "empty subcategory",
+			Source: `
+page_title: Example Page Title
+subcategory: ""
+`,
+		},
+		{
+			Name: "whitespace subcategory",
+			Source: `
+page_title: Example Page Title
+subcategory: " "
+`,
+		},
+		{
+			Name: "empty subcategory with allowed subcategories",
+			Source: `
+page_title: Example Page Title
+subcategory: ""
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories: []string{"", "Example Subcategory"},
+			},
+		},
 		{
 			Name: "allowed subcategory option matching",
 			Source: `
@@ -75,6 +116,63 @@ subcategory: Example Subcategory
 			},
 			ExpectError: true,
 		},
+		{
+			Name: "allowed subcategory option not matching case",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcategory: example subcategory
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories: []string{"Example Subcategory"},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "allowed subcategory option normalized case",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcategory: example subcategory
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories:   []string{"Example Subcategory"},
+				NormalizeSubcategories: true,
+			},
+		},
+		{
+			Name: "allowed subcategory option normalized whitespace",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcategory: " Example Subcategory "
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories:   []string{"Example Subcategory"},
+				NormalizeSubcategories: true,
+			},
+		},
+		{
+			Name: "allowed subcategory option normalized not matching",
+			Source: `
+description: |-
+  Example description
+layout: "example"
+page_title: Example Page Title
+subcategory: Example Subcategory
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories:   []string{"Another Subcategory"},
+				NormalizeSubcategories: true,
+			},
+			ExpectError: true,
+		},
 		{
 			Name: "no description option",
 			Source: `
@@ -146,6 +244,17 @@ subcategory: Example Subcategory
 			},
 			ExpectError: true,
 		},
+		{
+			Name: "no subcategory option with empty subcategory",
+			Source: `
+layout: "example"
+page_title: Example Page Title
+subcategory: ""
+`,
+			Options: &FrontMatterOptions{
+				NoSubcategory: true,
+			},
+		},
 		{
 			Name: "require description option",
 			Source: `
@@ -197,6 +306,74 @@ page_title: Example Page Title
 			},
 			ExpectError: true,
 		},
+		{
+			Name: "forbid keys option not present",
+			Source: `
+description: |-
+  Example description
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				ForbidKeys: []string{"layout", "sidebar_current"},
+			},
+		},
+		{
+			Name: "forbid keys option present",
+			Source: `
+description: |-
+  Example description
+page_title: Example Page Title
+sidebar_current: "docs-example"
+`,
+			Options: &FrontMatterOptions{
+				ForbidKeys: []string{"layout", "sidebar_current"},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "require keys option present",
+			Source: `
+description: |-
+  Example description
+owner: "@example/team"
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				RequireKeys: []string{"description", "owner"},
+			},
+		},
+		{
+			Name: "require keys option missing",
+			Source: `
+description: |-
+  Example description
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				RequireKeys: []string{"description", "owner"},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "schema option matching",
+			Source: `
+owner: "@example/team"
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				Schema: testFrontMatterCheckSchema(t),
+			},
+		},
+		{
+			Name: "schema option not matching",
+			Source: `
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				Schema: testFrontMatterCheckSchema(t),
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -213,3 +390,149 @@ page_title: Example Page Title
 		})
 	}
 }
+
+func TestFrontMatterCheckSuggestion(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Source           string
+		Options          *FrontMatterOptions
+		ExpectSuggestion string
+	}{
+		{
+			Name: "missing subcategory",
+			Source: `
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories: []string{"Example Subcategory"},
+				RequireSubcategory:   true,
+			},
+			ExpectSuggestion: "add an allowed subcategory to the YAML frontmatter, e.g. `subcategory: \"Example Subcategory\"`",
+		},
+		{
+			Name: "allowed subcategory not matching case",
+			Source: `
+subcategory: example subcategory
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories: []string{"Example Subcategory"},
+			},
+			ExpectSuggestion: "change the YAML frontmatter to `subcategory: \"Example Subcategory\"` or use the -normalize-subcategories flag",
+		},
+		{
+			Name: "no layout",
+			Source: `
+layout: "example"
+`,
+			Options: &FrontMatterOptions{
+				NoLayout: true,
+			},
+			ExpectSuggestion: "remove `layout` from the YAML frontmatter",
+		},
+		{
+			Name: "forbidden keys",
+			Source: `
+layout: "example"
+sidebar_current: "docs-example"
+`,
+			Options: &FrontMatterOptions{
+				ForbidKeys: []string{"layout", "sidebar_current"},
+			},
+			ExpectSuggestion: "remove `layout`, `sidebar_current` from the YAML frontmatter",
+		},
+		{
+			Name: "missing required key",
+			Source: `
+page_title: Example Page Title
+`,
+			Options: &FrontMatterOptions{
+				RequireKeys: []string{"page_title", "owner"},
+			},
+			ExpectSuggestion: "add `owner: \"...\"` to the YAML frontmatter",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := ErrorSuggestion(NewFrontMatterCheck(testCase.Options).Run([]byte(testCase.Source)))
+
+			if got != testCase.ExpectSuggestion {
+				t.Errorf("expected suggestion %q, got: %q", testCase.ExpectSuggestion, got)
+			}
+		})
+	}
+}
+
+func TestFrontMatterCheck_syntax(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Source      string
+		ExpectError string
+		ExpectLine  int
+	}{
+		{
+			Name:   "valid",
+			Source: "---\nsubcategory: Example\ntfproviderdocs:\n  skip: [contents]\n---\n\n# Markdown\n",
+		},
+		{
+			Name:        "missing closing delimiter",
+			Source:      "---\nsubcategory: Example\n\n# Markdown\n",
+			ExpectError: "YAML frontmatter opened on line 1 is missing the closing --- delimiter",
+			ExpectLine:  1,
+		},
+		{
+			Name:        "tab indentation",
+			Source:      "---\ndescription: |-\n\tExample description\n\tcontinued\n---\n",
+			ExpectError: "YAML frontmatter indented with tabs (lines 3, 4)",
+			ExpectLine:  3,
+		},
+		{
+			Name:        "duplicate keys",
+			Source:      "---\nsubcategory: First\npage_title: Example\nsubcategory: Second\n---\n",
+			ExpectError: "YAML frontmatter contains duplicate key(s): subcategory (line 4)",
+			ExpectLine:  4,
+		},
+		{
+			Name:        "duplicate nested keys",
+			Source:      "---\ntfproviderdocs:\n  skip: [contents]\n  skip: [rules]\n---\n",
+			ExpectError: "YAML frontmatter contains duplicate key(s): skip (line 4)",
+			ExpectLine:  4,
+		},
+		{
+			Name:   "duplicate keys after frontmatter",
+			Source: "---\nsubcategory: Example\n---\n\nsubcategory: Example\nsubcategory: Example\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := NewFrontMatterCheck(nil).Run([]byte(testCase.Source))
+
+			var got string
+
+			if err != nil {
+				got = err.Error()
+			}
+
+			if got != testCase.ExpectError {
+				t.Errorf("expected error %q, got: %q", testCase.ExpectError, got)
+			}
+
+			if line := ErrorLine(err); line != testCase.ExpectLine {
+				t.Errorf("expected line %d, got: %d", testCase.ExpectLine, line)
+			}
+		})
+	}
+}
+
+func testFrontMatterCheckSchema(t *testing.T) *FrontMatterSchema {
+	t.Helper()
+
+	schema, err := ParseFrontMatterSchema([]byte(testFrontMatterSchema))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return schema
+}
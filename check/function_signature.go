@@ -0,0 +1,335 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark/ast"
+)
+
+var (
+	// functionArgumentRegexp matches a terraform-plugin-docs function
+	// argument list item, e.g. `input` (String) Description or `inputs`
+	// (Variadic, String) Description.
+	functionArgumentRegexp = regexp.MustCompile("^`([^`]+)`\\s*\\(([^)]*)\\)")
+
+	// functionSignatureRegexp matches a terraform-plugin-docs function
+	// signature, e.g. parse(input string, inputs string...) object.
+	functionSignatureRegexp = regexp.MustCompile(`^(\S+)\((.*)\)\s*(.*)$`)
+)
+
+// FunctionSignatureOptions represents configuration options for FunctionSignature.
+type FunctionSignatureOptions struct {
+	*FileOptions
+
+	// Schemas contains the provider function signatures, keyed by function name.
+	Schemas map[string]*tfjson.FunctionSignature
+}
+
+// FunctionSignatureCheck verifies the signature and arguments documented in
+// provider function documentation match the providers schema.
+type FunctionSignatureCheck struct {
+	Options *FunctionSignatureOptions
+}
+
+// functionParameter represents a documented function parameter.
+type functionParameter struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+func NewFunctionSignatureCheck(opts *FunctionSignatureOptions) *FunctionSignatureCheck {
+	check := &FunctionSignatureCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &FunctionSignatureOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each function documentation file with a matching function in
+// the providers schema.
+func (check *FunctionSignatureCheck) Run(directories map[string][]string) error {
+	if len(check.Options.Schemas) == 0 {
+		check.Options.Log().Debug("Skipping function signature checks due to missing schemas", "check", CheckIDFunctionSignature)
+
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryDocumentationType(directory) != DocumentationTypeFunction {
+			continue
+		}
+
+		for _, file := range files {
+			name := TrimFileExtension(filepath.Base(file))
+			signature, ok := check.Options.Schemas[name]
+
+			if !ok {
+				continue
+			}
+
+			if err := check.RunFile(file, name, signature); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the function documentation file Signature and Arguments
+// sections against the function signature.
+func (check *FunctionSignatureCheck) RunFile(path string, name string, signature *tfjson.FunctionSignature) error {
+	check.Options.Log().Debug("Checking function documentation file", "check", CheckIDFunctionSignature, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	var result *multierror.Error
+
+	expected := functionSchemaParameters(signature)
+	documentedSignature, arguments := functionDocumentSections(content)
+
+	if documentedSignature == "" {
+		result = multierror.Append(result, fmt.Errorf("missing Signature section code block: %s", functionSignatureText(name, signature)))
+	} else if err := checkFunctionSignature(documentedSignature, name, signature, expected); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := checkFunctionArguments(arguments, expected); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		return NewFinding(CheckIDFunctionSignature, path, fmt.Errorf("%s: error checking function signature: %w", path, err))
+	}
+
+	return nil
+}
+
+// checkFunctionSignature verifies the documented signature name, parameters,
+// and return type.
+func checkFunctionSignature(text string, name string, signature *tfjson.FunctionSignature, expected []functionParameter) error {
+	expectedText := functionSignatureText(name, signature)
+	match := functionSignatureRegexp.FindStringSubmatch(text)
+
+	if match == nil {
+		return fmt.Errorf("signature (%s) should be: %s", text, expectedText)
+	}
+
+	if match[1] != name {
+		return fmt.Errorf("signature function name (%s) should be: %s", match[1], name)
+	}
+
+	var documented []functionParameter
+
+	for _, parameter := range strings.Split(match[2], ",") {
+		parameter = strings.TrimSpace(parameter)
+
+		if parameter == "" {
+			continue
+		}
+
+		parameterName, parameterType, _ := strings.Cut(parameter, " ")
+		parameterType = strings.TrimSpace(parameterType)
+
+		documented = append(documented, functionParameter{
+			Name:     parameterName,
+			Type:     strings.TrimSuffix(parameterType, "..."),
+			Variadic: strings.HasSuffix(parameterType, "..."),
+		})
+	}
+
+	if err := compareFunctionParameters("signature", documented, expected); err != nil {
+		return err
+	}
+
+	if returnType := signature.ReturnType.FriendlyName(); !strings.EqualFold(match[3], returnType) {
+		return fmt.Errorf("signature return type (%s) should be: %s", match[3], returnType)
+	}
+
+	return nil
+}
+
+// checkFunctionArguments verifies the documented Arguments section list
+// items, which are omitted for functions without parameters.
+func checkFunctionArguments(items []string, expected []functionParameter) error {
+	if len(items) == 0 && len(expected) == 0 {
+		return nil
+	}
+
+	var documented []functionParameter
+
+	for _, item := range items {
+		match := functionArgumentRegexp.FindStringSubmatch(item)
+
+		if match == nil {
+			return fmt.Errorf("arguments section list item (%s) should be in form: `name` (Type) Description", item)
+		}
+
+		parameter := functionParameter{
+			Name: match[1],
+			Type: strings.TrimSpace(match[2]),
+		}
+
+		if strings.HasPrefix(parameter.Type, "Variadic,") {
+			parameter.Type = strings.TrimSpace(strings.TrimPrefix(parameter.Type, "Variadic,"))
+			parameter.Variadic = true
+		}
+
+		documented = append(documented, parameter)
+	}
+
+	return compareFunctionParameters("arguments section", documented, expected)
+}
+
+// compareFunctionParameters verifies the documented parameters match the
+// expected parameters in order. Types are compared case insensitively, since
+// argument lists capitalize types (e.g. String) while signatures do not.
+func compareFunctionParameters(location string, documented []functionParameter, expected []functionParameter) error {
+	if len(documented) != len(expected) {
+		return fmt.Errorf("%s parameters (%d) should be: %d (%s)", location, len(documented), len(expected), functionParameterNames(expected))
+	}
+
+	for i, parameter := range documented {
+		expectedParameter := expected[i]
+
+		if parameter.Name != expectedParameter.Name {
+			return fmt.Errorf("%s parameter %d name (%s) should be: %s", location, i+1, parameter.Name, expectedParameter.Name)
+		}
+
+		if !strings.EqualFold(parameter.Type, expectedParameter.Type) {
+			return fmt.Errorf("%s parameter (%s) type (%s) should be: %s", location, parameter.Name, parameter.Type, expectedParameter.Type)
+		}
+
+		if parameter.Variadic != expectedParameter.Variadic {
+			if expectedParameter.Variadic {
+				return fmt.Errorf("%s parameter (%s) should be variadic", location, parameter.Name)
+			}
+
+			return fmt.Errorf("%s parameter (%s) should not be variadic", location, parameter.Name)
+		}
+	}
+
+	return nil
+}
+
+// functionDocumentSections returns the Signature section code block text and
+// the Arguments section list item texts.
+func functionDocumentSections(source []byte) (string, []string) {
+	document, _ := markdown.Parse(source)
+
+	var section string
+	var signature string
+	var arguments []string
+
+	for node := document.FirstChild(); node != nil; node = node.NextSibling() {
+		switch node := node.(type) {
+		case *ast.Heading:
+			if node.Level <= 2 {
+				section = string(node.Text(source))
+			}
+		case *ast.FencedCodeBlock:
+			if section == "Signature" && signature == "" {
+				signature = strings.TrimSpace(markdown.FencedCodeBlockText(node, source))
+			}
+		case *ast.List:
+			if section != "Arguments" {
+				continue
+			}
+
+			for item := node.FirstChild(); item != nil; item = item.NextSibling() {
+				if block := item.FirstChild(); block != nil {
+					arguments = append(arguments, strings.TrimSpace(nodeLinesText(block, source)))
+				}
+			}
+		}
+	}
+
+	return signature, arguments
+}
+
+// functionSchemaParameters returns the expected parameters of the function
+// signature, including any variadic parameter last.
+func functionSchemaParameters(signature *tfjson.FunctionSignature) []functionParameter {
+	var result []functionParameter
+
+	for _, parameter := range signature.Parameters {
+		result = append(result, functionParameter{
+			Name: parameter.Name,
+			Type: parameter.Type.FriendlyName(),
+		})
+	}
+
+	if parameter := signature.VariadicParameter; parameter != nil {
+		result = append(result, functionParameter{
+			Name:     parameter.Name,
+			Type:     parameter.Type.FriendlyName(),
+			Variadic: true,
+		})
+	}
+
+	return result
+}
+
+func functionParameterNames(parameters []functionParameter) string {
+	names := make([]string, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		names = append(names, parameter.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// functionSignatureText returns the signature as rendered by
+// terraform-plugin-docs, e.g. parse(input string, inputs string...) object.
+func functionSignatureText(name string, signature *tfjson.FunctionSignature) string {
+	var parameters []string
+
+	for _, parameter := range functionSchemaParameters(signature) {
+		text := parameter.Name + " " + parameter.Type
+
+		if parameter.Variadic {
+			text += "..."
+		}
+
+		parameters = append(parameters, text)
+	}
+
+	return fmt.Sprintf("%s(%s) %s", name, strings.Join(parameters, ", "), signature.ReturnType.FriendlyName())
+}
+
+// nodeLinesText returns the source text of the block node lines, which unlike
+// Text preserves inline markup such as code spans.
+func nodeLinesText(node ast.Node, source []byte) string {
+	var builder strings.Builder
+
+	lines := node.Lines()
+
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		builder.Write(segment.Value(source))
+	}
+
+	return builder.String()
+}
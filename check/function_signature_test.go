@@ -0,0 +1,150 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestFunctionSignatureCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Options     *FunctionSignatureOptions
+		ExpectPaths []string
+	}{
+		{
+			Name:    "no schemas",
+			Options: &FunctionSignatureOptions{},
+		},
+		{
+			Name: "passing",
+			Options: &FunctionSignatureOptions{
+				Schemas: map[string]*tfjson.FunctionSignature{
+					"parse": testFunctionSignatureParse(),
+				},
+			},
+		},
+		{
+			Name: "drift and missing signature",
+			Options: &FunctionSignatureOptions{
+				Schemas: map[string]*tfjson.FunctionSignature{
+					"drift": {
+						Parameters: []*tfjson.FunctionParameter{
+							{
+								Name: "value",
+								Type: cty.Number,
+							},
+						},
+						ReturnType: cty.String,
+					},
+					"missing_signature": {
+						ReturnType: cty.Bool,
+					},
+					"parse": testFunctionSignatureParse(),
+				},
+			},
+			ExpectPaths: []string{
+				"docs/functions/drift.md",
+				"docs/functions/missing_signature.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/function-signature",
+			}
+
+			got := testFindingPaths(testCheckFindings(t, testCase.Options.BasePath, NewFunctionSignatureCheck(testCase.Options).Run, CheckIDFunctionSignature))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
+
+func TestCheckFunctionSignature(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Text        string
+		ExpectError bool
+	}{
+		{
+			Name: "passing",
+			Text: "parse(input string, options string...) object",
+		},
+		{
+			Name:        "invalid format",
+			Text:        "parse input string",
+			ExpectError: true,
+		},
+		{
+			Name:        "function name",
+			Text:        "decode(input string, options string...) object",
+			ExpectError: true,
+		},
+		{
+			Name:        "parameter name",
+			Text:        "parse(value string, options string...) object",
+			ExpectError: true,
+		},
+		{
+			Name:        "parameter type",
+			Text:        "parse(input number, options string...) object",
+			ExpectError: true,
+		},
+		{
+			Name:        "missing parameter",
+			Text:        "parse(input string) object",
+			ExpectError: true,
+		},
+		{
+			Name:        "missing variadic",
+			Text:        "parse(input string, options string) object",
+			ExpectError: true,
+		},
+		{
+			Name:        "return type",
+			Text:        "parse(input string, options string...) string",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			signature := testFunctionSignatureParse()
+			err := checkFunctionSignature(testCase.Text, "parse", signature, functionSchemaParameters(signature))
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+		})
+	}
+}
+
+func testFunctionSignatureParse() *tfjson.FunctionSignature {
+	return &tfjson.FunctionSignature{
+		Parameters: []*tfjson.FunctionParameter{
+			{
+				Name: "input",
+				Type: cty.String,
+			},
+		},
+		ReturnType: cty.EmptyObject,
+		VariadicParameter: &tfjson.FunctionParameter{
+			Name: "options",
+			Type: cty.String,
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// GuideFilenameSeparatorDash requires guide file names to separate words
+	// with dashes, e.g. getting-started.md.
+	GuideFilenameSeparatorDash = "dash"
+
+	// GuideFilenameSeparatorUnderscore requires guide file names to separate
+	// words with underscores, e.g. getting_started.md.
+	GuideFilenameSeparatorUnderscore = "underscore"
+)
+
+// GuideFilenameSeparators contains all available guide file name separators.
+var GuideFilenameSeparators = []string{
+	GuideFilenameSeparatorDash,
+	GuideFilenameSeparatorUnderscore,
+}
+
+var (
+	// guideFilenameInvalidRegexp matches characters of guide file names other
+	// than lowercase letters, numbers, dashes, underscores, and spaces, which
+	// are reported separately.
+	guideFilenameInvalidRegexp = regexp.MustCompile(`[^a-z0-9_\- ]`)
+
+	// guideFilenameSlugRegexp matches runs of characters which are not
+	// lowercase letters or numbers, which are a single dash in slugs.
+	guideFilenameSlugRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// GuideFilenamesOptions represents configuration options for GuideFilenames.
+type GuideFilenamesOptions struct {
+	*FileOptions
+
+	// Enable enables the guide-filenames check.
+	Enable bool
+
+	// Separator is the required word separator of guide file names
+	// (GuideFilenameSeparatorDash or GuideFilenameSeparatorUnderscore). If
+	// empty, the separator used by most guides is required.
+	Separator string
+}
+
+// GuideFilenamesCheck verifies guide file names, which are the guide URL path
+// in the Terraform Registry, are lowercase, contain no spaces, consistently
+// separate words with dashes or underscores, and do not collide after
+// normalization.
+type GuideFilenamesCheck struct {
+	Options *GuideFilenamesOptions
+}
+
+func NewGuideFilenamesCheck(opts *GuideFilenamesOptions) *GuideFilenamesCheck {
+	check := &GuideFilenamesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &GuideFilenamesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the file names of guides in each guides directory, except CDKTF
+// and localized documentation, whose file names follow the guides they are
+// generated or translated from.
+func (check *GuideFilenamesCheck) Run(directories map[string][]string) error {
+	var guides []string
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		if DirectoryDocumentationType(directory) != DocumentationTypeGuide {
+			continue
+		}
+
+		guides = append(guides, files...)
+	}
+
+	if len(guides) == 0 {
+		return nil
+	}
+
+	sort.Strings(guides)
+
+	separator := check.separator(guides)
+
+	check.Options.Log().Debug("Checking guide file names", "check", CheckIDGuideFilenames, "separator", separator)
+
+	// Guides are keyed by directory and slug to find collisions, which are
+	// reported for all but the first guide in path order.
+	slugs := make(map[string]string)
+	var result *multierror.Error
+
+	for _, file := range guides {
+		base := filepath.Base(file)
+		name := TrimFileExtension(base)
+		slugKey := filepath.Join(filepath.Dir(file), guideFilenameSlug(name))
+
+		var messages []string
+
+		if strings.Contains(name, " ") {
+			messages = append(messages, "contains spaces")
+		}
+
+		if strings.ToLower(name) != name {
+			messages = append(messages, "contains uppercase letters")
+		}
+
+		if guideFilenameInvalidRegexp.MatchString(strings.ToLower(name)) {
+			messages = append(messages, "contains characters other than lowercase letters, numbers, dashes, and underscores")
+		}
+
+		switch {
+		case separator == GuideFilenameSeparatorDash && strings.Contains(name, "_"):
+			messages = append(messages, "separates words with underscores instead of dashes")
+		case separator == GuideFilenameSeparatorUnderscore && strings.Contains(name, "-"):
+			messages = append(messages, "separates words with dashes instead of underscores")
+		}
+
+		suggestion := fmt.Sprintf("rename the file to %s", guideFilenameSuggestion(name, separator)+strings.TrimPrefix(base, name))
+
+		if other, ok := slugs[slugKey]; ok {
+			messages = append(messages, fmt.Sprintf("collides with guide (%s) after Terraform Registry URL normalization", other))
+			suggestion = fmt.Sprintf("rename or combine the guide with %s", other)
+		} else {
+			slugs[slugKey] = file
+		}
+
+		if len(messages) == 0 {
+			continue
+		}
+
+		err := fmt.Errorf("%s: guide file name (%s) %s", file, base, strings.Join(messages, ", "))
+		result = multierror.Append(result, NewFinding(CheckIDGuideFilenames, file, WithSuggestion(err, suggestion)))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// separator returns the configured separator, otherwise the separator used by
+// most of the guides, preferring dashes.
+func (check *GuideFilenamesCheck) separator(guides []string) string {
+	if check.Options.Separator != "" {
+		return check.Options.Separator
+	}
+
+	var dashes, underscores int
+
+	for _, file := range guides {
+		name := TrimFileExtension(filepath.Base(file))
+
+		if strings.Contains(name, "-") {
+			dashes++
+		}
+
+		if strings.Contains(name, "_") {
+			underscores++
+		}
+	}
+
+	if underscores > dashes {
+		return GuideFilenameSeparatorUnderscore
+	}
+
+	return GuideFilenameSeparatorDash
+}
+
+// guideFilenameSlug returns the normalized guide file name, which is
+// lowercased with runs of other characters replaced by a single dash, e.g.
+// getting-started for Getting_Started.
+func guideFilenameSlug(name string) string {
+	return strings.Trim(guideFilenameSlugRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// guideFilenameSuggestion returns the guide file name slug using the separator.
+func guideFilenameSuggestion(name string, separator string) string {
+	slug := guideFilenameSlug(name)
+
+	if separator == GuideFilenameSeparatorUnderscore {
+		return strings.ReplaceAll(slug, "-", "_")
+	}
+
+	return slug
+}
@@ -0,0 +1,163 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestGuideFilenamesCheck(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Directories       map[string][]string
+		Separator         string
+		ExpectErrors      []string
+		ExpectSuggestions []string
+	}{
+		{
+			Name: "valid",
+			Directories: map[string][]string{
+				"docs/guides": {
+					"docs/guides/getting-started.md",
+					"docs/guides/version-2-upgrade.md",
+				},
+				"website/docs/guides": {
+					"website/docs/guides/getting-started.html.markdown",
+				},
+			},
+		},
+		{
+			Name: "spaces and uppercase letters",
+			Directories: map[string][]string{
+				"docs/guides": {
+					"docs/guides/Getting Started.md",
+					"docs/guides/version-2-upgrade.md",
+				},
+			},
+			ExpectErrors: []string{
+				"docs/guides/Getting Started.md: guide file name (Getting Started.md) contains spaces, contains uppercase letters",
+			},
+			ExpectSuggestions: []string{
+				"rename the file to getting-started.md",
+			},
+		},
+		{
+			Name: "invalid characters",
+			Directories: map[string][]string{
+				"docs/guides": {
+					"docs/guides/aws&azure.md",
+				},
+			},
+			ExpectErrors: []string{
+				"docs/guides/aws&azure.md: guide file name (aws&azure.md) contains characters other than lowercase letters, numbers, dashes, and underscores",
+			},
+			ExpectSuggestions: []string{
+				"rename the file to aws-azure.md",
+			},
+		},
+		{
+			Name: "inconsistent separators",
+			Directories: map[string][]string{
+				"docs/guides": {
+					"docs/guides/getting_started.md",
+					"docs/guides/version-2-upgrade.md",
+					"docs/guides/version-3-upgrade.md",
+				},
+			},
+			ExpectErrors: []string{
+				"docs/guides/getting_started.md: guide file name (getting_started.md) separates words with underscores instead of dashes",
+			},
+			ExpectSuggestions: []string{
+				"rename the file to getting-started.md",
+			},
+		},
+		{
+			Name: "inconsistent separators majority underscores",
+			Directories: map[string][]string{
+				"website/docs/guides": {
+					"website/docs/guides/getting_started.html.markdown",
+					"website/docs/guides/version-2-upgrade.html.markdown",
+					"website/docs/guides/version_3_upgrade.html.markdown",
+				},
+			},
+			ExpectErrors: []string{
+				"website/docs/guides/version-2-upgrade.html.markdown: guide file name (version-2-upgrade.html.markdown) separates words with dashes instead of underscores",
+			},
+			ExpectSuggestions: []string{
+				"rename the file to version_2_upgrade.html.markdown",
+			},
+		},
+		{
+			Name: "configured separator",
+			Directories: map[string][]string{
+				"docs/guides": {
+					"docs/guides/getting-started.md",
+					"docs/guides/version-2-upgrade.md",
+				},
+			},
+			Separator: GuideFilenameSeparatorUnderscore,
+			ExpectErrors: []string{
+				"docs/guides/getting-started.md: guide file name (getting-started.md) separates words with dashes instead of underscores",
+				"docs/guides/version-2-upgrade.md: guide file name (version-2-upgrade.md) separates words with dashes instead of underscores",
+			},
+			ExpectSuggestions: []string{
+				"rename the file to getting_started.md",
+				"rename the file to version_2_upgrade.md",
+			},
+		},
+		{
+			Name: "collision",
+			Directories: map[string][]string{
+				"docs/guides": {
+					"docs/guides/getting-started.md",
+					"docs/guides/getting--started.md",
+				},
+			},
+			ExpectErrors: []string{
+				"docs/guides/getting-started.md: guide file name (getting-started.md) collides with guide (docs/guides/getting--started.md) after Terraform Registry URL normalization",
+			},
+			ExpectSuggestions: []string{
+				"rename or combine the guide with docs/guides/getting--started.md",
+			},
+		},
+		{
+			Name: "cdktf and locale directories",
+			Directories: map[string][]string{
+				"docs/cdktf/python/guides": {
+					"docs/cdktf/python/guides/Getting Started.md",
+				},
+				"docs/de/guides": {
+					"docs/de/guides/Getting Started.md",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &GuideFilenamesOptions{
+				Separator: testCase.Separator,
+			}
+
+			findings := testFindings(t, NewGuideFilenamesCheck(opts).Run(testCase.Directories), CheckIDGuideFilenames)
+
+			var got, gotSuggestions []string
+
+			for _, finding := range findings {
+				got = append(got, finding.Error())
+				gotSuggestions = append(gotSuggestions, ErrorSuggestion(finding))
+			}
+
+			sort.Strings(got)
+			sort.Strings(gotSuggestions)
+
+			if !reflect.DeepEqual(got, testCase.ExpectErrors) {
+				t.Errorf("expected: %q, got: %q", testCase.ExpectErrors, got)
+			}
+
+			if !reflect.DeepEqual(gotSuggestions, testCase.ExpectSuggestions) {
+				t.Errorf("expected suggestions: %q, got: %q", testCase.ExpectSuggestions, gotSuggestions)
+			}
+		})
+	}
+}
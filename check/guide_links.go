@@ -0,0 +1,203 @@
+package check
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// GuideLinksOptions represents configuration options for GuideLinks.
+type GuideLinksOptions struct {
+	*FileOptions
+
+	// Enable enables the guide-links check.
+	Enable bool
+
+	ProviderName   string
+	ProviderSource string
+
+	// RequireLinked requires each guide to be reachable from the provider
+	// index documentation, either directly or through other guides.
+	RequireLinked bool
+}
+
+// GuideLinksCheck verifies links from the provider index and guide
+// documentation to guides.
+type GuideLinksCheck struct {
+	Options *GuideLinksOptions
+}
+
+func NewGuideLinksCheck(opts *GuideLinksOptions) *GuideLinksCheck {
+	check := &GuideLinksCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &GuideLinksOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies links of the provider index and guide documentation to guides,
+// either relative paths (e.g. guides/getting_started.md) or Terraform Registry
+// URLs, resolve to existing guide files. If RequireLinked is enabled, guides
+// which are not reachable from the provider index documentation are reported.
+func (check *GuideLinksCheck) Run(directories map[string][]string) error {
+	guides := make(map[string]string)
+	var indexFiles, sources []string
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		switch DirectoryDocumentationType(directory) {
+		case DocumentationTypeGuide:
+			for _, file := range files {
+				guides[TrimFileExtension(file)] = file
+			}
+		case DocumentationTypeIndex:
+			for _, file := range files {
+				if TrimFileExtension(file) == "index" {
+					indexFiles = append(indexFiles, file)
+				}
+			}
+		default:
+			continue
+		}
+
+		for _, file := range files {
+			if FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				sources = append(sources, file)
+			}
+		}
+	}
+
+	sort.Strings(indexFiles)
+	sort.Strings(sources)
+
+	var result *multierror.Error
+	links := make(map[string][]string)
+
+	for _, file := range sources {
+		linkedGuides, err := check.RunFile(file, guides)
+
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		links[file] = linkedGuides
+	}
+
+	if check.Options.RequireLinked && len(indexFiles) > 0 {
+		reachable := make(map[string]bool)
+		queue := append([]string{}, indexFiles...)
+
+		for len(queue) > 0 {
+			file := queue[0]
+			queue = queue[1:]
+
+			for _, name := range links[file] {
+				if reachable[name] {
+					continue
+				}
+
+				reachable[name] = true
+				queue = append(queue, guides[name])
+			}
+		}
+
+		names := make([]string, 0, len(guides))
+
+		for name := range guides {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			if reachable[name] {
+				continue
+			}
+
+			err := WithSuggestion(fmt.Errorf("%s: guide is not linked from the provider index documentation (%s) or its linked guides", guides[name], indexFiles[0]), fmt.Sprintf("link to the guide from %s or a linked guide", indexFiles[0]))
+			result = multierror.Append(result, NewFinding(CheckIDGuideLinks, guides[name], err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the guide links of the documentation file resolve to the
+// guides, which map guide names to their file paths, and returns the names of
+// the linked guides.
+func (check *GuideLinksCheck) RunFile(path string, guides map[string]string) ([]string, error) {
+	check.Options.Log().Debug("Checking documentation file guide links", "check", CheckIDGuideLinks, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	var linkedGuides []string
+	var result *multierror.Error
+
+	for _, destination := range linkDestinations(content) {
+		u, err := url.Parse(destination)
+
+		if err != nil {
+			continue
+		}
+
+		documentationType, name, ok := registryReference(u, check.Options.ProviderName, check.Options.ProviderSource)
+
+		if !ok {
+			documentationType, name, ok = relativeReference(path, u)
+		}
+
+		if !ok || documentationType != DocumentationTypeGuide {
+			continue
+		}
+
+		if _, ok := guides[name]; !ok {
+			result = multierror.Append(result, fmt.Errorf("link (%s) guide not found: %s", destination, name))
+			continue
+		}
+
+		linkedGuides = append(linkedGuides, name)
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		return linkedGuides, NewFinding(CheckIDGuideLinks, path, fmt.Errorf("%s: error checking guide links: %w", path, err))
+	}
+
+	return linkedGuides, nil
+}
+
+// isGuideLinkSource returns true if the documentation file is the provider
+// index or a guide, whose guide links are verified by the guide-links check.
+func isGuideLinkSource(path string) bool {
+	directory := filepath.ToSlash(filepath.Dir(path))
+
+	if DirectoryCdktfLanguage(directory) != "" {
+		return false
+	}
+
+	switch DirectoryDocumentationType(directory) {
+	case DocumentationTypeGuide, DocumentationTypeIndex:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,129 @@
+package check
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestGuideLinksCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Options      *GuideLinksOptions
+		ExpectErrors []string
+	}{
+		{
+			Name: "unknown provider name",
+			Options: &GuideLinksOptions{
+				ProviderSource: "example/test",
+			},
+		},
+		{
+			Name: "missing guide",
+			Options: &GuideLinksOptions{
+				ProviderName: "test",
+			},
+			ExpectErrors: []string{
+				"docs/index.md: error checking guide links: 1 error occurred:\n\t* link (https://registry.terraform.io/providers/example/test/latest/docs/guides/version-2-upgrade) guide not found: version-2-upgrade\n\n",
+			},
+		},
+		{
+			Name: "require linked",
+			Options: &GuideLinksOptions{
+				ProviderName:  "test",
+				RequireLinked: true,
+			},
+			ExpectErrors: []string{
+				"docs/guides/orphan.md: guide is not linked from the provider index documentation (docs/index.md) or its linked guides",
+				"docs/index.md: error checking guide links: 1 error occurred:\n\t* link (https://registry.terraform.io/providers/example/test/latest/docs/guides/version-2-upgrade) guide not found: version-2-upgrade\n\n",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/guide-links",
+			}
+
+			findings := testCheckFindings(t, testCase.Options.BasePath, NewGuideLinksCheck(testCase.Options).Run, CheckIDGuideLinks)
+
+			var got []string
+
+			for _, finding := range findings {
+				if !strings.HasPrefix(finding.Error(), finding.Path+": ") {
+					t.Errorf("expected error prefixed with path %s, got: %s", finding.Path, finding)
+				}
+
+				got = append(got, finding.Error())
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectErrors) {
+				t.Errorf("expected: %q, got: %q", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestRelativeReference(t *testing.T) {
+	testCases := []struct {
+		File         string
+		Destination  string
+		ExpectType   string
+		ExpectName   string
+		ExpectNotRef bool
+	}{
+		{
+			File:        "docs/index.md",
+			Destination: "guides/getting_started.md",
+			ExpectType:  DocumentationTypeGuide,
+			ExpectName:  "getting_started",
+		},
+		{
+			File:        "docs/guides/getting_started.md",
+			Destination: "../resources/thing.md#argument-reference",
+			ExpectType:  DocumentationTypeResource,
+			ExpectName:  "thing",
+		},
+		{
+			File:        "website/docs/index.html.markdown",
+			Destination: "guides/getting_started.html",
+			ExpectType:  DocumentationTypeGuide,
+			ExpectName:  "getting_started",
+		},
+		{
+			File:         "docs/index.md",
+			Destination:  "../CHANGELOG.md",
+			ExpectNotRef: true,
+		},
+		{
+			File:         "docs/index.md",
+			Destination:  "/docs/language/index.html",
+			ExpectNotRef: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Destination, func(t *testing.T) {
+			u, err := url.Parse(testCase.Destination)
+
+			if err != nil {
+				t.Fatalf("unexpected error parsing URL: %s", err)
+			}
+
+			gotType, gotName, ok := relativeReference(testCase.File, u)
+
+			if ok == testCase.ExpectNotRef {
+				t.Fatalf("expected reference %t, got %t", !testCase.ExpectNotRef, ok)
+			}
+
+			if gotType != testCase.ExpectType || gotName != testCase.ExpectName {
+				t.Errorf("expected %s %s, got %s %s", testCase.ExpectType, testCase.ExpectName, gotType, gotName)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// HeadingSynonyms contains groups of common heading names which are used
+// interchangeably in Terraform Provider documentation.
+var HeadingSynonyms = [][]string{
+	{"Argument Reference", "Arguments Reference"},
+	{"Attribute Reference", "Attributes Reference"},
+}
+
+// HeadingNamesOptions represents configuration options for HeadingNames.
+type HeadingNamesOptions struct {
+	*FileOptions
+
+	// Headings maps canonical heading names to their synonyms, which should
+	// be renamed to the canonical heading name.
+	Headings map[string][]string
+}
+
+// HeadingNamesCheck verifies documentation headings use the canonical
+// heading names instead of their synonyms, so documentation converges on a
+// single style.
+type HeadingNamesCheck struct {
+	Options *HeadingNamesOptions
+}
+
+func NewHeadingNamesCheck(opts *HeadingNamesOptions) *HeadingNamesCheck {
+	check := &HeadingNamesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &HeadingNamesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// CanonicalHeadingSynonyms returns the synonyms of the canonical heading name
+// in HeadingSynonyms, or false if it is not found.
+func CanonicalHeadingSynonyms(canonical string) ([]string, bool) {
+	for _, synonyms := range HeadingSynonyms {
+		if !containsString(synonyms, canonical) {
+			continue
+		}
+
+		var result []string
+
+		for _, synonym := range synonyms {
+			if synonym != canonical {
+				result = append(result, synonym)
+			}
+		}
+
+		return result, true
+	}
+
+	return nil, false
+}
+
+// Run verifies the headings of each documentation file, except CDKTF
+// documentation, which is generated from the same documentation.
+func (check *HeadingNamesCheck) Run(directories map[string][]string) error {
+	if len(check.Options.Headings) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		// Headings of localized documentation are translated
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		switch DirectoryDocumentationType(directory) {
+		case DocumentationTypeFunction, DocumentationTypeUnknown:
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the headings of the documentation file.
+func (check *HeadingNamesCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file heading names", "check", CheckIDHeadingNames, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	document, _ := markdown.Parse(content)
+	canonicals := make(map[string]string)
+
+	for canonical, synonyms := range check.Options.Headings {
+		canonicals[normalizeName(canonical)] = canonical
+
+		for _, synonym := range synonyms {
+			canonicals[normalizeName(synonym)] = canonical
+		}
+	}
+
+	var headings []string
+	var suggestions []string
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		text := string(heading.Text(content))
+		canonical, ok := canonicals[normalizeName(text)]
+
+		if !ok || text == canonical {
+			return ast.WalkSkipChildren, nil
+		}
+
+		headings = append(headings, fmt.Sprintf("%s (should be: %s)", text, canonical))
+		suggestions = append(suggestions, fmt.Sprintf("rename the %q heading to %q", text, canonical))
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if len(headings) == 0 {
+		return nil
+	}
+
+	sort.Strings(headings)
+	sort.Strings(suggestions)
+
+	err = fmt.Errorf("%s: non-canonical headings: %s", path, strings.Join(headings, ", "))
+	err = WithSuggestion(err, strings.Join(suggestions, ", "))
+
+	return NewFinding(CheckIDHeadingNames, path, err)
+}
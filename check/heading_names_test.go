@@ -0,0 +1,96 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestHeadingNamesCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Options     *HeadingNamesOptions
+		ExpectPaths []string
+	}{
+		{
+			Name:    "no headings",
+			Options: &HeadingNamesOptions{},
+		},
+		{
+			Name: "argument reference",
+			Options: &HeadingNamesOptions{
+				Headings: map[string][]string{
+					"Argument Reference": {"Arguments Reference"},
+				},
+			},
+			ExpectPaths: []string{
+				"docs/resources/thing.md",
+			},
+		},
+		{
+			Name: "attributes reference",
+			Options: &HeadingNamesOptions{
+				Headings: map[string][]string{
+					"Attributes Reference": {"Attribute Reference"},
+				},
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/thing.md",
+			},
+		},
+		{
+			Name: "arguments reference",
+			Options: &HeadingNamesOptions{
+				Headings: map[string][]string{
+					"Arguments Reference": {"Argument Reference"},
+				},
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/thing.md",
+				"docs/index.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/heading-names",
+			}
+
+			findings := testCheckFindings(t, testCase.Options.BasePath, NewHeadingNamesCheck(testCase.Options).Run, CheckIDHeadingNames)
+
+			var got []string
+
+			for _, finding := range findings {
+				if finding.Suggestion == "" {
+					t.Errorf("expected suggestion, got none")
+				}
+
+				got = append(got, finding.Path)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
+
+func TestCanonicalHeadingSynonyms(t *testing.T) {
+	got, ok := CanonicalHeadingSynonyms("Attributes Reference")
+
+	if !ok {
+		t.Fatalf("expected synonyms, got none")
+	}
+
+	if expected := []string{"Attribute Reference"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+
+	if _, ok := CanonicalHeadingSynonyms("Example Usage"); ok {
+		t.Errorf("expected no synonyms, got synonyms")
+	}
+}
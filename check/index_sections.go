@@ -0,0 +1,136 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// IndexSectionsOptions represents configuration options for IndexSections.
+type IndexSectionsOptions struct {
+	*FileOptions
+
+	// Sections contains the heading names of the sections required in the
+	// provider index documentation. Each element contains alternative heading
+	// names, of which one must be present, such as Argument Reference or
+	// Schema.
+	Sections [][]string
+}
+
+// IndexSectionsCheck verifies the provider index documentation contains the
+// required sections, since it is the landing page of the provider in the
+// Terraform Registry.
+type IndexSectionsCheck struct {
+	Options *IndexSectionsOptions
+}
+
+func NewIndexSectionsCheck(opts *IndexSectionsOptions) *IndexSectionsCheck {
+	check := &IndexSectionsCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &IndexSectionsOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the provider index documentation of each directory structure,
+// except CDKTF and localized documentation, whose headings are generated or
+// translated.
+func (check *IndexSectionsCheck) Run(directories map[string][]string) error {
+	if len(check.Options.Sections) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		if DirectoryDocumentationType(directory) != DocumentationTypeIndex {
+			continue
+		}
+
+		for _, file := range files {
+			if !strings.HasPrefix(filepath.Base(file), "index.") || !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the provider index documentation file contains a heading
+// of each required section.
+func (check *IndexSectionsCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking provider index documentation sections", "check", CheckIDIndexSections, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	document, _ := markdown.Parse(content)
+	headings := make(map[string]bool)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		headings[normalizeName(string(heading.Text(content)))] = true
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	var missing []string
+	var suggestions []string
+
+	for _, alternatives := range check.Options.Sections {
+		found := false
+
+		for _, section := range alternatives {
+			if headings[normalizeName(section)] {
+				found = true
+
+				break
+			}
+		}
+
+		if found || len(alternatives) == 0 {
+			continue
+		}
+
+		missing = append(missing, strings.Join(alternatives, " or "))
+		suggestions = append(suggestions, fmt.Sprintf("`## %s`", alternatives[0]))
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	err = fmt.Errorf("%s: missing required provider index sections: %s", path, strings.Join(missing, ", "))
+	err = WithSuggestion(err, fmt.Sprintf("add the %s section heading(s)", strings.Join(suggestions, ", ")))
+
+	return NewFinding(CheckIDIndexSections, path, err)
+}
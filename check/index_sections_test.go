@@ -0,0 +1,53 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndexSectionsCheck(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Sections [][]string
+		Expect   []string
+	}{
+		{
+			Name: "no sections",
+		},
+		{
+			Name: "sections present",
+			Sections: [][]string{
+				{"Example Usage"},
+			},
+		},
+		{
+			Name: "sections missing",
+			Sections: [][]string{
+				{"Example Usage"},
+				{"Authentication"},
+				{"Argument Reference", "Schema"},
+			},
+			Expect: []string{
+				"docs/index.md: missing required provider index sections: Authentication",
+				"website/docs/index.html.markdown: missing required provider index sections: Authentication, Argument Reference or Schema",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &IndexSectionsOptions{
+				FileOptions: &FileOptions{
+					BasePath: "testdata/index-sections",
+				},
+				Sections: testCase.Sections,
+			}
+
+			got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewIndexSectionsCheck(opts).Run, CheckIDIndexSections))
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected errors: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -2,8 +2,6 @@ package check
 
 import (
 	"fmt"
-	"log"
-	"os"
 
 	"github.com/hashicorp/go-multierror"
 )
@@ -48,27 +46,67 @@ func NewLegacyGuideFileCheck(opts *LegacyGuideFileOptions) *LegacyGuideFileCheck
 func (check *LegacyGuideFileCheck) Run(path string) error {
 	fullpath := check.Options.FullPath(path)
 
-	log.Printf("[DEBUG] Checking file: %s", fullpath)
+	check.Options.Log().Debug("Checking file", "file", fullpath)
+	check.Options.ReportProgress(path)
 
-	if err := LegacyFileExtensionCheck(path); err != nil {
-		return fmt.Errorf("%s: error checking file extension: %w", path, err)
+	if check.Options.CheckSelected(CheckIDFileExtension) {
+		if err := check.Options.TimeCheck(CheckIDFileExtension, func() error { return check.Options.FileExtensionCheck(path, ValidLegacyFileExtensions) }); err != nil {
+			return NewFinding(CheckIDFileExtension, path, fmt.Errorf("%s: error checking file extension: %w", path, err))
+		}
 	}
 
-	if err := FileSizeCheck(fullpath); err != nil {
-		return fmt.Errorf("%s: error checking file size: %w", path, err)
+	if check.Options.CheckSelected(CheckIDFileSize) {
+		if err := check.Options.TimeCheck(CheckIDFileSize, func() error { return check.Options.FileSizeCheck(path) }); err != nil {
+			return NewFinding(CheckIDFileSize, path, fmt.Errorf("%s: error checking file size: %w", path, err))
+		}
 	}
 
-	content, err := os.ReadFile(fullpath)
+	content, err := check.Options.ReadFile(path)
 
 	if err != nil {
 		return fmt.Errorf("%s: error reading file: %w", path, err)
 	}
 
-	if err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content); err != nil {
-		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
+	if check.Options.CheckSelected(CheckIDFileEncoding) {
+		if err := check.Options.TimeCheck(CheckIDFileEncoding, func() error { return FileEncodingCheck(content) }); err != nil {
+			return NewFinding(CheckIDFileEncoding, path, fmt.Errorf("%s: error checking file encoding: %w", path, err))
+		}
+	}
+
+	if check.Options.RequireLFLineEndings && check.Options.CheckSelected(CheckIDFileLineEndings) {
+		if err := check.Options.TimeCheck(CheckIDFileLineEndings, func() error { return FileLineEndingsCheck(content, check.Options.AllowCRLFLineEndings) }); err != nil {
+			return NewFinding(CheckIDFileLineEndings, path, fmt.Errorf("%s: error checking file line endings: %w", path, err))
+		}
+	}
+
+	var result *multierror.Error
+
+	if check.Options.CheckSelected(CheckIDFrontMatter) {
+		if err := check.Options.TimeCheck(CheckIDFrontMatter, func() error { return newFrontMatterCheck(check.Options.FrontMatter, check.Options.Log()).Run(content) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDFrontMatter, path, fmt.Errorf("%s: error checking file frontmatter: %w", path, err)))
+		}
 	}
 
-	return nil
+	frontMatterErr := result.ErrorOrNil()
+
+	if check.Options.CheckSelected(CheckIDImageReferences) {
+		if err := check.Options.TimeCheck(CheckIDImageReferences, func() error { return ImageReferencesCheck(path, content, check.Options.FileOptions) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDImageReferences, path, fmt.Errorf("%s: error checking image references: %w", path, err)))
+		}
+	}
+
+	// Other checks require valid frontmatter.
+	if frontMatterErr != nil {
+		return result.ErrorOrNil()
+	}
+
+	if check.Options.CheckSelected(CheckIDSubcategoryBlank) {
+		if err := check.Options.TimeCheck(CheckIDSubcategoryBlank, func() error { return SubcategoryBlankCheck(content, check.Options.FrontMatter) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDSubcategoryBlank, path, fmt.Errorf("%s: error checking file frontmatter subcategory: %w", path, err)))
+		}
+	}
+
+	return result.ErrorOrNil()
 }
 
 func (check *LegacyGuideFileCheck) RunAll(files []string) error {
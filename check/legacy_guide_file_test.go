@@ -1,6 +1,7 @@
 package check
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -72,3 +73,27 @@ func TestLegacyGuideFileCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestLegacyGuideFileCheck_imageReferencesAndFrontMatter(t *testing.T) {
+	options := &LegacyGuideFileOptions{
+		FileOptions: &FileOptions{
+			BasePath: "testdata/invalid-legacy-files",
+		},
+	}
+
+	findings, errs := Findings(NewLegacyGuideFileCheck(options).Run("guide_missing_image_without_description.html.markdown"))
+
+	if len(errs) > 0 {
+		t.Fatalf("unexpected operational errors: %v", errs)
+	}
+
+	var got []string
+
+	for _, finding := range findings {
+		got = append(got, finding.CheckID)
+	}
+
+	if expected := []string{CheckIDFrontMatter, CheckIDImageReferences}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+}
@@ -0,0 +1,252 @@
+package check
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// LegacyNavigationGlobPattern matches the legacy website navigation files
+	// in the Terraform Provider codebase, such as website/aws.erb.
+	LegacyNavigationGlobPattern = `website/*.erb`
+
+	// LegacyNavigationLinkPrefix is the path prefix of documentation links
+	// in legacy website navigation files, which is followed by the provider
+	// name, such as /docs/providers/aws/r/instance.html.
+	LegacyNavigationLinkPrefix = `/docs/providers/`
+)
+
+// legacyNavigationHrefRegexp matches the link destinations of legacy website
+// navigation files, capturing the destination.
+var legacyNavigationHrefRegexp = regexp.MustCompile(`href\s*=\s*["']([^"']*)["']`)
+
+// LegacyNavigationOptions represents configuration options for
+// LegacyNavigation.
+type LegacyNavigationOptions struct {
+	*FileOptions
+
+	// Enable enables the legacy-navigation check.
+	Enable bool
+
+	// Ignore contains glob patterns of documentation file paths, with or
+	// without file extension (e.g. website/docs/r/old_*), or navigation link
+	// paths (e.g. /docs/providers/*/r/old_*.html), whose findings are ignored,
+	// such as during a migration.
+	Ignore []string
+}
+
+// LegacyNavigationCheck verifies the legacy website navigation files, such as
+// website/aws.erb, link to existing documentation and link all legacy
+// documentation, for Terraform Providers still maintaining the navigation.
+type LegacyNavigationCheck struct {
+	Options *LegacyNavigationOptions
+
+	// IgnoredFindings is the number of findings ignored by the Ignore option
+	// during Run.
+	IgnoredFindings int
+}
+
+func NewLegacyNavigationCheck(opts *LegacyNavigationOptions) *LegacyNavigationCheck {
+	check := &LegacyNavigationCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &LegacyNavigationOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the legacy website navigation files, if present. Each link to
+// documentation must resolve to a legacy documentation file and each legacy
+// documentation file, except CDKTF and localized documentation, must be
+// linked from a navigation file.
+func (check *LegacyNavigationCheck) Run(directories map[string][]string) error {
+	navigationFiles, err := check.Options.Glob(LegacyNavigationGlobPattern)
+
+	if err != nil {
+		return fmt.Errorf("error finding legacy navigation files: %w", err)
+	}
+
+	if len(navigationFiles) == 0 {
+		return nil
+	}
+
+	// Documentation files are keyed by their path without file extension,
+	// such as website/docs/r/instance, which navigation links resolve to.
+	documentationFiles := make(map[string]string)
+
+	for directory, files := range directories {
+		if DirectoryStructure(directory) != DirectoryStructureLegacy || DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			documentationFiles[path.Join(filepath.ToSlash(directory), TrimFileExtension(file))] = file
+		}
+	}
+
+	var result *multierror.Error
+	linked := make(map[string]bool)
+
+	sort.Strings(navigationFiles)
+
+	for index, navigationFile := range navigationFiles {
+		navigationFiles[index] = path.Join(path.Dir(LegacyNavigationGlobPattern), filepath.Base(navigationFile))
+		links, err := check.RunFile(navigationFiles[index], documentationFiles)
+
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		for _, link := range links {
+			linked[link] = true
+		}
+	}
+
+	// The navigation file name is the provider name, e.g. website/aws.erb
+	providerName := strings.TrimSuffix(path.Base(navigationFiles[0]), ".erb")
+	names := make([]string, 0, len(documentationFiles))
+
+	for name := range documentationFiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		file := documentationFiles[name]
+
+		if linked[name] {
+			continue
+		}
+
+		if check.ignore(name, file) {
+			check.IgnoredFindings++
+
+			continue
+		}
+
+		err := fmt.Errorf("%s: documentation file is not linked from the legacy navigation (%s)", file, strings.Join(navigationFiles, ", "))
+		err = WithSuggestion(err, fmt.Sprintf("add a link to %s%s/%s.html in %s", LegacyNavigationLinkPrefix, providerName, strings.TrimPrefix(name, LegacyIndexDirectory+"/"), navigationFiles[0]))
+		result = multierror.Append(result, NewFinding(CheckIDLegacyNavigation, file, err))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the documentation links of the legacy navigation file
+// resolve to the documentation files, which are keyed by their path without
+// file extension, and returns the linked keys.
+func (check *LegacyNavigationCheck) RunFile(path string, documentationFiles map[string]string) ([]string, error) {
+	check.Options.Log().Debug("Checking legacy navigation file", "check", CheckIDLegacyNavigation, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	var linked []string
+	var result *multierror.Error
+
+	for _, match := range legacyNavigationHrefRegexp.FindAllSubmatchIndex(content, -1) {
+		destination := string(content[match[2]:match[3]])
+		name, ok := legacyNavigationLinkPath(destination)
+
+		if !ok {
+			continue
+		}
+
+		if _, ok := documentationFiles[name]; ok {
+			linked = append(linked, name)
+
+			continue
+		}
+
+		if check.ignore(name, destination) {
+			check.IgnoredFindings++
+
+			continue
+		}
+
+		line := strings.Count(string(content[:match[0]]), "\n") + 1
+		err := fmt.Errorf("%s: navigation link (%s) does not resolve to a documentation file (line %d)", path, destination, line)
+		err = WithLine(err, line)
+		err = WithSuggestion(err, fmt.Sprintf("fix or remove the link, or add the documentation file %s%s", name, FileExtensionHtmlMarkdown))
+		result = multierror.Append(result, NewFinding(CheckIDLegacyNavigation, path, err))
+	}
+
+	return linked, result.ErrorOrNil()
+}
+
+func (check *LegacyNavigationCheck) ignore(values ...string) bool {
+	for _, pattern := range check.Options.Ignore {
+		for _, value := range values {
+			if matched, _ := doublestar.Match(pattern, value); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// legacyNavigationLinkPath returns the legacy documentation file path, without
+// file extension, of a navigation link destination, such as
+// website/docs/r/instance for /docs/providers/aws/r/instance.html. Links to
+// other websites or outside the provider documentation are not resolved.
+func legacyNavigationLinkPath(destination string) (string, bool) {
+	// ERB expressions, e.g. <%= ... %>, cannot be resolved
+	if strings.Contains(destination, "<%") {
+		return "", false
+	}
+
+	u, err := url.Parse(destination)
+
+	if err != nil {
+		return "", false
+	}
+
+	if u.Host != "" && u.Host != "terraform.io" && u.Host != "www.terraform.io" {
+		return "", false
+	}
+
+	providerPath, ok := strings.CutPrefix(u.Path, LegacyNavigationLinkPrefix)
+
+	if !ok {
+		return "", false
+	}
+
+	// The first element is the provider name, e.g. not /docs/providers/index.html
+	_, documentationPath, ok := strings.Cut(providerPath, "/")
+
+	if !ok {
+		return "", false
+	}
+
+	documentationPath = strings.TrimSuffix(strings.TrimSuffix(documentationPath, "/"), ".html")
+
+	if documentationPath == "" {
+		documentationPath = "index"
+	}
+
+	return path.Join(LegacyIndexDirectory, documentationPath), true
+}
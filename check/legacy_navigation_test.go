@@ -0,0 +1,143 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLegacyNavigationCheck(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		BasePath              string
+		Options               *LegacyNavigationOptions
+		ExpectErrors          []string
+		ExpectIgnoredFindings int
+	}{
+		{
+			Name:     "missing navigation file",
+			BasePath: "testdata/valid-legacy-directories",
+			Options:  &LegacyNavigationOptions{},
+		},
+		{
+			Name:     "navigation file",
+			BasePath: "testdata/legacy-navigation",
+			Options:  &LegacyNavigationOptions{},
+			ExpectErrors: []string{
+				"website/docs/r/orphan.html.markdown: documentation file is not linked from the legacy navigation (website/test.erb)",
+				"website/test.erb: navigation link (/docs/providers/test/r/removed.html) does not resolve to a documentation file (line 35)",
+			},
+		},
+		{
+			Name:     "ignore",
+			BasePath: "testdata/legacy-navigation",
+			Options: &LegacyNavigationOptions{
+				Ignore: []string{
+					"/docs/providers/*/r/removed.html",
+					"website/docs/r/orphan",
+				},
+			},
+			ExpectIgnoredFindings: 2,
+		},
+		{
+			Name:     "ignore file path",
+			BasePath: "testdata/legacy-navigation",
+			Options: &LegacyNavigationOptions{
+				Ignore: []string{
+					"website/docs/r/*.html.markdown",
+				},
+			},
+			ExpectErrors: []string{
+				"website/test.erb: navigation link (/docs/providers/test/r/removed.html) does not resolve to a documentation file (line 35)",
+			},
+			ExpectIgnoredFindings: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: testCase.BasePath,
+			}
+
+			legacyNavigationCheck := NewLegacyNavigationCheck(testCase.Options)
+			findings := testCheckFindings(t, testCase.BasePath, legacyNavigationCheck.Run, CheckIDLegacyNavigation)
+
+			var got []string
+
+			for _, finding := range findings {
+				if !strings.HasPrefix(finding.Error(), finding.Path+": ") {
+					t.Errorf("expected error prefixed with path %s, got: %s", finding.Path, finding)
+				}
+
+				got = append(got, finding.Error())
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectErrors) {
+				t.Errorf("expected: %q, got: %q", testCase.ExpectErrors, got)
+			}
+
+			if legacyNavigationCheck.IgnoredFindings != testCase.ExpectIgnoredFindings {
+				t.Errorf("expected %d ignored findings, got: %d", testCase.ExpectIgnoredFindings, legacyNavigationCheck.IgnoredFindings)
+			}
+		})
+	}
+}
+
+func TestLegacyNavigationLinkPath(t *testing.T) {
+	testCases := []struct {
+		Destination  string
+		Expect       string
+		ExpectNotRef bool
+	}{
+		{
+			Destination: "/docs/providers/test/r/thing.html",
+			Expect:      "website/docs/r/thing",
+		},
+		{
+			Destination: "https://www.terraform.io/docs/providers/test/d/thing.html#argument-reference",
+			Expect:      "website/docs/d/thing",
+		},
+		{
+			Destination: "/docs/providers/test/index.html",
+			Expect:      "website/docs/index",
+		},
+		{
+			Destination: "/docs/providers/test/",
+			Expect:      "website/docs/index",
+		},
+		{
+			Destination:  "/docs/providers/index.html",
+			ExpectNotRef: true,
+		},
+		{
+			Destination:  "https://github.com/example/terraform-provider-test",
+			ExpectNotRef: true,
+		},
+		{
+			Destination:  "#",
+			ExpectNotRef: true,
+		},
+		{
+			Destination:  "<%= provider_path %>/r/thing.html",
+			ExpectNotRef: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Destination, func(t *testing.T) {
+			got, ok := legacyNavigationLinkPath(testCase.Destination)
+
+			if ok == testCase.ExpectNotRef {
+				t.Fatalf("expected reference %t, got %t", !testCase.ExpectNotRef, ok)
+			}
+
+			if got != testCase.Expect {
+				t.Errorf("expected %s, got %s", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -2,8 +2,6 @@ package check
 
 import (
 	"fmt"
-	"log"
-	"os"
 
 	"github.com/hashicorp/go-multierror"
 )
@@ -11,9 +9,11 @@ import (
 type LegacyResourceFileOptions struct {
 	*FileOptions
 
-	Contents     *ContentsOptions
-	FrontMatter  *FrontMatterOptions
-	ProviderName string
+	Contents          *ContentsOptions
+	ExampleAttributes *ExampleAttributesOptions
+	ExampleUsage      *ExampleUsageOptions
+	FrontMatter       *FrontMatterOptions
+	ProviderName      string
 }
 
 type LegacyResourceFileCheck struct {
@@ -39,6 +39,26 @@ func NewLegacyResourceFileCheck(opts *LegacyResourceFileOptions) *LegacyResource
 		check.Options.Contents.ProviderName = check.Options.ProviderName
 	}
 
+	if check.Options.ExampleAttributes == nil {
+		check.Options.ExampleAttributes = &ExampleAttributesOptions{}
+	}
+
+	if check.Options.ExampleAttributes.ProviderName == "" {
+		check.Options.ExampleAttributes.ProviderName = check.Options.ProviderName
+	}
+
+	if check.Options.ExampleAttributes.ResourceType == "" {
+		check.Options.ExampleAttributes.ResourceType = ResourceTypeResource
+	}
+
+	if check.Options.ExampleUsage == nil {
+		check.Options.ExampleUsage = &ExampleUsageOptions{}
+	}
+
+	if check.Options.ExampleUsage.ProviderName == "" {
+		check.Options.ExampleUsage.ProviderName = check.Options.ProviderName
+	}
+
 	if check.Options.FileOptions == nil {
 		check.Options.FileOptions = &FileOptions{}
 	}
@@ -58,31 +78,105 @@ func NewLegacyResourceFileCheck(opts *LegacyResourceFileOptions) *LegacyResource
 func (check *LegacyResourceFileCheck) Run(path string, exampleLanguage string) error {
 	fullpath := check.Options.FullPath(path)
 
-	log.Printf("[DEBUG] Checking file: %s", fullpath)
+	check.Options.Log().Debug("Checking file", "file", fullpath)
+	check.Options.ReportProgress(path)
+
+	if check.Options.CheckSelected(CheckIDFileExtension) {
+		if err := check.Options.TimeCheck(CheckIDFileExtension, func() error { return check.Options.FileExtensionCheck(path, ValidLegacyFileExtensions) }); err != nil {
+			return NewFinding(CheckIDFileExtension, path, fmt.Errorf("%s: error checking file extension: %w", path, err))
+		}
+	}
 
-	if err := LegacyFileExtensionCheck(path); err != nil {
-		return fmt.Errorf("%s: error checking file extension: %w", path, err)
+	if check.Options.EnableFileNameCheck && check.Options.CheckSelected(CheckIDFileName) {
+		if err := check.Options.TimeCheck(CheckIDFileName, func() error { return FileNameCheck(path) }); err != nil {
+			return NewFinding(CheckIDFileName, path, fmt.Errorf("%s: error checking file name: %w", path, err))
+		}
 	}
 
-	if err := FileSizeCheck(fullpath); err != nil {
-		return fmt.Errorf("%s: error checking file size: %w", path, err)
+	if check.Options.CheckSelected(CheckIDFileSize) {
+		if err := check.Options.TimeCheck(CheckIDFileSize, func() error { return check.Options.FileSizeCheck(path) }); err != nil {
+			return NewFinding(CheckIDFileSize, path, fmt.Errorf("%s: error checking file size: %w", path, err))
+		}
 	}
 
-	content, err := os.ReadFile(fullpath)
+	content, err := check.Options.ReadFile(path)
 
 	if err != nil {
 		return fmt.Errorf("%s: error reading file: %w", path, err)
 	}
 
-	if err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content); err != nil {
-		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
+	if check.Options.CheckSelected(CheckIDFileEncoding) {
+		if err := check.Options.TimeCheck(CheckIDFileEncoding, func() error { return FileEncodingCheck(content) }); err != nil {
+			return NewFinding(CheckIDFileEncoding, path, fmt.Errorf("%s: error checking file encoding: %w", path, err))
+		}
+	}
+
+	if check.Options.RequireLFLineEndings && check.Options.CheckSelected(CheckIDFileLineEndings) {
+		if err := check.Options.TimeCheck(CheckIDFileLineEndings, func() error { return FileLineEndingsCheck(content, check.Options.AllowCRLFLineEndings) }); err != nil {
+			return NewFinding(CheckIDFileLineEndings, path, fmt.Errorf("%s: error checking file line endings: %w", path, err))
+		}
 	}
 
-	if err := NewContentsCheck(check.Options.Contents).Run(fullpath, exampleLanguage); err != nil {
-		return fmt.Errorf("%s: error checking file contents: %w", path, err)
+	var result *multierror.Error
+
+	if check.Options.CheckSelected(CheckIDFrontMatter) {
+		if err := check.Options.TimeCheck(CheckIDFrontMatter, func() error { return newFrontMatterCheck(check.Options.FrontMatter, check.Options.Log()).Run(content) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDFrontMatter, path, fmt.Errorf("%s: error checking file frontmatter: %w", path, err)))
+		}
 	}
 
-	return nil
+	frontMatterErr := result.ErrorOrNil()
+
+	if check.Options.CheckSelected(CheckIDImageReferences) {
+		if err := check.Options.TimeCheck(CheckIDImageReferences, func() error { return ImageReferencesCheck(path, content, check.Options.FileOptions) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDImageReferences, path, fmt.Errorf("%s: error checking image references: %w", path, err)))
+		}
+	}
+
+	// Other checks require valid frontmatter.
+	if frontMatterErr != nil {
+		return result.ErrorOrNil()
+	}
+
+	if check.Options.CheckSelected(CheckIDSubcategoryBlank) {
+		if err := check.Options.TimeCheck(CheckIDSubcategoryBlank, func() error { return SubcategoryBlankCheck(content, check.Options.FrontMatter) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDSubcategoryBlank, path, fmt.Errorf("%s: error checking file frontmatter subcategory: %w", path, err)))
+		}
+	}
+
+	if check.Options.EnableFrontMatterPageTitleCheck && check.Options.CheckSelected(CheckIDFrontMatterPageTitle) {
+		if err := check.Options.TimeCheck(CheckIDFrontMatterPageTitle, func() error { return FrontMatterPageTitleCheck(path, content, check.Options.ProviderName) }); err != nil {
+			return multierror.Append(result, NewFinding(CheckIDFrontMatterPageTitle, path, fmt.Errorf("%s: error checking file frontmatter page_title: %w", path, err))).ErrorOrNil()
+		}
+	}
+
+	if check.Options.EnableTitleHeadingCheck && check.Options.CheckSelected(CheckIDTitleHeading) {
+		if err := check.Options.TimeCheck(CheckIDTitleHeading, func() error { return TitleHeadingCheck(path, content, check.Options.ProviderName) }); err != nil {
+			return multierror.Append(result, NewFinding(CheckIDTitleHeading, path, fmt.Errorf("%s: error checking title heading: %w", path, err))).ErrorOrNil()
+		}
+	}
+
+	if check.Options.CheckSelected(CheckIDExampleUsage) {
+		if err := check.Options.TimeCheck(CheckIDExampleUsage, func() error { return NewExampleUsageCheck(check.Options.ExampleUsage).Run(path, content) }); err != nil {
+			return multierror.Append(result, NewFinding(CheckIDExampleUsage, path, fmt.Errorf("%s: error checking example usage: %w", path, err))).ErrorOrNil()
+		}
+	}
+
+	if check.Options.CheckSelected(CheckIDExampleAttributes) {
+		if err := check.Options.TimeCheck(CheckIDExampleAttributes, func() error { return NewExampleAttributesCheck(check.Options.ExampleAttributes).Run(path, content) }); err != nil {
+			return multierror.Append(result, NewFinding(CheckIDExampleAttributes, path, fmt.Errorf("%s: error checking example attributes: %w", path, err))).ErrorOrNil()
+		}
+	}
+
+	if check.Options.CheckSelected(CheckIDContents) {
+		if err := check.Options.TimeCheck(CheckIDContents, func() error {
+			return NewContentsCheck(check.Options.Contents).RunContent(path, content, exampleLanguage)
+		}); err != nil {
+			return multierror.Append(result, NewFinding(contentsCheckID(err), path, fmt.Errorf("%s: error checking file contents: %w", path, err))).ErrorOrNil()
+		}
+	}
+
+	return result.ErrorOrNil()
 }
 
 func (check *LegacyResourceFileCheck) RunAll(files []string, exampleLanguage string) error {
@@ -0,0 +1,183 @@
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+// lineLengthTableDelimiterRegexp matches the delimiter row of Markdown
+// tables, e.g. | --- | :---: |, which follows the table header row.
+var lineLengthTableDelimiterRegexp = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+
+// LineLengthOptions represents configuration options for LineLength.
+type LineLengthOptions struct {
+	*FileOptions
+
+	// Maximum is the maximum number of characters of prose lines. The check
+	// is disabled if zero.
+	Maximum int
+}
+
+// LineLengthCheck verifies prose lines of documentation do not exceed a
+// maximum length, for providers wrapping Markdown to simplify reviews. YAML
+// frontmatter, code blocks, headings, HTML blocks, and tables are not
+// checked, since their lines cannot be wrapped.
+type LineLengthCheck struct {
+	Options *LineLengthOptions
+}
+
+func NewLineLengthCheck(opts *LineLengthOptions) *LineLengthCheck {
+	check := &LineLengthCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &LineLengthOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each documentation file, except CDKTF documentation, which is
+// generated from the same documentation.
+func (check *LineLengthCheck) Run(directories map[string][]string) error {
+	if check.Options.Maximum <= 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the prose line lengths of the documentation file.
+func (check *LineLengthCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file line length", "check", CheckIDLineLength, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	exempt := lineLengthExemptLines(content)
+
+	var messages []string
+
+	for index, line := range strings.Split(string(content), "\n") {
+		if exempt[index+1] {
+			continue
+		}
+
+		if length := utf8.RuneCountInString(strings.TrimRight(line, "\r")); length > check.Options.Maximum {
+			messages = append(messages, fmt.Sprintf("line %d (%d characters)", index+1, length))
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err = fmt.Errorf("%s: lines exceed maximum line length (%d): %s", path, check.Options.Maximum, strings.Join(messages, ", "))
+	err = WithSuggestion(err, "wrap the lines, or increase the limit with -maximum-line-length")
+
+	return NewFinding(CheckIDLineLength, path, err)
+}
+
+// lineLengthExemptLines returns the line numbers of the YAML frontmatter, code
+// blocks, headings, HTML blocks, and tables of the Markdown source.
+func lineLengthExemptLines(source []byte) map[int]bool {
+	result := make(map[int]bool)
+
+	lineOf := func(offset int) int {
+		return bytes.Count(source[:offset], []byte("\n")) + 1
+	}
+
+	if bytes.HasPrefix(source, []byte("---\n")) || bytes.HasPrefix(source, []byte("---\r\n")) {
+		lines := strings.Split(string(source), "\n")
+
+		for index, line := range lines {
+			result[index+1] = true
+
+			if index > 0 && strings.TrimSpace(line) == "---" {
+				break
+			}
+		}
+	}
+
+	document, _ := markdown.Parse(source)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || node.Type() != ast.TypeBlock {
+			return ast.WalkContinue, nil
+		}
+
+		lines := node.Lines()
+
+		if lines == nil || lines.Len() == 0 {
+			return ast.WalkContinue, nil
+		}
+
+		first := lineOf(lines.At(0).Start)
+		last := lineOf(lines.At(lines.Len() - 1).Start)
+
+		switch node := node.(type) {
+		case *ast.CodeBlock, *ast.Heading:
+		case *ast.FencedCodeBlock:
+			// Include the opening and closing fence lines
+			first--
+			last++
+		case *ast.HTMLBlock:
+			if node.HasClosure() {
+				last = lineOf(node.ClosureLine.Start)
+			}
+		case *ast.Paragraph:
+			// Without the table extension, tables are parsed as paragraphs
+			// with a delimiter row as the second line
+			if lines.Len() < 2 {
+				return ast.WalkContinue, nil
+			}
+
+			if delimiter := lines.At(1); !lineLengthTableDelimiterRegexp.Match(delimiter.Value(source)) {
+				return ast.WalkContinue, nil
+			}
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		for line := first; line <= last; line++ {
+			result[line] = true
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	return result
+}
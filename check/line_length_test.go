@@ -0,0 +1,46 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLineLengthCheck(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Maximum int
+		Expect  []string
+	}{
+		{
+			Name: "disabled",
+		},
+		{
+			Name:    "maximum",
+			Maximum: 60,
+			Expect: []string{
+				"docs/resources/thing.md: lines exceed maximum line length (60): line 8 (83 characters), line 31 (82 characters)",
+			},
+		},
+		{
+			Name:    "maximum above line lengths",
+			Maximum: 83,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &LineLengthOptions{
+				FileOptions: &FileOptions{
+					BasePath: "testdata/line-length",
+				},
+				Maximum: testCase.Maximum,
+			}
+
+			got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewLineLengthCheck(opts).Run, CheckIDLineLength))
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected errors: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
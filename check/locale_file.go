@@ -0,0 +1,137 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// LocaleFileOptions represents configuration options for LocaleFile.
+type LocaleFileOptions struct {
+	*FileOptions
+
+	// FileExtensions are the default valid file extensions of the directory
+	// structure, e.g. ValidRegistryFileExtensions.
+	FileExtensions []string
+
+	// FrontMatter contains the frontmatter options of the documentation type
+	// in the default locale.
+	FrontMatter *FrontMatterOptions
+
+	// ProviderName, if set, enables verifying the frontmatter page_title
+	// resource name matches the file name.
+	ProviderName string
+}
+
+// LocaleFileCheck verifies localized documentation files with the structural
+// and frontmatter checks of their documentation type. The contents and
+// example checks are not run, as headings and prose are translated.
+type LocaleFileCheck struct {
+	FileCheck
+
+	Options *LocaleFileOptions
+}
+
+func NewLocaleFileCheck(opts *LocaleFileOptions) *LocaleFileCheck {
+	check := &LocaleFileCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &LocaleFileOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	if check.Options.FrontMatter == nil {
+		check.Options.FrontMatter = &FrontMatterOptions{}
+	}
+
+	return check
+}
+
+func (check *LocaleFileCheck) Run(path string) error {
+	fullpath := check.Options.FullPath(path)
+
+	check.Options.Log().Debug("Checking file", "file", fullpath)
+	check.Options.ReportProgress(path)
+
+	if check.Options.CheckSelected(CheckIDFileExtension) {
+		if err := check.Options.TimeCheck(CheckIDFileExtension, func() error { return check.Options.FileExtensionCheck(path, check.Options.FileExtensions) }); err != nil {
+			return NewFinding(CheckIDFileExtension, path, fmt.Errorf("%s: error checking file extension: %w", path, err))
+		}
+	}
+
+	if check.Options.CheckSelected(CheckIDFileSize) {
+		if err := check.Options.TimeCheck(CheckIDFileSize, func() error { return check.Options.FileSizeCheck(path) }); err != nil {
+			return NewFinding(CheckIDFileSize, path, fmt.Errorf("%s: error checking file size: %w", path, err))
+		}
+	}
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	if check.Options.CheckSelected(CheckIDFileEncoding) {
+		if err := check.Options.TimeCheck(CheckIDFileEncoding, func() error { return FileEncodingCheck(content) }); err != nil {
+			return NewFinding(CheckIDFileEncoding, path, fmt.Errorf("%s: error checking file encoding: %w", path, err))
+		}
+	}
+
+	if check.Options.RequireLFLineEndings && check.Options.CheckSelected(CheckIDFileLineEndings) {
+		if err := check.Options.TimeCheck(CheckIDFileLineEndings, func() error { return FileLineEndingsCheck(content, check.Options.AllowCRLFLineEndings) }); err != nil {
+			return NewFinding(CheckIDFileLineEndings, path, fmt.Errorf("%s: error checking file line endings: %w", path, err))
+		}
+	}
+
+	var result *multierror.Error
+
+	if check.Options.CheckSelected(CheckIDFrontMatter) {
+		if err := check.Options.TimeCheck(CheckIDFrontMatter, func() error { return newFrontMatterCheck(check.Options.FrontMatter, check.Options.Log()).Run(content) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDFrontMatter, path, fmt.Errorf("%s: error checking file frontmatter: %w", path, err)))
+		}
+	}
+
+	frontMatterErr := result.ErrorOrNil()
+
+	if check.Options.CheckSelected(CheckIDImageReferences) {
+		if err := check.Options.TimeCheck(CheckIDImageReferences, func() error { return ImageReferencesCheck(path, content, check.Options.FileOptions) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDImageReferences, path, fmt.Errorf("%s: error checking image references: %w", path, err)))
+		}
+	}
+
+	// Other checks require valid frontmatter.
+	if frontMatterErr != nil {
+		return result.ErrorOrNil()
+	}
+
+	if check.Options.CheckSelected(CheckIDSubcategoryBlank) {
+		if err := check.Options.TimeCheck(CheckIDSubcategoryBlank, func() error { return SubcategoryBlankCheck(content, check.Options.FrontMatter) }); err != nil {
+			result = multierror.Append(result, NewFinding(CheckIDSubcategoryBlank, path, fmt.Errorf("%s: error checking file frontmatter subcategory: %w", path, err)))
+		}
+	}
+
+	if check.Options.EnableFrontMatterPageTitleCheck && check.Options.CheckSelected(CheckIDFrontMatterPageTitle) {
+		if err := check.Options.TimeCheck(CheckIDFrontMatterPageTitle, func() error { return FrontMatterPageTitleCheck(path, content, check.Options.ProviderName) }); err != nil {
+			return multierror.Append(result, NewFinding(CheckIDFrontMatterPageTitle, path, fmt.Errorf("%s: error checking file frontmatter page_title: %w", path, err))).ErrorOrNil()
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (check *LocaleFileCheck) RunAll(files []string) error {
+	var result *multierror.Error
+
+	for _, file := range files {
+		if err := check.Run(file); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
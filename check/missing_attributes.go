@@ -0,0 +1,105 @@
+package check
+
+import (
+	"sort"
+
+	"github.com/bflad/tfproviderdocs/check/contents"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// MissingAttribute is a schema attribute which is not documented.
+type MissingAttribute struct {
+	// Attribute is the attribute name, prefixed with the nested block or
+	// nested attribute name if nested (e.g. setting.key).
+	Attribute string `json:"attribute"`
+
+	// Reason describes why the attribute is reported, such as undocumented
+	// write-only attribute.
+	Reason string `json:"reason"`
+
+	Resource string `json:"resource"`
+}
+
+// MissingAttributes records the undocumented schema attributes of each
+// resource checked by the contents check, e.g. for a report. A nil
+// MissingAttributes records nothing.
+type MissingAttributes struct {
+	Attributes []*MissingAttribute
+}
+
+func NewMissingAttributes() *MissingAttributes {
+	return &MissingAttributes{}
+}
+
+// Add records the undocumented attributes of the resource.
+func (m *MissingAttributes) Add(resource string, errs []*contents.MissingAttributeError) {
+	if m == nil {
+		return
+	}
+
+	for _, err := range errs {
+		attribute := err.Name
+
+		if err.Block != "" {
+			attribute = err.Block + "." + attribute
+		}
+
+		reason := "undocumented attribute"
+
+		if err.Kind != "" {
+			reason = "undocumented " + err.Kind + " attribute"
+		}
+
+		m.Attributes = append(m.Attributes, &MissingAttribute{
+			Attribute: attribute,
+			Reason:    reason,
+			Resource:  resource,
+		})
+	}
+}
+
+// Sorted returns the undocumented attributes, ordered by resource then
+// attribute.
+func (m *MissingAttributes) Sorted() []*MissingAttribute {
+	if m == nil {
+		return nil
+	}
+
+	result := make([]*MissingAttribute, len(m.Attributes))
+	copy(result, m.Attributes)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Resource != result[j].Resource {
+			return result[i].Resource < result[j].Resource
+		}
+
+		return result[i].Attribute < result[j].Attribute
+	})
+
+	return result
+}
+
+// schemaAttributeKinds returns the kind of each attribute, which is
+// write-only, sensitive, or an empty string, keyed by the nested block or
+// nested attribute name, or an empty string for the root block, then by the
+// attribute name.
+func schemaAttributeKinds(block *tfjson.SchemaBlock) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+
+	walkSchemaAttributes(block, func(parent string, name string, attribute *tfjson.SchemaAttribute) {
+		if result[parent] == nil {
+			result[parent] = make(map[string]string)
+		}
+
+		switch {
+		case attribute.WriteOnly:
+			result[parent][name] = "write-only"
+		case attribute.Sensitive:
+			result[parent][name] = "sensitive"
+		default:
+			result[parent][name] = ""
+		}
+	})
+
+	return result
+}
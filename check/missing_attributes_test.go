@@ -0,0 +1,71 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check/contents"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestMissingAttributes(t *testing.T) {
+	var nilMissingAttributes *MissingAttributes
+
+	nilMissingAttributes.Add("test_thing", []*contents.MissingAttributeError{{Name: "name"}})
+
+	if got := nilMissingAttributes.Sorted(); got != nil {
+		t.Errorf("expected no attributes, got: %v", got)
+	}
+
+	missingAttributes := NewMissingAttributes()
+	missingAttributes.Add("test_widget", []*contents.MissingAttributeError{{Name: "name"}})
+	missingAttributes.Add("test_thing", []*contents.MissingAttributeError{
+		{Kind: "write-only", Name: "password_wo"},
+		{Block: "setting", Name: "key"},
+	})
+
+	got := missingAttributes.Sorted()
+	expected := []*MissingAttribute{
+		{Attribute: "password_wo", Reason: "undocumented write-only attribute", Resource: "test_thing"},
+		{Attribute: "setting.key", Reason: "undocumented attribute", Resource: "test_thing"},
+		{Attribute: "name", Reason: "undocumented attribute", Resource: "test_widget"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestSchemaAttributeKinds(t *testing.T) {
+	block := &tfjson.SchemaBlock{
+		Attributes: map[string]*tfjson.SchemaAttribute{
+			"name": {},
+			"password_wo": {
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"token": {
+				Sensitive: true,
+			},
+		},
+		NestedBlocks: map[string]*tfjson.SchemaBlockType{
+			"setting": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"key": {},
+					},
+				},
+			},
+		},
+	}
+
+	got := schemaAttributeKinds(block)
+	expected := map[string]map[string]string{
+		"":        {"name": "", "password_wo": "write-only", "token": "sensitive"},
+		"setting": {"key": ""},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+}
@@ -0,0 +1,133 @@
+package check
+
+import (
+	"sort"
+)
+
+// Navigation represents the navigation tree of Terraform Provider
+// documentation, as derived from the YAML frontmatter subcategories, e.g. for
+// the Terraform Registry navigation sidebar.
+type Navigation struct {
+	// Index is the provider index documentation, if present.
+	Index *NavigationEntry `json:"index,omitempty" yaml:"index,omitempty"`
+
+	// Functions contains the function documentation, which is not grouped
+	// by subcategory.
+	Functions []*NavigationEntry `json:"functions,omitempty" yaml:"functions,omitempty"`
+
+	// Subcategories contains the documentation grouped by subcategory, sorted
+	// by name. Documentation without a subcategory is in the subcategory
+	// with an empty name, which is first.
+	Subcategories []*NavigationSubcategory `json:"subcategories" yaml:"subcategories"`
+}
+
+// NavigationSubcategory represents the documentation of a subcategory.
+type NavigationSubcategory struct {
+	// Name is the YAML frontmatter subcategory.
+	Name string `json:"name" yaml:"name"`
+
+	Actions       []*NavigationEntry `json:"actions,omitempty" yaml:"actions,omitempty"`
+	DataSources   []*NavigationEntry `json:"data_sources,omitempty" yaml:"data_sources,omitempty"`
+	Guides        []*NavigationEntry `json:"guides,omitempty" yaml:"guides,omitempty"`
+	ListResources []*NavigationEntry `json:"list_resources,omitempty" yaml:"list_resources,omitempty"`
+	Resources     []*NavigationEntry `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// NavigationEntry represents a documentation file in the navigation tree.
+type NavigationEntry struct {
+	// Name is the inferred data source or resource name, if applicable,
+	// otherwise the file name without file extension.
+	Name string `json:"name" yaml:"name"`
+
+	// PageTitle is the YAML frontmatter page_title, if present.
+	PageTitle string `json:"page_title,omitempty" yaml:"page_title,omitempty"`
+
+	// Path is the file path relative to the Terraform Provider codebase.
+	Path string `json:"path" yaml:"path"`
+}
+
+// NewNavigation returns the navigation tree of the documentation files. CDKTF
+// and localized documentation, which share the navigation of the default
+// documentation, and files in invalid documentation directories are skipped.
+// Entries are sorted by name.
+func NewNavigation(files []*DocumentationFile) *Navigation {
+	result := &Navigation{
+		Subcategories: []*NavigationSubcategory{},
+	}
+	subcategories := make(map[string]*NavigationSubcategory)
+
+	for _, file := range files {
+		if file.CdktfLanguage != "" || file.Locale != "" {
+			continue
+		}
+
+		entry := &NavigationEntry{
+			Name:      file.ResourceName,
+			PageTitle: file.PageTitle,
+			Path:      file.Path,
+		}
+
+		if entry.Name == "" {
+			entry.Name = TrimFileExtension(file.Path)
+		}
+
+		switch file.Type {
+		case DocumentationTypeFunction:
+			result.Functions = append(result.Functions, entry)
+
+			continue
+		case DocumentationTypeIndex:
+			result.Index = entry
+
+			continue
+		case DocumentationTypeAction, DocumentationTypeDataSource, DocumentationTypeGuide, DocumentationTypeListResource, DocumentationTypeResource:
+		default:
+			continue
+		}
+
+		subcategory, ok := subcategories[file.Subcategory]
+
+		if !ok {
+			subcategory = &NavigationSubcategory{
+				Name: file.Subcategory,
+			}
+			subcategories[file.Subcategory] = subcategory
+			result.Subcategories = append(result.Subcategories, subcategory)
+		}
+
+		switch file.Type {
+		case DocumentationTypeAction:
+			subcategory.Actions = append(subcategory.Actions, entry)
+		case DocumentationTypeDataSource:
+			subcategory.DataSources = append(subcategory.DataSources, entry)
+		case DocumentationTypeGuide:
+			subcategory.Guides = append(subcategory.Guides, entry)
+		case DocumentationTypeListResource:
+			subcategory.ListResources = append(subcategory.ListResources, entry)
+		case DocumentationTypeResource:
+			subcategory.Resources = append(subcategory.Resources, entry)
+		}
+	}
+
+	sortNavigationEntries(result.Functions)
+
+	sort.Slice(result.Subcategories, func(i, j int) bool {
+		return result.Subcategories[i].Name < result.Subcategories[j].Name
+	})
+
+	for _, subcategory := range result.Subcategories {
+		sortNavigationEntries(subcategory.Actions)
+		sortNavigationEntries(subcategory.DataSources)
+		sortNavigationEntries(subcategory.Guides)
+		sortNavigationEntries(subcategory.ListResources)
+		sortNavigationEntries(subcategory.Resources)
+	}
+
+	return result
+}
+
+func sortNavigationEntries(entries []*NavigationEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+}
@@ -0,0 +1,156 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewNavigation(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Files  []*DocumentationFile
+		Expect *Navigation
+	}{
+		{
+			Name: "empty",
+			Expect: &Navigation{
+				Subcategories: []*NavigationSubcategory{},
+			},
+		},
+		{
+			Name: "documentation",
+			Files: []*DocumentationFile{
+				{
+					Path:         "docs/data-sources/thing.md",
+					ResourceName: "test_thing",
+					Subcategory:  "Things",
+					Type:         DocumentationTypeDataSource,
+				},
+				{
+					Path:        "docs/functions/parse.md",
+					PageTitle:   "parse function - test",
+					Type:        DocumentationTypeFunction,
+					Subcategory: "Ignored",
+				},
+				{
+					Path:        "docs/guides/upgrade.md",
+					PageTitle:   "Upgrade Guide",
+					Subcategory: "Upgrades",
+					Type:        DocumentationTypeGuide,
+				},
+				{
+					Path:      "docs/guides/authentication.md",
+					PageTitle: "Authentication",
+					Type:      DocumentationTypeGuide,
+				},
+				{
+					Path:      "docs/index.md",
+					PageTitle: "Test Provider",
+					Type:      DocumentationTypeIndex,
+				},
+				{
+					Path:         "docs/resources/widget.md",
+					ResourceName: "test_widget",
+					Subcategory:  "Things",
+					Type:         DocumentationTypeResource,
+				},
+				{
+					Path:         "docs/resources/thing.md",
+					PageTitle:    "test_thing",
+					ResourceName: "test_thing",
+					Subcategory:  "Things",
+					Type:         DocumentationTypeResource,
+				},
+			},
+			Expect: &Navigation{
+				Functions: []*NavigationEntry{
+					{
+						Name:      "parse",
+						PageTitle: "parse function - test",
+						Path:      "docs/functions/parse.md",
+					},
+				},
+				Index: &NavigationEntry{
+					Name:      "index",
+					PageTitle: "Test Provider",
+					Path:      "docs/index.md",
+				},
+				Subcategories: []*NavigationSubcategory{
+					{
+						Guides: []*NavigationEntry{
+							{
+								Name:      "authentication",
+								PageTitle: "Authentication",
+								Path:      "docs/guides/authentication.md",
+							},
+						},
+					},
+					{
+						DataSources: []*NavigationEntry{
+							{
+								Name: "test_thing",
+								Path: "docs/data-sources/thing.md",
+							},
+						},
+						Name: "Things",
+						Resources: []*NavigationEntry{
+							{
+								Name:      "test_thing",
+								PageTitle: "test_thing",
+								Path:      "docs/resources/thing.md",
+							},
+							{
+								Name: "test_widget",
+								Path: "docs/resources/widget.md",
+							},
+						},
+					},
+					{
+						Guides: []*NavigationEntry{
+							{
+								Name:      "upgrade",
+								PageTitle: "Upgrade Guide",
+								Path:      "docs/guides/upgrade.md",
+							},
+						},
+						Name: "Upgrades",
+					},
+				},
+			},
+		},
+		{
+			Name: "cdktf, locale, and unknown",
+			Files: []*DocumentationFile{
+				{
+					CdktfLanguage: "typescript",
+					Path:          "docs/cdktf/typescript/resources/thing.md",
+					ResourceName:  "test_thing",
+					Type:          DocumentationTypeResource,
+				},
+				{
+					Locale:       "ja",
+					Path:         "docs/ja/resources/thing.md",
+					ResourceName: "test_thing",
+					Type:         DocumentationTypeResource,
+				},
+				{
+					Path: "docs/other/thing.md",
+					Type: DocumentationTypeUnknown,
+				},
+			},
+			Expect: &Navigation{
+				Subcategories: []*NavigationSubcategory{},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewNavigation(testCase.Files)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %#v, got: %#v", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+)
+
+const (
+	// NestedSchemaHeadingPrefix is the heading text prefix of
+	// terraform-plugin-docs nested schema sections, which is followed by the
+	// attribute path, e.g. ### Nested Schema for `rule.action`.
+	NestedSchemaHeadingPrefix = "Nested Schema for "
+)
+
+var (
+	// nestedSchemaAnchorRegexp matches terraform-plugin-docs nested schema
+	// anchors, e.g. <a id="nestedblock--rule"></a>, capturing the identifier.
+	nestedSchemaAnchorRegexp = regexp.MustCompile(`<a\s+id\s*=\s*"(nested[A-Za-z]*--[^"]+)"`)
+
+	// nestedSchemaLinkRegexp matches terraform-plugin-docs nested schema link
+	// destinations, e.g. #nestedblock--rule.
+	nestedSchemaLinkRegexp = regexp.MustCompile(`^#nested[A-Za-z]*--.+`)
+)
+
+// NestedSchemaLinksOptions represents configuration options for
+// NestedSchemaLinks.
+type NestedSchemaLinksOptions struct {
+	*FileOptions
+
+	// Enable enables the nested-schema-links check.
+	Enable bool
+}
+
+// NestedSchemaLinksCheck verifies the nested schema links of
+// terraform-plugin-docs generated documentation, e.g. (see [below for nested
+// schema](#nestedblock--rule)), have a matching anchor and Nested Schema for
+// section, and each Nested Schema for section is linked, since regeneration
+// problems can leave dangling links and sections.
+type NestedSchemaLinksCheck struct {
+	Options *NestedSchemaLinksOptions
+}
+
+// nestedSchemaLink represents a nested schema link destination and its line.
+type nestedSchemaLink struct {
+	Destination string
+	Line        int
+}
+
+func NewNestedSchemaLinksCheck(opts *NestedSchemaLinksOptions) *NestedSchemaLinksCheck {
+	check := &NestedSchemaLinksCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &NestedSchemaLinksOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each documentation file, except CDKTF documentation, which is
+// generated from the same documentation, and localized documentation, whose
+// headings are translated.
+func (check *NestedSchemaLinksCheck) Run(directories map[string][]string) error {
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the nested schema links and sections of the documentation
+// file.
+func (check *NestedSchemaLinksCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file nested schema links", "check", CheckIDNestedSchemaLinks, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	document, _ := markdown.Parse(content)
+
+	var links []nestedSchemaLink
+	var sectionPaths []string
+	sectionLines := make(map[string]int)
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.CodeBlock, *ast.CodeSpan, *ast.FencedCodeBlock:
+			return ast.WalkSkipChildren, nil
+		case *ast.Heading:
+			attributePath, ok := strings.CutPrefix(string(node.Text(content)), NestedSchemaHeadingPrefix)
+
+			if !ok || node.Lines().Len() == 0 {
+				return ast.WalkSkipChildren, nil
+			}
+
+			attributePath = strings.Trim(attributePath, "`")
+
+			if _, ok := sectionLines[attributePath]; !ok {
+				sectionPaths = append(sectionPaths, attributePath)
+				sectionLines[attributePath] = strings.Count(string(content[:node.Lines().At(0).Start]), "\n") + 1
+			}
+
+			return ast.WalkSkipChildren, nil
+		case *ast.Link:
+			destination := string(node.Destination)
+
+			if !nestedSchemaLinkRegexp.MatchString(destination) {
+				return ast.WalkContinue, nil
+			}
+
+			link := nestedSchemaLink{
+				Destination: destination,
+			}
+
+			if text, ok := node.FirstChild().(*ast.Text); ok {
+				link.Line = strings.Count(string(content[:text.Segment.Start]), "\n") + 1
+			}
+
+			links = append(links, link)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	anchors := make(map[string]bool)
+
+	for _, segment := range rawHTMLSegments(content) {
+		for _, match := range nestedSchemaAnchorRegexp.FindAllStringSubmatch(string(segment.Value(content)), -1) {
+			anchors[match[1]] = true
+		}
+	}
+
+	var messages []string
+	linkedPaths := make(map[string]bool)
+
+	for _, link := range links {
+		anchor := strings.TrimPrefix(link.Destination, "#")
+		attributePath := nestedSchemaAttributePath(anchor)
+		linkedPaths[attributePath] = true
+
+		if _, ok := sectionLines[attributePath]; !ok {
+			messages = append(messages, fmt.Sprintf("link (%s) has no matching section: ### %s`%s` (line %d)", link.Destination, NestedSchemaHeadingPrefix, attributePath, link.Line))
+
+			continue
+		}
+
+		if !anchors[anchor] {
+			messages = append(messages, fmt.Sprintf("link (%s) has no matching anchor: <a id=%q></a> (line %d)", link.Destination, anchor, link.Line))
+		}
+	}
+
+	for _, attributePath := range sectionPaths {
+		if linkedPaths[attributePath] {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("section (### %s`%s`) is not linked from an attribute (line %d)", NestedSchemaHeadingPrefix, attributePath, sectionLines[attributePath]))
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err = fmt.Errorf("%s: dangling nested schema links or sections: %s", path, strings.Join(messages, ", "))
+	err = WithSuggestion(err, "regenerate the documentation with terraform-plugin-docs, or fix the links and Nested Schema for sections")
+
+	return NewFinding(CheckIDNestedSchemaLinks, path, err)
+}
+
+// nestedSchemaAttributePath returns the attribute path of a terraform-plugin-docs
+// nested schema anchor, e.g. rule.action for nestedblock--rule--action.
+func nestedSchemaAttributePath(anchor string) string {
+	parts := strings.Split(anchor, "--")
+
+	return strings.Join(parts[1:], ".")
+}
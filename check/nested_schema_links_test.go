@@ -0,0 +1,55 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestNestedSchemaLinksCheck(t *testing.T) {
+	opts := &NestedSchemaLinksOptions{
+		FileOptions: &FileOptions{
+			BasePath: "testdata/nested-schema-links",
+		},
+	}
+
+	got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewNestedSchemaLinksCheck(opts).Run, CheckIDNestedSchemaLinks))
+
+	sort.Strings(got)
+
+	expect := []string{
+		"docs/resources/dangling.md: dangling nested schema links or sections: link (#nestedatt--filter) has no matching section: ### Nested Schema for `filter` (line 17), link (#nestedblock--timeouts) has no matching anchor: <a id=\"nestedblock--timeouts\"></a> (line 19), section (### Nested Schema for `setting`) is not linked from an attribute (line 35)",
+	}
+
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected errors: %v, got: %v", expect, got)
+	}
+}
+
+func TestNestedSchemaAttributePath(t *testing.T) {
+	testCases := []struct {
+		Anchor string
+		Expect string
+	}{
+		{
+			Anchor: "nestedblock--rule",
+			Expect: "rule",
+		},
+		{
+			Anchor: "nestedatt--rule--action",
+			Expect: "rule.action",
+		},
+		{
+			Anchor: "nestedobjatt--rule--action--type",
+			Expect: "rule.action.type",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Anchor, func(t *testing.T) {
+			if got := nestedSchemaAttributePath(testCase.Anchor); got != testCase.Expect {
+				t.Errorf("expected %s, got: %s", testCase.Expect, got)
+			}
+		})
+	}
+}
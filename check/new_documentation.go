@@ -0,0 +1,145 @@
+package check
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// NewDocumentationOptions represents configuration options for
+// NewDocumentation.
+type NewDocumentationOptions struct {
+	*FileOptions
+
+	DataSourceSchemas map[string]*tfjson.Schema
+
+	// DataSourceFiles maps data source names to documentation file names,
+	// without file extension, which differ from the name without provider
+	// prefix.
+	DataSourceFiles map[string]string
+
+	// PreviousFiles contains the slash separated paths of all files, relative
+	// to the Terraform Provider codebase, in the previous release.
+	PreviousFiles []string
+
+	ProviderName string
+
+	// Ref is the git reference of the previous release, such as the previous
+	// release tag, which is used in messages.
+	Ref string
+
+	ResourceSchemas map[string]*tfjson.Schema
+
+	// ResourceFiles maps resource names to documentation file names, without
+	// file extension, which differ from the name without provider prefix.
+	ResourceFiles map[string]string
+}
+
+// NewDocumentationCheck verifies data sources and resources in the providers
+// schema which were not documented in the previous release, such as newly
+// added data sources and resources, are documented.
+type NewDocumentationCheck struct {
+	Options *NewDocumentationOptions
+}
+
+func NewNewDocumentationCheck(opts *NewDocumentationOptions) *NewDocumentationCheck {
+	check := &NewDocumentationCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &NewDocumentationOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each data source and resource in the providers schema is
+// documented in the directories if it was not documented in the previous
+// release files. CDKTF and localized documentation is ignored.
+func (check *NewDocumentationCheck) Run(directories map[string][]string) error {
+	var currentFiles []string
+
+	for _, files := range directories {
+		currentFiles = append(currentFiles, files...)
+	}
+
+	resourceTypes := []struct {
+		Files        map[string]string
+		ResourceType string
+		Schemas      map[string]*tfjson.Schema
+	}{
+		{
+			Files:        check.Options.DataSourceFiles,
+			ResourceType: ResourceTypeDataSource,
+			Schemas:      check.Options.DataSourceSchemas,
+		},
+		{
+			Files:        check.Options.ResourceFiles,
+			ResourceType: ResourceTypeResource,
+			Schemas:      check.Options.ResourceSchemas,
+		},
+	}
+
+	var result *multierror.Error
+
+	for _, resourceType := range resourceTypes {
+		previous := check.documentedFiles(check.Options.PreviousFiles, resourceType.ResourceType)
+		current := check.documentedFiles(currentFiles, resourceType.ResourceType)
+
+		var added []string
+
+		for _, resourceName := range resourceNames(resourceType.Schemas) {
+			if resourceHasFile(previous, check.Options.ProviderName, resourceName) || resourceHasMappedFile(previous, resourceType.Files, resourceName) {
+				continue
+			}
+
+			added = append(added, resourceName)
+
+			if resourceHasFile(current, check.Options.ProviderName, resourceName) || resourceHasMappedFile(current, resourceType.Files, resourceName) {
+				continue
+			}
+
+			err := fmt.Errorf("missing documentation file for %s added since %s: %s", resourceType.ResourceType, check.Options.Ref, resourceName)
+			err = WithSuggestion(err, fmt.Sprintf("add a documentation file, e.g. %s%s", resourceFileName(check.Options.ProviderName, resourceName), FileExtensionMd))
+			result = multierror.Append(result, NewFinding(CheckIDNewDocumentation, "", err))
+		}
+
+		if len(added) > 0 {
+			check.Options.Log().Info("Found undocumented "+resourceType.ResourceType+"s in previous release", "check", CheckIDNewDocumentation, "ref", check.Options.Ref, "count", len(added), "names", strings.Join(added, ","))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// documentedFiles returns the file names of the files in the documentation
+// directories of the resource type, excluding CDKTF and localized
+// documentation.
+func (check *NewDocumentationCheck) documentedFiles(files []string, resourceType string) []string {
+	var result []string
+
+	for _, file := range files {
+		directory := path.Dir(filepath.ToSlash(file))
+
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		if DirectoryDocumentationType(directory) != resourceType {
+			continue
+		}
+
+		result = append(result, path.Base(filepath.ToSlash(file)))
+	}
+
+	return result
+}
@@ -0,0 +1,100 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestNewDocumentationCheck(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Directories   map[string][]string
+		PreviousFiles []string
+		ResourceFiles map[string]string
+		ExpectErrors  []string
+	}{
+		{
+			Name: "documented",
+			Directories: map[string][]string{
+				"docs/data-sources": {"docs/data-sources/thing.md"},
+				"docs/resources":    {"docs/resources/new.md", "docs/resources/thing.md"},
+			},
+			PreviousFiles: []string{
+				"docs/data-sources/thing.md",
+				"docs/resources/thing.md",
+			},
+		},
+		{
+			Name: "new resource missing documentation",
+			Directories: map[string][]string{
+				"docs/data-sources": {"docs/data-sources/thing.md"},
+				"docs/resources":    {"docs/resources/thing.md"},
+			},
+			PreviousFiles: []string{
+				"docs/data-sources/thing.md",
+				"docs/resources/thing.md",
+			},
+			ExpectErrors: []string{
+				"missing documentation file for resource added since v1.0.0: test_new",
+			},
+		},
+		{
+			Name: "undocumented in previous release",
+			Directories: map[string][]string{
+				"website/docs/r": {"website/docs/r/thing.html.markdown"},
+			},
+			PreviousFiles: []string{
+				"website/docs/r/thing.html.markdown",
+				"docs/cdktf/python/d/thing.md",
+			},
+			ExpectErrors: []string{
+				"missing documentation file for data source added since v1.0.0: test_thing",
+				"missing documentation file for resource added since v1.0.0: test_new",
+			},
+		},
+		{
+			Name: "mapped file",
+			Directories: map[string][]string{
+				"docs/data-sources": {"docs/data-sources/thing.md"},
+				"docs/resources":    {"docs/resources/things.md"},
+			},
+			PreviousFiles: []string{
+				"docs/data-sources/thing.md",
+				"docs/resources/things.md",
+			},
+			ResourceFiles: map[string]string{
+				"test_new":   "things",
+				"test_thing": "things",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &NewDocumentationOptions{
+				DataSourceSchemas: map[string]*tfjson.Schema{
+					"test_thing": {},
+				},
+				PreviousFiles: testCase.PreviousFiles,
+				ProviderName:  "test",
+				Ref:           "v1.0.0",
+				ResourceFiles: testCase.ResourceFiles,
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_new":   {},
+					"test_thing": {},
+				},
+			}
+
+			got := testFindingErrors(testFindings(t, NewNewDocumentationCheck(opts).Run(testCase.Directories), CheckIDNewDocumentation))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectErrors) {
+				t.Errorf("expected: %q, got: %q", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
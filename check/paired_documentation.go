@@ -0,0 +1,212 @@
+package check
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// PairedDocumentationOptions represents configuration options for
+// PairedDocumentation.
+type PairedDocumentationOptions struct {
+	*FileOptions
+
+	// Enable enables the paired-documentation check.
+	Enable bool
+
+	ProviderName string
+
+	// ProviderSource, if set, limits the Terraform Registry links between
+	// paired documentation to the provider namespace.
+	ProviderSource string
+
+	// RequireLinks requires the resource and data source documentation of a
+	// pair to link to each other.
+	RequireLinks bool
+}
+
+// PairedDocumentationCheck verifies the documentation of a resource and the
+// data source of the same name are consistent, so the pair is listed in the
+// same Terraform Registry navigation sidebar section.
+type PairedDocumentationCheck struct {
+	Options *PairedDocumentationOptions
+}
+
+// pairedDocumentationFile is the frontmatter subcategory and links to other
+// documentation of a resource or data source documentation file.
+type pairedDocumentationFile struct {
+	links       map[string]map[string]bool
+	subcategory string
+}
+
+func NewPairedDocumentationCheck(opts *PairedDocumentationOptions) *PairedDocumentationCheck {
+	check := &PairedDocumentationCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &PairedDocumentationOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each resource and data source documentation pair of the same
+// name and directory structure uses the same frontmatter subcategory and, if
+// required, links to each other. Documentation with invalid frontmatter,
+// which is reported by the frontmatter check, is skipped.
+func (check *PairedDocumentationCheck) Run(directories map[string][]string) error {
+	// Pairs are keyed by directory structure and name.
+	dataSources := make(map[string]string)
+	resources := make(map[string]string)
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		var documentationFiles map[string]string
+
+		switch DirectoryDocumentationType(directory) {
+		case DocumentationTypeDataSource:
+			documentationFiles = dataSources
+		case DocumentationTypeResource:
+			documentationFiles = resources
+		default:
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			documentationFiles[DirectoryStructure(directory)+"/"+TrimFileExtension(file)] = file
+		}
+	}
+
+	var keys []string
+
+	for key := range resources {
+		if _, ok := dataSources[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var result *multierror.Error
+
+	for _, key := range keys {
+		if err := check.RunPair(resources[key], dataSources[key]); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunPair verifies the resource and data source documentation files of the
+// same name.
+func (check *PairedDocumentationCheck) RunPair(resourcePath string, dataSourcePath string) error {
+	check.Options.Log().Debug("Checking paired documentation files", "check", CheckIDPairedDocumentation, "resource_file", resourcePath, "data_source_file", dataSourcePath)
+
+	resource, err := check.file(resourcePath)
+
+	if err != nil || resource == nil {
+		return err
+	}
+
+	dataSource, err := check.file(dataSourcePath)
+
+	if err != nil || dataSource == nil {
+		return err
+	}
+
+	name := TrimFileExtension(resourcePath)
+
+	var result *multierror.Error
+
+	if resource.subcategory != dataSource.subcategory {
+		err := fmt.Errorf("%s: YAML frontmatter subcategory (%q) differs from the resource documentation (%s) subcategory (%q)", dataSourcePath, dataSource.subcategory, resourcePath, resource.subcategory)
+		err = WithSuggestion(err, fmt.Sprintf("change the YAML frontmatter of both files to the same subcategory, e.g. `subcategory: %q`", resource.subcategory))
+		result = multierror.Append(result, NewFinding(CheckIDPairedDocumentation, dataSourcePath, err))
+	}
+
+	if !check.Options.RequireLinks {
+		return result.ErrorOrNil()
+	}
+
+	if !resource.links[DocumentationTypeDataSource][name] {
+		err := fmt.Errorf("%s: missing link to the data source documentation of the same name: %s", resourcePath, dataSourcePath)
+		err = WithSuggestion(err, "add a link to the data source documentation, e.g. in the description")
+		result = multierror.Append(result, NewFinding(CheckIDPairedDocumentation, resourcePath, err))
+	}
+
+	if !dataSource.links[DocumentationTypeResource][name] {
+		err := fmt.Errorf("%s: missing link to the resource documentation of the same name: %s", dataSourcePath, resourcePath)
+		err = WithSuggestion(err, "add a link to the resource documentation, e.g. in the description")
+		result = multierror.Append(result, NewFinding(CheckIDPairedDocumentation, dataSourcePath, err))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// file returns the frontmatter subcategory and documentation links of the
+// file, or nil if the frontmatter is invalid.
+func (check *PairedDocumentationCheck) file(path string) (*pairedDocumentationFile, error) {
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	frontMatter, err := ParseFrontMatter(content)
+
+	if err != nil {
+		return nil, nil
+	}
+
+	result := &pairedDocumentationFile{
+		links: make(map[string]map[string]bool),
+	}
+
+	if frontMatter.Subcategory != nil {
+		result.subcategory = *frontMatter.Subcategory
+	}
+
+	for _, destination := range linkDestinations(content) {
+		u, err := url.Parse(destination)
+
+		if err != nil {
+			continue
+		}
+
+		documentationType, name, ok := registryReference(u, check.Options.ProviderName, check.Options.ProviderSource)
+
+		if !ok {
+			documentationType, name, ok = relativeReference(filepath.ToSlash(path), u)
+		}
+
+		if !ok {
+			continue
+		}
+
+		if result.links[documentationType] == nil {
+			result.links[documentationType] = make(map[string]bool)
+		}
+
+		result.links[documentationType][name] = true
+	}
+
+	return result, nil
+}
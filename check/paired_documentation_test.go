@@ -0,0 +1,60 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPairedDocumentationCheck(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Opts   *PairedDocumentationOptions
+		Expect []string
+	}{
+		{
+			Name: "subcategories",
+			Opts: &PairedDocumentationOptions{},
+			Expect: []string{
+				`docs/data-sources/thing.md: YAML frontmatter subcategory ("Thing") differs from the resource documentation (docs/resources/thing.md) subcategory ("Things")`,
+			},
+		},
+		{
+			Name: "require links",
+			Opts: &PairedDocumentationOptions{
+				ProviderName: "test",
+				RequireLinks: true,
+			},
+			Expect: []string{
+				`docs/data-sources/thing.md: YAML frontmatter subcategory ("Thing") differs from the resource documentation (docs/resources/thing.md) subcategory ("Things")`,
+				"docs/data-sources/widget.md: missing link to the resource documentation of the same name: docs/resources/widget.md",
+				"docs/resources/widget.md: missing link to the data source documentation of the same name: docs/data-sources/widget.md",
+			},
+		},
+		{
+			Name: "require links without provider name",
+			Opts: &PairedDocumentationOptions{
+				RequireLinks: true,
+			},
+			Expect: []string{
+				`docs/data-sources/thing.md: YAML frontmatter subcategory ("Thing") differs from the resource documentation (docs/resources/thing.md) subcategory ("Things")`,
+				"docs/data-sources/thing.md: missing link to the resource documentation of the same name: docs/resources/thing.md",
+				"docs/data-sources/widget.md: missing link to the resource documentation of the same name: docs/resources/widget.md",
+				"docs/resources/widget.md: missing link to the data source documentation of the same name: docs/data-sources/widget.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Opts.FileOptions = &FileOptions{
+				BasePath: "testdata/paired-documentation",
+			}
+
+			got := testFindingErrors(testCheckFindings(t, testCase.Opts.BasePath, NewPairedDocumentationCheck(testCase.Opts).Run, CheckIDPairedDocumentation))
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected errors: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
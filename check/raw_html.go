@@ -0,0 +1,236 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/hashicorp/go-multierror"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+var (
+	// rawHTMLTagRegexp matches opening HTML tags, capturing the tag name and
+	// attributes. Closing tags, comments, and declarations are not matched.
+	rawHTMLTagRegexp = regexp.MustCompile(`<([A-Za-z][A-Za-z0-9-]*)((?:\s[^<>]*)?)/?>`)
+
+	// rawHTMLRowspanRegexp matches the rowspan attribute, which the Terraform
+	// Registry removes from table cells.
+	rawHTMLRowspanRegexp = regexp.MustCompile(`(?i)(?:^|\s)rowspan\s*=`)
+)
+
+// DefaultAllowedHTMLTags contains the raw HTML tags rendered by the Terraform
+// Registry. Other tags, such as div, iframe, script, and style, are removed.
+var DefaultAllowedHTMLTags = []string{
+	"a",
+	"b",
+	"br",
+	"code",
+	"details",
+	"em",
+	"i",
+	"kbd",
+	"li",
+	"ol",
+	"p",
+	"pre",
+	"span",
+	"strong",
+	"sub",
+	"summary",
+	"sup",
+	"table",
+	"tbody",
+	"td",
+	"th",
+	"thead",
+	"tr",
+	"ul",
+}
+
+// RawHTMLOptions represents configuration options for RawHTML.
+type RawHTMLOptions struct {
+	*FileOptions
+
+	// AllowedTags contains additional raw HTML tags to allow, such as tags
+	// rendered by the Terraform Registry which are not in
+	// DefaultAllowedHTMLTags.
+	AllowedTags []string
+
+	// Enable enables the raw-html check.
+	Enable bool
+}
+
+// RawHTMLCheck verifies raw HTML in documentation only uses tags the
+// Terraform Registry renders, since other tags and the rowspan attribute are
+// removed when the documentation is displayed. Code blocks and code spans are
+// not checked.
+type RawHTMLCheck struct {
+	Options *RawHTMLOptions
+}
+
+func NewRawHTMLCheck(opts *RawHTMLOptions) *RawHTMLCheck {
+	check := &RawHTMLCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &RawHTMLOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each documentation file, except CDKTF documentation, which is
+// generated from the same documentation.
+func (check *RawHTMLCheck) Run(directories map[string][]string) error {
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the raw HTML of the documentation file.
+func (check *RawHTMLCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file raw HTML", "check", CheckIDRawHTML, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	// Removed tags and attributes are reported once with all of their lines,
+	// in order of first appearance.
+	var removed []string
+	lines := make(map[string][]string)
+
+	addRemoved := func(name string, line int) {
+		removedLines, ok := lines[name]
+
+		if !ok {
+			removed = append(removed, name)
+		}
+
+		if n := len(removedLines); n == 0 || removedLines[n-1] != fmt.Sprint(line) {
+			lines[name] = append(removedLines, fmt.Sprint(line))
+		}
+	}
+
+	for _, segment := range rawHTMLSegments(content) {
+		html := string(segment.Value(content))
+
+		for _, match := range rawHTMLTagRegexp.FindAllStringSubmatchIndex(html, -1) {
+			line := strings.Count(string(content[:segment.Start+match[0]]), "\n") + 1
+			tag := strings.ToLower(html[match[2]:match[3]])
+
+			if !check.allowedTag(tag) {
+				addRemoved(tag, line)
+
+				continue
+			}
+
+			if rawHTMLRowspanRegexp.MatchString(html[match[4]:match[5]]) {
+				addRemoved("rowspan attribute", line)
+			}
+		}
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(removed))
+
+	for index, name := range removed {
+		if len(lines[name]) == 1 {
+			messages[index] = fmt.Sprintf("%s (line %s)", name, lines[name][0])
+		} else {
+			messages[index] = fmt.Sprintf("%s (lines %s)", name, strings.Join(lines[name], ", "))
+		}
+	}
+
+	err = fmt.Errorf("%s: raw HTML removed by the Terraform Registry: %s", path, strings.Join(messages, ", "))
+	err = WithSuggestion(err, "use Markdown instead, or use the -allowed-html-tags flag for tags the Terraform Registry renders")
+
+	return NewFinding(CheckIDRawHTML, path, err)
+}
+
+func (check *RawHTMLCheck) allowedTag(tag string) bool {
+	for _, allowedTag := range DefaultAllowedHTMLTags {
+		if tag == allowedTag {
+			return true
+		}
+	}
+
+	for _, allowedTag := range check.Options.AllowedTags {
+		if strings.EqualFold(tag, allowedTag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rawHTMLSegments returns the source segments of the HTML blocks and inline
+// raw HTML of the Markdown source. Each segment spans all lines of the HTML,
+// so tags with attributes on multiple lines are matched.
+func rawHTMLSegments(source []byte) []text.Segment {
+	document, _ := markdown.Parse(source)
+
+	var result []text.Segment
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := node.(type) {
+		case *ast.CodeBlock, *ast.CodeSpan, *ast.FencedCodeBlock:
+			return ast.WalkSkipChildren, nil
+		case *ast.HTMLBlock:
+			if lines := node.Lines(); lines.Len() > 0 {
+				segment := text.NewSegment(lines.At(0).Start, lines.At(lines.Len()-1).Stop)
+
+				if node.HasClosure() {
+					segment.Stop = node.ClosureLine.Stop
+				}
+
+				result = append(result, segment)
+			}
+
+			return ast.WalkSkipChildren, nil
+		case *ast.RawHTML:
+			if node.Segments.Len() > 0 {
+				result = append(result, text.NewSegment(node.Segments.At(0).Start, node.Segments.At(node.Segments.Len()-1).Stop))
+			}
+
+			return ast.WalkSkipChildren, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return result
+}
@@ -0,0 +1,45 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRawHTMLCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		AllowedTags []string
+		Expect      []string
+	}{
+		{
+			Name: "default allowed tags",
+			Expect: []string{
+				"docs/resources/thing.md: raw HTML removed by the Terraform Registry: div (lines 12, 41), script (line 16), font (line 31), rowspan attribute (line 35)",
+			},
+		},
+		{
+			Name:        "additional allowed tags",
+			AllowedTags: []string{"DIV", "font", "script"},
+			Expect: []string{
+				"docs/resources/thing.md: raw HTML removed by the Terraform Registry: rowspan attribute (line 35)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &RawHTMLOptions{
+				AllowedTags: testCase.AllowedTags,
+				FileOptions: &FileOptions{
+					BasePath: "testdata/raw-html",
+				},
+			}
+
+			got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewRawHTMLCheck(opts).Run, CheckIDRawHTML))
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected errors: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
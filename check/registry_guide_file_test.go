@@ -28,6 +28,23 @@ func TestRegistryGuideFileCheck(t *testing.T) {
 			Path:        "guide_invalid_extension.markdown",
 			ExpectError: true,
 		},
+		{
+			Name:     "crlf line endings",
+			BasePath: "testdata/invalid-registry-files",
+			Path:     "guide_crlf_line_endings.md",
+		},
+		{
+			Name:     "crlf line endings with required lf line endings",
+			BasePath: "testdata/invalid-registry-files",
+			Path:     "guide_crlf_line_endings.md",
+			Options: &RegistryGuideFileOptions{
+				FileOptions: &FileOptions{
+					BasePath:             "testdata/invalid-registry-files",
+					RequireLFLineEndings: true,
+				},
+			},
+			ExpectError: true,
+		},
 		{
 			Name:        "invalid frontmatter",
 			BasePath:    "testdata/invalid-registry-files",
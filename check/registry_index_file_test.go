@@ -17,6 +17,11 @@ func TestRegistryIndexFileCheck(t *testing.T) {
 			BasePath: "testdata/valid-registry-files",
 			Path:     "index.md",
 		},
+		{
+			Name:     "valid with empty subcategory",
+			BasePath: "testdata/valid-registry-files",
+			Path:     "index_with_empty_subcategory.md",
+		},
 		{
 			Name:        "invalid extension",
 			BasePath:    "testdata/invalid-registry-files",
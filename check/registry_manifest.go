@@ -0,0 +1,182 @@
+package check
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+const (
+	// RegistryManifestFile is the Terraform Registry manifest file name in the
+	// Terraform Provider codebase, which is included in release artifacts.
+	RegistryManifestFile = "terraform-registry-manifest.json"
+
+	// RegistryManifestVersion is the supported Terraform Registry manifest
+	// version.
+	RegistryManifestVersion = 1
+
+	// RegistryManifestProtocolVersion6 is the Terraform Plugin Protocol
+	// version required by nested attributes.
+	RegistryManifestProtocolVersion6 = "6.0"
+)
+
+// RegistryManifestProtocolVersions contains the Terraform Plugin Protocol
+// versions supported by the Terraform Registry.
+var RegistryManifestProtocolVersions = []string{
+	"5.0",
+	RegistryManifestProtocolVersion6,
+}
+
+// RegistryManifestOptions represents configuration options for
+// RegistryManifest.
+type RegistryManifestOptions struct {
+	*FileOptions
+
+	// DataSourceSchemas contains the data source schemas from the providers
+	// schema, if loaded, for verifying the protocol versions.
+	DataSourceSchemas map[string]*tfjson.Schema
+
+	// Require reports a missing manifest, such as when the provider is
+	// published to a registry.
+	Require bool
+
+	// ResourceSchemas contains the resource schemas from the providers
+	// schema, if loaded, for verifying the protocol versions.
+	ResourceSchemas map[string]*tfjson.Schema
+}
+
+// RegistryManifestCheck verifies the Terraform Registry manifest of the
+// Terraform Provider codebase parses and declares supported protocol versions,
+// since an invalid manifest blocks publishing the provider and documentation.
+type RegistryManifestCheck struct {
+	Options *RegistryManifestOptions
+}
+
+// registryManifest represents the terraform-registry-manifest.json file.
+type registryManifest struct {
+	Version  int `json:"version"`
+	Metadata struct {
+		ProtocolVersions []string `json:"protocol_versions"`
+	} `json:"metadata"`
+}
+
+func NewRegistryManifestCheck(opts *RegistryManifestOptions) *RegistryManifestCheck {
+	check := &RegistryManifestCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &RegistryManifestOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the manifest, if present. A missing manifest is only reported
+// if required.
+func (check *RegistryManifestCheck) Run() error {
+	check.Options.Log().Debug("Checking Terraform Registry manifest", "check", CheckIDRegistryManifest, "file", RegistryManifestFile)
+
+	content, err := check.Options.ReadFile(RegistryManifestFile)
+
+	if errors.Is(err, os.ErrNotExist) {
+		if !check.Options.Require {
+			return nil
+		}
+
+		err := fmt.Errorf("missing %s, which declares the Terraform Plugin Protocol versions for the Terraform Registry", RegistryManifestFile)
+		err = WithSuggestion(err, fmt.Sprintf(`add %s, e.g. {"version": %d, "metadata": {"protocol_versions": ["%s"]}}`, RegistryManifestFile, RegistryManifestVersion, RegistryManifestProtocolVersion6))
+
+		return NewFinding(CheckIDRegistryManifest, "", err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", RegistryManifestFile, err)
+	}
+
+	var manifest registryManifest
+
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return NewFinding(CheckIDRegistryManifest, RegistryManifestFile, fmt.Errorf("%s: error parsing JSON: %w", RegistryManifestFile, err))
+	}
+
+	var result *multierror.Error
+
+	if manifest.Version != RegistryManifestVersion {
+		err := fmt.Errorf("%s: unsupported version (%d), expected %d", RegistryManifestFile, manifest.Version, RegistryManifestVersion)
+		result = multierror.Append(result, NewFinding(CheckIDRegistryManifest, RegistryManifestFile, err))
+	}
+
+	if len(manifest.Metadata.ProtocolVersions) == 0 {
+		err := fmt.Errorf("%s: missing metadata.protocol_versions", RegistryManifestFile)
+		err = WithSuggestion(err, fmt.Sprintf(`add "protocol_versions": ["%s"] to the metadata`, RegistryManifestProtocolVersion6))
+		result = multierror.Append(result, NewFinding(CheckIDRegistryManifest, RegistryManifestFile, err))
+	}
+
+	for _, protocolVersion := range manifest.Metadata.ProtocolVersions {
+		if !containsString(RegistryManifestProtocolVersions, protocolVersion) {
+			err := fmt.Errorf("%s: unsupported protocol version (%s), expected one of: %s", RegistryManifestFile, protocolVersion, strings.Join(RegistryManifestProtocolVersions, ", "))
+			result = multierror.Append(result, NewFinding(CheckIDRegistryManifest, RegistryManifestFile, err))
+		}
+	}
+
+	if len(manifest.Metadata.ProtocolVersions) > 0 && !containsString(manifest.Metadata.ProtocolVersions, RegistryManifestProtocolVersion6) {
+		if names := nestedAttributeSchemaNames(check.Options.DataSourceSchemas, check.Options.ResourceSchemas); len(names) > 0 {
+			err := fmt.Errorf("%s: protocol version %s required by nested attributes in providers schema: %s", RegistryManifestFile, RegistryManifestProtocolVersion6, strings.Join(names, ", "))
+			err = WithSuggestion(err, fmt.Sprintf(`set "protocol_versions": ["%s"] in the metadata`, RegistryManifestProtocolVersion6))
+			result = multierror.Append(result, NewFinding(CheckIDRegistryManifest, RegistryManifestFile, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// nestedAttributeSchemaNames returns the sorted names of the data source and
+// resource schemas with nested attributes, which require protocol version 6.
+func nestedAttributeSchemaNames(schemaGroups ...map[string]*tfjson.Schema) []string {
+	var result []string
+
+	for _, schemas := range schemaGroups {
+		for name, schema := range schemas {
+			if schema != nil && blockHasNestedAttributes(schema.Block) && !containsString(result, name) {
+				result = append(result, name)
+			}
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// blockHasNestedAttributes returns true if the schema block or its nested
+// blocks contain an attribute with a nested type.
+func blockHasNestedAttributes(block *tfjson.SchemaBlock) bool {
+	if block == nil {
+		return false
+	}
+
+	for _, attribute := range block.Attributes {
+		if attribute != nil && attribute.AttributeNestedType != nil {
+			return true
+		}
+	}
+
+	for _, nestedBlock := range block.NestedBlocks {
+		if nestedBlock != nil && blockHasNestedAttributes(nestedBlock.Block) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,124 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestRegistryManifestCheck(t *testing.T) {
+	nestedAttributeSchemas := map[string]*tfjson.Schema{
+		"test_nested": {
+			Block: &tfjson.SchemaBlock{
+				NestedBlocks: map[string]*tfjson.SchemaBlockType{
+					"setting": {
+						Block: &tfjson.SchemaBlock{
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"values": {
+									AttributeNestedType: &tfjson.SchemaNestedAttributeType{},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"test_thing": {
+			Block: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"name": {},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		BasePath        string
+		Require         bool
+		ResourceSchemas map[string]*tfjson.Schema
+		Expect          []string
+	}{
+		{
+			Name:     "valid",
+			BasePath: "testdata/registry-manifest/valid",
+			Require:  true,
+		},
+		{
+			Name:            "valid with nested attributes",
+			BasePath:        "testdata/registry-manifest/valid",
+			ResourceSchemas: nestedAttributeSchemas,
+		},
+		{
+			Name:     "missing",
+			BasePath: "testdata/registry-manifest/missing",
+		},
+		{
+			Name:     "missing required",
+			BasePath: "testdata/registry-manifest/missing",
+			Require:  true,
+			Expect: []string{
+				"missing terraform-registry-manifest.json, which declares the Terraform Plugin Protocol versions for the Terraform Registry",
+			},
+		},
+		{
+			Name:     "invalid json",
+			BasePath: "testdata/registry-manifest/invalid-json",
+			Expect: []string{
+				"terraform-registry-manifest.json: error parsing JSON: unexpected end of JSON input",
+			},
+		},
+		{
+			Name:     "unsupported version",
+			BasePath: "testdata/registry-manifest/unsupported-version",
+			Expect: []string{
+				"terraform-registry-manifest.json: unsupported version (2), expected 1",
+			},
+		},
+		{
+			Name:     "unsupported protocol version",
+			BasePath: "testdata/registry-manifest/unsupported-protocol-version",
+			Expect: []string{
+				"terraform-registry-manifest.json: unsupported protocol version (4.0), expected one of: 5.0, 6.0",
+			},
+		},
+		{
+			Name:     "missing protocol versions",
+			BasePath: "testdata/registry-manifest/missing-protocol-versions",
+			Expect: []string{
+				"terraform-registry-manifest.json: missing metadata.protocol_versions",
+			},
+		},
+		{
+			Name:     "protocol version 5",
+			BasePath: "testdata/registry-manifest/protocol-version-5",
+		},
+		{
+			Name:            "protocol version 5 with nested attributes",
+			BasePath:        "testdata/registry-manifest/protocol-version-5",
+			ResourceSchemas: nestedAttributeSchemas,
+			Expect: []string{
+				"terraform-registry-manifest.json: protocol version 6.0 required by nested attributes in providers schema: test_nested",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &RegistryManifestOptions{
+				FileOptions: &FileOptions{
+					BasePath: testCase.BasePath,
+				},
+				Require:         testCase.Require,
+				ResourceSchemas: testCase.ResourceSchemas,
+			}
+
+			got := testFindingErrors(testFindings(t, NewRegistryManifestCheck(opts).Run(), CheckIDRegistryManifest))
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected errors: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
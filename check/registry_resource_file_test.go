@@ -47,6 +47,52 @@ func TestRegistryResourceFileCheck(t *testing.T) {
 			ExampleLanguage: "terraform",
 			ExpectError:     true,
 		},
+		{
+			Name:            "invalid frontmatter page_title",
+			BasePath:        "testdata/valid-registry-files",
+			Path:            "resource.md",
+			ExampleLanguage: "terraform",
+			Options: &RegistryResourceFileOptions{
+				FileOptions: &FileOptions{
+					BasePath:                        "testdata/valid-registry-files",
+					EnableFrontMatterPageTitleCheck: true,
+				},
+				ProviderName: "example",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:            "invalid title heading",
+			BasePath:        "testdata/invalid-registry-files",
+			Path:            "resource_with_mismatched_title_heading.md",
+			ExampleLanguage: "terraform",
+			Options: &RegistryResourceFileOptions{
+				FileOptions: &FileOptions{
+					BasePath:                "testdata/invalid-registry-files",
+					EnableTitleHeadingCheck: true,
+				},
+				ProviderName: "example",
+			},
+			ExpectError: true,
+		},
+		{
+			Name:            "invalid title heading disabled",
+			BasePath:        "testdata/invalid-registry-files",
+			Path:            "resource_with_mismatched_title_heading.md",
+			ExampleLanguage: "terraform",
+			Options: &RegistryResourceFileOptions{
+				ProviderName: "example",
+			},
+		},
+		{
+			Name:            "invalid frontmatter page_title disabled",
+			BasePath:        "testdata/valid-registry-files",
+			Path:            "resource.md",
+			ExampleLanguage: "terraform",
+			Options: &RegistryResourceFileOptions{
+				ProviderName: "example",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
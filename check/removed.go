@@ -0,0 +1,118 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// RemovedOptions represents configuration options for Removed.
+type RemovedOptions struct {
+	*FileOptions
+
+	// DataSources maps removed data source names to the provider version
+	// which removed them, which may be empty if unknown.
+	DataSources map[string]string
+
+	ProviderName string
+
+	// Resources maps removed resource names to the provider version which
+	// removed them, which may be empty if unknown.
+	Resources map[string]string
+}
+
+// RemovedCheck verifies the documentation of removed data sources and
+// resources, which is kept to inform practitioners upgrading the provider.
+type RemovedCheck struct {
+	Options *RemovedOptions
+}
+
+func NewRemovedCheck(opts *RemovedOptions) *RemovedCheck {
+	check := &RemovedCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &RemovedOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each data source and resource documentation file for a
+// removed name contains a removal callout (e.g. !> This resource was removed
+// in version 6.0.0).
+func (check *RemovedCheck) Run(directories map[string][]string) error {
+	if len(check.Options.DataSources) == 0 && len(check.Options.Resources) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		var removals map[string]string
+		var resourceType string
+
+		switch DirectoryDocumentationType(directory) {
+		case DocumentationTypeDataSource:
+			removals = check.Options.DataSources
+			resourceType = ResourceTypeDataSource
+		case DocumentationTypeResource:
+			removals = check.Options.Resources
+			resourceType = ResourceTypeResource
+		default:
+			continue
+		}
+
+		for _, file := range files {
+			version, ok := removals[fileResourceName(check.Options.ProviderName, file)]
+
+			if !ok {
+				continue
+			}
+
+			if err := check.RunFile(file, resourceType, version); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the documentation file for a removed name. If the version
+// is not empty, the removal callout must also mention it.
+func (check *RemovedCheck) RunFile(path string, resourceType string, version string) error {
+	check.Options.Log().Debug("Checking removed documentation file", "check", CheckIDRemoved, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	if hasCallout(content, "removed", version) {
+		return nil
+	}
+
+	example := fmt.Sprintf("!> This %s has been removed.", resourceType)
+
+	if version != "" {
+		example = fmt.Sprintf("!> This %s was removed in version %s.", resourceType, version)
+	}
+
+	err = fmt.Errorf("%s: error checking removed %s documentation: missing removal callout", path, resourceType)
+	err = WithSuggestion(err, fmt.Sprintf("add a removal callout, e.g. %s", example))
+
+	return NewFinding(CheckIDRemoved, path, err)
+}
@@ -0,0 +1,78 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRemovedCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Options     *RemovedOptions
+		ExpectPaths []string
+	}{
+		{
+			Name: "no removals",
+			Options: &RemovedOptions{
+				ProviderName: "test",
+			},
+		},
+		{
+			Name: "passing",
+			Options: &RemovedOptions{
+				DataSources: map[string]string{
+					"test_gone": "2.0.0",
+				},
+				ProviderName: "test",
+				Resources: map[string]string{
+					"test_gone": "",
+				},
+			},
+		},
+		{
+			Name: "missing callout",
+			Options: &RemovedOptions{
+				ProviderName: "test",
+				Resources: map[string]string{
+					"test_gone":            "",
+					"test_missing_callout": "2.0.0",
+				},
+			},
+			ExpectPaths: []string{
+				"docs/resources/missing_callout.md",
+			},
+		},
+		{
+			Name: "missing version",
+			Options: &RemovedOptions{
+				DataSources: map[string]string{
+					"test_gone": "2.0.0",
+				},
+				ProviderName: "test",
+				Resources: map[string]string{
+					"test_gone": "3.0.0",
+				},
+			},
+			ExpectPaths: []string{
+				"docs/resources/gone.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/removed",
+			}
+
+			got := testFindingPaths(testCheckFindings(t, testCase.Options.BasePath, NewRemovedCheck(testCase.Options).Run, CheckIDRemoved))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package check
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// RenamedOptions represents configuration options for Renamed.
+type RenamedOptions struct {
+	*FileOptions
+
+	// DataSources maps previous data source names to their new names.
+	DataSources map[string]string
+
+	ProviderName string
+
+	// Resources maps previous resource names to their new names.
+	Resources map[string]string
+}
+
+// RenamedCheck verifies the documentation of renamed data sources and
+// resources, which is kept under the previous name for deprecation.
+type RenamedCheck struct {
+	Options *RenamedOptions
+}
+
+func NewRenamedCheck(opts *RenamedOptions) *RenamedCheck {
+	check := &RenamedCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &RenamedOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each data source and resource documentation file for a
+// previous name contains a deprecation callout (e.g. ~> This resource is
+// deprecated) and links to the documentation of the new name.
+func (check *RenamedCheck) Run(directories map[string][]string) error {
+	if len(check.Options.DataSources) == 0 && len(check.Options.Resources) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		var renames map[string]string
+
+		switch DirectoryDocumentationType(directory) {
+		case DocumentationTypeDataSource:
+			renames = check.Options.DataSources
+		case DocumentationTypeResource:
+			renames = check.Options.Resources
+		default:
+			continue
+		}
+
+		for _, file := range files {
+			newName, ok := renames[fileResourceName(check.Options.ProviderName, file)]
+
+			if !ok {
+				continue
+			}
+
+			if err := check.RunFile(file, newName); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the documentation file for a previous name against the
+// new name.
+func (check *RenamedCheck) RunFile(path string, newName string) error {
+	check.Options.Log().Debug("Checking renamed documentation file", "check", CheckIDRenamed, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	var result *multierror.Error
+
+	if !hasDeprecationCallout(content) {
+		result = multierror.Append(result, fmt.Errorf("missing deprecation callout (e.g. ~> This resource is deprecated, use %s instead.)", newName))
+	}
+
+	if !hasRenamedLink(content, check.Options.ProviderName, newName) {
+		result = multierror.Append(result, fmt.Errorf("missing link to new documentation: %s", newName))
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		return NewFinding(CheckIDRenamed, path, fmt.Errorf("%s: error checking renamed documentation: %w", path, err))
+	}
+
+	return nil
+}
+
+// hasDeprecationCallout returns true if the source contains a Terraform
+// Registry callout (->, ~>, or !>) mentioning deprecation.
+func hasDeprecationCallout(source []byte) bool {
+	return hasCallout(source, "deprecat")
+}
+
+// hasCallout returns true if the source contains a Terraform Registry callout
+// (->, ~>, or !>) line containing all the case insensitive substrings.
+func hasCallout(source []byte, substrings ...string) bool {
+	for _, line := range strings.Split(string(source), "\n") {
+		line = strings.TrimSpace(line)
+
+		if !strings.HasPrefix(line, "->") && !strings.HasPrefix(line, "~>") && !strings.HasPrefix(line, "!>") {
+			continue
+		}
+
+		line = strings.ToLower(line)
+		found := true
+
+		for _, substring := range substrings {
+			if !strings.Contains(line, strings.ToLower(substring)) {
+				found = false
+
+				break
+			}
+		}
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasRenamedLink returns true if the source contains a link to the
+// documentation of the new name. Links are matched by the last path element
+// without file extensions, which may include the provider name prefix.
+func hasRenamedLink(source []byte, providerName string, newName string) bool {
+	names := []string{newName, strings.TrimPrefix(newName, providerName+"_")}
+
+	for _, linkName := range documentationLinkNames(source) {
+		if containsString(names, linkName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// documentationLinkNames returns the last path element, without file
+// extensions, of each link destination in the source.
+func documentationLinkNames(source []byte) []string {
+	var result []string
+
+	for _, destination := range linkDestinations(source) {
+		u, err := url.Parse(destination)
+
+		if err != nil {
+			continue
+		}
+
+		result = append(result, TrimFileExtension(path.Base(u.Path)))
+	}
+
+	return result
+}
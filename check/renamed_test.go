@@ -0,0 +1,64 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRenamedCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Options     *RenamedOptions
+		ExpectPaths []string
+	}{
+		{
+			Name: "no renames",
+			Options: &RenamedOptions{
+				ProviderName: "test",
+			},
+		},
+		{
+			Name: "passing",
+			Options: &RenamedOptions{
+				ProviderName: "test",
+				Resources: map[string]string{
+					"test_old_thing": "test_thing",
+				},
+			},
+		},
+		{
+			Name: "missing callout and link",
+			Options: &RenamedOptions{
+				DataSources: map[string]string{
+					"test_old_missing_link": "test_thing",
+				},
+				ProviderName: "test",
+				Resources: map[string]string{
+					"test_old_missing_callout": "test_thing",
+					"test_old_thing":           "test_thing",
+				},
+			},
+			ExpectPaths: []string{
+				"docs/data-sources/old_missing_link.md",
+				"docs/resources/old_missing_callout.md",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/renamed",
+			}
+
+			got := testFindingPaths(testCheckFindings(t, testCase.Options.BasePath, NewRenamedCheck(testCase.Options).Run, CheckIDRenamed))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
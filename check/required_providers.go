@@ -0,0 +1,177 @@
+package check
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/yuin/goldmark/ast"
+)
+
+// defaultProviderRegistryHostname is the hostname of provider source
+// addresses, which is typically omitted in configurations.
+const defaultProviderRegistryHostname = "registry.terraform.io"
+
+// RequiredProvidersCheck verifies required_providers entries for the
+// provider in terraform (or hcl) code blocks of the documentation source,
+// e.g. the provider index page. The source address must match the provider
+// source, if given, and the version constraint must be valid. Entries are
+// matched by the local name or the source address type matching the
+// provider name, so entries of other providers are skipped.
+func RequiredProvidersCheck(source []byte, providerName string, providerSource string) error {
+	if providerName == "" {
+		return nil
+	}
+
+	document, _ := markdown.Parse(source)
+
+	var result error
+
+	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		fencedCodeBlock, ok := node.(*ast.FencedCodeBlock)
+
+		if !entering || !ok || result != nil {
+			return ast.WalkContinue, nil
+		}
+
+		switch markdown.FencedCodeBlockLanguage(fencedCodeBlock, source) {
+		case markdown.FencedCodeBlockLanguageHcl, markdown.FencedCodeBlockLanguageTerraform:
+		default:
+			return ast.WalkSkipChildren, nil
+		}
+
+		configuration, err := parseExampleConfiguration(markdown.FencedCodeBlockText(fencedCodeBlock, source))
+
+		if err != nil {
+			result = fmt.Errorf("error parsing example configuration: %w", err)
+
+			return ast.WalkStop, nil
+		}
+
+		for _, block := range configuration.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+
+			for _, nestedBlock := range block.Body.Blocks {
+				if nestedBlock.Type != "required_providers" {
+					continue
+				}
+
+				for _, name := range nestedBlock.Body.Attributes {
+					if err := requiredProviderCheck(name, nestedBlock.Body.Expressions[name], providerName, providerSource); err != nil {
+						result = err
+
+						return ast.WalkStop, nil
+					}
+				}
+			}
+		}
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error walking documentation: %w", err)
+	}
+
+	return result
+}
+
+// requiredProviderCheck verifies a required_providers entry, which is either
+// an object with source and version arguments or a legacy version string.
+func requiredProviderCheck(name string, expression string, providerName string, providerSource string) error {
+	var sourceAddress, versionConstraint string
+	var hasSource, hasVersion bool
+
+	if strings.HasPrefix(expression, "{") && strings.HasSuffix(expression, "}") {
+		object, err := parseExampleConfiguration(expression[1 : len(expression)-1])
+
+		if err != nil {
+			return fmt.Errorf("error parsing required_providers %s: %w", name, err)
+		}
+
+		sourceAddress, hasSource = exampleStringValue(object.Expressions["source"])
+		versionConstraint, hasVersion = exampleStringValue(object.Expressions["version"])
+	} else {
+		versionConstraint, hasVersion = exampleStringValue(expression)
+	}
+
+	if name != providerName && (!hasSource || path.Base(sourceAddress) != providerName) {
+		return nil
+	}
+
+	if hasSource && strings.Contains(providerSource, "/") && normalizeProviderSource(sourceAddress) != normalizeProviderSource(providerSource) {
+		err := fmt.Errorf("required_providers %s source (%s) should be: %s", name, sourceAddress, shortProviderSource(providerSource))
+
+		return WithSuggestion(err, fmt.Sprintf("source = %q", shortProviderSource(providerSource)))
+	}
+
+	if hasVersion {
+		if _, err := goversion.NewConstraint(versionConstraint); err != nil {
+			err := fmt.Errorf("required_providers %s version constraint (%s) is invalid: %w", name, versionConstraint, err)
+
+			return WithSuggestion(err, `use a version constraint such as version = "~> 1.0" or version = ">= 1.2.0"`)
+		}
+	}
+
+	return nil
+}
+
+// exampleStringValue returns the value of a quoted string expression, which
+// may be followed by a comment. Expressions which are not strings or contain
+// template interpolations or directives return false.
+func exampleStringValue(expression string) (string, bool) {
+	if !strings.HasPrefix(expression, `"`) {
+		return "", false
+	}
+
+	end := 1
+
+	for end < len(expression) && expression[end] != '"' {
+		if expression[end] == '\\' {
+			end++
+		}
+
+		end++
+	}
+
+	if end >= len(expression) {
+		return "", false
+	}
+
+	rest := strings.TrimSpace(expression[end+1:])
+
+	if rest != "" && !strings.HasPrefix(rest, "#") && !strings.HasPrefix(rest, "//") {
+		return "", false
+	}
+
+	value, err := strconv.Unquote(expression[:end+1])
+
+	if err != nil || strings.Contains(value, "${") || strings.Contains(value, "%{") {
+		return "", false
+	}
+
+	return value, true
+}
+
+// normalizeProviderSource returns the lowercase provider source address with
+// the default registry hostname, e.g. registry.terraform.io/hashicorp/aws.
+func normalizeProviderSource(source string) string {
+	source = strings.ToLower(source)
+
+	if strings.Count(source, "/") == 1 {
+		source = defaultProviderRegistryHostname + "/" + source
+	}
+
+	return source
+}
+
+// shortProviderSource returns the provider source address without the
+// default registry hostname, e.g. hashicorp/aws.
+func shortProviderSource(source string) string {
+	return strings.TrimPrefix(source, defaultProviderRegistryHostname+"/")
+}
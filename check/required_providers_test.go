@@ -0,0 +1,116 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestRequiredProvidersCheck(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Source         string
+		ProviderName   string
+		ProviderSource string
+		ExpectError    bool
+	}{
+		{
+			Name:           "no provider name",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = {\n      source = \"other/test\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "",
+			ProviderSource: "hashicorp/test",
+		},
+		{
+			Name:           "valid",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = {\n      source  = \"hashicorp/test\"\n      version = \"~> 1.0\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "registry.terraform.io/hashicorp/test",
+		},
+		{
+			Name:           "valid hostname",
+			Source:         "```hcl\nterraform {\n  required_providers {\n    test = {\n      source  = \"registry.terraform.io/HashiCorp/test\" # comment\n      version = \">= 1.2.0, < 2.0.0\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+		},
+		{
+			Name:           "valid inline object",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = { source = \"hashicorp/test\", version = \"1.2.3\" }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+		},
+		{
+			Name:           "valid legacy version",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = \"~> 1.0\"\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+		},
+		{
+			Name:           "valid without provider source",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = {\n      source = \"other/test\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "",
+		},
+		{
+			Name:           "other provider",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    other = {\n      source  = \"hashicorp/other\"\n      version = \"invalid\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+		},
+		{
+			Name:           "other language",
+			Source:         "```shell\nterraform {\n  required_providers {\n    test = {\n      source = \"other/test\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+		},
+		{
+			Name:           "variable version",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = {\n      source  = \"hashicorp/test\"\n      version = \"${var.version}\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+		},
+		{
+			Name:           "incorrect source",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = {\n      source  = \"other/test\"\n      version = \"~> 1.0\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "registry.terraform.io/hashicorp/test",
+			ExpectError:    true,
+		},
+		{
+			Name:           "incorrect source local name",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    example = {\n      source = \"other/test\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+			ExpectError:    true,
+		},
+		{
+			Name:           "invalid version",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = {\n      source  = \"hashicorp/test\"\n      version = \"~> latest\"\n    }\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+			ExpectError:    true,
+		},
+		{
+			Name:           "invalid legacy version",
+			Source:         "```terraform\nterraform {\n  required_providers {\n    test = \"1.x\"\n  }\n}\n```\n",
+			ProviderName:   "test",
+			ProviderSource: "hashicorp/test",
+			ExpectError:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := RequiredProvidersCheck([]byte(testCase.Source), testCase.ProviderName, testCase.ProviderSource)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if got != nil && ErrorSuggestion(got) == "" {
+				t.Errorf("expected suggestion, got none")
+			}
+		})
+	}
+}
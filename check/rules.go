@@ -0,0 +1,211 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bmatcuk/doublestar"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// RuleSeverityError reports rule violations as findings.
+	RuleSeverityError = "error"
+
+	// RuleSeverityWarning logs rule violations as warnings, which do not fail
+	// checking.
+	RuleSeverityWarning = "warning"
+)
+
+// Rule is a custom content rule, which reports documentation files matching
+// its pattern or, if required, not matching its pattern.
+type Rule struct {
+	// Message describes the violation, e.g. the house rule and its fix.
+	Message string
+
+	// Name identifies the rule in findings.
+	Name string
+
+	// Paths contains glob patterns of documentation file paths, relative to
+	// the Terraform Provider codebase, which the rule applies to. The rule
+	// applies to all documentation files if empty.
+	Paths []string
+
+	Pattern *regexp.Regexp
+
+	// Required reports files which do not match the pattern, instead of
+	// files which match the pattern.
+	Required bool
+
+	// Severity is RuleSeverityError or RuleSeverityWarning. Defaults to
+	// RuleSeverityError.
+	Severity string
+}
+
+// RulesOptions represents configuration options for Rules.
+type RulesOptions struct {
+	*FileOptions
+
+	Rules []*Rule
+}
+
+// RulesCheck verifies documentation files against custom content rules, so
+// codebases can enforce lightweight house rules without changes to this tool.
+type RulesCheck struct {
+	Options *RulesOptions
+}
+
+func NewRulesCheck(opts *RulesOptions) *RulesCheck {
+	check := &RulesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &RulesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each documentation file, except CDKTF documentation, which is
+// generated from the same documentation.
+func (check *RulesCheck) Run(directories map[string][]string) error {
+	if len(check.Options.Rules) == 0 {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies the documentation file against the rules which apply to
+// its path, returning a finding for each violated rule with error severity.
+func (check *RulesCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file rules", "check", CheckIDRules, "file", path)
+
+	var content []byte
+	var result *multierror.Error
+
+	for _, rule := range check.Options.Rules {
+		applies, err := rule.Applies(path)
+
+		if err != nil {
+			return err
+		}
+
+		if !applies {
+			continue
+		}
+
+		if content == nil {
+			content, err = check.Options.ReadFile(path)
+
+			if err != nil {
+				return fmt.Errorf("%s: error reading file: %w", path, err)
+			}
+		}
+
+		message := rule.Violation(content)
+
+		if message == "" {
+			continue
+		}
+
+		if rule.Severity == RuleSeverityWarning {
+			check.Options.Log().Warn("Documentation file violates rule", "file", path, "rule", rule.Name, "message", message)
+			continue
+		}
+
+		result = multierror.Append(result, NewFinding(CheckIDRules, path, fmt.Errorf("%s: rule (%s): %s", path, rule.Name, message)))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Applies returns true if the rule applies to the documentation file path.
+func (rule *Rule) Applies(path string) (bool, error) {
+	if len(rule.Paths) == 0 {
+		return true, nil
+	}
+
+	for _, pattern := range rule.Paths {
+		matched, err := doublestar.Match(pattern, filepath.ToSlash(path))
+
+		if err != nil {
+			return false, fmt.Errorf("rule (%s): invalid path pattern (%s): %w", rule.Name, pattern, err)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Violation returns the message of the rule violation in the content, which
+// includes the lines of matches, or an empty string if there is none.
+func (rule *Rule) Violation(content []byte) string {
+	matches := rule.Pattern.FindAllIndex(content, -1)
+
+	if rule.Required {
+		if len(matches) > 0 {
+			return ""
+		}
+
+		if rule.Message != "" {
+			return rule.Message
+		}
+
+		return fmt.Sprintf("missing required match of pattern: %s", rule.Pattern)
+	}
+
+	if len(matches) == 0 {
+		return ""
+	}
+
+	var lines []string
+
+	for _, match := range matches {
+		line := fmt.Sprint(strings.Count(string(content[:match[0]]), "\n") + 1)
+
+		if len(lines) == 0 || lines[len(lines)-1] != line {
+			lines = append(lines, line)
+		}
+	}
+
+	message := rule.Message
+
+	if message == "" {
+		message = fmt.Sprintf("matches pattern: %s", rule.Pattern)
+	}
+
+	if len(lines) == 1 {
+		return fmt.Sprintf("%s (line %s)", message, lines[0])
+	}
+
+	return fmt.Sprintf("%s (lines %s)", message, strings.Join(lines, ", "))
+}
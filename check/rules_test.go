@@ -0,0 +1,150 @@
+package check
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+)
+
+func TestRulesCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Rules        []*Rule
+		ExpectErrors []string
+	}{
+		{
+			Name: "no rules",
+		},
+		{
+			Name: "match",
+			Rules: []*Rule{
+				{
+					Message: "remove TODO comments",
+					Name:    "no-todo",
+					Pattern: regexp.MustCompile(`TODO`),
+				},
+			},
+			ExpectErrors: []string{
+				"docs/resources/thing.md: rule (no-todo): remove TODO comments (lines 10, 22)",
+			},
+		},
+		{
+			Name: "match default message",
+			Rules: []*Rule{
+				{
+					Name:    "no-todo",
+					Pattern: regexp.MustCompile(`TODO`),
+				},
+			},
+			ExpectErrors: []string{
+				"docs/resources/thing.md: rule (no-todo): matches pattern: TODO (lines 10, 22)",
+			},
+		},
+		{
+			Name: "required match with paths",
+			Rules: []*Rule{
+				{
+					Message:  "add an Import section",
+					Name:     "import-section",
+					Paths:    []string{"docs/resources/*.md"},
+					Pattern:  regexp.MustCompile(`(?m)^## Import$`),
+					Required: true,
+				},
+			},
+			ExpectErrors: []string{
+				"docs/resources/thing.md: rule (import-section): add an Import section",
+			},
+		},
+		{
+			Name: "required match default message",
+			Rules: []*Rule{
+				{
+					Name:     "example-usage",
+					Pattern:  regexp.MustCompile(`## Example Usage`),
+					Required: true,
+				},
+			},
+			ExpectErrors: []string{
+				"docs/data-sources/thing.md: rule (example-usage): missing required match of pattern: ## Example Usage",
+				"docs/index.md: rule (example-usage): missing required match of pattern: ## Example Usage",
+			},
+		},
+		{
+			Name: "warning severity",
+			Rules: []*Rule{
+				{
+					Name:     "no-todo",
+					Pattern:  regexp.MustCompile(`TODO`),
+					Severity: RuleSeverityWarning,
+				},
+			},
+		},
+		{
+			Name: "multiple rules",
+			Rules: []*Rule{
+				{
+					Name:    "no-todo",
+					Paths:   []string{"docs/**/*.md"},
+					Pattern: regexp.MustCompile(`TODO: document`),
+				},
+				{
+					Name:    "provider-name",
+					Paths:   []string{"docs/index.md"},
+					Pattern: regexp.MustCompile(`Test provider`),
+				},
+			},
+			ExpectErrors: []string{
+				"docs/index.md: rule (provider-name): matches pattern: Test provider (lines 4, 9)",
+				"docs/resources/thing.md: rule (no-todo): matches pattern: TODO: document (line 22)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			opts := &RulesOptions{
+				FileOptions: &FileOptions{
+					BasePath: "testdata/rules",
+				},
+				Rules: testCase.Rules,
+			}
+
+			got := testFindingErrors(testCheckFindings(t, opts.BasePath, NewRulesCheck(opts).Run, CheckIDRules))
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectErrors) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
+
+func TestRuleApplies(t *testing.T) {
+	rule := &Rule{
+		Name:  "test",
+		Paths: []string{"docs/resources/*.md", "docs/guides/**"},
+	}
+
+	for path, expect := range map[string]bool{
+		"docs/resources/thing.md":          true,
+		"docs/guides/nested/getting.md":    true,
+		"docs/data-sources/thing.md":       false,
+		"docs/resources/nested/example.md": false,
+	} {
+		got, err := rule.Applies(path)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != expect {
+			t.Errorf("%s: expected %t, got %t", path, expect, got)
+		}
+	}
+
+	if _, err := (&Rule{Name: "test", Paths: []string{"docs/["}}).Applies("docs/index.md"); err == nil {
+		t.Errorf("expected error for invalid path pattern, got no error")
+	}
+}
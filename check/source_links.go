@@ -0,0 +1,168 @@
+package check
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// sourceLinkPinnedRefRegexp matches commit SHAs and version tags, whose links
+// remain valid after files are moved or removed.
+var sourceLinkPinnedRefRegexp = regexp.MustCompile(`^([0-9a-f]{7,40}|v?[0-9]+(\.[0-9]+)+.*)$`)
+
+// SourceLinksOptions represents configuration options for SourceLinks.
+type SourceLinksOptions struct {
+	*FileOptions
+
+	// Repository is the GitHub repository of the Terraform Provider codebase
+	// as owner/name (e.g. hashicorp/terraform-provider-aws). The check is
+	// disabled if empty.
+	Repository string
+}
+
+// SourceLinksCheck verifies links to files and directories of the Terraform
+// Provider GitHub repository, such as examples, exist in the codebase.
+type SourceLinksCheck struct {
+	Options *SourceLinksOptions
+}
+
+func NewSourceLinksCheck(opts *SourceLinksOptions) *SourceLinksCheck {
+	check := &SourceLinksCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &SourceLinksOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// ParseSourceRepository returns the owner/name of the GitHub repository URL
+// (e.g. https://github.com/hashicorp/terraform-provider-aws), which may omit
+// the scheme or the github.com hostname.
+func ParseSourceRepository(value string) (string, error) {
+	repository := strings.TrimPrefix(value, "https://")
+	repository = strings.TrimPrefix(repository, "http://")
+	repository = strings.TrimPrefix(repository, "github.com/")
+	repository = strings.TrimSuffix(strings.TrimSuffix(repository, "/"), ".git")
+
+	parts := strings.Split(repository, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid GitHub repository (%s), expected owner/name or https://github.com/owner/name", value)
+	}
+
+	return repository, nil
+}
+
+// Run verifies the source links of each documentation file, except CDKTF
+// documentation, which is generated from the same documentation.
+func (check *SourceLinksCheck) Run(directories map[string][]string) error {
+	if check.Options.Repository == "" {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		for _, file := range files {
+			if !FilePathEndsWithExtensionFrom(file, ValidLegacyFileExtensions) {
+				continue
+			}
+
+			if err := check.RunFile(file); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunFile verifies links of the documentation file to the GitHub repository
+// source tree resolve to paths in the codebase. Links to the repository root
+// and links pinned to commit SHAs or version tags are not verified.
+func (check *SourceLinksCheck) RunFile(path string) error {
+	check.Options.Log().Debug("Checking documentation file source links", "check", CheckIDSourceLinks, "file", path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	var result *multierror.Error
+
+	for _, destination := range linkDestinations(content) {
+		u, err := url.Parse(destination)
+
+		if err != nil {
+			continue
+		}
+
+		sourcePath, ok := sourceLinkPath(u, check.Options.Repository)
+
+		if !ok {
+			continue
+		}
+
+		if _, err := check.Options.Stat(sourcePath); err != nil {
+			result = multierror.Append(result, fmt.Errorf("link (%s) path not found in codebase: %s", destination, sourcePath))
+		}
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		err = WithSuggestion(fmt.Errorf("%s: error checking source links: %w", path, err), "update links to moved files or remove links to removed files")
+
+		return NewFinding(CheckIDSourceLinks, path, err)
+	}
+
+	return nil
+}
+
+// sourceLinkPath returns the codebase path of a GitHub link to the source tree
+// of the repository, such as github.com/owner/name/blob/main/examples/main.tf
+// or raw.githubusercontent.com/owner/name/main/examples/main.tf. Links to other
+// repositories, the repository root, or pinned refs are not source links.
+func sourceLinkPath(u *url.URL, repository string) (string, bool) {
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	switch strings.ToLower(u.Hostname()) {
+	case "github.com", "www.github.com":
+		if len(parts) < 5 {
+			return "", false
+		}
+
+		switch parts[2] {
+		case "blob", "raw", "tree":
+		default:
+			return "", false
+		}
+
+		parts = append(parts[:2], parts[3:]...)
+	case "raw.githubusercontent.com":
+		if len(parts) < 4 {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	if !strings.EqualFold(parts[0]+"/"+parts[1], repository) || sourceLinkPinnedRefRegexp.MatchString(parts[2]) {
+		return "", false
+	}
+
+	return strings.Join(parts[3:], "/"), true
+}
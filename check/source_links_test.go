@@ -0,0 +1,116 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSourceLinksCheck(t *testing.T) {
+	opts := &SourceLinksOptions{
+		FileOptions: &FileOptions{
+			BasePath: "testdata/source-links",
+		},
+		Repository: "example/terraform-provider-example",
+	}
+
+	findings := testCheckFindings(t, opts.BasePath, NewSourceLinksCheck(opts).Run, CheckIDSourceLinks)
+
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding, got: %v", findings)
+	}
+
+	finding := findings[0]
+
+	if finding.Path != "docs/resources/thing.md" {
+		t.Errorf("expected path docs/resources/thing.md, got: %s", finding.Path)
+	}
+
+	for _, expected := range []string{
+		"link (https://github.com/Example/terraform-provider-example/blob/main/examples/thing/main.tf) path not found in codebase: examples/thing/main.tf",
+		"link (https://raw.githubusercontent.com/example/terraform-provider-example/main/examples/thing.tf) path not found in codebase: examples/thing.tf",
+	} {
+		if !strings.Contains(finding.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %s", expected, finding.Error())
+		}
+	}
+
+	if strings.Count(finding.Error(), "path not found") != 2 {
+		t.Errorf("expected 2 links not found, got: %s", finding.Error())
+	}
+}
+
+func TestSourceLinksCheckNoRepository(t *testing.T) {
+	opts := &SourceLinksOptions{
+		FileOptions: &FileOptions{
+			BasePath: "testdata/source-links",
+		},
+	}
+
+	directories, err := GetDirectories(opts.BasePath, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error getting directories: %s", err)
+	}
+
+	if err := NewSourceLinksCheck(opts).Run(directories); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestParseSourceRepository(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Value       string
+		Expect      string
+		ExpectError bool
+	}{
+		{
+			Name:   "owner/name",
+			Value:  "hashicorp/terraform-provider-aws",
+			Expect: "hashicorp/terraform-provider-aws",
+		},
+		{
+			Name:   "URL",
+			Value:  "https://github.com/hashicorp/terraform-provider-aws",
+			Expect: "hashicorp/terraform-provider-aws",
+		},
+		{
+			Name:   "URL with .git suffix",
+			Value:  "https://github.com/hashicorp/terraform-provider-aws.git",
+			Expect: "hashicorp/terraform-provider-aws",
+		},
+		{
+			Name:   "hostname without scheme",
+			Value:  "github.com/hashicorp/terraform-provider-aws/",
+			Expect: "hashicorp/terraform-provider-aws",
+		},
+		{
+			Name:        "owner only",
+			Value:       "hashicorp",
+			ExpectError: true,
+		},
+		{
+			Name:        "tree URL",
+			Value:       "https://github.com/hashicorp/terraform-provider-aws/tree/main",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ParseSourceRepository(testCase.Value)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
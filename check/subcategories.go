@@ -0,0 +1,372 @@
+package check
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// SubcategoriesOptions represents configuration options for Subcategories.
+type SubcategoriesOptions struct {
+	*FileOptions
+
+	// EnableConsistency enables the subcategory-consistency check.
+	EnableConsistency bool
+
+	// MaximumEntries, if positive, is the maximum number of data source and
+	// resource documentation files with the same frontmatter subcategory.
+	MaximumEntries int
+
+	// MaximumSubcategories, if positive, is the maximum number of distinct
+	// frontmatter subcategories of action, data source, list resource, and
+	// resource documentation.
+	MaximumSubcategories int
+
+	// Mappings contains the expected frontmatter subcategories of action,
+	// data source, list resource, and resource documentation by name pattern,
+	// in priority order.
+	Mappings []*SubcategoryMapping
+
+	ProviderName string
+
+	// WarningSubcategories, if positive, is the number of distinct
+	// frontmatter subcategories of action, data source, list resource, and
+	// resource documentation at which a warning is logged.
+	WarningSubcategories int
+
+	// WarnSingleEntry logs a warning for each subcategory of only one data
+	// source or resource documentation file, which may be a typo.
+	WarnSingleEntry bool
+}
+
+// SubcategoryMapping represents the expected frontmatter subcategory of
+// documentation for names matching a pattern, e.g. aws_s3_* => S3.
+type SubcategoryMapping struct {
+	// Pattern is the action, data source, list resource, or resource name
+	// pattern, including the provider name prefix, matched with path.Match
+	// syntax (e.g. * matches any characters).
+	Pattern string
+
+	Subcategory string
+}
+
+// NewSubcategoryMapping returns a SubcategoryMapping, returning an error if
+// the pattern is invalid or the subcategory is empty.
+func NewSubcategoryMapping(pattern string, subcategory string) (*SubcategoryMapping, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("empty subcategory mapping pattern")
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid subcategory mapping pattern (%s): %w", pattern, err)
+	}
+
+	if strings.TrimSpace(subcategory) == "" {
+		return nil, fmt.Errorf("empty subcategory for subcategory mapping pattern (%s)", pattern)
+	}
+
+	return &SubcategoryMapping{
+		Pattern:     pattern,
+		Subcategory: subcategory,
+	}, nil
+}
+
+// Match returns true if the name matches the pattern.
+func (m *SubcategoryMapping) Match(name string) bool {
+	matched, _ := path.Match(m.Pattern, name)
+
+	return matched
+}
+
+// SubcategoriesCheck verifies the number of data source and resource
+// documentation files per frontmatter subcategory, which are the entries of
+// a subcategory in the Terraform Registry navigation sidebar.
+type SubcategoriesCheck struct {
+	Options *SubcategoriesOptions
+}
+
+func NewSubcategoriesCheck(opts *SubcategoriesOptions) *SubcategoriesCheck {
+	check := &SubcategoriesCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &SubcategoriesOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies each frontmatter subcategory of data source and resource
+// documentation contains at most the maximum number of entries, if
+// configured, and logs a warning for subcategories with a single entry, if
+// enabled. Documentation without a subcategory or with invalid frontmatter,
+// which is reported by the frontmatter check, is skipped.
+func (check *SubcategoriesCheck) Run(directories map[string][]string) error {
+	if check.Options.MaximumEntries <= 0 && !check.Options.WarnSingleEntry {
+		return nil
+	}
+
+	subcategoryFiles, err := check.subcategoryFiles(directories, DocumentationTypeDataSource, DocumentationTypeResource)
+
+	if err != nil {
+		return err
+	}
+
+	subcategories := make([]string, 0, len(subcategoryFiles))
+
+	for subcategory := range subcategoryFiles {
+		subcategories = append(subcategories, subcategory)
+	}
+
+	sort.Strings(subcategories)
+
+	var result *multierror.Error
+
+	for _, subcategory := range subcategories {
+		files := subcategoryFiles[subcategory]
+
+		check.Options.Log().Debug("Found data source and resource documentation files in subcategory", "check", CheckIDSubcategorySize, "subcategory", subcategory, "count", len(files))
+
+		if check.Options.WarnSingleEntry && len(files) == 1 {
+			check.Options.Log().Warn("Subcategory has a single entry, which may be a typo of another subcategory", "check", CheckIDSubcategorySize, "file", files[0], "subcategory", subcategory)
+		}
+
+		if check.Options.MaximumEntries > 0 && len(files) > check.Options.MaximumEntries {
+			err := fmt.Errorf("exceeded maximum (%d) number of data source and resource documentation files in subcategory (%q): %d", check.Options.MaximumEntries, subcategory, len(files))
+			err = WithSuggestion(err, fmt.Sprintf("split the %q subcategory into smaller subcategories", subcategory))
+			result = multierror.Append(result, NewFinding(CheckIDSubcategorySize, "", err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunConsistency verifies the frontmatter subcategories of each documentation
+// type sharing a Terraform Registry navigation sidebar do not differ only by
+// case or whitespace (e.g. VPC and Vpc). Files using a variant other than the
+// most common variant are reported.
+func (check *SubcategoriesCheck) RunConsistency(directories map[string][]string) error {
+	var result *multierror.Error
+
+	for _, documentationTypes := range [][]string{
+		{DocumentationTypeAction, DocumentationTypeDataSource, DocumentationTypeListResource, DocumentationTypeResource},
+		{DocumentationTypeGuide},
+	} {
+		subcategoryFiles, err := check.subcategoryFiles(directories, documentationTypes...)
+
+		if err != nil {
+			return err
+		}
+
+		variants := make(map[string][]string)
+
+		for subcategory := range subcategoryFiles {
+			normalized := normalizeName(subcategory)
+			variants[normalized] = append(variants[normalized], subcategory)
+		}
+
+		for _, subcategories := range variants {
+			if len(subcategories) < 2 {
+				continue
+			}
+
+			// Prefer the most common variant, then variants without
+			// surrounding whitespace, for a stable suggestion.
+			sort.Slice(subcategories, func(i, j int) bool {
+				if len(subcategoryFiles[subcategories[i]]) != len(subcategoryFiles[subcategories[j]]) {
+					return len(subcategoryFiles[subcategories[i]]) > len(subcategoryFiles[subcategories[j]])
+				}
+
+				iTrimmed := subcategories[i] == strings.TrimSpace(subcategories[i])
+				jTrimmed := subcategories[j] == strings.TrimSpace(subcategories[j])
+
+				if iTrimmed != jTrimmed {
+					return iTrimmed
+				}
+
+				return subcategories[i] < subcategories[j]
+			})
+
+			subcategory := subcategories[0]
+
+			for _, variant := range subcategories[1:] {
+				for _, file := range subcategoryFiles[variant] {
+					err := fmt.Errorf("%s: YAML frontmatter subcategory (%q) differs only by case or whitespace from the more common subcategory (%q)", file, variant, subcategory)
+					err = WithSuggestion(err, fmt.Sprintf("change the YAML frontmatter to `subcategory: %q`", subcategory))
+					result = multierror.Append(result, NewFinding(CheckIDSubcategoryConsistency, file, err))
+				}
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// RunCount verifies the number of distinct frontmatter subcategories of
+// action, data source, list resource, and resource documentation, which are
+// the categories of the Terraform Registry navigation sidebar, is at most the
+// maximum, if configured, and logs a warning when reaching the warning number,
+// if configured. Guide subcategories are listed separately and not counted.
+func (check *SubcategoriesCheck) RunCount(directories map[string][]string) error {
+	if check.Options.MaximumSubcategories <= 0 && check.Options.WarningSubcategories <= 0 {
+		return nil
+	}
+
+	subcategoryFiles, err := check.subcategoryFiles(directories, DocumentationTypeAction, DocumentationTypeDataSource, DocumentationTypeListResource, DocumentationTypeResource)
+
+	if err != nil {
+		return err
+	}
+
+	count := len(subcategoryFiles)
+
+	check.Options.Log().Debug("Found subcategories", "check", CheckIDNumberOfSubcategories, "count", count)
+
+	if check.Options.MaximumSubcategories > 0 && count > check.Options.MaximumSubcategories {
+		err := fmt.Errorf("exceeded maximum (%d) number of subcategories: %d", check.Options.MaximumSubcategories, count)
+		err = WithSuggestion(err, fmt.Sprintf("merge subcategories with few entries into related subcategories, such as %s", smallestSubcategories(subcategoryFiles, count-check.Options.MaximumSubcategories)))
+
+		return NewFinding(CheckIDNumberOfSubcategories, "", err)
+	}
+
+	if check.Options.WarningSubcategories > 0 && count >= check.Options.WarningSubcategories {
+		check.Options.Log().Warn("Number of subcategories is approaching the maximum", "check", CheckIDNumberOfSubcategories, "count", count, "warning", check.Options.WarningSubcategories, "maximum", check.Options.MaximumSubcategories)
+	}
+
+	return nil
+}
+
+// smallestSubcategories returns up to the number of subcategories with the
+// fewest files, with their number of files, e.g. "Thing" (1).
+func smallestSubcategories(subcategoryFiles map[string][]string, number int) string {
+	subcategories := make([]string, 0, len(subcategoryFiles))
+
+	for subcategory := range subcategoryFiles {
+		subcategories = append(subcategories, subcategory)
+	}
+
+	sort.Slice(subcategories, func(i, j int) bool {
+		if len(subcategoryFiles[subcategories[i]]) != len(subcategoryFiles[subcategories[j]]) {
+			return len(subcategoryFiles[subcategories[i]]) < len(subcategoryFiles[subcategories[j]])
+		}
+
+		return subcategories[i] < subcategories[j]
+	})
+
+	if number > len(subcategories) {
+		number = len(subcategories)
+	}
+
+	result := make([]string, number)
+
+	for index, subcategory := range subcategories[:number] {
+		result[index] = fmt.Sprintf("%q (%d)", subcategory, len(subcategoryFiles[subcategory]))
+	}
+
+	return strings.Join(result, ", ")
+}
+
+// RunMapping verifies the frontmatter subcategory of action, data source, list
+// resource, and resource documentation is the subcategory of the first mapping
+// matching its name, so documentation of the same service is not scattered
+// across Terraform Registry navigation categories. Documentation without a
+// matching mapping or without a subcategory is skipped.
+func (check *SubcategoriesCheck) RunMapping(directories map[string][]string) error {
+	if len(check.Options.Mappings) == 0 {
+		return nil
+	}
+
+	subcategoryFiles, err := check.subcategoryFiles(directories, DocumentationTypeAction, DocumentationTypeDataSource, DocumentationTypeListResource, DocumentationTypeResource)
+
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+
+	for subcategory, files := range subcategoryFiles {
+		for _, file := range files {
+			name := fileResourceName(check.Options.ProviderName, file)
+			mapping := check.mapping(name)
+
+			if mapping == nil {
+				check.Options.Log().Trace("No subcategory mapping found", "check", CheckIDSubcategoryMapping, "file", file, "name", name)
+
+				continue
+			}
+
+			if mapping.Subcategory == subcategory {
+				continue
+			}
+
+			err := fmt.Errorf("%s: YAML frontmatter subcategory (%q) does not match subcategory (%q) of mapping pattern (%s) for %s", file, subcategory, mapping.Subcategory, mapping.Pattern, name)
+			err = WithSuggestion(err, fmt.Sprintf("change the YAML frontmatter to `subcategory: %q`", mapping.Subcategory))
+			result = multierror.Append(result, NewFinding(CheckIDSubcategoryMapping, file, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// mapping returns the first mapping matching the name, if any.
+func (check *SubcategoriesCheck) mapping(name string) *SubcategoryMapping {
+	for _, mapping := range check.Options.Mappings {
+		if mapping.Match(name) {
+			return mapping
+		}
+	}
+
+	return nil
+}
+
+// subcategoryFiles returns the documentation files of the documentation
+// types, keyed by frontmatter subcategory. Documentation without a
+// subcategory or with invalid frontmatter, which is reported by the
+// frontmatter check, is skipped.
+func (check *SubcategoriesCheck) subcategoryFiles(directories map[string][]string, documentationTypes ...string) (map[string][]string, error) {
+	result := make(map[string][]string)
+
+	for directory, files := range directories {
+		// CDKTF documentation is generated from the same documentation and
+		// localized documentation is translated from it
+		if DirectoryCdktfLanguage(directory) != "" || DirectoryLocale(directory) != "" {
+			continue
+		}
+
+		if !containsString(documentationTypes, DirectoryDocumentationType(directory)) {
+			continue
+		}
+
+		for _, file := range files {
+			content, err := check.Options.ReadFile(file)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: error reading file: %w", file, err)
+			}
+
+			frontMatter, err := ParseFrontMatter(content)
+
+			if err != nil || frontMatter.Subcategory == nil || strings.TrimSpace(*frontMatter.Subcategory) == "" {
+				continue
+			}
+
+			result[*frontMatter.Subcategory] = append(result[*frontMatter.Subcategory], file)
+		}
+	}
+
+	return result, nil
+}
+
+// normalizeName returns the name, such as a subcategory or heading, in lower
+// case with surrounding whitespace removed and inner whitespace collapsed.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
@@ -0,0 +1,278 @@
+package check
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSubcategoriesCheck(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Options        *SubcategoriesOptions
+		ExpectFindings int
+	}{
+		{
+			Name:    "no options",
+			Options: &SubcategoriesOptions{},
+		},
+		{
+			Name: "under maximum entries",
+			Options: &SubcategoriesOptions{
+				MaximumEntries: 3,
+			},
+		},
+		{
+			Name: "over maximum entries",
+			Options: &SubcategoriesOptions{
+				MaximumEntries: 2,
+			},
+			ExpectFindings: 1,
+		},
+		{
+			Name: "single entry at maximum entries",
+			Options: &SubcategoriesOptions{
+				MaximumEntries: 1,
+			},
+			ExpectFindings: 1,
+		},
+		{
+			Name: "warn single entry",
+			Options: &SubcategoriesOptions{
+				WarnSingleEntry: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/subcategories",
+			}
+
+			findings := testCheckFindings(t, testCase.Options.BasePath, NewSubcategoriesCheck(testCase.Options).Run, CheckIDSubcategorySize)
+
+			if len(findings) != testCase.ExpectFindings {
+				t.Errorf("expected %d findings, got: %v", testCase.ExpectFindings, findings)
+			}
+
+			for _, finding := range findings {
+				if finding.Suggestion == "" {
+					t.Errorf("expected suggestion, got none")
+				}
+			}
+		})
+	}
+}
+
+func TestSubcategoriesCheckRunCount(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Options          *SubcategoriesOptions
+		ExpectFindings   int
+		ExpectSuggestion string
+	}{
+		{
+			Name:    "no options",
+			Options: &SubcategoriesOptions{},
+		},
+		{
+			Name: "at maximum subcategories",
+			Options: &SubcategoriesOptions{
+				MaximumSubcategories: 2,
+			},
+		},
+		{
+			Name: "over maximum subcategories",
+			Options: &SubcategoriesOptions{
+				MaximumSubcategories: 1,
+			},
+			ExpectFindings:   1,
+			ExpectSuggestion: `merge subcategories with few entries into related subcategories, such as "Network" (1)`,
+		},
+		{
+			Name: "at warning subcategories",
+			Options: &SubcategoriesOptions{
+				MaximumSubcategories: 3,
+				WarningSubcategories: 2,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Options.FileOptions = &FileOptions{
+				BasePath: "testdata/subcategories",
+			}
+
+			findings := testCheckFindings(t, testCase.Options.BasePath, NewSubcategoriesCheck(testCase.Options).RunCount, CheckIDNumberOfSubcategories)
+
+			if len(findings) != testCase.ExpectFindings {
+				t.Errorf("expected %d findings, got: %v", testCase.ExpectFindings, findings)
+			}
+
+			for _, finding := range findings {
+				if finding.Suggestion != testCase.ExpectSuggestion {
+					t.Errorf("expected suggestion %q, got: %q", testCase.ExpectSuggestion, finding.Suggestion)
+				}
+			}
+		})
+	}
+}
+
+func TestSubcategoriesCheckRunConsistency(t *testing.T) {
+	options := &SubcategoriesOptions{
+		FileOptions: &FileOptions{
+			BasePath: "testdata/subcategory-consistency",
+		},
+	}
+
+	findings := testCheckFindings(t, options.BasePath, NewSubcategoriesCheck(options).RunConsistency, CheckIDSubcategoryConsistency)
+
+	var got []string
+
+	for _, finding := range findings {
+		if finding.Suggestion == "" {
+			t.Errorf("expected suggestion, got none")
+		}
+
+		if !strings.HasPrefix(finding.Error(), finding.Path+": ") {
+			t.Errorf("expected error prefixed with path %s, got: %s", finding.Path, finding.Error())
+		}
+
+		got = append(got, finding.Path)
+	}
+
+	sort.Strings(got)
+
+	expected := []string{
+		"docs/resources/listener.md",
+		"docs/resources/subnet.md",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestSubcategoriesCheckRunMapping(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Mappings    map[string]string
+		Patterns    []string
+		ExpectPaths []string
+	}{
+		{
+			Name:     "matching",
+			Mappings: map[string]string{"test_network": "Network", "test_*": "Compute"},
+			Patterns: []string{"test_network", "test_*"},
+		},
+		{
+			Name:        "contradicting",
+			Mappings:    map[string]string{"test_volume": "Storage", "test_network": "Network", "test_*": "Compute"},
+			Patterns:    []string{"test_volume", "test_network", "test_*"},
+			ExpectPaths: []string{"docs/resources/volume.md"},
+		},
+		{
+			Name:        "first match",
+			Mappings:    map[string]string{"test_*": "Compute", "test_network": "Network"},
+			Patterns:    []string{"test_*", "test_network"},
+			ExpectPaths: []string{"docs/resources/network.md"},
+		},
+		{
+			Name:     "no match",
+			Mappings: map[string]string{"other_*": "Other"},
+			Patterns: []string{"other_*"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			options := &SubcategoriesOptions{
+				FileOptions: &FileOptions{
+					BasePath: "testdata/subcategories",
+				},
+				ProviderName: "test",
+			}
+
+			for _, pattern := range testCase.Patterns {
+				mapping, err := NewSubcategoryMapping(pattern, testCase.Mappings[pattern])
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				options.Mappings = append(options.Mappings, mapping)
+			}
+
+			findings := testCheckFindings(t, options.BasePath, NewSubcategoriesCheck(options).RunMapping, CheckIDSubcategoryMapping)
+
+			var got []string
+
+			for _, finding := range findings {
+				if finding.Suggestion == "" {
+					t.Errorf("expected suggestion, got none")
+				}
+
+				if !strings.HasPrefix(finding.Error(), finding.Path+": ") {
+					t.Errorf("expected error prefixed with path %s, got: %s", finding.Path, finding.Error())
+				}
+
+				got = append(got, finding.Path)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.ExpectPaths) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectPaths, got)
+			}
+		})
+	}
+}
+
+func TestNewSubcategoryMapping(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Pattern     string
+		Subcategory string
+		ExpectError bool
+	}{
+		{
+			Name:        "valid",
+			Pattern:     "aws_s3_*",
+			Subcategory: "S3 (Simple Storage)",
+		},
+		{
+			Name:        "empty pattern",
+			Subcategory: "S3",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid pattern",
+			Pattern:     "aws_s3_[",
+			Subcategory: "S3",
+			ExpectError: true,
+		},
+		{
+			Name:        "empty subcategory",
+			Pattern:     "aws_s3_*",
+			Subcategory: " ",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := NewSubcategoryMapping(testCase.Pattern, testCase.Subcategory)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+		})
+	}
+}
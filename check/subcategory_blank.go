@@ -0,0 +1,47 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SubcategoryBlankCheck verifies a YAML frontmatter subcategory, if present,
+// is not empty or only whitespace (e.g. subcategory: ""), which is usually
+// unintended. Options not allowing a subcategory or allowing an empty
+// subcategory skip the check.
+func SubcategoryBlankCheck(src []byte, opts *FrontMatterOptions) error {
+	if opts != nil && (opts.NoSubcategory || isAllowedSubcategory("", opts.AllowedSubcategories)) {
+		return nil
+	}
+
+	frontMatter, err := ParseFrontMatter(src)
+
+	if err != nil {
+		return err
+	}
+
+	if frontMatter.Subcategory == nil || strings.TrimSpace(*frontMatter.Subcategory) != "" {
+		return nil
+	}
+
+	var allowedSubcategories []string
+
+	if opts != nil {
+		allowedSubcategories = opts.AllowedSubcategories
+	}
+
+	return WithSuggestion(fmt.Errorf("YAML frontmatter subcategory is empty"), blankSubcategorySuggestion(allowedSubcategories))
+}
+
+// blankSubcategorySuggestion returns a remediation hint for an empty
+// frontmatter subcategory, using the first non-empty allowed subcategory as
+// an example.
+func blankSubcategorySuggestion(allowedSubcategories []string) string {
+	for _, allowedSubcategory := range allowedSubcategories {
+		if strings.TrimSpace(allowedSubcategory) != "" {
+			return fmt.Sprintf("change the YAML frontmatter to an allowed subcategory, e.g. `subcategory: %q`", allowedSubcategory)
+		}
+	}
+
+	return "set a subcategory or remove `subcategory` from the YAML frontmatter"
+}
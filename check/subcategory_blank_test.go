@@ -0,0 +1,97 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestSubcategoryBlankCheck(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Source           string
+		Options          *FrontMatterOptions
+		ExpectError      bool
+		ExpectSuggestion string
+	}{
+		{
+			Name: "no subcategory",
+			Source: `
+page_title: Example Page Title
+`,
+		},
+		{
+			Name: "subcategory",
+			Source: `
+page_title: Example Page Title
+subcategory: Example Subcategory
+`,
+		},
+		{
+			Name: "empty subcategory",
+			Source: `
+page_title: Example Page Title
+subcategory: ""
+`,
+			ExpectError:      true,
+			ExpectSuggestion: "set a subcategory or remove `subcategory` from the YAML frontmatter",
+		},
+		{
+			Name: "whitespace subcategory",
+			Source: `
+page_title: Example Page Title
+subcategory: " "
+`,
+			ExpectError:      true,
+			ExpectSuggestion: "set a subcategory or remove `subcategory` from the YAML frontmatter",
+		},
+		{
+			Name: "empty subcategory with allowed subcategories",
+			Source: `
+page_title: Example Page Title
+subcategory: ""
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories: []string{" ", "Example Subcategory"},
+			},
+			ExpectError:      true,
+			ExpectSuggestion: "change the YAML frontmatter to an allowed subcategory, e.g. `subcategory: \"Example Subcategory\"`",
+		},
+		{
+			Name: "empty subcategory allowed",
+			Source: `
+page_title: Example Page Title
+subcategory: ""
+`,
+			Options: &FrontMatterOptions{
+				AllowedSubcategories: []string{"", "Example Subcategory"},
+			},
+		},
+		{
+			Name: "empty subcategory with no subcategory option",
+			Source: `
+page_title: Example Page Title
+subcategory: ""
+`,
+			Options: &FrontMatterOptions{
+				NoSubcategory: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := SubcategoryBlankCheck([]byte(testCase.Source), testCase.Options)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+
+			if suggestion := ErrorSuggestion(got); suggestion != testCase.ExpectSuggestion {
+				t.Errorf("expected suggestion %q, got: %q", testCase.ExpectSuggestion, suggestion)
+			}
+		})
+	}
+}
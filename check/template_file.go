@@ -0,0 +1,408 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/bmatcuk/doublestar"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// TemplatesDirectory is the terraform-plugin-docs templates directory.
+	TemplatesDirectory = `templates`
+
+	// TemplateFileExtension is the terraform-plugin-docs template file extension.
+	TemplateFileExtension = `.md.tmpl`
+
+	// TemplateClassification is the summary classification of
+	// terraform-plugin-docs template files.
+	TemplateClassification = "terraform-plugin-docs template"
+
+	TemplateTypeFunction = "function"
+	TemplateTypeOther    = "other"
+	TemplateTypeProvider = "provider"
+	TemplateTypeResource = "resource"
+)
+
+// TemplateFunctions contains the template functions available in
+// terraform-plugin-docs templates, in addition to the text/template builtins.
+var TemplateFunctions = []string{
+	"codefile",
+	"lower",
+	"plainmarkdown",
+	"prefixlines",
+	"split",
+	"tffile",
+	"title",
+	"trimspace",
+	"upper",
+}
+
+// TemplateFields contains the data fields available in terraform-plugin-docs
+// templates, keyed by template type.
+var TemplateFields = map[string][]string{
+	TemplateTypeFunction: {
+		"Description",
+		"ExampleFile",
+		"FunctionArgumentsMarkdown",
+		"FunctionSignatureMarkdown",
+		"FunctionVariadicArgumentMarkdown",
+		"HasExample",
+		"HasVariadic",
+		"Name",
+		"ProviderName",
+		"ProviderShortName",
+		"RenderedProviderName",
+		"Summary",
+		"Type",
+	},
+	TemplateTypeOther: {
+		"ProviderName",
+		"ProviderShortName",
+		"RenderedProviderName",
+	},
+	TemplateTypeProvider: {
+		"Description",
+		"ExampleFile",
+		"HasExample",
+		"ProviderName",
+		"ProviderShortName",
+		"RenderedProviderName",
+		"SchemaMarkdown",
+		"Type",
+	},
+	TemplateTypeResource: {
+		"Description",
+		"ExampleFile",
+		"HasExample",
+		"HasImport",
+		"HasImportIDConfig",
+		"HasImportIdentityConfig",
+		"IdentitySchemaMarkdown",
+		"ImportFile",
+		"ImportIDConfigFile",
+		"ImportIdentityConfigFile",
+		"Name",
+		"ProviderName",
+		"ProviderShortName",
+		"RenderedProviderName",
+		"SchemaMarkdown",
+		"Type",
+	},
+}
+
+type TemplateFileOptions struct {
+	*FileOptions
+
+	// Enable enables the template check.
+	Enable bool
+}
+
+type TemplateFileCheck struct {
+	FileCheck
+
+	Options *TemplateFileOptions
+}
+
+func NewTemplateFileCheck(opts *TemplateFileOptions) *TemplateFileCheck {
+	check := &TemplateFileCheck{
+		Options: opts,
+	}
+
+	if check.Options == nil {
+		check.Options = &TemplateFileOptions{}
+	}
+
+	if check.Options.FileOptions == nil {
+		check.Options.FileOptions = &FileOptions{}
+	}
+
+	return check
+}
+
+// Run verifies the template parses with the terraform-plugin-docs template
+// functions, only uses data fields available for its template type, and that
+// example files referenced by tffile and codefile exist.
+func (check *TemplateFileCheck) Run(path string) error {
+	fullpath := check.Options.FullPath(path)
+
+	check.Options.Log().Debug("Checking file", "file", fullpath)
+	check.Options.ReportProgress(path)
+
+	content, err := check.Options.ReadFile(path)
+
+	if err != nil {
+		return fmt.Errorf("%s: error reading file: %w", path, err)
+	}
+
+	funcs := make(template.FuncMap, len(TemplateFunctions))
+
+	for _, name := range TemplateFunctions {
+		funcs[name] = func(...interface{}) string { return "" }
+	}
+
+	tmpl, err := template.New(path).Funcs(funcs).Parse(string(content))
+
+	if err != nil {
+		return NewFinding(CheckIDTemplate, path, fmt.Errorf("%s: error parsing template: %w", path, err))
+	}
+
+	// Empty templates have no parse tree
+	if tmpl.Tree == nil {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	validFields := TemplateFields[TemplateFileType(path)]
+
+	for _, field := range templateFields(tmpl.Tree.Root) {
+		if !containsString(validFields, field) {
+			result = multierror.Append(result, fmt.Errorf("unknown %s template field: .%s", TemplateFileType(path), field))
+		}
+	}
+
+	for _, file := range templateFiles(tmpl.Tree.Root) {
+		stat := check.Options.Stat
+
+		if filepath.IsAbs(file) {
+			stat = os.Stat
+		}
+
+		if _, err := stat(file); err != nil {
+			result = multierror.Append(result, fmt.Errorf("referenced file (%s) not found", file))
+		}
+	}
+
+	if err := result.ErrorOrNil(); err != nil {
+		return NewFinding(CheckIDTemplate, path, fmt.Errorf("%s: error checking template: %w", path, err))
+	}
+
+	return nil
+}
+
+func (check *TemplateFileCheck) RunAll(files []string) error {
+	var result *multierror.Error
+
+	for _, file := range files {
+		if err := check.Run(file); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// GetTemplateFiles returns all terraform-plugin-docs template files, relative
+// to the base path, sorted by path.
+func GetTemplateFiles(basepath string) ([]string, error) {
+	globPattern := fmt.Sprintf("%s/**/*%s", TemplatesDirectory, TemplateFileExtension)
+
+	if basepath != "" {
+		globPattern = fmt.Sprintf("%s/%s", basepath, globPattern)
+	}
+
+	files, err := doublestar.Glob(globPattern)
+
+	if err != nil {
+		return nil, fmt.Errorf("error globbing terraform-plugin-docs template files: %w", err)
+	}
+
+	if basepath != "" {
+		for index, file := range files {
+			files[index], _ = filepath.Rel(basepath, file)
+		}
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// GetTemplateFilesFS returns the terraform-plugin-docs template files of the
+// file system, relative to its root, such as embedded fixtures.
+func GetTemplateFilesFS(fsys fs.FS) ([]string, error) {
+	var files []string
+
+	err := fs.WalkDir(fsys, TemplatesDirectory, func(path string, d fs.DirEntry, err error) error {
+		// Providers without templates are not an error
+		if path == TemplatesDirectory && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() && strings.HasSuffix(path, TemplateFileExtension) {
+			files = append(files, filepath.FromSlash(path))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error walking terraform-plugin-docs template files: %w", err)
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// templateFiles returns the terraform-plugin-docs template files, relative to
+// the base path, from FS if set, otherwise the operating system.
+func (opts *FileOptions) templateFiles() ([]string, error) {
+	if opts.FS == nil {
+		return GetTemplateFiles(opts.BasePath)
+	}
+
+	fsys, err := fs.Sub(opts.FS, opts.fsPath(""))
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading terraform-plugin-docs template files: %w", err)
+	}
+
+	return GetTemplateFilesFS(fsys)
+}
+
+// TemplateFileType returns the template type of the terraform-plugin-docs
+// template file, which determines the available data fields.
+func TemplateFileType(path string) string {
+	parts := strings.Split(strings.TrimPrefix(filepath.ToSlash(path), TemplatesDirectory+"/"), "/")
+
+	// Top level templates, such as resources.md.tmpl, are the fallback
+	// templates for their type.
+	name := strings.TrimSuffix(parts[0], TemplateFileExtension)
+
+	switch name {
+	case "index":
+		if len(parts) == 1 {
+			return TemplateTypeProvider
+		}
+	case "actions", "data-sources", "ephemeral-resources", "list-resources", "resources":
+		return TemplateTypeResource
+	case "functions":
+		return TemplateTypeFunction
+	}
+
+	return TemplateTypeOther
+}
+
+// templateFields returns the top level data fields used in the template. Fields
+// within range and with actions are skipped as the data context changes.
+func templateFields(node parse.Node) []string {
+	var result []string
+
+	walkTemplate(node, func(node parse.Node) {
+		switch node := node.(type) {
+		case *parse.FieldNode:
+			result = append(result, node.Ident[0])
+		case *parse.VariableNode:
+			// $ always refers to the top level data
+			if node.Ident[0] == "$" && len(node.Ident) > 1 {
+				result = append(result, node.Ident[1])
+			}
+		}
+	})
+
+	return result
+}
+
+// templateFiles returns the file paths given as string literals to the
+// tffile and codefile template functions.
+func templateFiles(node parse.Node) []string {
+	var result []string
+
+	walkTemplate(node, func(node parse.Node) {
+		command, ok := node.(*parse.CommandNode)
+
+		if !ok || len(command.Args) == 0 {
+			return
+		}
+
+		identifier, ok := command.Args[0].(*parse.IdentifierNode)
+
+		if !ok {
+			return
+		}
+
+		var fileArg int
+
+		switch identifier.Ident {
+		case "tffile":
+			fileArg = 1
+		case "codefile":
+			fileArg = 2
+		default:
+			return
+		}
+
+		if len(command.Args) <= fileArg {
+			return
+		}
+
+		if file, ok := command.Args[fileArg].(*parse.StringNode); ok {
+			result = append(result, file.Text)
+		}
+	})
+
+	return result
+}
+
+// walkTemplate calls the function for each node in the template parse tree,
+// except the bodies of range and with actions.
+func walkTemplate(node parse.Node, fn func(parse.Node)) {
+	if node == nil {
+		return
+	}
+
+	fn(node)
+
+	switch node := node.(type) {
+	case *parse.ActionNode:
+		walkTemplate(node.Pipe, fn)
+	case *parse.ChainNode:
+		walkTemplate(node.Node, fn)
+	case *parse.CommandNode:
+		for _, arg := range node.Args {
+			walkTemplate(arg, fn)
+		}
+	case *parse.IfNode:
+		walkTemplate(node.Pipe, fn)
+		walkTemplate(node.List, fn)
+		walkTemplate(node.ElseList, fn)
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+
+		for _, child := range node.Nodes {
+			walkTemplate(child, fn)
+		}
+	case *parse.PipeNode:
+		if node == nil {
+			return
+		}
+
+		for _, command := range node.Cmds {
+			walkTemplate(command, fn)
+		}
+	case *parse.RangeNode:
+		walkTemplate(node.Pipe, fn)
+		walkTemplate(node.ElseList, fn)
+	case *parse.TemplateNode:
+		walkTemplate(node.Pipe, fn)
+	case *parse.WithNode:
+		walkTemplate(node.Pipe, fn)
+		walkTemplate(node.ElseList, fn)
+	}
+}
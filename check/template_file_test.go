@@ -0,0 +1,165 @@
+package check
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestTemplateFileCheck(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		BasePath    string
+		Path        string
+		ExpectError bool
+	}{
+		{
+			Name:     "valid provider template",
+			BasePath: "testdata/templates",
+			Path:     "templates/index.md.tmpl",
+		},
+		{
+			Name:     "valid resource template",
+			BasePath: "testdata/templates",
+			Path:     "templates/resources/thing.md.tmpl",
+		},
+		{
+			Name:        "invalid field",
+			BasePath:    "testdata/templates",
+			Path:        "templates/guides/invalid-field.md.tmpl",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid function",
+			BasePath:    "testdata/templates",
+			Path:        "templates/resources/invalid-function.md.tmpl",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid syntax",
+			BasePath:    "testdata/templates",
+			Path:        "templates/resources/invalid-syntax.md.tmpl",
+			ExpectError: true,
+		},
+		{
+			Name:        "missing referenced file",
+			BasePath:    "testdata/templates",
+			Path:        "templates/resources/missing-file.md.tmpl",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewTemplateFileCheck(&TemplateFileOptions{
+				FileOptions: &FileOptions{
+					BasePath: testCase.BasePath,
+				},
+			}).Run(testCase.Path)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestGetTemplateFiles(t *testing.T) {
+	got, err := GetTemplateFiles("testdata/templates")
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	expected := []string{
+		"templates/guides/invalid-field.md.tmpl",
+		"templates/index.md.tmpl",
+		"templates/resources/invalid-function.md.tmpl",
+		"templates/resources/invalid-syntax.md.tmpl",
+		"templates/resources/missing-file.md.tmpl",
+		"templates/resources/thing.md.tmpl",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestGetTemplateFilesFS(t *testing.T) {
+	got, err := GetTemplateFilesFS(os.DirFS("testdata/templates"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	expected, err := GetTemplateFiles("testdata/templates")
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	got, err = GetTemplateFilesFS(fstest.MapFS{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("expected no files, got %v", got)
+	}
+}
+
+func TestTemplateFileType(t *testing.T) {
+	testCases := []struct {
+		Path   string
+		Expect string
+	}{
+		{
+			Path:   "templates/index.md.tmpl",
+			Expect: TemplateTypeProvider,
+		},
+		{
+			Path:   "templates/resources.md.tmpl",
+			Expect: TemplateTypeResource,
+		},
+		{
+			Path:   "templates/resources/thing.md.tmpl",
+			Expect: TemplateTypeResource,
+		},
+		{
+			Path:   "templates/data-sources/thing.md.tmpl",
+			Expect: TemplateTypeResource,
+		},
+		{
+			Path:   "templates/ephemeral-resources/thing.md.tmpl",
+			Expect: TemplateTypeResource,
+		},
+		{
+			Path:   "templates/functions/thing.md.tmpl",
+			Expect: TemplateTypeFunction,
+		},
+		{
+			Path:   "templates/guides/index.md.tmpl",
+			Expect: TemplateTypeOther,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Path, func(t *testing.T) {
+			got := TemplateFileType(testCase.Path)
+
+			if got != testCase.Expect {
+				t.Errorf("expected %s, got %s", testCase.Expect, got)
+			}
+		})
+	}
+}
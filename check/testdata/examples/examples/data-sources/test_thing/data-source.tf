@@ -0,0 +1 @@
+data "test_thing" "example" {}
@@ -0,0 +1 @@
+resource "test_thing" "example" {}
@@ -0,0 +1,96 @@
+package check
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	TimingTypeCheck     = "check"
+	TimingTypeDirectory = "directory"
+)
+
+// Timing is the total wall-clock time of a check or documentation directory.
+type Timing struct {
+	Duration time.Duration
+
+	// Name is the check identifier or documentation directory.
+	Name string
+
+	// Type is TimingTypeCheck or TimingTypeDirectory.
+	Type string
+}
+
+// Timings records the total wall-clock time of each check and documentation
+// directory. Directory times include the checks of their files, so check and
+// directory times overlap. A nil Timings records nothing.
+type Timings struct {
+	Checks      map[string]time.Duration
+	Directories map[string]time.Duration
+}
+
+func NewTimings() *Timings {
+	return &Timings{
+		Checks:      make(map[string]time.Duration),
+		Directories: make(map[string]time.Duration),
+	}
+}
+
+// Check runs the check function and records its time for the check
+// identifier.
+func (t *Timings) Check(id string, f func() error) error {
+	if t == nil {
+		return f()
+	}
+
+	start := time.Now()
+	err := f()
+	t.Checks[id] += time.Since(start)
+
+	return err
+}
+
+// Directory records the time since start for the documentation directory.
+func (t *Timings) Directory(directory string, start time.Time) {
+	if t == nil {
+		return
+	}
+
+	t.Directories[directory] += time.Since(start)
+}
+
+// Slowest returns up to limit checks and directories, ordered by descending
+// time. All are returned if limit is not positive.
+func (t *Timings) Slowest(limit int) []Timing {
+	if t == nil {
+		return nil
+	}
+
+	timings := make([]Timing, 0, len(t.Checks)+len(t.Directories))
+
+	for id, duration := range t.Checks {
+		timings = append(timings, Timing{Duration: duration, Name: id, Type: TimingTypeCheck})
+	}
+
+	for directory, duration := range t.Directories {
+		timings = append(timings, Timing{Duration: duration, Name: directory, Type: TimingTypeDirectory})
+	}
+
+	sort.Slice(timings, func(i, j int) bool {
+		if timings[i].Duration != timings[j].Duration {
+			return timings[i].Duration > timings[j].Duration
+		}
+
+		if timings[i].Type != timings[j].Type {
+			return timings[i].Type < timings[j].Type
+		}
+
+		return timings[i].Name < timings[j].Name
+	})
+
+	if limit > 0 && len(timings) > limit {
+		timings = timings[:limit]
+	}
+
+	return timings
+}
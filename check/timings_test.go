@@ -0,0 +1,76 @@
+package check
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimingsCheck(t *testing.T) {
+	expectedErr := errors.New("test")
+
+	var nilTimings *Timings
+
+	if err := nilTimings.Check(CheckIDFrontMatter, func() error { return expectedErr }); err != expectedErr {
+		t.Errorf("expected error from nil Timings, got: %s", err)
+	}
+
+	timings := NewTimings()
+
+	if err := timings.Check(CheckIDFrontMatter, func() error { return expectedErr }); err != expectedErr {
+		t.Errorf("expected error, got: %s", err)
+	}
+
+	if _, ok := timings.Checks[CheckIDFrontMatter]; !ok {
+		t.Errorf("expected %s timing, got none", CheckIDFrontMatter)
+	}
+}
+
+func TestTimingsSlowest(t *testing.T) {
+	timings := &Timings{
+		Checks: map[string]time.Duration{
+			CheckIDContents:    3 * time.Second,
+			CheckIDFrontMatter: time.Second,
+			CheckIDFileSize:    time.Second,
+		},
+		Directories: map[string]time.Duration{
+			"docs/resources": 5 * time.Second,
+		},
+	}
+
+	testCases := []struct {
+		Name   string
+		Limit  int
+		Expect []Timing
+	}{
+		{
+			Name:  "no limit",
+			Limit: 0,
+			Expect: []Timing{
+				{Duration: 5 * time.Second, Name: "docs/resources", Type: TimingTypeDirectory},
+				{Duration: 3 * time.Second, Name: CheckIDContents, Type: TimingTypeCheck},
+				{Duration: time.Second, Name: CheckIDFileSize, Type: TimingTypeCheck},
+				{Duration: time.Second, Name: CheckIDFrontMatter, Type: TimingTypeCheck},
+			},
+		},
+		{
+			Name:  "limit",
+			Limit: 2,
+			Expect: []Timing{
+				{Duration: 5 * time.Second, Name: "docs/resources", Type: TimingTypeDirectory},
+				{Duration: 3 * time.Second, Name: CheckIDContents, Type: TimingTypeCheck},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := timings.Slowest(testCase.Limit)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/markdown"
+	"github.com/yuin/goldmark/ast"
+)
+
+// titleHeadingTypeLabels maps documentation types to the label of their title
+// heading, e.g. # Resource: aws_instance or # aws_instance (Resource).
+var titleHeadingTypeLabels = map[string]string{
+	DocumentationTypeAction:       "Action",
+	DocumentationTypeDataSource:   "Data Source",
+	DocumentationTypeListResource: "List Resource",
+	DocumentationTypeResource:     "Resource",
+}
+
+// TitleHeadingCheck verifies the first level 1 heading of action, data
+// source, list resource, and resource documentation agrees with the YAML
+// frontmatter page_title, which the Terraform Registry shows in the
+// navigation sidebar, and the documentation type. The heading must reference
+// a resource name of the page_title, or of the documentation file name if the
+// page_title has none, and a type label (e.g. Data Source: or (Resource)) must
+// match the documentation directory. Headings without a resource name are
+// skipped.
+func TitleHeadingCheck(path string, src []byte, providerName string) error {
+	if providerName == "" {
+		return nil
+	}
+
+	headingText, ok := titleHeadingText(src)
+
+	if !ok {
+		return nil
+	}
+
+	if label, ok := titleHeadingTypeLabel(headingText); ok {
+		expectedLabel := titleHeadingTypeLabels[DirectoryDocumentationType(filepath.ToSlash(filepath.Dir(path)))]
+
+		if expectedLabel != "" && label != expectedLabel {
+			err := fmt.Errorf("title heading (%q) type (%s) does not match documentation type: %s", headingText, label, expectedLabel)
+
+			return WithSuggestion(err, fmt.Sprintf("update the title heading to # %s: %s", expectedLabel, fileResourceName(providerName, path)))
+		}
+	}
+
+	resourceNameRegexp := pageTitleResourceNameRegexp(providerName)
+	resourceNames := resourceNameRegexp.FindAllString(headingText, -1)
+
+	if len(resourceNames) == 0 {
+		return nil
+	}
+
+	expectedSource := "file"
+	expectedResourceNames := []string{fileResourceName(providerName, path)}
+
+	frontMatter, err := ParseFrontMatter(src)
+
+	if err != nil {
+		return err
+	}
+
+	if frontMatter.PageTitle != nil {
+		if names := resourceNameRegexp.FindAllString(*frontMatter.PageTitle, -1); len(names) > 0 {
+			expectedSource = fmt.Sprintf("YAML frontmatter page_title (%q)", *frontMatter.PageTitle)
+			expectedResourceNames = names
+		}
+	}
+
+	for _, resourceName := range resourceNames {
+		if containsString(expectedResourceNames, resourceName) {
+			return nil
+		}
+	}
+
+	err = fmt.Errorf("title heading (%q) resource name (%s) does not match %s resource name: %s", headingText, resourceNames[0], expectedSource, expectedResourceNames[0])
+
+	return WithSuggestion(err, fmt.Sprintf("update the title heading to reference %s", expectedResourceNames[0]))
+}
+
+// titleHeadingText returns the text of the first level 1 heading, if any.
+func titleHeadingText(src []byte) (string, bool) {
+	document, _ := markdown.Parse(src)
+
+	for node := document.FirstChild(); node != nil; node = node.NextSibling() {
+		if heading, ok := node.(*ast.Heading); ok && heading.Level == 1 {
+			return string(heading.Text(src)), true
+		}
+	}
+
+	return "", false
+}
+
+// titleHeadingTypeLabel returns the documentation type label of the title
+// heading, either a prefix (e.g. Data Source: aws_ami) or a terraform-plugin-docs
+// suffix (e.g. aws_ami (Data Source)), if any.
+func titleHeadingTypeLabel(headingText string) (string, bool) {
+	for _, label := range titleHeadingTypeLabels {
+		if strings.HasPrefix(headingText, label+": ") || strings.HasSuffix(headingText, " ("+label+")") {
+			return label, true
+		}
+	}
+
+	return "", false
+}
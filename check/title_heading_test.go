@@ -0,0 +1,120 @@
+package check
+
+import (
+	"testing"
+)
+
+func TestTitleHeadingCheck(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		Source       string
+		ProviderName string
+		ExpectError  bool
+	}{
+		{
+			Name:         "no provider name",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\n# Data Source: test_other\n",
+			ProviderName: "",
+		},
+		{
+			Name:         "no heading",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\nManages a thing.\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "heading without resource name",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\n# Thing\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "legacy",
+			Path:         "website/docs/d/thing.html.markdown",
+			Source:       "---\npage_title: \"Test: test_thing\"\n---\n\n# Data Source: test_thing\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "terraform-plugin-docs",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\n# test_thing (Resource)\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "list resource",
+			Path:         "docs/list-resources/thing.md",
+			Source:       "---\npage_title: \"test_thing List Resource - terraform-provider-test\"\n---\n\n# List Resource: test_thing\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "page_title without resource name",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"Thing\"\n---\n\n# Resource: test_thing\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "page_title with multiple resource names",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing (formerly test_old_thing)\"\n---\n\n# Resource: test_old_thing\n",
+			ProviderName: "test",
+		},
+		{
+			Name:         "mismatched page_title resource name",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\n# Resource: test_other\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "mismatched file resource name",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"Thing\"\n---\n\n# Resource: test_other\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "mismatched type",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\n# Data Source: test_thing\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "mismatched terraform-plugin-docs type",
+			Path:         "docs/data-sources/thing.md",
+			Source:       "---\npage_title: \"test_thing Data Source - terraform-provider-test\"\n---\n\n# test_thing (Resource)\n",
+			ProviderName: "test",
+			ExpectError:  true,
+		},
+		{
+			Name:         "heading in code block",
+			Path:         "docs/resources/thing.md",
+			Source:       "---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\n```\n# Resource: test_other\n```\n\n# Resource: test_thing\n",
+			ProviderName: "test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := TitleHeadingCheck(testCase.Path, []byte(testCase.Source), testCase.ProviderName)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestTitleHeadingCheckSuggestion(t *testing.T) {
+	err := TitleHeadingCheck("docs/resources/thing.md", []byte("---\npage_title: \"test_thing Resource - terraform-provider-test\"\n---\n\n# Resource: test_other\n"), "test")
+
+	if got, expected := ErrorSuggestion(err), "update the title heading to reference test_thing"; got != expected {
+		t.Errorf("expected suggestion %q, got: %q", expected, got)
+	}
+}
@@ -0,0 +1,103 @@
+package check
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// MissingTranslations returns the default locale documentation files without
+// a translation in each locale of the same directory structure, keyed by the
+// locale index directory (e.g. docs/ja). Files are matched by documentation
+// type and name. CDKTF documentation is not translated and is skipped.
+func MissingTranslations(directories map[string][]string) map[string][]string {
+	// Documentation is keyed by directory structure, then by documentation
+	// type and name.
+	defaultFiles := make(map[string]map[string]string)
+	localeFiles := make(map[string]map[string]bool)
+
+	for directory, files := range directories {
+		if DirectoryCdktfLanguage(directory) != "" {
+			continue
+		}
+
+		documentationType := DirectoryDocumentationType(directory)
+
+		if documentationType == DocumentationTypeUnknown {
+			continue
+		}
+
+		structure := DirectoryStructure(directory)
+
+		if locale := DirectoryLocale(directory); locale != "" {
+			localeDirectory := structureIndexDirectory(structure) + "/" + locale
+
+			if localeFiles[localeDirectory] == nil {
+				localeFiles[localeDirectory] = make(map[string]bool)
+			}
+
+			for _, file := range files {
+				localeFiles[localeDirectory][documentationType+"/"+TrimFileExtension(file)] = true
+			}
+
+			continue
+		}
+
+		if defaultFiles[structure] == nil {
+			defaultFiles[structure] = make(map[string]string)
+		}
+
+		for _, file := range files {
+			defaultFiles[structure][documentationType+"/"+TrimFileExtension(file)] = file
+		}
+	}
+
+	result := make(map[string][]string)
+
+	for localeDirectory, translations := range localeFiles {
+		for key, file := range defaultFiles[DirectoryStructure(localeDirectory)] {
+			if !translations[key] {
+				result[localeDirectory] = append(result[localeDirectory], file)
+			}
+		}
+
+		sort.Strings(result[localeDirectory])
+	}
+
+	return result
+}
+
+// TranslationsCheck logs a warning for each default locale documentation file
+// without a translation. Missing translations are not findings, as
+// translations commonly follow the default locale documentation.
+func TranslationsCheck(directories map[string][]string) {
+	translationsCheck(hclog.L(), directories)
+}
+
+func translationsCheck(logger hclog.Logger, directories map[string][]string) {
+	missingTranslations := MissingTranslations(directories)
+	localeDirectories := make([]string, 0, len(missingTranslations))
+
+	for localeDirectory := range missingTranslations {
+		localeDirectories = append(localeDirectories, localeDirectory)
+	}
+
+	sort.Strings(localeDirectories)
+
+	for _, localeDirectory := range localeDirectories {
+		for _, file := range missingTranslations[localeDirectory] {
+			logger.Warn("Documentation file missing translation", "check", CheckIDTranslations, "file", file, "locale", filepath.Base(localeDirectory))
+		}
+	}
+}
+
+// structureIndexDirectory returns the index directory of the directory
+// structure, e.g. docs for the registry directory structure.
+func structureIndexDirectory(structure string) string {
+	if structure == DirectoryStructureLegacy {
+		return LegacyIndexDirectory
+	}
+
+	return RegistryIndexDirectory
+}
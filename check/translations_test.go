@@ -0,0 +1,54 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMissingTranslations(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		BasePath string
+		Expect   map[string][]string
+	}{
+		{
+			Name:     "no locales",
+			BasePath: "testdata/valid-registry-directories-with-cdktf",
+			Expect:   map[string][]string{},
+		},
+		{
+			Name:     "registry locales",
+			BasePath: "testdata/valid-registry-directories-with-locales",
+			Expect: map[string][]string{
+				"docs/ja": {
+					"docs/data-sources/thing.md",
+				},
+				"docs/pt-BR": {
+					"docs/data-sources/thing.md",
+					"docs/resources/thing.md",
+				},
+			},
+		},
+		{
+			Name:     "legacy locales",
+			BasePath: "testdata/valid-legacy-directories-with-locales",
+			Expect:   map[string][]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			directories, err := GetDirectories(testCase.BasePath, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error getting directories: %s", err)
+			}
+
+			got := MissingTranslations(directories)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected %v, got %v", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package command
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// archiveExtensions contains the supported archive file extensions.
+var archiveExtensions = []string{
+	".tar.gz",
+	".tgz",
+	".zip",
+}
+
+// defaultArchiveLimits contains the limits of extracting archives, which
+// prevent archives with many or highly compressed entries, such as zip bombs,
+// from exhausting the disk.
+var defaultArchiveLimits = archiveLimits{
+	MaximumEntries:  100000,
+	MaximumFileSize: 512 << 20,
+	MaximumSize:     2 << 30,
+}
+
+// archiveVersionRegexp matches the version suffix of an archive name, such as
+// -1.2.3 or -v1.2.3-beta1.
+var archiveVersionRegexp = regexp.MustCompile(`-v?[0-9][0-9A-Za-z.+-]*$`)
+
+// archiveLimits contains the limits of extracting an archive.
+type archiveLimits struct {
+	// MaximumEntries is the maximum number of entries in the archive.
+	MaximumEntries int
+
+	// MaximumFileSize is the maximum uncompressed size of each file.
+	MaximumFileSize int64
+
+	// MaximumSize is the maximum uncompressed size of all files.
+	MaximumSize int64
+}
+
+// archiveExtraction tracks the number of entries and the uncompressed size of
+// the files extracted from an archive, returning an error once they exceed
+// the limits.
+type archiveExtraction struct {
+	limits  archiveLimits
+	entries int
+	size    int64
+}
+
+// addEntry counts the archive entry, returning an error if the archive has
+// too many entries.
+func (extraction *archiveExtraction) addEntry() error {
+	extraction.entries++
+
+	if extraction.entries > extraction.limits.MaximumEntries {
+		return fmt.Errorf("exceeded maximum (%d) number of archive entries", extraction.limits.MaximumEntries)
+	}
+
+	return nil
+}
+
+// writeFile writes the archive file contents to the path, stopping once the
+// file or all files exceed their maximum uncompressed size, as the sizes in
+// archive headers cannot be trusted.
+func (extraction *archiveExtraction) writeFile(name string, target string, src io.Reader) error {
+	limit := extraction.limits.MaximumFileSize
+
+	if remaining := extraction.limits.MaximumSize - extraction.size; remaining < limit {
+		limit = remaining
+	}
+
+	written, err := writeArchiveFile(target, io.LimitReader(src, limit+1))
+
+	if err != nil {
+		return err
+	}
+
+	if written > extraction.limits.MaximumFileSize {
+		return fmt.Errorf("exceeded maximum (%d) uncompressed size of archive file (%s)", extraction.limits.MaximumFileSize, name)
+	}
+
+	extraction.size += written
+
+	if extraction.size > extraction.limits.MaximumSize {
+		return fmt.Errorf("exceeded maximum (%d) uncompressed size of archive", extraction.limits.MaximumSize)
+	}
+
+	return nil
+}
+
+// isArchive returns true if the path has a supported archive file extension.
+func isArchive(path string) bool {
+	return archiveExtension(path) != ""
+}
+
+// archiveExtension returns the supported archive file extension of the path,
+// otherwise an empty string.
+func archiveExtension(path string) string {
+	lowerPath := strings.ToLower(path)
+
+	for _, extension := range archiveExtensions {
+		if strings.HasSuffix(lowerPath, extension) {
+			return extension
+		}
+	}
+
+	return ""
+}
+
+// archiveProviderName returns the Terraform Provider short name of archive
+// names prefixed with terraform-provider-, such as
+// terraform-provider-test_1.2.3_linux_amd64.zip or
+// terraform-provider-test-1.2.3.tar.gz, otherwise an empty string.
+func archiveProviderName(path string) string {
+	base := filepath.Base(path)
+	base = base[:len(base)-len(archiveExtension(base))]
+
+	if !strings.HasPrefix(base, "terraform-provider-") {
+		return ""
+	}
+
+	name := strings.TrimPrefix(base, "terraform-provider-")
+	name, _, _ = strings.Cut(name, "_")
+
+	return archiveVersionRegexp.ReplaceAllString(name, "")
+}
+
+// extractArchive extracts the .zip or .tar.gz archive into a temporary
+// directory and returns the Terraform Provider codebase path, which is the
+// single top level directory of the archive if present and not a
+// documentation directory, such as in source archives, otherwise the
+// temporary directory. Archives exceeding the default limits return an
+// error. The returned function removes the temporary directory.
+func extractArchive(archivePath string) (string, func() error, error) {
+	directory, err := os.MkdirTemp("", "tfproviderdocs-")
+
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	cleanup := func() error {
+		return os.RemoveAll(directory)
+	}
+
+	if archiveExtension(archivePath) == ".zip" {
+		err = extractZip(archivePath, directory, defaultArchiveLimits)
+	} else {
+		err = extractTarGz(archivePath, directory, defaultArchiveLimits)
+	}
+
+	if err != nil {
+		cleanup()
+
+		return "", nil, err
+	}
+
+	entries, err := os.ReadDir(directory)
+
+	if err != nil {
+		cleanup()
+
+		return "", nil, fmt.Errorf("error reading temporary directory: %w", err)
+	}
+
+	if len(entries) == 1 && entries[0].IsDir() && entries[0].Name() != "docs" && entries[0].Name() != "website" {
+		return filepath.Join(directory, entries[0].Name()), cleanup, nil
+	}
+
+	return directory, cleanup, nil
+}
+
+// extractZip extracts the directories and regular files of the zip archive
+// into the directory, within the limits.
+func extractZip(archivePath string, directory string, limits archiveLimits) error {
+	reader, err := zip.OpenReader(archivePath)
+
+	if err != nil {
+		return fmt.Errorf("error opening zip archive: %w", err)
+	}
+
+	defer reader.Close()
+
+	extraction := &archiveExtraction{limits: limits}
+
+	for _, file := range reader.File {
+		if err := extraction.addEntry(); err != nil {
+			return err
+		}
+
+		if !file.Mode().IsDir() && !file.Mode().IsRegular() {
+			continue
+		}
+
+		target, err := archiveEntryPath(directory, file.Name)
+
+		if err != nil {
+			return err
+		}
+
+		if file.Mode().IsDir() {
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return fmt.Errorf("error creating directory: %w", err)
+			}
+
+			continue
+		}
+
+		src, err := file.Open()
+
+		if err != nil {
+			return fmt.Errorf("error reading zip archive file (%s): %w", file.Name, err)
+		}
+
+		err = extraction.writeFile(file.Name, target, src)
+		src.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractTarGz extracts the directories and regular files of the gzip
+// compressed tar archive into the directory, within the limits.
+func extractTarGz(archivePath string, directory string, limits archiveLimits) error {
+	file, err := os.Open(archivePath)
+
+	if err != nil {
+		return fmt.Errorf("error opening tar archive: %w", err)
+	}
+
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+
+	if err != nil {
+		return fmt.Errorf("error reading gzip data: %w", err)
+	}
+
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	extraction := &archiveExtraction{limits: limits}
+
+	for {
+		header, err := tarReader.Next()
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading tar archive: %w", err)
+		}
+
+		if err := extraction.addEntry(); err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeDir && header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		target, err := archiveEntryPath(directory, header.Name)
+
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag == tar.TypeDir {
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return fmt.Errorf("error creating directory: %w", err)
+			}
+
+			continue
+		}
+
+		if err := extraction.writeFile(header.Name, target, tarReader); err != nil {
+			return err
+		}
+	}
+}
+
+// archiveEntryPath returns the extraction path of the archive entry in the
+// directory. Entries outside the directory, such as with .. elements or
+// absolute paths, return an error.
+func archiveEntryPath(directory string, name string) (string, error) {
+	slashName := strings.ReplaceAll(name, `\`, "/")
+
+	if strings.HasPrefix(slashName, "/") || strings.Contains("/"+slashName+"/", "/../") {
+		return "", fmt.Errorf("invalid archive entry path (%s)", name)
+	}
+
+	return filepath.Join(directory, filepath.FromSlash(path.Clean("/"+slashName))), nil
+}
+
+// writeArchiveFile writes the archive file contents to the path, creating
+// any parent directories, and returns the number of bytes written.
+func writeArchiveFile(target string, src io.Reader) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return 0, fmt.Errorf("error creating directory: %w", err)
+	}
+
+	dst, err := os.Create(target)
+
+	if err != nil {
+		return 0, fmt.Errorf("error creating file: %w", err)
+	}
+
+	written, err := io.Copy(dst, src)
+
+	if err != nil {
+		dst.Close()
+
+		return written, fmt.Errorf("error writing file (%s): %w", target, err)
+	}
+
+	if err := dst.Close(); err != nil {
+		return written, fmt.Errorf("error closing file (%s): %w", target, err)
+	}
+
+	return written, nil
+}
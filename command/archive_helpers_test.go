@@ -0,0 +1,352 @@
+package command
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestArchiveProviderName(t *testing.T) {
+	testCases := []struct {
+		Path   string
+		Expect string
+	}{
+		{
+			Path:   "terraform-provider-test_1.2.3_linux_amd64.zip",
+			Expect: "test",
+		},
+		{
+			Path:   "dist/terraform-provider-test-1.2.3.tar.gz",
+			Expect: "test",
+		},
+		{
+			Path:   "terraform-provider-test-v1.2.3-beta1.tgz",
+			Expect: "test",
+		},
+		{
+			Path:   "terraform-provider-test.zip",
+			Expect: "test",
+		},
+		{
+			Path:   "docs.zip",
+			Expect: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Path, func(t *testing.T) {
+			if got := archiveProviderName(testCase.Path); got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestExtractArchive(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		File        string
+		Entries     map[string]string
+		ExpectFile  string
+		ExpectError bool
+	}{
+		{
+			Name: "zip",
+			File: "terraform-provider-test_1.2.3.zip",
+			Entries: map[string]string{
+				"docs/index.md":              "# Test Provider\n",
+				"docs/resources/thing.md":    "# test_thing\n",
+				"terraform-provider-test.md": "binary\n",
+			},
+			ExpectFile: "docs/index.md",
+		},
+		{
+			Name: "tar.gz with top level directory",
+			File: "terraform-provider-test-1.2.3.tar.gz",
+			Entries: map[string]string{
+				"terraform-provider-test-1.2.3/docs/index.md":           "# Test Provider\n",
+				"terraform-provider-test-1.2.3/docs/resources/thing.md": "# test_thing\n",
+			},
+			ExpectFile: "docs/index.md",
+		},
+		{
+			Name: "zip with only documentation directory",
+			File: "docs.zip",
+			Entries: map[string]string{
+				"docs/index.md": "# Test Provider\n",
+			},
+			ExpectFile: "docs/index.md",
+		},
+		{
+			Name: "zip with path outside directory",
+			File: "invalid.zip",
+			Entries: map[string]string{
+				"../docs/index.md": "# Test Provider\n",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "tar.gz with absolute path",
+			File: "invalid.tgz",
+			Entries: map[string]string{
+				"/docs/index.md": "# Test Provider\n",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			archivePath := filepath.Join(t.TempDir(), testCase.File)
+
+			if archiveExtension(archivePath) == ".zip" {
+				testWriteZip(t, archivePath, testCase.Entries)
+			} else {
+				testWriteTarGz(t, archivePath, testCase.Entries)
+			}
+
+			path, cleanup, err := extractArchive(archivePath)
+
+			if err == nil && testCase.ExpectError {
+				cleanup()
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil {
+				if !testCase.ExpectError {
+					t.Fatalf("expected no error, got error: %s", err)
+				}
+
+				return
+			}
+
+			if _, err := os.Stat(filepath.Join(path, testCase.ExpectFile)); err != nil {
+				t.Errorf("expected extracted file (%s): %s", testCase.ExpectFile, err)
+			}
+
+			if err := cleanup(); err != nil {
+				t.Fatalf("unexpected error removing extracted archive: %s", err)
+			}
+
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				t.Errorf("expected extracted archive to be removed, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestExtractArchiveLimits(t *testing.T) {
+	limits := archiveLimits{
+		MaximumEntries:  3,
+		MaximumFileSize: 1024,
+		MaximumSize:     2048,
+	}
+
+	testCases := []struct {
+		Name        string
+		File        string
+		Entries     map[string]string
+		ExpectError bool
+	}{
+		{
+			Name: "zip within limits",
+			File: "valid.zip",
+			Entries: map[string]string{
+				"docs/index.md":           strings.Repeat("a", 1024),
+				"docs/resources/thing.md": strings.Repeat("a", 1024),
+			},
+		},
+		{
+			Name: "tar.gz within limits",
+			File: "valid.tar.gz",
+			Entries: map[string]string{
+				"docs/index.md":           strings.Repeat("a", 1024),
+				"docs/resources/thing.md": strings.Repeat("a", 1024),
+			},
+		},
+		{
+			Name: "zip exceeding maximum entries",
+			File: "entries.zip",
+			Entries: map[string]string{
+				"docs/index.md":            "# Test Provider\n",
+				"docs/guides/example.md":   "# Example\n",
+				"docs/resources/thing.md":  "# test_thing\n",
+				"docs/resources/thing2.md": "# test_thing2\n",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "tar.gz exceeding maximum entries",
+			File: "entries.tar.gz",
+			Entries: map[string]string{
+				"docs/index.md":            "# Test Provider\n",
+				"docs/guides/example.md":   "# Example\n",
+				"docs/resources/thing.md":  "# test_thing\n",
+				"docs/resources/thing2.md": "# test_thing2\n",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "zip exceeding maximum file size",
+			File: "file.zip",
+			Entries: map[string]string{
+				"docs/index.md": strings.Repeat("a", 1<<20),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "tar.gz exceeding maximum file size",
+			File: "file.tar.gz",
+			Entries: map[string]string{
+				"docs/index.md": strings.Repeat("a", 1<<20),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "zip exceeding maximum size",
+			File: "size.zip",
+			Entries: map[string]string{
+				"docs/index.md":           strings.Repeat("a", 1024),
+				"docs/guides/example.md":  strings.Repeat("a", 1024),
+				"docs/resources/thing.md": strings.Repeat("a", 1024),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "tar.gz exceeding maximum size",
+			File: "size.tar.gz",
+			Entries: map[string]string{
+				"docs/index.md":           strings.Repeat("a", 1024),
+				"docs/guides/example.md":  strings.Repeat("a", 1024),
+				"docs/resources/thing.md": strings.Repeat("a", 1024),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			archivePath := filepath.Join(t.TempDir(), testCase.File)
+			directory := t.TempDir()
+
+			var err error
+
+			if archiveExtension(archivePath) == ".zip" {
+				testWriteZip(t, archivePath, testCase.Entries)
+				err = extractZip(archivePath, directory, limits)
+			} else {
+				testWriteTarGz(t, archivePath, testCase.Entries)
+				err = extractTarGz(archivePath, directory, limits)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			var size int64
+
+			err = filepath.WalkDir(directory, func(path string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+
+				info, err := d.Info()
+
+				if err != nil {
+					return err
+				}
+
+				size += info.Size()
+
+				return nil
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error reading extracted files: %s", err)
+			}
+
+			if size > limits.MaximumSize+1 {
+				t.Errorf("expected extracted size within maximum (%d), got: %d", limits.MaximumSize, size)
+			}
+		})
+	}
+}
+
+func testWriteZip(t *testing.T, path string, entries map[string]string) {
+	t.Helper()
+
+	file, err := os.Create(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error creating archive: %s", err)
+	}
+
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+
+	for name, content := range entries {
+		w, err := writer.Create(name)
+
+		if err != nil {
+			t.Fatalf("unexpected error creating archive entry: %s", err)
+		}
+
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error writing archive entry: %s", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("unexpected error closing archive: %s", err)
+	}
+}
+
+func testWriteTarGz(t *testing.T, path string, entries map[string]string) {
+	t.Helper()
+
+	file, err := os.Create(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error creating archive: %s", err)
+	}
+
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	writer := tar.NewWriter(gzipWriter)
+
+	for name, content := range entries {
+		header := &tar.Header{
+			Mode:     0o644,
+			Name:     name,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}
+
+		if err := writer.WriteHeader(header); err != nil {
+			t.Fatalf("unexpected error writing archive header: %s", err)
+		}
+
+		if _, err := writer.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error writing archive entry: %s", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("unexpected error closing archive: %s", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("unexpected error closing archive: %s", err)
+	}
+}
@@ -3,40 +3,177 @@ package command
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
-	"log"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/check/contents"
+	"github.com/bflad/tfproviderdocs/providerbinary"
+	"github.com/fatih/color"
+	"github.com/hashicorp/go-hclog"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/mitchellh/cli"
 )
 
+const (
+	// DefaultFindingsExitCode is the default exit code of the check command
+	// when only documentation findings are reported.
+	DefaultFindingsExitCode = 2
+
+	// ProvidersSchemaJsonStdin is the -providers-schema-json value which reads
+	// the providers schema JSON from standard input.
+	ProvidersSchemaJsonStdin = "-"
+
+	// DefaultTimingsLimit is the number of checks and documentation
+	// directories output by -show-timings.
+	DefaultTimingsLimit = 10
+)
+
 type CheckCommandConfig struct {
-	AllowedGuideSubcategories        string
-	AllowedGuideSubcategoriesFile    string
-	AllowedResourceSubcategories     string
-	AllowedResourceSubcategoriesFile string
-	EnableContentsCheck              bool
-	IgnoreCdktfMissingFiles          bool
-	IgnoreFileMismatchDataSources    string
-	IgnoreFileMismatchResources      string
-	IgnoreFileMissingDataSources     string
-	IgnoreFileMissingResources       string
-	LogLevel                         string
-	Path                             string
-	ProviderName                     string
-	ProviderSource                   string
-	ProvidersSchemaJson              string
-	RequireGuideSubcategory          bool
-	RequireResourceSubcategory       bool
-	RequireSchemaOrdering            bool
+	AllowCRLFLineEndings              bool
+	AllowedGuideSubcategories         string
+	AllowedGuideSubcategoriesFile     string
+	AllowedHTMLTags                   string
+	AllowedResourceSubcategories      string
+	AllowedResourceSubcategoriesFile  string
+	CompareRegistryVersion            string
+	ConfigFile                        string
+	CPUProfile                        string
+	DisableChecks                     string
+	DocsDirs                          string
+	EnableChecks                      string
+	EnableContentsCheck               bool
+	EnableCrossReferencesCheck        bool
+	EnableExampleSecretsCheck         bool
+	EnableFileNameCheck               bool
+	EnableFormatVerbsCheck            bool
+	EnableFrontMatterPageTitleCheck   bool
+	EnableGuideFilenamesCheck         bool
+	EnableGuideLinksCheck             bool
+	EnableLegacyNavigationCheck       bool
+	EnableNestedSchemaLinksCheck      bool
+	EnablePairedDocumentationCheck    bool
+	EnableRawHTMLCheck                bool
+	EnableSubcategoryConsistencyCheck bool
+	EnableTemplateCheck               bool
+	EnableTitleHeadingCheck           bool
+	Exclude                           []string
+	Files                             bool
+	FindingsExitCode                  int
+	FollowSymlinks                    bool
+	ForbidFrontMatterKeys             string
+	ForbiddenWordsFile                string
+	Format                            string
+	FrontMatterSchema                 string
+	GitRef                            string
+	GitURL                            string
+	GuideFilenameSeparator            string
+	IgnoreCdktfMissingFiles           bool
+	IgnoreExampleDataSources          string
+	IgnoreExampleDataSourcesFile      string
+	IgnoreExampleResources            string
+	IgnoreExampleResourcesFile        string
+	IgnoreFileMismatchDataSources     string
+	IgnoreFileMismatchDataSourcesFile string
+	IgnoreFileMismatchResources       string
+	IgnoreFileMismatchResourcesFile   string
+	IgnoreFileMissingDataSources      string
+	IgnoreFileMissingDataSourcesFile  string
+	IgnoreFileMissingResources        string
+	IgnoreFileMissingResourcesFile    string
+	IgnoreLegacyNavigation            string
+	LogFormat                         string
+	LogLevel                          string
+	MaximumFiles                      int
+	MaximumGuides                     int
+	MaximumLineLength                 int
+	MaximumSubcategories              int
+	MaximumSubcategoryEntries         int
+	MemProfile                        string
+	MissingAttributesReport           string
+	NewDocumentationRef               string
+	Outputs                           []*checkOutput
+	NoColor                           bool
+	NormalizeSubcategories            bool
+	Path                              string
+	Profile                           string
+	ProviderBinary                    string
+	ProviderName                      string
+	ProviderSource                    string
+	ProviderVersion                   string
+	ProvidersSchemaJson               string
+	Quiet                             bool
+	Recursive                         bool
+	RequireCalloutPrefix              bool
+	RequireDeprecationReplacement     bool
+	RequireExampleUsage               bool
+	RequireExamples                   bool
+	RequireFileExtension              string
+	RequireFrontMatterKeys            string
+	RequireGuideSubcategory           bool
+	RequireIdentity                   bool
+	RequireImportBlock                bool
+	RequireIndexSections              string
+	RequireLFLineEndings              bool
+	RequireLegacyStructure            bool
+	RequireLinkedGuides               bool
+	RequireNewDocumentation           bool
+	RequirePairedLinks                bool
+	RequireRegistryManifest           bool
+	RequireRegistryStructure          bool
+	RequireResourceSubcategory        bool
+	RequireSchemaDescriptions         bool
+	RequireSchemaOrdering             bool
+	RequireSelfContainedExamples      bool
+	SchemaOrderingStyle               string
+	ShowTimings                       bool
+	SourceRepository                  string
+	SubcategoryMappingFile            string
+	Strict                            bool
+	Timeout                           time.Duration
+	Verbose                           bool
+	WarnFiles                         int
+	WarnLocalImages                   bool
+	WarnSingleEntrySubcategories      bool
+	WarnSubcategories                 int
+	Watch                             bool
+
+	// FilePaths contains the documentation files to check if Files is
+	// enabled, which are given as arguments.
+	FilePaths []string
+
+	// MissingAttributes, if set, records the undocumented schema attributes
+	// for -missing-attributes-report. It is not configurable via flags.
+	MissingAttributes *check.MissingAttributes
+
+	// Paths contains the Terraform Provider codebase paths to check, which
+	// are given as arguments or with repeated -path flags. Each path is
+	// checked separately with Path set.
+	Paths []string
+
+	// Progress, if set, is called with the path of each documentation file
+	// before it is checked. It is not configurable via flags.
+	Progress func(path string)
+
+	// RegistryBaseURL overrides the Terraform Registry base URL for
+	// -compare-registry-version and -provider-version. It is not
+	// configurable via flags.
+	RegistryBaseURL string
+
+	// Timings, if set, records the time of each check and documentation
+	// directory. It is not configurable via flags.
+	Timings *check.Timings
 }
 
 // CheckCommand is a Command implementation
@@ -47,34 +184,41 @@ type CheckCommand struct {
 func (*CheckCommand) Help() string {
 	optsBuffer := bytes.NewBuffer([]byte{})
 	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
 	LogLevelFlagHelp(opts)
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-guide-subcategories", "Comma separated list of allowed guide frontmatter subcategories.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-guide-subcategories-file", "Path to newline separated file of allowed guide frontmatter subcategories.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories", "Comma separated list of allowed data source and resource frontmatter subcategories.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories-file", "Path to newline separated file of allowed data source and resource frontmatter subcategories.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-contents-check", "(Experimental) Enable contents checking.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-cdktf-missing-files", "Ignore checks for missing CDK for Terraform documentation files when iteratively introducing them in large providers.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-data-sources", "Comma separated list of data sources to ignore mismatched/extra files.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-resources", "Comma separated list of resources to ignore mismatched/extra files.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-data-sources", "Comma separated list of data sources to ignore missing files.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-resources", "Comma separated list of resources to ignore missing files.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws) for Terraform CLI 0.13 and later -providers-schema-json. Automatically sets -provider-name by dropping hostname and namespace prefix.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-providers-schema-json", "Path to terraform providers schema -json file. Enables enhanced validations.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-guide-subcategory", "Require guide frontmatter subcategory.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-resource-subcategory", "Require data source and resource frontmatter subcategory.")
-	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-schema-ordering", "Require schema attribute lists to be alphabetically ordered (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-findings-exit-code", fmt.Sprintf("Exit code when only documentation findings are reported. Defaults to %d.", DefaultFindingsExitCode))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-follow-symlinks", "Follow symbolic links to directories within the documentation directories, skipping links which would create a cycle. Symbolic links to files are always checked at each location they appear.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-format", fmt.Sprintf("Output format of findings (%s). The codeclimate format outputs only a Code Climate JSON issue array, e.g. for GitLab CI code quality reports. The shields format outputs only a shields.io endpoint badge JSON object with the number of checked files and findings. The tap format outputs only a TAP version 13 stream with a test point per checked file. Can be repeated with -out to write multiple formats in one run. Defaults to %s.", strings.Join(OutputFormats, ", "), OutputFormatText))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-files", "Treat arguments as documentation file paths, relative to the current directory, and only run checks of individual files. Checks across files, such as file mismatch and directory checks, are skipped. Other files are ignored, e.g. for pre-commit hooks.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-git-ref", "Branch, tag, or commit of the -git-url repository to check. Defaults to the default branch.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-git-url", "Instead of a path, shallow clone the git repository (e.g. https://github.com/example/terraform-provider-example) into a temporary directory and check it. Requires the git executable.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-missing-attributes-report", "After checking, write each undocumented schema attribute found by the contents check as a resource, attribute, and reason to the given .csv or .json file. Requires the contents check and -providers-schema-json, -provider-binary, or -provider-version.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-no-color", "Disable colorized output. Also disabled if the NO_COLOR environment variable is set.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-out", "Path to write the report of the preceding -format flag to instead of standard output, e.g. -format codeclimate -out report.json. Text reports are written without color.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-path", "Terraform Provider codebase path to check. Can be repeated or combined with PATH arguments to check multiple codebases, exiting with the most severe exit code.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-quiet", "Only output findings and a single line summary. Defaults the log level to ERROR.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-recursive", "Check each terraform-provider-* directory found within the paths, with the provider name detected from each directory name.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-show-timings", fmt.Sprintf("After checking, output the %d slowest checks and documentation directories by wall-clock time.", DefaultTimingsLimit))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-strict", "Exit with the findings exit code if warnings are logged, such as the provider name not being found in the providers schema, even without findings.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-timeout", "Maximum duration of cloning and checking (e.g. 10m), after which remaining checks are skipped and the exit code is 1. Defaults to no timeout.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-verbose", "Output each checked file. Defaults the log level to INFO.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-watch", "After checking, watch the documentation and templates directories and re-run checks for changed files until interrupted.")
+	CheckFlagsHelp(opts)
 	opts.Flush()
 
 	helpText := fmt.Sprintf(`
-Usage: tfproviderdocs check [options] [PATH]
+Usage: tfproviderdocs check [options] [PATH...]
+       tfproviderdocs check -files [options] FILE...
 
-  Performs documentation directory and file checks against the given Terraform Provider codebase.
+  Performs documentation directory and file checks against the given Terraform Provider codebases.
+
+  Exits with the -findings-exit-code (default %d) if documentation findings are reported
+  and 1 if an error prevented checking, such as invalid flags or unreadable files.
 
 Options:
 
 %s
-`, optsBuffer.String())
+`, DefaultFindingsExitCode, optsBuffer.String())
 
 	return strings.TrimSpace(helpText)
 }
@@ -83,26 +227,33 @@ func (c *CheckCommand) Name() string { return "check" }
 
 func (c *CheckCommand) Run(args []string) int {
 	var config CheckCommandConfig
+	var outputs formatFlag
 
 	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
 	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
 	LogLevelFlag(flags, &config.LogLevel)
-	flags.StringVar(&config.AllowedGuideSubcategories, "allowed-guide-subcategories", "", "")
-	flags.StringVar(&config.AllowedGuideSubcategoriesFile, "allowed-guide-subcategories-file", "", "")
-	flags.StringVar(&config.AllowedResourceSubcategories, "allowed-resource-subcategories", "", "")
-	flags.StringVar(&config.AllowedResourceSubcategoriesFile, "allowed-resource-subcategories-file", "", "")
-	flags.BoolVar(&config.EnableContentsCheck, "enable-contents-check", false, "")
-	flags.BoolVar(&config.IgnoreCdktfMissingFiles, "ignore-cdktf-missing-files", false, "")
-	flags.StringVar(&config.IgnoreFileMismatchDataSources, "ignore-file-mismatch-data-sources", "", "")
-	flags.StringVar(&config.IgnoreFileMismatchResources, "ignore-file-mismatch-resources", "", "")
-	flags.StringVar(&config.IgnoreFileMissingDataSources, "ignore-file-missing-data-sources", "", "")
-	flags.StringVar(&config.IgnoreFileMissingResources, "ignore-file-missing-resources", "", "")
-	flags.StringVar(&config.ProviderName, "provider-name", "", "")
-	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
-	flags.StringVar(&config.ProvidersSchemaJson, "providers-schema-json", "", "")
-	flags.BoolVar(&config.RequireGuideSubcategory, "require-guide-subcategory", false, "")
-	flags.BoolVar(&config.RequireResourceSubcategory, "require-resource-subcategory", false, "")
-	flags.BoolVar(&config.RequireSchemaOrdering, "require-schema-ordering", false, "")
+	flags.IntVar(&config.FindingsExitCode, "findings-exit-code", DefaultFindingsExitCode, "")
+	flags.StringVar(&config.MissingAttributesReport, "missing-attributes-report", "", "")
+	flags.Var(&outputs, "format", "")
+	flags.Var(outFlag{outputs: &outputs}, "out", "")
+	flags.BoolVar(&config.NoColor, "no-color", false, "")
+	flags.BoolVar(&config.Quiet, "quiet", false, "")
+	flags.BoolVar(&config.Recursive, "recursive", false, "")
+	flags.BoolVar(&config.Files, "files", false, "")
+	flags.BoolVar(&config.FollowSymlinks, "follow-symlinks", false, "")
+	flags.StringVar(&config.GitRef, "git-ref", "", "")
+	flags.StringVar(&config.GitURL, "git-url", "", "")
+	flags.Var((*stringSliceFlag)(&config.Paths), "path", "")
+	flags.BoolVar(&config.ShowTimings, "show-timings", false, "")
+	flags.BoolVar(&config.Strict, "strict", false, "")
+	flags.DurationVar(&config.Timeout, "timeout", 0, "")
+	flags.BoolVar(&config.Verbose, "verbose", false, "")
+	flags.BoolVar(&config.Watch, "watch", false, "")
+	// Profiling flags are intentionally omitted from the help output.
+	flags.StringVar(&config.CPUProfile, "cpuprofile", "", "")
+	flags.StringVar(&config.MemProfile, "memprofile", "", "")
+	CheckFlags(flags, &config)
 
 	if err := flags.Parse(args); err != nil {
 		flags.Usage()
@@ -111,311 +262,2427 @@ func (c *CheckCommand) Run(args []string) int {
 
 	args = flags.Args()
 
-	if len(args) == 1 {
-		config.Path = args[0]
+	if config.Files {
+		config.FilePaths = args
 	}
 
-	ConfigureLogging(c.Name(), config.LogLevel)
+	if config.NoColor {
+		color.NoColor = true
+	}
 
-	if config.ProviderName == "" && config.ProviderSource != "" {
-		providerSourceParts := strings.Split(config.ProviderSource, "/")
-		config.ProviderName = providerSourceParts[len(providerSourceParts)-1]
+	if config.Quiet && config.Verbose {
+		c.Ui.Error("Error configuring checks: only one of -quiet or -verbose can be given")
+		return 1
 	}
 
-	if config.ProviderName == "" {
-		if config.Path == "" {
-			config.ProviderName = providerNameFromCurrentDirectory()
-		} else {
-			config.ProviderName = providerNameFromPath(config.Path)
-		}
+	if !isFlagSet(flags, "log-level") {
+		config.LogLevel = outputLogLevel(config.Quiet, config.Verbose)
 	}
 
-	if config.ProviderName == "" {
-		log.Printf("[WARN] Unable to determine provider name. Contents and enhanced validations may fail.")
-	} else {
-		log.Printf("[DEBUG] Found provider name: %s", config.ProviderName)
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
 	}
 
-	directories, err := check.GetDirectories(config.Path)
+	if config.CompareRegistryVersion != "" && config.Watch {
+		c.Ui.Error("Error configuring checks: only one of -compare-registry-version or -watch can be given")
+		return 1
+	}
 
-	if err != nil {
-		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+	if config.Files && (config.CompareRegistryVersion != "" || config.Watch) {
+		c.Ui.Error("Error configuring checks: -files cannot be given with -compare-registry-version or -watch")
 		return 1
 	}
 
-	if len(directories) == 0 {
-		if config.Path == "" {
-			c.Ui.Error("No Terraform Provider documentation directories found in current path")
-		} else {
-			c.Ui.Error(fmt.Sprintf("No Terraform Provider documentation directories found in path: %s", config.Path))
-		}
+	var err error
 
+	config.Format, config.Outputs, err = checkOutputs(outputs)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: %s", err))
 		return 1
 	}
 
-	var allowedGuideSubcategories []string
-	if v := config.AllowedGuideSubcategories; v != "" {
-		allowedGuideSubcategories = strings.Split(v, ",")
+	if config.Format != OutputFormatText && (config.CompareRegistryVersion != "" || config.ShowTimings || config.Watch) {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: -format %s cannot be given with -compare-registry-version, -show-timings, or -watch", config.Format))
+		return 1
 	}
 
-	if v := config.AllowedGuideSubcategoriesFile; v != "" {
-		var err error
-		allowedGuideSubcategories, err = allowedSubcategoriesFile(v)
+	if len(config.Outputs) > 0 && (config.CompareRegistryVersion != "" || config.Watch) {
+		c.Ui.Error("Error configuring checks: -out cannot be given with -compare-registry-version or -watch")
+		return 1
+	}
 
-		if err != nil {
-			c.Ui.Error(fmt.Sprintf("Error getting allowed guide subcategories: %s", err))
+	if config.MissingAttributesReport != "" {
+		if config.Files || config.CompareRegistryVersion != "" || config.Watch {
+			c.Ui.Error("Error configuring checks: -missing-attributes-report cannot be given with -files, -compare-registry-version, or -watch")
+			return 1
+		}
+
+		if _, err := reportFormat(config.MissingAttributesReport); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error configuring checks: %s", err))
 			return 1
 		}
 	}
 
-	var allowedResourceSubcategories []string
-	if v := config.AllowedResourceSubcategories; v != "" {
-		allowedResourceSubcategories = strings.Split(v, ",")
+	if config.FindingsExitCode < 0 || config.FindingsExitCode > 255 {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: invalid -findings-exit-code (%d), must be between 0 and 255", config.FindingsExitCode))
+		return 1
 	}
 
-	if v := config.AllowedResourceSubcategoriesFile; v != "" {
-		var err error
-		allowedResourceSubcategories, err = allowedSubcategoriesFile(v)
+	if config.Files && len(config.Paths) > 0 {
+		c.Ui.Error("Error configuring checks: -files cannot be given with -path")
+		return 1
+	}
 
-		if err != nil {
-			c.Ui.Error(fmt.Sprintf("Error getting allowed resource subcategories: %s", err))
-			return 1
-		}
+	paths := config.Paths
+
+	if !config.Files {
+		paths = append(paths, args...)
 	}
 
-	var ignoreFileMismatchDataSources []string
-	if v := config.IgnoreFileMismatchDataSources; v != "" {
-		ignoreFileMismatchDataSources = strings.Split(v, ",")
+	if config.Timeout < 0 {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: invalid -timeout (%s), must be positive", config.Timeout))
+		return 1
 	}
 
-	var ignoreFileMismatchResources []string
-	if v := config.IgnoreFileMismatchResources; v != "" {
-		ignoreFileMismatchResources = strings.Split(v, ",")
+	if config.Timeout > 0 && config.Watch {
+		c.Ui.Error("Error configuring checks: -timeout cannot be given with -watch")
+		return 1
 	}
 
-	var ignoreFileMissingDataSources []string
-	if v := config.IgnoreFileMissingDataSources; v != "" {
-		ignoreFileMissingDataSources = strings.Split(v, ",")
+	ctx := context.Background()
+
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
 	}
 
-	var ignoreFileMissingResources []string
-	if v := config.IgnoreFileMissingResources; v != "" {
-		ignoreFileMissingResources = strings.Split(v, ",")
+	if config.GitRef != "" && config.GitURL == "" {
+		c.Ui.Error("Error configuring checks: -git-ref requires -git-url")
+		return 1
 	}
 
-	var schemaDataSources, schemaResources map[string]*tfjson.Schema
-	if config.ProvidersSchemaJson != "" {
-		ps, err := providerSchemas(config.ProvidersSchemaJson)
+	if config.GitURL != "" {
+		if len(paths) > 0 || config.Files || config.Recursive || config.Watch {
+			c.Ui.Error("Error configuring checks: -git-url cannot be given with paths, -files, -recursive, or -watch")
+			return 1
+		}
+
+		path, cleanup, err := cloneGitRepository(ctx, config.GitURL, config.GitRef)
 
 		if err != nil {
-			c.Ui.Error(fmt.Sprintf("Error enabling Terraform Provider schema checks: %s", err))
+			c.Ui.Error(fmt.Sprintf("Error cloning git repository (%s): %s", config.GitURL, err))
 			return 1
 		}
 
-		if config.ProviderName == "" {
-			msg := `Unknown provider name for enabling Terraform Provider schema checks.
+		defer func() {
+			if err := cleanup(); err != nil {
+				hclog.L().Warn("Unable to remove cloned git repository", "path", path, "error", err)
+			}
+		}()
+
+		if config.ProviderName == "" && config.ProviderSource == "" {
+			config.ProviderName = gitProviderName(config.GitURL)
+		}
+
+		hclog.L().Debug("Cloned git repository", "url", config.GitURL, "ref", config.GitRef, "path", path)
+		paths = []string{path}
+	}
 
-Check that the current working directory or provided path is prefixed with terraform-provider-*.`
-			c.Ui.Error(msg)
+	if config.Recursive {
+		if config.Files || config.ProviderName != "" || config.ProviderSource != "" {
+			c.Ui.Error("Error configuring checks: -recursive cannot be given with -files, -provider-name, or -provider-source")
 			return 1
 		}
 
-		schemaDataSources = providerSchemasDataSources(ps, config.ProviderName, config.ProviderSource)
-		schemaResources = providerSchemasResources(ps, config.ProviderName, config.ProviderSource)
+		var err error
+
+		paths, err = providerPaths(paths)
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error finding Terraform Provider codebases: %s", err))
+			return 1
+		}
 	}
 
-	fileOpts := &check.FileOptions{
-		BasePath: config.Path,
+	if config.Watch && len(paths) == 1 && isArchive(paths[0]) {
+		c.Ui.Error("Error configuring checks: archive paths cannot be given with -watch")
+		return 1
 	}
-	checkOpts := &check.CheckOptions{
-		DataSourceFileMismatch: &check.FileMismatchOptions{
-			IgnoreFileMismatch: ignoreFileMismatchDataSources,
-			IgnoreFileMissing:  ignoreFileMissingDataSources,
-			ProviderName:       config.ProviderName,
-			ResourceType:       check.ResourceTypeDataSource,
-			Schemas:            schemaDataSources,
-		},
-		LegacyDataSourceFile: &check.LegacyDataSourceFileOptions{
-			FileOptions: fileOpts,
-			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
-			},
-		},
-		LegacyGuideFile: &check.LegacyGuideFileOptions{
-			FileOptions: fileOpts,
-			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedGuideSubcategories,
-				RequireSubcategory:   config.RequireGuideSubcategory,
-			},
-		},
-		LegacyIndexFile: &check.LegacyIndexFileOptions{
-			FileOptions: fileOpts,
-		},
-		LegacyResourceFile: &check.LegacyResourceFileOptions{
-			Contents: &check.ContentsOptions{
-				Enable:                config.EnableContentsCheck,
-				RequireSchemaOrdering: config.RequireSchemaOrdering,
-			},
-			FileOptions: fileOpts,
-			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
-			},
-			ProviderName: config.ProviderName,
-		},
-		ProviderName:   config.ProviderName,
-		ProviderSource: config.ProviderSource,
-		RegistryDataSourceFile: &check.RegistryDataSourceFileOptions{
-			FileOptions: fileOpts,
-			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
-			},
-		},
-		RegistryGuideFile: &check.RegistryGuideFileOptions{
-			FileOptions: fileOpts,
-			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedGuideSubcategories,
-				RequireSubcategory:   config.RequireGuideSubcategory,
-			},
-		},
-		RegistryIndexFile: &check.RegistryIndexFileOptions{
-			FileOptions: fileOpts,
-		},
-		RegistryResourceFile: &check.RegistryResourceFileOptions{
-			Contents: &check.ContentsOptions{
-				Enable:                config.EnableContentsCheck,
-				RequireSchemaOrdering: config.RequireSchemaOrdering,
-			},
-			FileOptions: fileOpts,
-			FrontMatter: &check.FrontMatterOptions{
-				AllowedSubcategories: allowedResourceSubcategories,
-				RequireSubcategory:   config.RequireResourceSubcategory,
-			},
-			ProviderName: config.ProviderName,
-		},
-		ResourceFileMismatch: &check.FileMismatchOptions{
-			IgnoreFileMismatch: ignoreFileMismatchResources,
-			IgnoreFileMissing:  ignoreFileMissingResources,
-			ProviderName:       config.ProviderName,
-			ResourceType:       check.ResourceTypeResource,
-			Schemas:            schemaResources,
-		},
-		IgnoreCdktfMissingFiles: config.IgnoreCdktfMissingFiles,
+
+	if len(paths) > 1 && (config.CompareRegistryVersion != "" || config.Watch) {
+		c.Ui.Error("Error configuring checks: multiple paths cannot be given with -compare-registry-version or -watch")
+		return 1
 	}
 
-	if err := check.NewCheck(checkOpts).Run(directories); err != nil {
-		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation: %s", err))
+	if len(paths) > 1 && config.Format != OutputFormatText {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: multiple paths cannot be given with -format %s", config.Format))
 		return 1
 	}
 
-	return 0
-}
+	if len(paths) > 1 && len(config.Outputs) > 0 {
+		c.Ui.Error("Error configuring checks: multiple paths cannot be given with -out")
+		return 1
+	}
 
-func (c *CheckCommand) Synopsis() string {
-	return "Checks Terraform Provider documentation"
-}
+	if len(paths) > 1 && config.MissingAttributesReport != "" {
+		c.Ui.Error("Error configuring checks: multiple paths cannot be given with -missing-attributes-report")
+		return 1
+	}
 
-func allowedSubcategoriesFile(path string) ([]string, error) {
-	log.Printf("[DEBUG] Loading allowed subcategories file: %s", path)
+	if len(paths) > 1 && config.ProvidersSchemaJson == ProvidersSchemaJsonStdin {
+		c.Ui.Error("Error configuring checks: multiple paths cannot be given with -providers-schema-json reading standard input")
+		return 1
+	}
 
-	file, err := os.Open(path)
+	stopProfiling, err := startProfiling(config.CPUProfile, config.MemProfile)
 
 	if err != nil {
-		return nil, fmt.Errorf("error opening allowed subcategories file (%s): %w", path, err)
+		c.Ui.Error(fmt.Sprintf("Error configuring profiling: %s", err))
+		return 1
 	}
 
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
-	var allowedSubcategories []string
+	defer func() {
+		if err := stopProfiling(); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing profile: %s", err))
+		}
+	}()
 
-	for scanner.Scan() {
-		allowedSubcategories = append(allowedSubcategories, scanner.Text())
-	}
+	if len(paths) <= 1 {
+		if len(paths) == 1 {
+			config.Path = paths[0]
+		}
 
-	if err != nil {
-		return nil, fmt.Errorf("error reading allowed subcategories file (%s): %w", path, err)
+		return c.runPath(ctx, config)
 	}
 
-	return allowedSubcategories, nil
-}
-
-func providerNameFromCurrentDirectory() string {
-	path, _ := os.Getwd()
-
-	return providerNameFromPath(path)
-}
+	var result int
 
-func providerNameFromPath(path string) string {
-	base := filepath.Base(path)
+	for i, path := range paths {
+		pathConfig := config
+		pathConfig.Path = path
 
-	if strings.ContainsAny(base, "./") {
-		return ""
-	}
+		if i > 0 {
+			c.Ui.Output("")
+		}
 
-	if !strings.HasPrefix(base, "terraform-provider-") {
-		return ""
+		c.Ui.Output(fmt.Sprintf("Checking path: %s", path))
+		result = aggregateExitCode(result, c.runPath(ctx, pathConfig))
 	}
 
-	return strings.TrimPrefix(base, "terraform-provider-")
+	return result
 }
 
-// providerSchemas reads, parses, and validates a provided terraform provider schema -json path.
-func providerSchemas(path string) (*tfjson.ProviderSchemas, error) {
-	log.Printf("[DEBUG] Loading providers schema JSON file: %s", path)
+// runPath runs the checks of the Terraform Provider codebase path in the
+// configuration and returns the exit code. Archive paths are extracted into
+// a temporary directory first. With -strict, the findings exit code is
+// returned if warnings were logged without findings or errors. Once the
+// context is done, the remaining checks are skipped.
+func (c *CheckCommand) runPath(ctx context.Context, config CheckCommandConfig) (exitCode int) {
+	if !config.Files && isArchive(config.Path) {
+		path, cleanup, err := extractArchive(config.Path)
 
-	content, err := os.ReadFile(path)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error extracting Terraform Provider archive (%s): %s", config.Path, err))
+			return 1
+		}
 
-	if err != nil {
-		return nil, fmt.Errorf("error reading providers schema JSON file (%s): %w", path, err)
-	}
+		defer func() {
+			if err := cleanup(); err != nil {
+				hclog.L().Warn("Unable to remove extracted archive", "path", path, "error", err)
+			}
+		}()
 
-	var ps tfjson.ProviderSchemas
+		if config.ProviderName == "" && config.ProviderSource == "" {
+			config.ProviderName = archiveProviderName(config.Path)
+		}
 
-	if err := json.Unmarshal(content, &ps); err != nil {
-		return nil, fmt.Errorf("error parsing providers schema JSON file (%s): %w", path, err)
+		hclog.L().Debug("Extracted Terraform Provider archive", "archive", config.Path, "path", path)
+		config.Path = path
 	}
 
-	if err := ps.Validate(); err != nil {
-		return nil, fmt.Errorf("error validating providers schema JSON file (%s): %w", path, err)
+	if config.Strict {
+		warnings := countWarnings()
+
+		defer func() {
+			if count := warnings(); count > 0 && exitCode == 0 {
+				c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation: %d warning(s) logged with -strict", count))
+				exitCode = config.FindingsExitCode
+			}
+		}()
 	}
 
-	return &ps, nil
-}
+	// Progress updates are only written to terminals and would interleave
+	// with the per-file logging of verbose mode or watch mode output.
+	var progress *progressReporter
 
-// providerSchemasDataSources returns all data sources from a terraform providers schema -json provider.
-func providerSchemasDataSources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.Schema {
-	if ps == nil || ps.Schemas == nil {
-		return nil
+	if !config.Quiet && !config.Verbose && !config.Watch && isTerminal(os.Stderr) {
+		progress = newProgressReporter(os.Stderr, 0)
+		config.Progress = progress.Progress
 	}
 
-	provider, ok := ps.Schemas[providerSource]
-
-	if !ok {
-		provider, ok = ps.Schemas[providerName]
+	if config.ShowTimings {
+		config.Timings = check.NewTimings()
 	}
 
-	if !ok {
-		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
-		return nil
+	if config.MissingAttributesReport != "" {
+		config.MissingAttributes = check.NewMissingAttributes()
 	}
 
-	dataSources := make([]string, 0, len(provider.DataSourceSchemas))
+	checkOpts, err := config.CheckOptions()
 
-	for name := range provider.DataSourceSchemas {
-		dataSources = append(dataSources, name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: %s", err))
+		return 1
 	}
 
-	sort.Strings(dataSources)
-
-	log.Printf("[DEBUG] Found provider schema data sources: %v", dataSources)
+	configureProviderLogging(config.ProviderName)
 
-	return provider.DataSourceSchemas
-}
+	directories, err := config.Directories()
 
-// providerSchemasResources returns all resources from a terraform providers schema -json provider.
-func providerSchemasResources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.Schema {
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	if len(directories) == 0 {
+		if config.Path == "" {
+			c.Ui.Error("No Terraform Provider documentation directories found in current path")
+		} else {
+			c.Ui.Error(fmt.Sprintf("No Terraform Provider documentation directories found in path: %s", config.Path))
+		}
+
+		return 1
+	}
+
+	if config.CompareRegistryVersion != "" {
+		return c.compareRegistryVersion(ctx, config, checkOpts, directories)
+	}
+
+	if config.Watch {
+		return c.watch(config, checkOpts)
+	}
+
+	if config.Files {
+		directories = fileListDirectories(directories, config.FilePaths)
+	}
+
+	if progress != nil {
+		progress.total = len(checkedFiles(config, checkOpts, directories))
+	}
+
+	startTime := time.Now()
+	docsCheck := check.NewCheck(checkOpts)
+
+	if config.Files {
+		err = docsCheck.RunFilesContext(ctx, directories)
+	} else {
+		err = docsCheck.RunContext(ctx, directories)
+	}
+
+	if progress != nil {
+		progress.Done()
+	}
+	findings, errs := check.Findings(err)
+
+	for _, output := range config.Outputs {
+		report, err := checkReport(output.Format, config, checkOpts, directories, docsCheck.Summary, findings, errs, time.Since(startTime))
+
+		if err == nil {
+			err = writeReportFile(output.Path, report)
+		}
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing %s report: %s", output.Format, err))
+			return 1
+		}
+	}
+
+	switch config.Format {
+	case OutputFormatCodeClimate:
+		return c.outputCodeClimate(config, findings, errs)
+	case OutputFormatShields:
+		return c.outputShields(config, docsCheck.Summary, findings, errs)
+	case OutputFormatTAP:
+		return c.outputTAP(config, checkedFiles(config, checkOpts, directories), findings, errs)
+	}
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation:\n\n%s\n", newFindingsFormatter(!color.NoColor).Format(findings, errs)))
+	}
+
+	if config.Quiet {
+		c.Ui.Output(checkSummaryLine(docsCheck.Summary, findings, time.Since(startTime)))
+	} else {
+		c.Ui.Output(checkSummary(docsCheck.Summary, findings, time.Since(startTime)))
+	}
+
+	if config.Timings != nil {
+		c.Ui.Output(fmt.Sprintf("\n%s", checkTimings(config.Timings, DefaultTimingsLimit)))
+	}
+
+	if config.MissingAttributes != nil {
+		if err := writeMissingAttributesReport(config.MissingAttributesReport, config.MissingAttributes.Sorted()); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing missing attributes report: %s", err))
+			return 1
+		}
+	}
+
+	if err != nil {
+		if len(errs) == 0 {
+			return config.FindingsExitCode
+		}
+
+		return 1
+	}
+
+	return 0
+}
+
+// outputCodeClimate outputs the findings as a Code Climate JSON issue array,
+// writes the missing attributes report if enabled, and returns the exit code.
+// Errors which prevented checking are output separately from the report.
+func (c *CheckCommand) outputCodeClimate(config CheckCommandConfig, findings []*check.Finding, errs []error) int {
+	report, err := codeClimateReport(findings, config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error encoding JSON: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(string(report))
+
+	if len(errs) > 0 {
+		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation:\n\n%s\n", newFindingsFormatter(!color.NoColor).Format(nil, errs)))
+	}
+
+	if config.MissingAttributes != nil {
+		if err := writeMissingAttributesReport(config.MissingAttributesReport, config.MissingAttributes.Sorted()); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing missing attributes report: %s", err))
+			return 1
+		}
+	}
+
+	if len(errs) > 0 {
+		return 1
+	}
+
+	if len(findings) > 0 {
+		return config.FindingsExitCode
+	}
+
+	return 0
+}
+
+// outputShields outputs a shields.io endpoint badge JSON object of the check
+// run, writes the missing attributes report if enabled, and returns the exit
+// code. Errors which prevented checking are output separately from the badge.
+func (c *CheckCommand) outputShields(config CheckCommandConfig, summary *check.CheckSummary, findings []*check.Finding, errs []error) int {
+	report, err := shieldsReport(summary, findings, errs)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error encoding JSON: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(string(report))
+
+	if len(errs) > 0 {
+		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation:\n\n%s\n", newFindingsFormatter(!color.NoColor).Format(nil, errs)))
+	}
+
+	if config.MissingAttributes != nil {
+		if err := writeMissingAttributesReport(config.MissingAttributesReport, config.MissingAttributes.Sorted()); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing missing attributes report: %s", err))
+			return 1
+		}
+	}
+
+	if len(errs) > 0 {
+		return 1
+	}
+
+	if len(findings) > 0 {
+		return config.FindingsExitCode
+	}
+
+	return 0
+}
+
+// outputTAP outputs the findings as a TAP stream of the checked files, writes
+// the missing attributes report if enabled, and returns the exit code. Errors
+// which prevented checking end the stream with a bail out.
+func (c *CheckCommand) outputTAP(config CheckCommandConfig, files []string, findings []*check.Finding, errs []error) int {
+	report, err := tapReport(files, findings)
+
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	if len(errs) > 0 {
+		report += "\nBail out! Error checking Terraform Provider documentation"
+	}
+
+	c.Ui.Output(report)
+
+	if len(errs) > 0 {
+		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation:\n\n%s\n", newFindingsFormatter(!color.NoColor).Format(nil, errs)))
+	}
+
+	if config.MissingAttributes != nil {
+		if err := writeMissingAttributesReport(config.MissingAttributesReport, config.MissingAttributes.Sorted()); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error writing missing attributes report: %s", err))
+			return 1
+		}
+	}
+
+	if len(errs) > 0 {
+		return 1
+	}
+
+	if len(findings) > 0 {
+		return config.FindingsExitCode
+	}
+
+	return 0
+}
+
+// checkReport returns the findings report in the output format, for writing
+// to an -out file. Text reports contain the findings and errors without color
+// and the summary.
+func checkReport(format string, config CheckCommandConfig, checkOpts *check.CheckOptions, directories map[string][]string, summary *check.CheckSummary, findings []*check.Finding, errs []error, elapsed time.Duration) (string, error) {
+	switch format {
+	case OutputFormatCodeClimate:
+		report, err := codeClimateReport(findings, config.Path)
+
+		return string(report), err
+	case OutputFormatShields:
+		report, err := shieldsReport(summary, findings, errs)
+
+		return string(report), err
+	case OutputFormatTAP:
+		report, err := tapReport(checkedFiles(config, checkOpts, directories), findings)
+
+		if err == nil && len(errs) > 0 {
+			report += "\nBail out! Error checking Terraform Provider documentation"
+		}
+
+		return report, err
+	}
+
+	report := checkSummary(summary, findings, elapsed)
+
+	if len(findings) > 0 || len(errs) > 0 {
+		report = fmt.Sprintf("%s\n\n%s", newFindingsFormatter(false).Format(findings, errs), report)
+	}
+
+	return report, nil
+}
+
+// checkedFiles returns the documentation files, and template files if
+// enabled, which are checked in the directories.
+func checkedFiles(config CheckCommandConfig, checkOpts *check.CheckOptions, directories map[string][]string) []string {
+	var result []string
+
+	for _, files := range check.RemoveOtherFiles(directories, checkOpts.Directories) {
+		result = append(result, files...)
+	}
+
+	if checkOpts.CheckEnabled(check.CheckIDTemplate) && !config.Files {
+		if files, err := check.GetTemplateFiles(config.Path); err == nil {
+			result = append(result, files...)
+		}
+	}
+
+	return result
+}
+
+func (c *CheckCommand) Synopsis() string {
+	return "Checks Terraform Provider documentation"
+}
+
+// CheckFlags adds the check configuration flags to the flag set.
+func CheckFlags(flags *flag.FlagSet, config *CheckCommandConfig) {
+	flags.BoolVar(&config.AllowCRLFLineEndings, "allow-crlf-line-endings", false, "")
+	flags.StringVar(&config.AllowedGuideSubcategories, "allowed-guide-subcategories", "", "")
+	flags.StringVar(&config.AllowedGuideSubcategoriesFile, "allowed-guide-subcategories-file", "", "")
+	flags.StringVar(&config.AllowedHTMLTags, "allowed-html-tags", "", "")
+	flags.StringVar(&config.AllowedResourceSubcategories, "allowed-resource-subcategories", "", "")
+	flags.StringVar(&config.AllowedResourceSubcategoriesFile, "allowed-resource-subcategories-file", "", "")
+	flags.StringVar(&config.CompareRegistryVersion, "compare-registry-version", "", "")
+	flags.StringVar(&config.ConfigFile, "config", "", "")
+	flags.StringVar(&config.DisableChecks, "disable-checks", "", "")
+	flags.StringVar(&config.DocsDirs, "docs-dir", "", "")
+	flags.StringVar(&config.EnableChecks, "enable-checks", "", "")
+	flags.BoolVar(&config.EnableContentsCheck, "enable-contents-check", false, "")
+	flags.BoolVar(&config.EnableCrossReferencesCheck, "enable-cross-references-check", false, "")
+	flags.BoolVar(&config.EnableExampleSecretsCheck, "enable-example-secrets-check", false, "")
+	flags.BoolVar(&config.EnableFileNameCheck, "enable-file-name-check", false, "")
+	flags.BoolVar(&config.EnableFormatVerbsCheck, "enable-format-verbs-check", false, "")
+	flags.BoolVar(&config.EnableFrontMatterPageTitleCheck, "enable-frontmatter-page-title-check", false, "")
+	flags.BoolVar(&config.EnableGuideFilenamesCheck, "enable-guide-filenames-check", false, "")
+	flags.BoolVar(&config.EnableGuideLinksCheck, "enable-guide-links-check", false, "")
+	flags.BoolVar(&config.EnableLegacyNavigationCheck, "enable-legacy-navigation-check", false, "")
+	flags.BoolVar(&config.EnableNestedSchemaLinksCheck, "enable-nested-schema-links-check", false, "")
+	flags.BoolVar(&config.EnablePairedDocumentationCheck, "enable-paired-documentation-check", false, "")
+	flags.BoolVar(&config.EnableRawHTMLCheck, "enable-raw-html-check", false, "")
+	flags.BoolVar(&config.EnableSubcategoryConsistencyCheck, "enable-subcategory-consistency-check", false, "")
+	flags.BoolVar(&config.EnableTemplateCheck, "enable-template-check", false, "")
+	flags.Var((*stringSliceFlag)(&config.Exclude), "exclude", "")
+	flags.BoolVar(&config.EnableTitleHeadingCheck, "enable-title-heading-check", false, "")
+	flags.StringVar(&config.ForbidFrontMatterKeys, "forbid-frontmatter-keys", "", "")
+	flags.StringVar(&config.ForbiddenWordsFile, "forbidden-words-file", "", "")
+	flags.StringVar(&config.FrontMatterSchema, "frontmatter-schema", "", "")
+	flags.StringVar(&config.GuideFilenameSeparator, "guide-filename-separator", "", "")
+	flags.BoolVar(&config.IgnoreCdktfMissingFiles, "ignore-cdktf-missing-files", false, "")
+	flags.StringVar(&config.IgnoreExampleDataSources, "ignore-example-data-sources", "", "")
+	flags.StringVar(&config.IgnoreExampleDataSourcesFile, "ignore-example-data-sources-file", "", "")
+	flags.StringVar(&config.IgnoreExampleResources, "ignore-example-resources", "", "")
+	flags.StringVar(&config.IgnoreExampleResourcesFile, "ignore-example-resources-file", "", "")
+	flags.StringVar(&config.IgnoreFileMismatchDataSources, "ignore-file-mismatch-data-sources", "", "")
+	flags.StringVar(&config.IgnoreFileMismatchDataSourcesFile, "ignore-file-mismatch-data-sources-file", "", "")
+	flags.StringVar(&config.IgnoreFileMismatchResources, "ignore-file-mismatch-resources", "", "")
+	flags.StringVar(&config.IgnoreFileMismatchResourcesFile, "ignore-file-mismatch-resources-file", "", "")
+	flags.StringVar(&config.IgnoreFileMissingDataSources, "ignore-file-missing-data-sources", "", "")
+	flags.StringVar(&config.IgnoreFileMissingDataSourcesFile, "ignore-file-missing-data-sources-file", "", "")
+	flags.StringVar(&config.IgnoreFileMissingResources, "ignore-file-missing-resources", "", "")
+	flags.StringVar(&config.IgnoreFileMissingResourcesFile, "ignore-file-missing-resources-file", "", "")
+	flags.StringVar(&config.IgnoreLegacyNavigation, "ignore-legacy-navigation", "", "")
+	flags.IntVar(&config.MaximumFiles, "maximum-files", check.RegistryMaximumNumberOfFiles, "")
+	flags.IntVar(&config.MaximumGuides, "maximum-guides", check.RegistryMaximumNumberOfGuides, "")
+	flags.IntVar(&config.MaximumLineLength, "maximum-line-length", 0, "")
+	flags.IntVar(&config.MaximumSubcategories, "maximum-subcategories", 0, "")
+	flags.IntVar(&config.MaximumSubcategoryEntries, "maximum-subcategory-entries", 0, "")
+	flags.StringVar(&config.NewDocumentationRef, "new-documentation-ref", "", "")
+	flags.BoolVar(&config.NormalizeSubcategories, "normalize-subcategories", false, "")
+	flags.StringVar(&config.Profile, "profile", "", "")
+	flags.StringVar(&config.ProviderBinary, "provider-binary", "", "")
+	flags.StringVar(&config.ProviderName, "provider-name", "", "")
+	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
+	flags.StringVar(&config.ProviderVersion, "provider-version", "", "")
+	flags.StringVar(&config.ProvidersSchemaJson, "providers-schema-json", "", "")
+	flags.BoolVar(&config.RequireCalloutPrefix, "require-callout-prefix", false, "")
+	flags.BoolVar(&config.RequireDeprecationReplacement, "require-deprecation-replacement", false, "")
+	flags.BoolVar(&config.RequireExampleUsage, "require-example-usage", false, "")
+	flags.BoolVar(&config.RequireExamples, "require-examples", false, "")
+	flags.StringVar(&config.RequireFileExtension, "require-file-extension", "", "")
+	flags.StringVar(&config.RequireFrontMatterKeys, "require-frontmatter-keys", "", "")
+	flags.BoolVar(&config.RequireGuideSubcategory, "require-guide-subcategory", false, "")
+	flags.BoolVar(&config.RequireIdentity, "require-identity", false, "")
+	flags.BoolVar(&config.RequireImportBlock, "require-import-block", false, "")
+	flags.StringVar(&config.RequireIndexSections, "require-index-sections", "", "")
+	flags.BoolVar(&config.RequireLFLineEndings, "require-lf-line-endings", false, "")
+	flags.BoolVar(&config.RequireLegacyStructure, "require-legacy-structure", false, "")
+	flags.BoolVar(&config.RequireLinkedGuides, "require-linked-guides", false, "")
+	flags.BoolVar(&config.RequireNewDocumentation, "require-new-documentation", false, "")
+	flags.BoolVar(&config.RequirePairedLinks, "require-paired-links", false, "")
+	flags.BoolVar(&config.RequireRegistryManifest, "require-registry-manifest", false, "")
+	flags.BoolVar(&config.RequireRegistryStructure, "require-registry-structure", false, "")
+	flags.BoolVar(&config.RequireResourceSubcategory, "require-resource-subcategory", false, "")
+	flags.BoolVar(&config.RequireSchemaDescriptions, "require-schema-descriptions", false, "")
+	flags.BoolVar(&config.RequireSchemaOrdering, "require-schema-ordering", false, "")
+	flags.BoolVar(&config.RequireSelfContainedExamples, "require-self-contained-examples", false, "")
+	flags.StringVar(&config.SchemaOrderingStyle, "schema-ordering-style", contents.SchemaOrderingStyleAlphabetical, "")
+	flags.StringVar(&config.SourceRepository, "source-repository", "", "")
+	flags.StringVar(&config.SubcategoryMappingFile, "subcategory-mapping-file", "", "")
+	flags.IntVar(&config.WarnFiles, "warn-files", check.RegistryWarningNumberOfFiles, "")
+	flags.BoolVar(&config.WarnLocalImages, "warn-local-images", false, "")
+	flags.BoolVar(&config.WarnSingleEntrySubcategories, "warn-single-entry-subcategories", false, "")
+	flags.IntVar(&config.WarnSubcategories, "warn-subcategories", 0, "")
+}
+
+// CheckFlagsHelp adds the check configuration flags help to the writer.
+func CheckFlagsHelp(opts *tabwriter.Writer) {
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allow-crlf-line-endings", "Allow files to consistently use CRLF line endings instead of LF. Implies -require-lf-line-endings.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-guide-subcategories", "Comma separated list of allowed guide frontmatter subcategories.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-guide-subcategories-file", "Path to newline separated file of allowed guide frontmatter subcategories.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-html-tags", "Comma separated list of raw HTML tags to allow in addition to the tags rendered by the Terraform Registry. Implies -enable-raw-html-check.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories", "Comma separated list of allowed data source and resource frontmatter subcategories.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-allowed-resource-subcategories-file", "Path to newline separated file of allowed data source and resource frontmatter subcategories.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-compare-registry-version", "Instead of checks, compare documentation against the given provider version published in the Terraform Registry (e.g. v5.40.0) and report added, removed, and subcategory changed pages. Requires -provider-source.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-config", fmt.Sprintf("Path to YAML configuration file. Defaults to %s in the Terraform Provider codebase path, if it exists.", DefaultConfigFile))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-disable-checks", "Comma separated list of check identifiers to skip. Run the checks command for available identifiers.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-docs-dir", "Comma separated list of documentation root directories, relative to the Terraform Provider codebase path, to check instead of the docs and website/docs directories (e.g. during a migration). Each directory is checked with the legacy directory structure if it contains d or r directories, otherwise with the Terraform Registry directory structure.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-checks", "Comma separated list of check identifiers to exclusively run. Run the checks command for available identifiers.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-contents-check", "(Experimental) Enable contents checking.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-cross-references-check", "Enable checking links to other documentation of the provider resolve to existing documentation.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-example-secrets-check", "Enable checking documentation code blocks do not contain obvious secrets.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-file-name-check", "Enable checking data source, list resource, and resource file names only contain lowercase letters, numbers, and underscores.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-format-verbs-check", "Enable checking documentation prose and YAML frontmatter do not contain Go format verbs (e.g. %s), which indicate documentation generation errors.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-frontmatter-page-title-check", "Enable checking action, data source, list resource, and resource YAML frontmatter page_title resource names match the file name.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-guide-filenames-check", "Enable checking guide file names are lowercase, contain no spaces, consistently separate words, and do not collide in Terraform Registry URLs.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-guide-links-check", "Enable checking links from the provider index and guide documentation to guides resolve to existing guide files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-legacy-navigation-check", "Enable checking legacy website navigation files link to existing documentation and link all legacy documentation.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-nested-schema-links-check", "Enable checking terraform-plugin-docs nested schema links have a matching anchor and Nested Schema for section.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-paired-documentation-check", "Enable checking resource and data source documentation of the same name use the same subcategory.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-raw-html-check", "Enable checking raw HTML only uses tags rendered by the Terraform Registry.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-subcategory-consistency-check", "Enable checking action, data source, list resource, resource, and guide YAML frontmatter subcategories do not differ from another subcategory only by case or whitespace.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-template-check", "Enable checking terraform-plugin-docs templates (templates/**/*.md.tmpl).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-enable-title-heading-check", "Enable checking action, data source, list resource, and resource title headings agree with the YAML frontmatter page_title resource name and documentation type.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-exclude", "Glob pattern of documentation files or directories, relative to the Terraform Provider codebase path, to exclude from all checks (e.g. 'docs/drafts/**'). Can be repeated.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-forbid-frontmatter-keys", "Comma separated list of YAML frontmatter keys forbidden in all documentation, e.g. layout,sidebar_current.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-forbidden-words-file", "Path to newline separated file of words or phrases, optionally followed by = and a replacement, which are reported in documentation prose. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-frontmatter-schema", "Path to JSON Schema file which the YAML frontmatter of all documentation files must satisfy, e.g. for organization specific keys and value formats.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-guide-filename-separator", fmt.Sprintf("Word separator required in guide file names (%s). Defaults to the separator used by most guides. Implies -enable-guide-filenames-check.", strings.Join(check.GuideFilenameSeparators, ", ")))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-cdktf-missing-files", "Ignore checks for missing CDK for Terraform documentation files when iteratively introducing them in large providers.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-example-data-sources", "Comma separated list of data sources to ignore -require-example-usage.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-example-data-sources-file", "Path to newline separated file of data sources to ignore -require-example-usage. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-example-resources", "Comma separated list of resources to ignore -require-example-usage.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-example-resources-file", "Path to newline separated file of resources to ignore -require-example-usage. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-data-sources", "Comma separated list of data sources to ignore mismatched/extra files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-data-sources-file", "Path to newline separated file of data sources to ignore mismatched/extra files. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-resources", "Comma separated list of resources to ignore mismatched/extra files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-mismatch-resources-file", "Path to newline separated file of resources to ignore mismatched/extra files. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-data-sources", "Comma separated list of data sources to ignore missing files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-data-sources-file", "Path to newline separated file of data sources to ignore missing files. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-resources", "Comma separated list of resources to ignore missing files.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-file-missing-resources-file", "Path to newline separated file of resources to ignore missing files. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-ignore-legacy-navigation", "Comma separated list of glob patterns of documentation files (e.g. website/docs/r/old_*) or legacy navigation links to ignore in the legacy-navigation check, such as during a migration. Also configurable with the ignore_legacy_navigation configuration file setting.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-maximum-files", fmt.Sprintf("Maximum number of documentation files, excluding CDK for Terraform documentation, for the Terraform Registry. Defaults to %d.", check.RegistryMaximumNumberOfFiles))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-maximum-guides", fmt.Sprintf("Maximum number of guides (docs/guides and website/docs/guides) for the Terraform Registry. Defaults to %d.", check.RegistryMaximumNumberOfGuides))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-maximum-line-length", "Maximum number of characters of prose lines in documentation, for providers wrapping Markdown. Code blocks, headings, HTML blocks, tables, and YAML frontmatter are exempt. Disabled by default.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-maximum-subcategories", "Maximum number of distinct action, data source, list resource, and resource documentation frontmatter subcategories, which are the categories of the Terraform Registry navigation sidebar. Disabled by default.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-maximum-subcategory-entries", "Maximum number of data source and resource documentation files per frontmatter subcategory, which are listed together in the Terraform Registry navigation sidebar.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-new-documentation-ref", "Git reference of the previous release (e.g. v1.2.3) for -require-new-documentation. Defaults to the most recent git tag reachable from, and not pointing at, the current commit. Implies -require-new-documentation.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-normalize-subcategories", "Match allowed frontmatter subcategories case insensitively and after trimming surrounding whitespace, logging a warning when normalization was necessary.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-profile", fmt.Sprintf("Predefined set of checks to run (%s). Other flags which enable checks, such as -enable-checks, extend the profile.", strings.Join(check.Profiles, ", ")))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-binary", "Path to Terraform Provider binary, which is launched to get its schema instead of -providers-schema-json. Enables enhanced validations without Terraform CLI.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws) for Terraform CLI 0.13 and later -providers-schema-json. Automatically sets -provider-name by dropping hostname and namespace prefix.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-version", "Terraform Provider version (e.g. 5.40.0) to download from the Terraform Registry for the current operating system and architecture, which is launched to get its schema instead of -providers-schema-json. Enables enhanced validations without a local provider build. Requires -provider-source.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-providers-schema-json", "Path to terraform providers schema -json file, which may be gzip or zstd compressed, or - to read standard input. Enables enhanced validations.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-callout-prefix", "Require callouts to start with **Note:** or, for !> warning callouts, **Warning:** (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-deprecation-replacement", "Require documentation of deprecated data sources and resources to link to the documentation of a replacement data source or resource, and provider index documentation of deprecated provider arguments to note a replacement (requires -providers-schema-json).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-example-usage", "Require data source and resource documentation to contain an Example Usage section with a terraform code block.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-examples", "Require terraform-plugin-docs example files (examples/) for data sources and resources and report orphaned example directories.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-file-extension", fmt.Sprintf("Require documentation files to use only the given file extension (%s) in directory structures which support it, e.g. .html.markdown for legacy directories.", strings.Join(check.ValidLegacyFileExtensions, ", ")))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-frontmatter-keys", "Comma separated list of YAML frontmatter keys required in all documentation with frontmatter, e.g. description,page_title. Use the required_frontmatter_keys configuration file setting for per documentation type keys.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-guide-subcategory", "Require guide frontmatter subcategory.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-identity", "Require resources with an identity schema to document identity attributes in an identity section (requires -enable-contents-check and -providers-schema-json).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-import-block", "Require import sections to contain an import block example (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-index-sections", "Comma separated list of section headings required in the provider index documentation, with alternatives separated by |, e.g. Example Usage,Authentication,Argument Reference|Schema. Also configurable with the required_index_sections configuration file setting.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-lf-line-endings", "Verify files use LF line endings.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-legacy-structure", "Require only legacy (website/docs) documentation directory structure.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-linked-guides", "Require each guide to be linked from the provider index documentation, either directly or through other linked guides. Implies -enable-guide-links-check.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-new-documentation", "Require data sources and resources in the providers schema which were not documented in the previous release (the previous git tag or -new-documentation-ref) to have a documentation file, e.g. when added since the previous release (requires -providers-schema-json and the git executable).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-paired-links", "Require resource and data source documentation of the same name to link to each other. Implies -enable-paired-documentation-check.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-registry-manifest", fmt.Sprintf("Require the Terraform Registry manifest (%s) in the Terraform Provider codebase, e.g. when the provider is published to the Terraform Registry.", check.RegistryManifestFile))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-registry-structure", "Require only Terraform Registry (docs) documentation directory structure.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-resource-subcategory", "Require data source and resource frontmatter subcategory.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-schema-descriptions", "Require documented attribute descriptions to be similar to the providers schema descriptions (requires -enable-contents-check and -providers-schema-json).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-schema-ordering", "Require schema attribute lists to be ordered (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-require-self-contained-examples", "Require resources, data sources, and variables referenced in example code blocks to be declared in the same or an adjacent code block (requires -enable-contents-check).")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-schema-ordering-style", fmt.Sprintf("Schema attribute list ordering style for -require-schema-ordering (%s). Defaults to %s.", strings.Join(contents.SchemaOrderingStyles, ", "), contents.SchemaOrderingStyleAlphabetical))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-source-repository", "GitHub repository of the Terraform Provider codebase (e.g. https://github.com/hashicorp/terraform-provider-aws). Enables verifying links to its source tree, such as examples, resolve to paths in the codebase.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-subcategory-mapping-file", "Path to newline separated file of data source and resource name patterns (e.g. aws_s3_*) followed by => and the expected frontmatter subcategory (e.g. \"S3\"), which is required for matching action, data source, list resource, and resource documentation. The first matching pattern applies. Lines starting with # are ignored.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-warn-files", fmt.Sprintf("Warn when the number of documentation files, excluding CDK for Terraform documentation, reaches this number before the -maximum-files limit. Defaults to %d.", check.RegistryWarningNumberOfFiles))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-warn-local-images", "Warn about local image references, which are not served by the Terraform Registry.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-warn-single-entry-subcategories", "Warn about frontmatter subcategories of a single data source or resource documentation file, which may be a typo of another subcategory.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-warn-subcategories", "Warn when the number of distinct action, data source, list resource, and resource documentation frontmatter subcategories reaches this number. Disabled by default.")
+}
+
+// Directories returns the documentation directories of the -docs-dir
+// documentation root directories, if given, otherwise of the conventional
+// documentation directories, without files matching -exclude patterns.
+func (config *CheckCommandConfig) Directories() (map[string][]string, error) {
+	opts := &check.DirectoryOptions{
+		Exclude:        config.Exclude,
+		FollowSymlinks: config.FollowSymlinks,
+	}
+
+	if config.DocsDirs == "" {
+		return check.GetDirectories(config.Path, opts)
+	}
+
+	return check.GetRootDirectories(config.Path, strings.Split(config.DocsDirs, ","), opts)
+}
+
+// CheckOptions returns the check options for the configuration, including
+// loading any configuration, subcategories, and providers schema files. The
+// provider name is automatically determined, if necessary.
+func (config *CheckCommandConfig) CheckOptions() (*check.CheckOptions, error) {
+	if config.RequireLegacyStructure && config.RequireRegistryStructure {
+		return nil, fmt.Errorf("only one of -require-legacy-structure or -require-registry-structure can be given")
+	}
+
+	if !isValidSchemaOrderingStyle(config.SchemaOrderingStyle) {
+		return nil, fmt.Errorf("invalid -schema-ordering-style (%s), valid styles: %s", config.SchemaOrderingStyle, strings.Join(contents.SchemaOrderingStyles, ", "))
+	}
+
+	if !isValidGuideFilenameSeparator(config.GuideFilenameSeparator) {
+		return nil, fmt.Errorf("invalid -guide-filename-separator (%s), valid separators: %s", config.GuideFilenameSeparator, strings.Join(check.GuideFilenameSeparators, ", "))
+	}
+
+	if !isValidFileExtension(config.RequireFileExtension) {
+		return nil, fmt.Errorf("invalid -require-file-extension (%s), valid extensions: %s", config.RequireFileExtension, strings.Join(check.ValidLegacyFileExtensions, ", "))
+	}
+
+	var requireDirectoryStructure string
+
+	if config.RequireLegacyStructure {
+		requireDirectoryStructure = check.DirectoryStructureLegacy
+	}
+
+	if config.RequireRegistryStructure {
+		requireDirectoryStructure = check.DirectoryStructureRegistry
+	}
+
+	var disableChecks []string
+	if v := config.DisableChecks; v != "" {
+		disableChecks = strings.Split(v, ",")
+	}
+
+	var explicitEnableChecks []string
+	if v := config.EnableChecks; v != "" {
+		explicitEnableChecks = strings.Split(v, ",")
+	}
+
+	var enableChecks []string
+	if v := config.Profile; v != "" {
+		profileChecks, err := check.ProfileChecks(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		enableChecks = append(enableChecks, profileChecks...)
+
+		if config.EnableContentsCheck {
+			enableChecks = append(enableChecks, check.CheckIDContents)
+		}
+
+		if config.EnableTitleHeadingCheck {
+			enableChecks = append(enableChecks, check.CheckIDTitleHeading)
+		}
+
+		if config.EnableFormatVerbsCheck {
+			enableChecks = append(enableChecks, check.CheckIDFormatVerbs)
+		}
+
+		if config.EnableGuideLinksCheck || config.RequireLinkedGuides {
+			enableChecks = append(enableChecks, check.CheckIDGuideLinks)
+		}
+
+		if config.EnableSubcategoryConsistencyCheck {
+			enableChecks = append(enableChecks, check.CheckIDSubcategoryConsistency)
+		}
+
+		if config.EnableFrontMatterPageTitleCheck {
+			enableChecks = append(enableChecks, check.CheckIDFrontMatterPageTitle)
+		}
+
+		if config.EnableFileNameCheck {
+			enableChecks = append(enableChecks, check.CheckIDFileName)
+		}
+
+		if config.EnableGuideFilenamesCheck || config.GuideFilenameSeparator != "" {
+			enableChecks = append(enableChecks, check.CheckIDGuideFilenames)
+		}
+
+		if config.EnableNestedSchemaLinksCheck {
+			enableChecks = append(enableChecks, check.CheckIDNestedSchemaLinks)
+		}
+
+		if config.EnableLegacyNavigationCheck {
+			enableChecks = append(enableChecks, check.CheckIDLegacyNavigation)
+		}
+
+		if config.EnableRawHTMLCheck || config.AllowedHTMLTags != "" {
+			enableChecks = append(enableChecks, check.CheckIDRawHTML)
+		}
+
+		if config.EnablePairedDocumentationCheck || config.RequirePairedLinks {
+			enableChecks = append(enableChecks, check.CheckIDPairedDocumentation)
+		}
+
+		if config.EnableExampleSecretsCheck {
+			enableChecks = append(enableChecks, check.CheckIDExampleSecrets)
+		}
+
+		if config.EnableCrossReferencesCheck {
+			enableChecks = append(enableChecks, check.CheckIDCrossReferences)
+		}
+
+		if config.EnableTemplateCheck {
+			enableChecks = append(enableChecks, check.CheckIDTemplate)
+		}
+
+		if config.RequireExampleUsage {
+			enableChecks = append(enableChecks, check.CheckIDExampleUsage)
+		}
+
+		if config.RequireExamples {
+			enableChecks = append(enableChecks, check.CheckIDExamples)
+		}
+
+		if config.RequireIdentity {
+			enableChecks = append(enableChecks, check.CheckIDContentsIdentity)
+		}
+
+		if config.RequireImportBlock {
+			enableChecks = append(enableChecks, check.CheckIDContentsImportBlock)
+		}
+
+		if config.RequireLFLineEndings || config.AllowCRLFLineEndings {
+			enableChecks = append(enableChecks, check.CheckIDFileLineEndings)
+		}
+
+		if config.RequireSchemaDescriptions {
+			enableChecks = append(enableChecks, check.CheckIDContentsSchemaDescriptions)
+		}
+
+		if config.RequireSchemaOrdering {
+			enableChecks = append(enableChecks, check.CheckIDContentsSchemaOrdering)
+		}
+
+		if config.RequireSelfContainedExamples {
+			enableChecks = append(enableChecks, check.CheckIDContentsExampleReferences)
+		}
+
+		if requireDirectoryStructure != "" {
+			enableChecks = append(enableChecks, check.CheckIDDirectoryStructure)
+		}
+
+		if config.MaximumLineLength > 0 {
+			enableChecks = append(enableChecks, check.CheckIDLineLength)
+		}
+
+		if config.MaximumSubcategories > 0 || config.WarnSubcategories > 0 {
+			enableChecks = append(enableChecks, check.CheckIDNumberOfSubcategories)
+		}
+
+		if config.MaximumSubcategoryEntries > 0 || config.WarnSingleEntrySubcategories {
+			enableChecks = append(enableChecks, check.CheckIDSubcategorySize)
+		}
+	}
+
+	enableChecks = append(enableChecks, explicitEnableChecks...)
+
+	checkSelection, err := check.NewCheckSelection(enableChecks, disableChecks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	enableContentsCheck := (config.EnableContentsCheck || checkSelection.IsEnabled(check.CheckIDContents)) && checkSelection.Selected(check.CheckIDContents)
+	enableTitleHeadingCheck := (config.EnableTitleHeadingCheck || checkSelection.IsEnabled(check.CheckIDTitleHeading)) && checkSelection.Selected(check.CheckIDTitleHeading)
+	enableFormatVerbsCheck := (config.EnableFormatVerbsCheck || checkSelection.IsEnabled(check.CheckIDFormatVerbs)) && checkSelection.Selected(check.CheckIDFormatVerbs)
+	enableGuideLinksCheck := (config.EnableGuideLinksCheck || config.RequireLinkedGuides || checkSelection.IsEnabled(check.CheckIDGuideLinks)) && checkSelection.Selected(check.CheckIDGuideLinks)
+	enableSubcategoryConsistencyCheck := (config.EnableSubcategoryConsistencyCheck || checkSelection.IsEnabled(check.CheckIDSubcategoryConsistency)) && checkSelection.Selected(check.CheckIDSubcategoryConsistency)
+	enableFrontMatterPageTitleCheck := (config.EnableFrontMatterPageTitleCheck || checkSelection.IsEnabled(check.CheckIDFrontMatterPageTitle)) && checkSelection.Selected(check.CheckIDFrontMatterPageTitle)
+	enableFileNameCheck := (config.EnableFileNameCheck || checkSelection.IsEnabled(check.CheckIDFileName)) && checkSelection.Selected(check.CheckIDFileName)
+	enableGuideFilenamesCheck := (config.EnableGuideFilenamesCheck || config.GuideFilenameSeparator != "" || checkSelection.IsEnabled(check.CheckIDGuideFilenames)) && checkSelection.Selected(check.CheckIDGuideFilenames)
+	enableNestedSchemaLinksCheck := (config.EnableNestedSchemaLinksCheck || checkSelection.IsEnabled(check.CheckIDNestedSchemaLinks)) && checkSelection.Selected(check.CheckIDNestedSchemaLinks)
+	enableLegacyNavigationCheck := (config.EnableLegacyNavigationCheck || checkSelection.IsEnabled(check.CheckIDLegacyNavigation)) && checkSelection.Selected(check.CheckIDLegacyNavigation)
+	enableRawHTMLCheck := (config.EnableRawHTMLCheck || config.AllowedHTMLTags != "" || checkSelection.IsEnabled(check.CheckIDRawHTML)) && checkSelection.Selected(check.CheckIDRawHTML)
+	enablePairedDocumentationCheck := (config.EnablePairedDocumentationCheck || config.RequirePairedLinks || checkSelection.IsEnabled(check.CheckIDPairedDocumentation)) && checkSelection.Selected(check.CheckIDPairedDocumentation)
+	enableExampleSecretsCheck := (config.EnableExampleSecretsCheck || checkSelection.IsEnabled(check.CheckIDExampleSecrets)) && checkSelection.Selected(check.CheckIDExampleSecrets)
+	enableCrossReferencesCheck := (config.EnableCrossReferencesCheck || checkSelection.IsEnabled(check.CheckIDCrossReferences)) && checkSelection.Selected(check.CheckIDCrossReferences)
+	enableTemplateCheck := (config.EnableTemplateCheck || checkSelection.IsEnabled(check.CheckIDTemplate)) && checkSelection.Selected(check.CheckIDTemplate)
+	requireExampleUsage := (config.RequireExampleUsage || checkSelection.IsEnabled(check.CheckIDExampleUsage)) && checkSelection.Selected(check.CheckIDExampleUsage)
+	requireExamples := (config.RequireExamples || checkSelection.IsEnabled(check.CheckIDExamples)) && checkSelection.Selected(check.CheckIDExamples)
+	requireIdentity := (config.RequireIdentity || checkSelection.IsEnabled(check.CheckIDContentsIdentity)) && checkSelection.Selected(check.CheckIDContentsIdentity)
+	requireNewDocumentation := (config.RequireNewDocumentation || config.NewDocumentationRef != "" || checkSelection.IsEnabled(check.CheckIDNewDocumentation)) && checkSelection.Selected(check.CheckIDNewDocumentation)
+	requireImportBlock := (config.RequireImportBlock || checkSelection.IsEnabled(check.CheckIDContentsImportBlock)) && checkSelection.Selected(check.CheckIDContentsImportBlock)
+	requireLFLineEndings := (config.RequireLFLineEndings || config.AllowCRLFLineEndings || checkSelection.IsEnabled(check.CheckIDFileLineEndings)) && checkSelection.Selected(check.CheckIDFileLineEndings)
+	requireSchemaDescriptions := (config.RequireSchemaDescriptions || checkSelection.IsEnabled(check.CheckIDContentsSchemaDescriptions)) && checkSelection.Selected(check.CheckIDContentsSchemaDescriptions)
+	requireSchemaOrdering := (config.RequireSchemaOrdering || checkSelection.IsEnabled(check.CheckIDContentsSchemaOrdering)) && checkSelection.Selected(check.CheckIDContentsSchemaOrdering)
+	requireSelfContainedExamples := (config.RequireSelfContainedExamples || checkSelection.IsEnabled(check.CheckIDContentsExampleReferences)) && checkSelection.Selected(check.CheckIDContentsExampleReferences)
+
+	config.ProviderName = detectProviderName(config.ProviderName, config.ProviderSource, config.Path)
+
+	if config.ProviderName == "" {
+		hclog.L().Warn("Unable to determine provider name. Contents and enhanced validations may fail.")
+	} else {
+		hclog.L().Debug("Found provider name", "provider", config.ProviderName)
+	}
+
+	if requireExamples && config.ProviderName == "" {
+		return nil, fmt.Errorf("unknown provider name for enabling examples check, check that the current working directory or provided path is prefixed with terraform-provider-* or use -provider-name")
+	}
+
+	configFile, err := loadConfigFile(config.ConfigFile, config.Path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading configuration file: %w", err)
+	}
+
+	directoryOpts := configFile.CheckDirectoryOptions()
+	exampleIdentifierPatterns, exampleIdentifierAllowedValues, err := configFile.CheckExampleIdentifiers()
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading configuration file: %w", err)
+	}
+
+	headingNames, err := configFile.CheckHeadingNames()
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading configuration file: %w", err)
+	}
+
+	var requireIndexSections []string
+	if v := config.RequireIndexSections; v != "" {
+		requireIndexSections = strings.Split(v, ",")
+	}
+
+	indexSections, err := configFile.CheckRequiredIndexSections(requireIndexSections)
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring required index sections: %w", err)
+	}
+
+	rules, err := configFile.CheckRules()
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading configuration file: %w", err)
+	}
+
+	var forbidFrontMatterKeys []string
+	if v := config.ForbidFrontMatterKeys; v != "" {
+		forbidFrontMatterKeys = strings.Split(v, ",")
+	}
+
+	var requireFrontMatterKeys []string
+	if v := config.RequireFrontMatterKeys; v != "" {
+		requireFrontMatterKeys = strings.Split(v, ",")
+	}
+
+	for _, key := range forbidFrontMatterKeys {
+		for _, requireKey := range requireFrontMatterKeys {
+			if key == requireKey {
+				return nil, fmt.Errorf("YAML frontmatter key (%s) cannot be given with both -forbid-frontmatter-keys and -require-frontmatter-keys", key)
+			}
+		}
+	}
+
+	requiredFrontMatterKeys, err := configFile.CheckRequiredFrontMatterKeys(requireFrontMatterKeys)
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading configuration file: %w", err)
+	}
+
+	var allowedGuideSubcategories []string
+	if v := config.AllowedGuideSubcategories; v != "" {
+		allowedGuideSubcategories = strings.Split(v, ",")
+	}
+
+	if v := config.AllowedGuideSubcategoriesFile; v != "" {
+		var err error
+		allowedGuideSubcategories, err = allowedSubcategoriesFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting allowed guide subcategories: %w", err)
+		}
+	}
+
+	allowedGuideSubcategories = append(allowedGuideSubcategories, configFile.AllowedGuideSubcategories...)
+
+	var allowedHTMLTags []string
+	if v := config.AllowedHTMLTags; v != "" {
+		allowedHTMLTags = strings.Split(v, ",")
+	}
+
+	var allowedResourceSubcategories []string
+	if v := config.AllowedResourceSubcategories; v != "" {
+		allowedResourceSubcategories = strings.Split(v, ",")
+	}
+
+	if v := config.AllowedResourceSubcategoriesFile; v != "" {
+		var err error
+		allowedResourceSubcategories, err = allowedSubcategoriesFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting allowed resource subcategories: %w", err)
+		}
+	}
+
+	allowedResourceSubcategories = append(allowedResourceSubcategories, configFile.AllowedResourceSubcategories...)
+
+	var subcategoryMappings []*check.SubcategoryMapping
+	if v := config.SubcategoryMappingFile; v != "" {
+		if config.ProviderName == "" {
+			return nil, fmt.Errorf("unknown provider name for enabling subcategory mapping check, check that the current working directory or provided path is prefixed with terraform-provider-* or use -provider-name")
+		}
+
+		var err error
+		subcategoryMappings, err = subcategoryMappingFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting subcategory mappings: %w", err)
+		}
+	}
+
+	var forbiddenWords []*check.ForbiddenWord
+	if v := config.ForbiddenWordsFile; v != "" {
+		var err error
+		forbiddenWords, err = forbiddenWordsFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting forbidden words: %w", err)
+		}
+	}
+
+	var frontMatterSchema *check.FrontMatterSchema
+	if v := config.FrontMatterSchema; v != "" {
+		var err error
+		frontMatterSchema, err = frontMatterSchemaFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting frontmatter schema: %w", err)
+		}
+	}
+
+	var ignoreFileMismatchDataSources []string
+	if v := config.IgnoreFileMismatchDataSources; v != "" {
+		ignoreFileMismatchDataSources = strings.Split(v, ",")
+	}
+
+	if v := config.IgnoreFileMismatchDataSourcesFile; v != "" {
+		names, err := ignoreFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting ignored file mismatch data sources: %w", err)
+		}
+
+		ignoreFileMismatchDataSources = append(ignoreFileMismatchDataSources, names...)
+	}
+
+	ignoreFileMismatchDataSources = append(ignoreFileMismatchDataSources, configFile.IgnoreFileMismatchDataSources...)
+
+	var ignoreExampleDataSources []string
+	if v := config.IgnoreExampleDataSources; v != "" {
+		ignoreExampleDataSources = strings.Split(v, ",")
+	}
+
+	if v := config.IgnoreExampleDataSourcesFile; v != "" {
+		names, err := ignoreFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting ignored example data sources: %w", err)
+		}
+
+		ignoreExampleDataSources = append(ignoreExampleDataSources, names...)
+	}
+
+	var ignoreExampleResources []string
+	if v := config.IgnoreExampleResources; v != "" {
+		ignoreExampleResources = strings.Split(v, ",")
+	}
+
+	if v := config.IgnoreExampleResourcesFile; v != "" {
+		names, err := ignoreFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting ignored example resources: %w", err)
+		}
+
+		ignoreExampleResources = append(ignoreExampleResources, names...)
+	}
+
+	var ignoreFileMismatchResources []string
+	if v := config.IgnoreFileMismatchResources; v != "" {
+		ignoreFileMismatchResources = strings.Split(v, ",")
+	}
+
+	if v := config.IgnoreFileMismatchResourcesFile; v != "" {
+		names, err := ignoreFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting ignored file mismatch resources: %w", err)
+		}
+
+		ignoreFileMismatchResources = append(ignoreFileMismatchResources, names...)
+	}
+
+	ignoreFileMismatchResources = append(ignoreFileMismatchResources, configFile.IgnoreFileMismatchResources...)
+
+	var ignoreFileMissingDataSources []string
+	if v := config.IgnoreFileMissingDataSources; v != "" {
+		ignoreFileMissingDataSources = strings.Split(v, ",")
+	}
+
+	if v := config.IgnoreFileMissingDataSourcesFile; v != "" {
+		names, err := ignoreFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting ignored file missing data sources: %w", err)
+		}
+
+		ignoreFileMissingDataSources = append(ignoreFileMissingDataSources, names...)
+	}
+
+	ignoreFileMissingDataSources = append(ignoreFileMissingDataSources, configFile.IgnoreFileMissingDataSources...)
+
+	var ignoreFileMissingResources []string
+	if v := config.IgnoreFileMissingResources; v != "" {
+		ignoreFileMissingResources = strings.Split(v, ",")
+	}
+
+	if v := config.IgnoreFileMissingResourcesFile; v != "" {
+		names, err := ignoreFile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting ignored file missing resources: %w", err)
+		}
+
+		ignoreFileMissingResources = append(ignoreFileMissingResources, names...)
+	}
+
+	ignoreFileMissingResources = append(ignoreFileMissingResources, configFile.IgnoreFileMissingResources...)
+
+	var ignoreLegacyNavigation []string
+	if v := config.IgnoreLegacyNavigation; v != "" {
+		ignoreLegacyNavigation = strings.Split(v, ",")
+	}
+
+	ignoreLegacyNavigation = append(ignoreLegacyNavigation, configFile.IgnoreLegacyNavigation...)
+
+	var sourceRepository string
+	if v := config.SourceRepository; v != "" {
+		var err error
+		sourceRepository, err = check.ParseSourceRepository(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting source repository: %w", err)
+		}
+	}
+
+	var schemaActions, schemaDataSources, schemaListResources, schemaResources map[string]*tfjson.Schema
+	var schemaFunctions map[string]*tfjson.FunctionSignature
+	var schemaProvider *tfjson.Schema
+	var schemaResourceIdentities map[string]*tfjson.IdentitySchema
+	if (config.ProviderBinary != "" && config.ProvidersSchemaJson != "") || (config.ProviderVersion != "" && (config.ProviderBinary != "" || config.ProvidersSchemaJson != "")) {
+		return nil, fmt.Errorf("only one of -provider-binary, -provider-version, or -providers-schema-json can be given")
+	}
+
+	// The default logger includes the fields of the configured Terraform
+	// Provider, so it is also used for the registry and git operations of the
+	// checks.
+	logger := hclog.L()
+
+	if config.ProviderBinary != "" || config.ProvidersSchemaJson != "" || config.ProviderVersion != "" {
+		if config.ProviderName == "" {
+			return nil, fmt.Errorf("unknown provider name for enabling Terraform Provider schema checks, check that the current working directory or provided path is prefixed with terraform-provider-* or use -provider-name")
+		}
+
+		var ps *tfjson.ProviderSchemas
+		var err error
+
+		switch {
+		case config.ProviderBinary != "":
+			ps, err = providerBinarySchemas(config.ProviderBinary, config.ProviderName, config.ProviderSource)
+		case config.ProviderVersion != "":
+			ps, err = providerVersionSchemas(logger, config.ProviderVersion, config.ProviderName, config.ProviderSource, config.RegistryBaseURL)
+		default:
+			ps, err = providerSchemas(config.ProvidersSchemaJson, config.ProviderName, config.ProviderSource)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error enabling Terraform Provider schema checks: %w", err)
+		}
+
+		schemaActions = providerSchemasActions(ps, config.ProviderName, config.ProviderSource)
+		schemaDataSources = providerSchemasDataSources(ps, config.ProviderName, config.ProviderSource)
+		schemaListResources = providerSchemasListResources(ps, config.ProviderName, config.ProviderSource)
+		schemaProvider = providerSchemasProvider(ps, config.ProviderName, config.ProviderSource)
+		schemaResources = providerSchemasResources(ps, config.ProviderName, config.ProviderSource)
+		schemaFunctions = providerSchemasFunctions(ps, config.ProviderName, config.ProviderSource)
+		schemaResourceIdentities = providerSchemasResourceIdentities(ps, config.ProviderName, config.ProviderSource)
+	}
+
+	var newDocumentationRef string
+	var newDocumentationFiles []string
+
+	if (config.RequireNewDocumentation || config.NewDocumentationRef != "") && schemaDataSources == nil && schemaResources == nil {
+		return nil, fmt.Errorf("-require-new-documentation requires -providers-schema-json, -provider-binary, or -provider-version")
+	}
+
+	if requireNewDocumentation && (schemaDataSources != nil || schemaResources != nil) {
+		var err error
+
+		newDocumentationRef, newDocumentationFiles, err = previousReleaseFiles(context.Background(), logger, config.Path, config.NewDocumentationRef)
+
+		if err != nil {
+			return nil, fmt.Errorf("error enabling new documentation check: %w", err)
+		}
+	}
+
+	if config.MissingAttributes != nil && (!enableContentsCheck || (schemaResources == nil && schemaListResources == nil)) {
+		return nil, fmt.Errorf("-missing-attributes-report requires the contents check and -providers-schema-json, -provider-binary, or -provider-version")
+	}
+
+	fileOpts := &check.FileOptions{
+		AllowCRLFLineEndings:            config.AllowCRLFLineEndings,
+		BasePath:                        config.Path,
+		Checks:                          checkSelection,
+		Directories:                     directoryOpts,
+		EnableFileNameCheck:             enableFileNameCheck,
+		EnableFrontMatterPageTitleCheck: enableFrontMatterPageTitleCheck,
+		EnableTitleHeadingCheck:         enableTitleHeadingCheck,
+		Logger:                          logger,
+		Progress:                        config.Progress,
+		RequireFileExtension:            config.RequireFileExtension,
+		RequireLFLineEndings:            requireLFLineEndings,
+		Timings:                         config.Timings,
+		WarnLocalImages:                 config.WarnLocalImages,
+	}
+	checkOpts := &check.CheckOptions{
+		ActionFileMismatch: &check.FileMismatchOptions{
+			FileOptions:  fileOpts,
+			ProviderName: config.ProviderName,
+			ResourceType: check.ResourceTypeAction,
+			Schemas:      schemaActions,
+		},
+		BasePath: config.Path,
+		Checks:   checkSelection,
+		CrossReferences: &check.CrossReferencesOptions{
+			FileOptions:      fileOpts,
+			Enable:           enableCrossReferencesCheck,
+			IgnoreGuideLinks: enableGuideLinksCheck,
+			ProviderName:     config.ProviderName,
+			ProviderSource:   config.ProviderSource,
+		},
+		DataSourceFileMismatch: &check.FileMismatchOptions{
+			FileOptions:        fileOpts,
+			Files:              configFile.DataSourceFiles,
+			IgnoreFileMismatch: ignoreFileMismatchDataSources,
+			IgnoreFileMissing:  ignoreFileMissingDataSources,
+			ProviderName:       config.ProviderName,
+			Removed:            configFile.RemovedDataSources,
+			Renamed:            configFile.RenamedDataSources,
+			ResourceType:       check.ResourceTypeDataSource,
+			Schemas:            schemaDataSources,
+		},
+		Deprecated: &check.DeprecatedOptions{
+			DataSourceSchemas:  schemaDataSources,
+			FileOptions:        fileOpts,
+			ProviderName:       config.ProviderName,
+			RenamedDataSources: configFile.RenamedDataSources,
+			RenamedResources:   configFile.RenamedResources,
+			RequireReplacement: config.RequireDeprecationReplacement,
+			ResourceSchemas:    schemaResources,
+		},
+		DeprecatedProviderArguments: &check.DeprecatedProviderArgumentsOptions{
+			FileOptions:        fileOpts,
+			RequireReplacement: config.RequireDeprecationReplacement,
+			Schema:             schemaProvider,
+		},
+		Directories: directoryOpts,
+		ExampleIdentifiers: &check.ExampleIdentifiersOptions{
+			AllowedValues: exampleIdentifierAllowedValues,
+			FileOptions:   fileOpts,
+			Patterns:      exampleIdentifierPatterns,
+		},
+		ExamplePunctuation: &check.ExamplePunctuationOptions{
+			FileOptions: fileOpts,
+		},
+		ExampleSecrets: &check.ExampleSecretsOptions{
+			FileOptions: fileOpts,
+			Enable:      enableExampleSecretsCheck,
+		},
+		Examples: &check.ExamplesOptions{
+			DataSourceSchemas: schemaDataSources,
+			Enable:            requireExamples,
+			FileOptions:       fileOpts,
+			ProviderName:      config.ProviderName,
+			ResourceSchemas:   schemaResources,
+		},
+		ForbiddenWords: &check.ForbiddenWordsOptions{
+			FileOptions: fileOpts,
+			Words:       forbiddenWords,
+		},
+		FormatVerbs: &check.FormatVerbsOptions{
+			FileOptions: fileOpts,
+			Enable:      enableFormatVerbsCheck,
+		},
+		FunctionSignature: &check.FunctionSignatureOptions{
+			FileOptions: fileOpts,
+			Schemas:     schemaFunctions,
+		},
+		GuideFilenames: &check.GuideFilenamesOptions{
+			FileOptions: fileOpts,
+			Enable:      enableGuideFilenamesCheck,
+			Separator:   config.GuideFilenameSeparator,
+		},
+		GuideLinks: &check.GuideLinksOptions{
+			FileOptions:    fileOpts,
+			Enable:         enableGuideLinksCheck,
+			ProviderName:   config.ProviderName,
+			ProviderSource: config.ProviderSource,
+			RequireLinked:  config.RequireLinkedGuides,
+		},
+		HeadingNames: &check.HeadingNamesOptions{
+			FileOptions: fileOpts,
+			Headings:    headingNames,
+		},
+		IndexSections: &check.IndexSectionsOptions{
+			FileOptions: fileOpts,
+			Sections:    indexSections,
+		},
+		LegacyDataSourceFile: &check.LegacyDataSourceFileOptions{
+			ExampleAttributes: &check.ExampleAttributesOptions{
+				Schemas: schemaDataSources,
+			},
+			ExampleUsage: &check.ExampleUsageOptions{
+				Enable: requireExampleUsage,
+				Ignore: ignoreExampleDataSources,
+			},
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedResourceSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireResourceSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeDataSource],
+				Schema:                 frontMatterSchema,
+			},
+			ProviderName: config.ProviderName,
+		},
+		LegacyGuideFile: &check.LegacyGuideFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedGuideSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireGuideSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeGuide],
+				Schema:                 frontMatterSchema,
+			},
+		},
+		LegacyIndexFile: &check.LegacyIndexFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				ForbidKeys:  forbidFrontMatterKeys,
+				RequireKeys: requiredFrontMatterKeys[check.DocumentationTypeIndex],
+				Schema:      frontMatterSchema,
+			},
+			ProviderName:   config.ProviderName,
+			ProviderSource: config.ProviderSource,
+		},
+		LegacyNavigation: &check.LegacyNavigationOptions{
+			FileOptions: fileOpts,
+			Enable:      enableLegacyNavigationCheck,
+			Ignore:      ignoreLegacyNavigation,
+		},
+		LegacyResourceFile: &check.LegacyResourceFileOptions{
+			ExampleAttributes: &check.ExampleAttributesOptions{
+				Schemas: schemaResources,
+			},
+			ExampleUsage: &check.ExampleUsageOptions{
+				Enable: requireExampleUsage,
+				Ignore: ignoreExampleResources,
+			},
+			Contents: &check.ContentsOptions{
+				Enable:                       enableContentsCheck,
+				FileOptions:                  fileOpts,
+				MissingAttributes:            config.MissingAttributes,
+				RequireCalloutPrefix:         config.RequireCalloutPrefix,
+				IdentitySchemas:              schemaResourceIdentities,
+				RequireIdentity:              requireIdentity,
+				RequireImportBlock:           requireImportBlock,
+				RequireSchemaDescriptions:    requireSchemaDescriptions,
+				RequireSchemaOrdering:        requireSchemaOrdering,
+				RequireSelfContainedExamples: requireSelfContainedExamples,
+				SchemaOrderingStyle:          config.SchemaOrderingStyle,
+				Schemas:                      schemaResources,
+			},
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedResourceSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireResourceSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeResource],
+				Schema:                 frontMatterSchema,
+			},
+			ProviderName: config.ProviderName,
+		},
+		LineLength: &check.LineLengthOptions{
+			FileOptions: fileOpts,
+			Maximum:     config.MaximumLineLength,
+		},
+		ListResourceFileMismatch: &check.FileMismatchOptions{
+			FileOptions:  fileOpts,
+			ProviderName: config.ProviderName,
+			ResourceType: check.ResourceTypeListResource,
+			Schemas:      schemaListResources,
+		},
+		Logger:                logger,
+		MaximumNumberOfFiles:  config.MaximumFiles,
+		MaximumNumberOfGuides: config.MaximumGuides,
+		NestedSchemaLinks: &check.NestedSchemaLinksOptions{
+			FileOptions: fileOpts,
+			Enable:      enableNestedSchemaLinksCheck,
+		},
+		NewDocumentation: &check.NewDocumentationOptions{
+			DataSourceFiles:   configFile.DataSourceFiles,
+			DataSourceSchemas: schemaDataSources,
+			FileOptions:       fileOpts,
+			PreviousFiles:     newDocumentationFiles,
+			ProviderName:      config.ProviderName,
+			Ref:               newDocumentationRef,
+			ResourceFiles:     configFile.ResourceFiles,
+			ResourceSchemas:   schemaResources,
+		},
+		PairedDocumentation: &check.PairedDocumentationOptions{
+			FileOptions:    fileOpts,
+			Enable:         enablePairedDocumentationCheck,
+			ProviderName:   config.ProviderName,
+			ProviderSource: config.ProviderSource,
+			RequireLinks:   config.RequirePairedLinks,
+		},
+		ProviderName:   config.ProviderName,
+		ProviderSource: config.ProviderSource,
+		RawHTML: &check.RawHTMLOptions{
+			AllowedTags: allowedHTMLTags,
+			Enable:      enableRawHTMLCheck,
+			FileOptions: fileOpts,
+		},
+		Removed: &check.RemovedOptions{
+			DataSources:  configFile.RemovedDataSources,
+			FileOptions:  fileOpts,
+			ProviderName: config.ProviderName,
+			Resources:    configFile.RemovedResources,
+		},
+		Renamed: &check.RenamedOptions{
+			DataSources:  configFile.RenamedDataSources,
+			FileOptions:  fileOpts,
+			ProviderName: config.ProviderName,
+			Resources:    configFile.RenamedResources,
+		},
+		RegistryActionFile: &check.RegistryActionFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedResourceSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireResourceSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeAction],
+				Schema:                 frontMatterSchema,
+			},
+			ProviderName: config.ProviderName,
+		},
+		RegistryDataSourceFile: &check.RegistryDataSourceFileOptions{
+			ExampleAttributes: &check.ExampleAttributesOptions{
+				Schemas: schemaDataSources,
+			},
+			ExampleUsage: &check.ExampleUsageOptions{
+				Enable: requireExampleUsage,
+				Ignore: ignoreExampleDataSources,
+			},
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedResourceSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireResourceSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeDataSource],
+				Schema:                 frontMatterSchema,
+			},
+			ProviderName: config.ProviderName,
+		},
+		RegistryGuideFile: &check.RegistryGuideFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedGuideSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireGuideSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeGuide],
+				Schema:                 frontMatterSchema,
+			},
+		},
+		RegistryIndexFile: &check.RegistryIndexFileOptions{
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				ForbidKeys:  forbidFrontMatterKeys,
+				RequireKeys: requiredFrontMatterKeys[check.DocumentationTypeIndex],
+				Schema:      frontMatterSchema,
+			},
+			ProviderName:   config.ProviderName,
+			ProviderSource: config.ProviderSource,
+		},
+		RegistryListResourceFile: &check.RegistryResourceFileOptions{
+			Contents: &check.ContentsOptions{
+				Enable:                       enableContentsCheck,
+				FileOptions:                  fileOpts,
+				MissingAttributes:            config.MissingAttributes,
+				RequireCalloutPrefix:         config.RequireCalloutPrefix,
+				RequireSchemaDescriptions:    requireSchemaDescriptions,
+				RequireSchemaOrdering:        requireSchemaOrdering,
+				RequireSelfContainedExamples: requireSelfContainedExamples,
+				SchemaOrderingStyle:          config.SchemaOrderingStyle,
+				Schemas:                      schemaListResources,
+			},
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedResourceSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireResourceSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeListResource],
+				Schema:                 frontMatterSchema,
+			},
+			ProviderName: config.ProviderName,
+		},
+		RegistryResourceFile: &check.RegistryResourceFileOptions{
+			ExampleAttributes: &check.ExampleAttributesOptions{
+				Schemas: schemaResources,
+			},
+			ExampleUsage: &check.ExampleUsageOptions{
+				Enable: requireExampleUsage,
+				Ignore: ignoreExampleResources,
+			},
+			Contents: &check.ContentsOptions{
+				Enable:                       enableContentsCheck,
+				FileOptions:                  fileOpts,
+				MissingAttributes:            config.MissingAttributes,
+				RequireCalloutPrefix:         config.RequireCalloutPrefix,
+				IdentitySchemas:              schemaResourceIdentities,
+				RequireIdentity:              requireIdentity,
+				RequireImportBlock:           requireImportBlock,
+				RequireSchemaDescriptions:    requireSchemaDescriptions,
+				RequireSchemaOrdering:        requireSchemaOrdering,
+				RequireSelfContainedExamples: requireSelfContainedExamples,
+				SchemaOrderingStyle:          config.SchemaOrderingStyle,
+				Schemas:                      schemaResources,
+			},
+			FileOptions: fileOpts,
+			FrontMatter: &check.FrontMatterOptions{
+				AllowedSubcategories:   allowedResourceSubcategories,
+				ForbidKeys:             forbidFrontMatterKeys,
+				RequireSubcategory:     config.RequireResourceSubcategory,
+				NormalizeSubcategories: config.NormalizeSubcategories,
+				RequireKeys:            requiredFrontMatterKeys[check.DocumentationTypeResource],
+				Schema:                 frontMatterSchema,
+			},
+			ProviderName: config.ProviderName,
+		},
+		ResourceFileMismatch: &check.FileMismatchOptions{
+			FileOptions:        fileOpts,
+			Files:              configFile.ResourceFiles,
+			IgnoreFileMismatch: ignoreFileMismatchResources,
+			IgnoreFileMissing:  ignoreFileMissingResources,
+			ProviderName:       config.ProviderName,
+			Removed:            configFile.RemovedResources,
+			Renamed:            configFile.RenamedResources,
+			ResourceType:       check.ResourceTypeResource,
+			Schemas:            schemaResources,
+		},
+		RegistryManifest: &check.RegistryManifestOptions{
+			DataSourceSchemas: schemaDataSources,
+			FileOptions:       fileOpts,
+			Require:           config.RequireRegistryManifest,
+			ResourceSchemas:   schemaResources,
+		},
+		RequireDirectoryStructure: requireDirectoryStructure,
+		Rules: &check.RulesOptions{
+			FileOptions: fileOpts,
+			Rules:       rules,
+		},
+		SourceLinks: &check.SourceLinksOptions{
+			FileOptions: fileOpts,
+			Repository:  sourceRepository,
+		},
+		Subcategories: &check.SubcategoriesOptions{
+			EnableConsistency:    enableSubcategoryConsistencyCheck,
+			FileOptions:          fileOpts,
+			Mappings:             subcategoryMappings,
+			MaximumEntries:       config.MaximumSubcategoryEntries,
+			MaximumSubcategories: config.MaximumSubcategories,
+			ProviderName:         config.ProviderName,
+			WarnSingleEntry:      config.WarnSingleEntrySubcategories,
+			WarningSubcategories: config.WarnSubcategories,
+		},
+		TemplateFile: &check.TemplateFileOptions{
+			Enable:      enableTemplateCheck,
+			FileOptions: fileOpts,
+		},
+		Timings:                 config.Timings,
+		WarningNumberOfFiles:    config.WarnFiles,
+		IgnoreCdktfMissingFiles: config.IgnoreCdktfMissingFiles,
+	}
+
+	for _, id := range explicitEnableChecks {
+		if !checkOpts.CheckEnabled(id) {
+			return nil, fmt.Errorf("check (%s) cannot be enabled without its required options, such as -providers-schema-json or -require-legacy-structure/-require-registry-structure", id)
+		}
+	}
+
+	return checkOpts, nil
+}
+
+// checkSummary returns the human readable summary of a check run, including
+// the number of checked files per directory classification and findings per
+// check.
+func checkSummary(summary *check.CheckSummary, findings []*check.Finding, elapsed time.Duration) string {
+	if summary == nil {
+		summary = &check.CheckSummary{}
+	}
+
+	var totalFiles int
+	classifications := make([]string, 0, len(summary.Files))
+
+	for classification, files := range summary.Files {
+		classifications = append(classifications, classification)
+		totalFiles += files
+	}
+
+	sort.Strings(classifications)
+
+	findingsByCheck := make(map[string]int)
+	checkIDs := []string{}
+
+	for _, finding := range findings {
+		if _, ok := findingsByCheck[finding.CheckID]; !ok {
+			checkIDs = append(checkIDs, finding.CheckID)
+		}
+
+		findingsByCheck[finding.CheckID]++
+	}
+
+	sort.Strings(checkIDs)
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	output := tabwriter.NewWriter(outputBuffer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(output, "Checked %d documentation files in %s\n", totalFiles, elapsed.Round(time.Millisecond))
+
+	if len(classifications) > 0 {
+		fmt.Fprintln(output)
+		fmt.Fprintln(output, "TYPE\tFILES")
+
+		for _, classification := range classifications {
+			fmt.Fprintf(output, "%s\t%d\n", classification, summary.Files[classification])
+		}
+	}
+
+	fmt.Fprintln(output)
+
+	if len(checkIDs) == 0 {
+		fmt.Fprintln(output, "No findings")
+	} else {
+		fmt.Fprintln(output, "CHECK\tFINDINGS")
+
+		for _, checkID := range checkIDs {
+			fmt.Fprintf(output, "%s\t%d\n", checkID, findingsByCheck[checkID])
+		}
+	}
+
+	fmt.Fprintln(output)
+	fmt.Fprintf(output, "Ignored findings: %d\n", summary.IgnoredFindings)
+
+	output.Flush()
+
+	return strings.TrimSpace(outputBuffer.String())
+}
+
+// checkTimings returns the human readable list of the slowest checks and
+// documentation directories, up to the limit.
+func checkTimings(timings *check.Timings, limit int) string {
+	slowest := timings.Slowest(limit)
+
+	if len(slowest) == 0 {
+		return "No timings recorded"
+	}
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	output := tabwriter.NewWriter(outputBuffer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(output, "Slowest checks and directories:\n\n")
+	fmt.Fprintln(output, "TYPE\tNAME\tTIME")
+
+	for _, timing := range slowest {
+		fmt.Fprintf(output, "%s\t%s\t%s\n", timing.Type, timing.Name, timing.Duration.Round(time.Microsecond))
+	}
+
+	output.Flush()
+
+	return strings.TrimSpace(outputBuffer.String())
+}
+
+// checkSummaryLine returns the single line summary of a check run.
+func checkSummaryLine(summary *check.CheckSummary, findings []*check.Finding, elapsed time.Duration) string {
+	if summary == nil {
+		summary = &check.CheckSummary{}
+	}
+
+	var totalFiles int
+
+	for _, files := range summary.Files {
+		totalFiles += files
+	}
+
+	return fmt.Sprintf("Checked %d documentation files in %s: %d findings, %d ignored findings", totalFiles, elapsed.Round(time.Millisecond), len(findings), summary.IgnoredFindings)
+}
+
+// isFlagSet returns true if the flag was given on the command line.
+func isFlagSet(flags *flag.FlagSet, name string) bool {
+	var result bool
+
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			result = true
+		}
+	})
+
+	return result
+}
+
+// isValidGuideFilenameSeparator returns true if the separator is empty, which
+// requires the separator used by most guides, or matches an available
+// separator.
+func isValidGuideFilenameSeparator(separator string) bool {
+	if separator == "" {
+		return true
+	}
+
+	for _, validSeparator := range check.GuideFilenameSeparators {
+		if separator == validSeparator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isValidFileExtension returns true if the extension is empty, which allows
+// all valid extensions, or is a valid documentation file extension. The legacy
+// extensions include the Terraform Registry extension.
+func isValidFileExtension(extension string) bool {
+	if extension == "" {
+		return true
+	}
+
+	for _, validExtension := range check.ValidLegacyFileExtensions {
+		if extension == validExtension {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isValidSchemaOrderingStyle returns true if the style is empty, which is
+// the default style, or matches an available style.
+func isValidSchemaOrderingStyle(style string) bool {
+	if style == "" {
+		return true
+	}
+
+	for _, validStyle := range contents.SchemaOrderingStyles {
+		if style == validStyle {
+			return true
+		}
+	}
+
+	return false
+}
+
+// outputLogLevel returns the default log level for the output mode. Per-file
+// progress is logged at INFO and warnings at WARN.
+func outputLogLevel(quiet bool, verbose bool) string {
+	switch {
+	case quiet:
+		return "ERROR"
+	case verbose:
+		return "INFO"
+	}
+
+	return "WARN"
+}
+
+// forbiddenWordsFile returns the forbidden words of the newline separated
+// file, where each line is a word or phrase, optionally followed by = and a
+// replacement. Empty lines and lines starting with # are ignored.
+func forbiddenWordsFile(path string) ([]*check.ForbiddenWord, error) {
+	hclog.L().Debug("Loading forbidden words file", "file", path)
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening forbidden words file (%s): %w", path, err)
+	}
+
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	var forbiddenWords []*check.ForbiddenWord
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		phrase, replacement, _ := strings.Cut(line, "=")
+		forbiddenWord, err := check.NewForbiddenWord(phrase, replacement)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing forbidden words file (%s) line %d: %w", path, lineNumber, err)
+		}
+
+		forbiddenWords = append(forbiddenWords, forbiddenWord)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading forbidden words file (%s): %w", path, err)
+	}
+
+	return forbiddenWords, nil
+}
+
+// subcategoryMappingFile returns the subcategory mappings of the subcategory
+// mapping file, whose lines contain a name pattern, =>, and the subcategory,
+// which may be double quoted, e.g. aws_s3_* => "S3".
+func subcategoryMappingFile(path string) ([]*check.SubcategoryMapping, error) {
+	hclog.L().Debug("Loading subcategory mapping file", "file", path)
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening subcategory mapping file (%s): %w", path, err)
+	}
+
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	var subcategoryMappings []*check.SubcategoryMapping
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		pattern, subcategory, ok := strings.Cut(line, "=>")
+
+		if !ok {
+			return nil, fmt.Errorf("error parsing subcategory mapping file (%s) line %d: expected PATTERN => SUBCATEGORY", path, lineNumber)
+		}
+
+		subcategory = strings.TrimSpace(subcategory)
+
+		if strings.HasPrefix(subcategory, `"`) {
+			subcategory, err = strconv.Unquote(subcategory)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing subcategory mapping file (%s) line %d: invalid quoted subcategory: %w", path, lineNumber, err)
+			}
+		}
+
+		subcategoryMapping, err := check.NewSubcategoryMapping(strings.TrimSpace(pattern), subcategory)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing subcategory mapping file (%s) line %d: %w", path, lineNumber, err)
+		}
+
+		subcategoryMappings = append(subcategoryMappings, subcategoryMapping)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading subcategory mapping file (%s): %w", path, err)
+	}
+
+	return subcategoryMappings, nil
+}
+
+// frontMatterSchemaFile returns the JSON Schema of the frontmatter schema file.
+func frontMatterSchemaFile(path string) (*check.FrontMatterSchema, error) {
+	hclog.L().Debug("Loading frontmatter schema file", "file", path)
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading frontmatter schema file (%s): %w", path, err)
+	}
+
+	schema, err := check.ParseFrontMatterSchema(content)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing frontmatter schema file (%s): %w", path, err)
+	}
+
+	return schema, nil
+}
+
+func allowedSubcategoriesFile(path string) ([]string, error) {
+	hclog.L().Debug("Loading allowed subcategories file", "file", path)
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening allowed subcategories file (%s): %w", path, err)
+	}
+
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	var allowedSubcategories []string
+
+	for scanner.Scan() {
+		allowedSubcategories = append(allowedSubcategories, scanner.Text())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading allowed subcategories file (%s): %w", path, err)
+	}
+
+	return allowedSubcategories, nil
+}
+
+// ignoreFile returns the names of the newline separated ignore file, such as
+// the -ignore-file-missing-resources-file flag value. Empty lines and lines
+// starting with # are ignored.
+func ignoreFile(path string) ([]string, error) {
+	hclog.L().Debug("Loading ignore file", "file", path)
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening ignore file (%s): %w", path, err)
+	}
+
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	var names []string
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		names = append(names, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading ignore file (%s): %w", path, err)
+	}
+
+	return names, nil
+}
+
+// detectProviderName returns the given provider name, otherwise the name
+// determined from the provider source address or codebase path.
+func detectProviderName(providerName string, providerSource string, path string) string {
+	if providerName != "" {
+		return providerName
+	}
+
+	if providerSource != "" {
+		providerSourceParts := strings.Split(providerSource, "/")
+
+		return providerSourceParts[len(providerSourceParts)-1]
+	}
+
+	if path == "" {
+		return providerNameFromCurrentDirectory()
+	}
+
+	return providerNameFromPath(path)
+}
+
+func providerNameFromCurrentDirectory() string {
+	path, _ := os.Getwd()
+
+	return providerNameFromPath(path)
+}
+
+func providerNameFromPath(path string) string {
+	base := filepath.Base(path)
+
+	if strings.ContainsAny(base, "./") {
+		return ""
+	}
+
+	if !strings.HasPrefix(base, "terraform-provider-") {
+		return ""
+	}
+
+	return strings.TrimPrefix(base, "terraform-provider-")
+}
+
+// providerPaths returns the terraform-provider-* directories within the
+// paths, which default to the current directory. Directories within a
+// Terraform Provider codebase and hidden directories are not searched.
+func providerPaths(roots []string) ([]string, error) {
+	if len(roots) == 0 {
+		roots = []string{"."}
+	}
+
+	var result []string
+
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.IsDir() {
+				return nil
+			}
+
+			if providerNameFromPath(path) != "" {
+				hclog.L().Debug("Found Terraform Provider codebase", "path", path)
+				result = append(result, path)
+
+				return filepath.SkipDir
+			}
+
+			if path != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error walking path (%s): %w", root, err)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no terraform-provider-* directories found in paths: %s", strings.Join(roots, ", "))
+	}
+
+	return result, nil
+}
+
+// providerSchemas reads, parses, and validates a provided terraform provider schema -json path,
+// or standard input if the path is ProvidersSchemaJsonStdin. Gzip and zstd compressed files are
+// decompressed.
+// The file is decoded as a stream and only the schema of the provider, matched by provider
+// source or name, is retained, which limits memory usage for very large providers.
+func providerSchemas(path string, providerName string, providerSource string) (*tfjson.ProviderSchemas, error) {
+	hclog.L().Debug("Loading providers schema JSON file", "file", path)
+
+	file := os.Stdin
+
+	if path == ProvidersSchemaJsonStdin {
+		path = "standard input"
+	} else {
+		var err error
+
+		file, err = os.Open(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading providers schema JSON file (%s): %w", path, err)
+		}
+
+		defer file.Close()
+	}
+
+	r, closeReader, err := decompressReader(bufio.NewReader(file))
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading providers schema JSON file (%s): %w", path, err)
+	}
+
+	defer closeReader()
+
+	ps, err := decodeProviderSchemas(r, providerName, providerSource)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing providers schema JSON file (%s): %w", path, err)
+	}
+
+	if err := ps.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating providers schema JSON file (%s): %w", path, err)
+	}
+
+	return ps, nil
+}
+
+// providerBinarySchemas launches the Terraform Provider binary to get its schema, which
+// is returned in terraform providers schema -json form for the provider source or name.
+func providerBinarySchemas(path string, providerName string, providerSource string) (*tfjson.ProviderSchemas, error) {
+	hclog.L().Debug("Loading schema from provider binary", "file", path)
+
+	schema, err := providerbinary.Schema(context.Background(), path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	address := providerSource
+
+	if address == "" {
+		address = providerName
+	}
+
+	ps := &tfjson.ProviderSchemas{
+		Schemas: map[string]*tfjson.ProviderSchema{
+			address: schema,
+		},
+	}
+
+	return ps, nil
+}
+
+// decodeProviderSchemas decodes terraform providers schema -json output, skipping the
+// schemas of providers other than the provider source or name without decoding them.
+func decodeProviderSchemas(r io.Reader, providerName string, providerSource string) (*tfjson.ProviderSchemas, error) {
+	decoder := json.NewDecoder(r)
+	ps := &tfjson.ProviderSchemas{}
+
+	err := decodeJSONObject(decoder, func(key string) error {
+		switch key {
+		case "format_version":
+			return decoder.Decode(&ps.FormatVersion)
+		case "provider_schemas":
+			ps.Schemas = make(map[string]*tfjson.ProviderSchema)
+
+			return decodeJSONObject(decoder, func(address string) error {
+				if address != providerSource && address != providerName {
+					hclog.L().Trace("Skipping provider schema", "provider_source", address)
+
+					return skipJSONValue(decoder)
+				}
+
+				var schema tfjson.ProviderSchema
+
+				if err := decoder.Decode(&schema); err != nil {
+					return err
+				}
+
+				ps.Schemas[address] = &schema
+
+				return nil
+			})
+		default:
+			return skipJSONValue(decoder)
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+// decodeJSONObject calls the function with each key of the next JSON object value,
+// which must decode or skip the key value. A null value is treated as an empty object.
+func decodeJSONObject(decoder *json.Decoder, f func(key string) error) error {
+	token, err := decoder.Token()
+
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected JSON object, got: %v", token)
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return err
+		}
+
+		key, ok := token.(string)
+
+		if !ok {
+			return fmt.Errorf("expected JSON object key, got: %v", token)
+		}
+
+		if err := f(key); err != nil {
+			return err
+		}
+	}
+
+	// Closing delimiter
+	_, err = decoder.Token()
+
+	return err
+}
+
+// skipJSONValue reads the next JSON value, including nested values, without
+// retaining it.
+func skipJSONValue(decoder *json.Decoder) error {
+	var depth int
+
+	for {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return err
+		}
+
+		if delim, ok := token.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// providerSchemasActions returns all actions from a terraform providers schema -json provider.
+func providerSchemasActions(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.Schema {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		hclog.L().Warn("Provider source and name not found in provider schema", "provider", providerName, "provider_source", providerSource)
+		return nil
+	}
+
+	actions := make([]string, 0, len(provider.ActionSchemas))
+	schemas := make(map[string]*tfjson.Schema, len(provider.ActionSchemas))
+
+	for name, actionSchema := range provider.ActionSchemas {
+		actions = append(actions, name)
+
+		// Action schemas only contain a block, which is converted for the
+		// schema based checks shared with data sources and resources.
+		schemas[name] = &tfjson.Schema{
+			Block: actionSchema.Block,
+		}
+	}
+
+	sort.Strings(actions)
+
+	hclog.L().Debug("Found provider schema actions", "actions", actions)
+
+	return schemas
+}
+
+// providerSchemasDataSources returns all data sources from a terraform providers schema -json provider.
+func providerSchemasDataSources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.Schema {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		hclog.L().Warn("Provider source and name not found in provider schema", "provider", providerName, "provider_source", providerSource)
+		return nil
+	}
+
+	dataSources := make([]string, 0, len(provider.DataSourceSchemas))
+
+	for name := range provider.DataSourceSchemas {
+		dataSources = append(dataSources, name)
+	}
+
+	sort.Strings(dataSources)
+
+	hclog.L().Debug("Found provider schema data sources", "data_sources", dataSources)
+
+	return provider.DataSourceSchemas
+}
+
+// providerSchemasListResources returns all list resources from a terraform providers schema -json provider.
+func providerSchemasListResources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.Schema {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		hclog.L().Warn("Provider source and name not found in provider schema", "provider", providerName, "provider_source", providerSource)
+		return nil
+	}
+
+	listResources := make([]string, 0, len(provider.ListResourceSchemas))
+
+	for name := range provider.ListResourceSchemas {
+		listResources = append(listResources, name)
+	}
+
+	sort.Strings(listResources)
+
+	hclog.L().Debug("Found provider schema list resources", "list_resources", listResources)
+
+	return provider.ListResourceSchemas
+}
+
+// providerSchemasProvider returns the provider configuration schema from a terraform providers schema -json provider.
+func providerSchemasProvider(ps *tfjson.ProviderSchemas, providerName string, providerSource string) *tfjson.Schema {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		hclog.L().Warn("Provider source and name not found in provider schema", "provider", providerName, "provider_source", providerSource)
+		return nil
+	}
+
+	return provider.ConfigSchema
+}
+
+// providerSchemasResourceIdentities returns all resource identity schemas from a terraform providers schema -json provider.
+func providerSchemasResourceIdentities(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.IdentitySchema {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		hclog.L().Warn("Provider source and name not found in provider schema", "provider", providerName, "provider_source", providerSource)
+		return nil
+	}
+
+	resources := make([]string, 0, len(provider.ResourceIdentitySchemas))
+
+	for name := range provider.ResourceIdentitySchemas {
+		resources = append(resources, name)
+	}
+
+	sort.Strings(resources)
+
+	hclog.L().Debug("Found provider schema resource identities", "resources", resources)
+
+	return provider.ResourceIdentitySchemas
+}
+
+// providerSchemasResources returns all resources from a terraform providers schema -json provider.
+func providerSchemasResources(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.Schema {
 	if ps == nil || ps.Schemas == nil {
 		return nil
 	}
@@ -427,7 +2694,7 @@ func providerSchemasResources(ps *tfjson.ProviderSchemas, providerName string, p
 	}
 
 	if !ok {
-		log.Printf("[WARN] Provider source (%s) and name (%s) not found in provider schema", providerSource, providerName)
+		hclog.L().Warn("Provider source and name not found in provider schema", "provider", providerName, "provider_source", providerSource)
 		return nil
 	}
 
@@ -439,7 +2706,104 @@ func providerSchemasResources(ps *tfjson.ProviderSchemas, providerName string, p
 
 	sort.Strings(resources)
 
-	log.Printf("[DEBUG] Found provider schema data sources: %v", resources)
+	hclog.L().Debug("Found provider schema resources", "resources", resources)
 
 	return provider.ResourceSchemas
 }
+
+// providerSchemasFunctions returns all functions from a terraform providers schema -json provider.
+func providerSchemasFunctions(ps *tfjson.ProviderSchemas, providerName string, providerSource string) map[string]*tfjson.FunctionSignature {
+	if ps == nil || ps.Schemas == nil {
+		return nil
+	}
+
+	provider, ok := ps.Schemas[providerSource]
+
+	if !ok {
+		provider, ok = ps.Schemas[providerName]
+	}
+
+	if !ok {
+		hclog.L().Warn("Provider source and name not found in provider schema", "provider", providerName, "provider_source", providerSource)
+		return nil
+	}
+
+	functions := make([]string, 0, len(provider.Functions))
+
+	for name := range provider.Functions {
+		functions = append(functions, name)
+	}
+
+	sort.Strings(functions)
+
+	hclog.L().Debug("Found provider schema functions", "functions", functions)
+
+	return provider.Functions
+}
+
+// fileListDirectories returns the documentation directories limited to the
+// file paths. Paths which are not documentation files are skipped, as hooks
+// may pass any changed file.
+func fileListDirectories(directories map[string][]string, paths []string) map[string][]string {
+	fileDirectories := make(map[string]string)
+
+	for directory, files := range directories {
+		for _, file := range files {
+			fileDirectories[file] = directory
+		}
+	}
+
+	result := make(map[string][]string)
+	seen := make(map[string]bool)
+
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		directory, ok := fileDirectories[path]
+
+		if !ok {
+			hclog.L().Debug("Skipping non-documentation file", "file", path)
+			continue
+		}
+
+		if seen[path] {
+			continue
+		}
+
+		seen[path] = true
+		result[directory] = append(result[directory], path)
+	}
+
+	return result
+}
+
+// aggregateExitCode returns the most severe of the exit codes of checking
+// multiple paths. Errors which prevented checking (1) take precedence over
+// findings (the -findings-exit-code).
+func aggregateExitCode(current int, next int) int {
+	if current == 1 || next == 1 {
+		return 1
+	}
+
+	if current != 0 {
+		return current
+	}
+
+	return next
+}
+
+// stringSliceFlag is a flag.Value which appends each value of a repeated flag.
+type stringSliceFlag []string
+
+func (f *stringSliceFlag) Set(value string) error {
+	*f = append(*f, value)
+
+	return nil
+}
+
+func (f *stringSliceFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return strings.Join(*f, ",")
+}
@@ -0,0 +1,161 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/registry"
+	"github.com/hashicorp/go-hclog"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// compareRegistryVersion outputs the differences between the local
+// documentation and the documentation published in the Terraform Registry for
+// the -compare-registry-version provider version.
+func (c *CheckCommand) compareRegistryVersion(ctx context.Context, config CheckCommandConfig, checkOpts *check.CheckOptions, directories map[string][]string) int {
+	namespace, name, err := providerSourceNamespaceName(config.ProviderSource)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error comparing Terraform Registry version: %s", err))
+		return 1
+	}
+
+	fileOpts := &check.FileOptions{
+		BasePath: config.Path,
+	}
+
+	files, err := check.DocumentationFiles(check.RemoveOtherFiles(directories, checkOpts.Directories), fileOpts, config.ProviderName)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	// Published documentation is only compared for HCL in the default
+	// locale, as CDKTF documentation is generated and localized
+	// documentation is translated from the default locale.
+	local := make(map[string]string, len(files))
+
+	for _, file := range files {
+		if file.CdktfLanguage != "" || file.Locale != "" || file.Type == check.DocumentationTypeUnknown {
+			continue
+		}
+
+		local[file.Path] = file.Subcategory
+	}
+
+	client := registry.NewClient()
+	client.Logger = checkOpts.Log()
+
+	if config.RegistryBaseURL != "" {
+		client.BaseURL = config.RegistryBaseURL
+	}
+
+	published, err := client.ProviderDocs(ctx, namespace, name, config.CompareRegistryVersion)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error comparing Terraform Registry version: %s", err))
+		return 1
+	}
+
+	comparison := registry.Compare(local, published)
+
+	c.Ui.Output(registryComparisonSummary(comparison, namespace+"/"+name, config.CompareRegistryVersion))
+
+	return 0
+}
+
+// providerSourceNamespaceName returns the namespace and name of the provider
+// source address, which may include the hostname.
+func providerSourceNamespaceName(providerSource string) (string, string, error) {
+	if providerSource == "" {
+		return "", "", fmt.Errorf("-provider-source is required (e.g. registry.terraform.io/hashicorp/aws)")
+	}
+
+	parts := strings.Split(providerSource, "/")
+
+	if len(parts) < 2 || len(parts) > 3 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return "", "", fmt.Errorf("invalid provider source (%s), should be in form: [HOSTNAME/]NAMESPACE/NAME", providerSource)
+	}
+
+	return parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+// registryComparisonSummary returns the human readable comparison output.
+func registryComparisonSummary(comparison *registry.Comparison, provider string, version string) string {
+	var output strings.Builder
+
+	fmt.Fprintf(&output, "Compared documentation with %s %s in the Terraform Registry\n", provider, version)
+
+	if !comparison.HasChanges() {
+		fmt.Fprintf(&output, "\nNo changes\n")
+
+		return strings.TrimSpace(output.String())
+	}
+
+	if len(comparison.Added) > 0 {
+		fmt.Fprintf(&output, "\nAdded (%d):\n", len(comparison.Added))
+
+		for _, path := range comparison.Added {
+			fmt.Fprintf(&output, "  %s\n", path)
+		}
+	}
+
+	if len(comparison.Removed) > 0 {
+		fmt.Fprintf(&output, "\nRemoved (%d):\n", len(comparison.Removed))
+
+		for _, path := range comparison.Removed {
+			fmt.Fprintf(&output, "  %s\n", path)
+		}
+	}
+
+	if len(comparison.SubcategoryChanged) > 0 {
+		fmt.Fprintf(&output, "\nSubcategory changed (%d):\n", len(comparison.SubcategoryChanged))
+
+		for _, change := range comparison.SubcategoryChanged {
+			fmt.Fprintf(&output, "  %s: %q => %q\n", change.Path, change.Published, change.Local)
+		}
+	}
+
+	return strings.TrimSpace(output.String())
+}
+
+// providerVersionSchemas downloads the Terraform Provider binary of the
+// -provider-version provider version for the current operating system and
+// architecture from the Terraform Registry and launches it to get its schema.
+func providerVersionSchemas(logger hclog.Logger, version string, providerName string, providerSource string, registryBaseURL string) (*tfjson.ProviderSchemas, error) {
+	namespace, name, err := providerSourceNamespaceName(providerSource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	directory, err := os.MkdirTemp("", "tfproviderdocs-")
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	defer os.RemoveAll(directory)
+
+	client := registry.NewClient()
+	client.Logger = logger
+
+	if registryBaseURL != "" {
+		client.BaseURL = registryBaseURL
+	}
+
+	logger.Debug("Downloading provider binary from Terraform Registry", "provider", name, "namespace", namespace, "version", version)
+
+	path, err := client.DownloadProviderBinary(context.Background(), namespace, name, version, runtime.GOOS, runtime.GOARCH, directory)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return providerBinarySchemas(path, providerName, providerSource)
+}
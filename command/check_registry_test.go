@@ -0,0 +1,112 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/registry"
+)
+
+func TestProviderSourceNamespaceName(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ProviderSource  string
+		ExpectNamespace string
+		ExpectName      string
+		ExpectError     bool
+	}{
+		{
+			Name:        "empty",
+			ExpectError: true,
+		},
+		{
+			Name:           "name only",
+			ProviderSource: "aws",
+			ExpectError:    true,
+		},
+		{
+			Name:            "namespace and name",
+			ProviderSource:  "hashicorp/aws",
+			ExpectNamespace: "hashicorp",
+			ExpectName:      "aws",
+		},
+		{
+			Name:            "hostname",
+			ProviderSource:  "registry.terraform.io/hashicorp/aws",
+			ExpectNamespace: "hashicorp",
+			ExpectName:      "aws",
+		},
+		{
+			Name:           "too many parts",
+			ProviderSource: "registry.terraform.io/hashicorp/aws/extra",
+			ExpectError:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			namespace, name, err := providerSourceNamespaceName(testCase.ProviderSource)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if namespace != testCase.ExpectNamespace || name != testCase.ExpectName {
+				t.Errorf("expected %s/%s, got %s/%s", testCase.ExpectNamespace, testCase.ExpectName, namespace, name)
+			}
+		})
+	}
+}
+
+func TestRegistryComparisonSummary(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Comparison *registry.Comparison
+		Expect     string
+	}{
+		{
+			Name:       "no changes",
+			Comparison: &registry.Comparison{},
+			Expect: `Compared documentation with hashicorp/test v1.0.0 in the Terraform Registry
+
+No changes`,
+		},
+		{
+			Name: "changes",
+			Comparison: &registry.Comparison{
+				Added:   []string{"docs/resources/new.md"},
+				Removed: []string{"docs/resources/old.md"},
+				SubcategoryChanged: []*registry.SubcategoryChange{
+					{
+						Local:     "New Things",
+						Path:      "docs/resources/thing.md",
+						Published: "Things",
+					},
+				},
+			},
+			Expect: `Compared documentation with hashicorp/test v1.0.0 in the Terraform Registry
+
+Added (1):
+  docs/resources/new.md
+
+Removed (1):
+  docs/resources/old.md
+
+Subcategory changed (1):
+  docs/resources/thing.md: "Things" => "New Things"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := registryComparisonSummary(testCase.Comparison, "hashicorp/test", "v1.0.0")
+
+			if got != testCase.Expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -1,12 +1,63 @@
 package command
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/bflad/tfproviderdocs/check"
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
+func TestAggregateExitCode(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Current int
+		Next    int
+		Expect  int
+	}{
+		{
+			Name:   "no findings",
+			Expect: 0,
+		},
+		{
+			Name:   "findings",
+			Next:   DefaultFindingsExitCode,
+			Expect: DefaultFindingsExitCode,
+		},
+		{
+			Name:    "findings then no findings",
+			Current: DefaultFindingsExitCode,
+			Expect:  DefaultFindingsExitCode,
+		},
+		{
+			Name:    "findings then error",
+			Current: DefaultFindingsExitCode,
+			Next:    1,
+			Expect:  1,
+		},
+		{
+			Name:    "error then findings",
+			Current: 1,
+			Next:    DefaultFindingsExitCode,
+			Expect:  1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := aggregateExitCode(testCase.Current, testCase.Next)
+
+			if got != testCase.Expect {
+				t.Errorf("expected: %d, got: %d", testCase.Expect, got)
+			}
+		})
+	}
+}
+
 func TestAllowedSubcategoriesFile(t *testing.T) {
 	testCases := []struct {
 		Name        string
@@ -51,6 +102,1159 @@ func TestAllowedSubcategoriesFile(t *testing.T) {
 	}
 }
 
+func TestIgnoreFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      []string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/ignore-resources.txt",
+			Expect: []string{
+				"example_one",
+				"example_two",
+			},
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.txt",
+			Expect:      nil,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ignoreFile(testCase.Path)
+			want := testCase.Expect
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected: %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
+func TestForbiddenWordsFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      [][2]string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/forbidden-words.txt",
+			Expect: [][2]string{
+				{"whitelist", "allowlist"},
+				{"blacklist", "denylist"},
+				{"Legacy Product", ""},
+			},
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.txt",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := forbiddenWordsFile(testCase.Path)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			var gotWords [][2]string
+
+			for _, word := range got {
+				gotWords = append(gotWords, [2]string{word.Phrase, word.Replacement})
+			}
+
+			if !reflect.DeepEqual(gotWords, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, gotWords)
+			}
+		})
+	}
+}
+
+func TestSubcategoryMappingFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		Expect      [][2]string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/subcategory-mapping.txt",
+			Expect: [][2]string{
+				{"aws_s3_*", "S3 (Simple Storage)"},
+				{"aws_instance", "EC2"},
+			},
+		},
+		{
+			Name:        "missing subcategory",
+			Path:        "testdata/invalid-subcategory-mapping.txt",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.txt",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := subcategoryMappingFile(testCase.Path)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			var gotMappings [][2]string
+
+			for _, mapping := range got {
+				gotMappings = append(gotMappings, [2]string{mapping.Pattern, mapping.Subcategory})
+			}
+
+			if !reflect.DeepEqual(gotMappings, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, gotMappings)
+			}
+		})
+	}
+}
+
+func TestFrontMatterSchemaFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/frontmatter-schema.json",
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/does-not-exist.json",
+			ExpectError: true,
+		},
+		{
+			Name:        "unsupported keyword",
+			Path:        "testdata/invalid-frontmatter-schema.json",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := frontMatterSchemaFile(testCase.Path)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if got == nil && !testCase.ExpectError {
+				t.Errorf("expected schema, got none")
+			}
+		})
+	}
+}
+
+func TestCheckCommandConfigCheckOptions(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      *CheckCommandConfig
+		Expect      []string
+		ExpectError bool
+	}{
+		{
+			Name:   "defaults",
+			Config: &CheckCommandConfig{},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable checks",
+			Config: &CheckCommandConfig{
+				EnableChecks: "frontmatter,contents",
+			},
+			Expect: []string{
+				check.CheckIDContents,
+				check.CheckIDFrontMatter,
+			},
+		},
+		{
+			Name: "require examples",
+			Config: &CheckCommandConfig{
+				RequireExamples: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDExamples,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable cross-references check",
+			Config: &CheckCommandConfig{
+				EnableCrossReferencesCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDCrossReferences,
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable example-secrets check",
+			Config: &CheckCommandConfig{
+				EnableExampleSecretsCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDExampleSecrets,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable paired-documentation check",
+			Config: &CheckCommandConfig{
+				EnablePairedDocumentationCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDPairedDocumentation,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "require paired links",
+			Config: &CheckCommandConfig{
+				RequirePairedLinks: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDPairedDocumentation,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable raw-html check",
+			Config: &CheckCommandConfig{
+				EnableRawHTMLCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRawHTML,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "allowed html tags",
+			Config: &CheckCommandConfig{
+				AllowedHTMLTags: "img",
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRawHTML,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable legacy-navigation check",
+			Config: &CheckCommandConfig{
+				EnableLegacyNavigationCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDLegacyNavigation,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable nested-schema-links check",
+			Config: &CheckCommandConfig{
+				EnableNestedSchemaLinksCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNestedSchemaLinks,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable guide-filenames check",
+			Config: &CheckCommandConfig{
+				EnableGuideFilenamesCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDGuideFilenames,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "guide filename separator",
+			Config: &CheckCommandConfig{
+				GuideFilenameSeparator: check.GuideFilenameSeparatorDash,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDGuideFilenames,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable file-name check",
+			Config: &CheckCommandConfig{
+				EnableFileNameCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileName,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable frontmatter-page-title check",
+			Config: &CheckCommandConfig{
+				EnableFrontMatterPageTitleCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDFrontMatterPageTitle,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable subcategory-consistency check",
+			Config: &CheckCommandConfig{
+				EnableSubcategoryConsistencyCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDSubcategoryConsistency,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable guide-links check",
+			Config: &CheckCommandConfig{
+				EnableGuideLinksCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDGuideLinks,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "require linked guides",
+			Config: &CheckCommandConfig{
+				RequireLinkedGuides: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDGuideLinks,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable format-verbs check",
+			Config: &CheckCommandConfig{
+				EnableFormatVerbsCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFormatVerbs,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable title-heading check",
+			Config: &CheckCommandConfig{
+				EnableTitleHeadingCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTitleHeading,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "enable checks without requirements",
+			Config: &CheckCommandConfig{
+				EnableChecks: "file-missing",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "disable checks",
+			Config: &CheckCommandConfig{
+				DisableChecks:       "contents,frontmatter",
+				EnableContentsCheck: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDImageReferences,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "invalid check identifier",
+			Config: &CheckCommandConfig{
+				DisableChecks: "not-a-check",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "profile registry-publish",
+			Config: &CheckCommandConfig{
+				Profile:                  "registry-publish",
+				RequireRegistryStructure: true,
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDDirectoryStructure,
+				check.CheckIDFileExtension,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+			},
+		},
+		{
+			Name: "profile strict",
+			Config: &CheckCommandConfig{
+				Profile: "strict",
+			},
+			Expect: []string{
+				check.CheckIDContents,
+				check.CheckIDContentsAttributeDescriptions,
+				check.CheckIDContentsCallouts,
+				check.CheckIDContentsDuplicateAttributes,
+				check.CheckIDContentsExampleReferences,
+				check.CheckIDContentsImportBlock,
+				check.CheckIDContentsMetaArguments,
+				check.CheckIDContentsSchemaOrdering,
+				check.CheckIDCrossReferences,
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDDirectoryOverlapping,
+				check.CheckIDExampleDataSource,
+				check.CheckIDExamplePunctuation,
+				check.CheckIDExampleSecrets,
+				check.CheckIDExampleUsage,
+				check.CheckIDExamples,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileLineEndings,
+				check.CheckIDFileName,
+				check.CheckIDFileSize,
+				check.CheckIDFormatVerbs,
+				check.CheckIDFrontMatter,
+				check.CheckIDFrontMatterPageTitle,
+				check.CheckIDGuideFilenames,
+				check.CheckIDGuideLinks,
+				check.CheckIDImageReferences,
+				check.CheckIDLegacyNavigation,
+				check.CheckIDNestedSchemaLinks,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDPairedDocumentation,
+				check.CheckIDRawHTML,
+				check.CheckIDRegistryManifest,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategoryBlank,
+				check.CheckIDSubcategoryConsistency,
+				check.CheckIDTemplate,
+				check.CheckIDTitleHeading,
+				check.CheckIDTranslations,
+			},
+		},
+		{
+			Name: "profile minimal maximum subcategory entries",
+			Config: &CheckCommandConfig{
+				MaximumSubcategoryEntries: 50,
+				Profile:                   "minimal",
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDExampleDataSource,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileLineEndings,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDFrontMatterPageTitle,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDRequiredProviders,
+				check.CheckIDSubcategorySize,
+				check.CheckIDTemplate,
+			},
+		},
+		{
+			Name: "profile minimal maximum subcategories",
+			Config: &CheckCommandConfig{
+				MaximumSubcategories: 40,
+				Profile:              "minimal",
+			},
+			Expect: []string{
+				check.CheckIDDirectoryInvalid,
+				check.CheckIDDirectoryMixed,
+				check.CheckIDExampleDataSource,
+				check.CheckIDFileEncoding,
+				check.CheckIDFileExtension,
+				check.CheckIDFileLineEndings,
+				check.CheckIDFileSize,
+				check.CheckIDFrontMatter,
+				check.CheckIDFrontMatterPageTitle,
+				check.CheckIDNumberOfFiles,
+				check.CheckIDNumberOfGuides,
+				check.CheckIDNumberOfSubcategories,
+				check.CheckIDRequiredProviders,
+				check.CheckIDTemplate,
+			},
+		},
+		{
+			Name: "invalid guide filename separator",
+			Config: &CheckCommandConfig{
+				GuideFilenameSeparator: "space",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid require file extension",
+			Config: &CheckCommandConfig{
+				RequireFileExtension: ".txt",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid schema ordering style",
+			Config: &CheckCommandConfig{
+				SchemaOrderingStyle: "not-a-style",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "forbidden and required frontmatter key",
+			Config: &CheckCommandConfig{
+				ForbidFrontMatterKeys:  "layout,sidebar_current",
+				RequireFrontMatterKeys: "description,layout",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid source repository",
+			Config: &CheckCommandConfig{
+				SourceRepository: "hashicorp",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "missing attributes report without schemas",
+			Config: &CheckCommandConfig{
+				EnableContentsCheck: true,
+				MissingAttributes:   check.NewMissingAttributes(),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid profile",
+			Config: &CheckCommandConfig{
+				Profile: "not-a-profile",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "provider version and provider binary",
+			Config: &CheckCommandConfig{
+				ProviderBinary:  "terraform-provider-test",
+				ProviderSource:  "hashicorp/test",
+				ProviderVersion: "1.0.0",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "require new documentation without schemas",
+			Config: &CheckCommandConfig{
+				RequireNewDocumentation: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid ignore file",
+			Config: &CheckCommandConfig{
+				IgnoreFileMissingResourcesFile: "testdata/does-not-exist.txt",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "provider version without provider source",
+			Config: &CheckCommandConfig{
+				ProviderVersion: "1.0.0",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config.ProviderName = "test"
+
+			checkOpts, err := testCase.Config.CheckOptions()
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			got := checkOpts.EnabledChecks()
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestCheckSummary(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Summary  *check.CheckSummary
+		Findings []*check.Finding
+		Expect   string
+	}{
+		{
+			Name:    "nil summary",
+			Summary: nil,
+			Expect: `Checked 0 documentation files in 1.5s
+
+No findings
+
+Ignored findings: 0`,
+		},
+		{
+			Name: "files and findings",
+			Summary: &check.CheckSummary{
+				Files: map[string]int{
+					"registry resource":    3,
+					"registry data source": 2,
+				},
+				IgnoredFindings: 1,
+			},
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", errors.New("test")),
+				check.NewFinding(check.CheckIDFileMissing, "", errors.New("test")),
+				check.NewFinding(check.CheckIDFrontMatter, "docs/resources/other.md", errors.New("test")),
+			},
+			Expect: `Checked 5 documentation files in 1.5s
+
+TYPE                  FILES
+registry data source  2
+registry resource     3
+
+CHECK         FINDINGS
+file-missing  1
+frontmatter   2
+
+Ignored findings: 1`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := checkSummary(testCase.Summary, testCase.Findings, 1500*time.Millisecond)
+
+			if got != testCase.Expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestCheckTimings(t *testing.T) {
+	timings := &check.Timings{
+		Checks: map[string]time.Duration{
+			check.CheckIDContents:    3 * time.Millisecond,
+			check.CheckIDFrontMatter: time.Millisecond,
+		},
+		Directories: map[string]time.Duration{
+			"docs/resources": 5 * time.Millisecond,
+		},
+	}
+
+	got := checkTimings(timings, 2)
+	expect := `Slowest checks and directories:
+
+TYPE       NAME            TIME
+directory  docs/resources  5ms
+check      contents        3ms`
+
+	if got != expect {
+		t.Errorf("expected:\n%s\n\ngot:\n%s", expect, got)
+	}
+
+	if got, expect := checkTimings(check.NewTimings(), 2), "No timings recorded"; got != expect {
+		t.Errorf("expected: %s, got: %s", expect, got)
+	}
+}
+
+func TestCheckSummaryLine(t *testing.T) {
+	summary := &check.CheckSummary{
+		Files: map[string]int{
+			"registry resource":    3,
+			"registry data source": 2,
+		},
+		IgnoredFindings: 1,
+	}
+	findings := []*check.Finding{
+		check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", errors.New("test")),
+	}
+
+	got := checkSummaryLine(summary, findings, 1500*time.Millisecond)
+	expect := "Checked 5 documentation files in 1.5s: 1 findings, 1 ignored findings"
+
+	if got != expect {
+		t.Errorf("expected: %s, got: %s", expect, got)
+	}
+}
+
+func TestFileListDirectories(t *testing.T) {
+	directories := map[string][]string{
+		"docs":           {"docs/index.md"},
+		"docs/resources": {"docs/resources/other.md", "docs/resources/thing.md"},
+	}
+
+	testCases := []struct {
+		Name   string
+		Paths  []string
+		Expect map[string][]string
+	}{
+		{
+			Name:   "no paths",
+			Expect: map[string][]string{},
+		},
+		{
+			Name:  "documentation files",
+			Paths: []string{"docs/resources/thing.md", "./docs/index.md"},
+			Expect: map[string][]string{
+				"docs":           {"docs/index.md"},
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+		},
+		{
+			Name:  "duplicate and other files",
+			Paths: []string{"docs/resources/thing.md", "main.go", "docs/resources/thing.md", "docs/resources/missing.md"},
+			Expect: map[string][]string{
+				"docs/resources": {"docs/resources/thing.md"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := fileListDirectories(directories, testCase.Paths)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestOutputLogLevel(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Quiet   bool
+		Verbose bool
+		Expect  string
+	}{
+		{
+			Name:   "default",
+			Expect: "WARN",
+		},
+		{
+			Name:   "quiet",
+			Quiet:  true,
+			Expect: "ERROR",
+		},
+		{
+			Name:    "verbose",
+			Verbose: true,
+			Expect:  "INFO",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := outputLogLevel(testCase.Quiet, testCase.Verbose)
+
+			if got != testCase.Expect {
+				t.Errorf("expected: %s, got: %s", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestDetectProviderName(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ProviderName   string
+		ProviderSource string
+		Path           string
+		Expect         string
+	}{
+		{
+			Name:           "provider name",
+			ProviderName:   "test",
+			ProviderSource: "registry.terraform.io/example/example",
+			Path:           "/path/to/terraform-provider-example",
+			Expect:         "test",
+		},
+		{
+			Name:           "provider source",
+			ProviderSource: "registry.terraform.io/example/test",
+			Path:           "/path/to/terraform-provider-example",
+			Expect:         "test",
+		},
+		{
+			Name:   "path",
+			Path:   "/path/to/terraform-provider-test",
+			Expect: "test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := detectProviderName(testCase.ProviderName, testCase.ProviderSource, testCase.Path)
+
+			if want != got {
+				t.Errorf("expected: %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
 func TestProviderNameFromPath(t *testing.T) {
 	testCases := []struct {
 		Name   string
@@ -73,19 +1277,81 @@ func TestProviderNameFromPath(t *testing.T) {
 			Expect: "",
 		},
 		{
-			Name:   "relative path with prefix",
-			Path:   "terraform-provider-test",
-			Expect: "test",
+			Name:   "relative path with prefix",
+			Path:   "terraform-provider-test",
+			Expect: "test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := providerNameFromPath(testCase.Path)
+
+			if want != got {
+				t.Errorf("expected: %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestProviderPaths(t *testing.T) {
+	root := t.TempDir()
+
+	for _, directory := range []string{
+		".terraform/terraform-provider-hidden",
+		"providers/terraform-provider-one/docs",
+		"providers/terraform-provider-one/terraform-provider-nested",
+		"providers/terraform-provider-two",
+		"tools",
+	} {
+		if err := os.MkdirAll(filepath.Join(root, directory), 0755); err != nil {
+			t.Fatalf("unexpected error creating directory: %s", err)
+		}
+	}
+
+	testCases := []struct {
+		Name        string
+		Roots       []string
+		Expect      []string
+		ExpectError bool
+	}{
+		{
+			Name:  "root",
+			Roots: []string{root},
+			Expect: []string{
+				filepath.Join(root, "providers/terraform-provider-one"),
+				filepath.Join(root, "providers/terraform-provider-two"),
+			},
+		},
+		{
+			Name:  "provider",
+			Roots: []string{filepath.Join(root, "providers/terraform-provider-two")},
+			Expect: []string{
+				filepath.Join(root, "providers/terraform-provider-two"),
+			},
+		},
+		{
+			Name:        "no providers",
+			Roots:       []string{filepath.Join(root, "tools")},
+			ExpectError: true,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			want := testCase.Expect
-			got := providerNameFromPath(testCase.Path)
+			got, err := providerPaths(testCase.Roots)
 
-			if want != got {
-				t.Errorf("expected: %s, got: %s", want, got)
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
 			}
 		})
 	}
@@ -93,13 +1359,37 @@ func TestProviderNameFromPath(t *testing.T) {
 
 func TestProviderSchemas(t *testing.T) {
 	testCases := []struct {
-		Name        string
-		Path        string
-		ExpectError bool
+		Name           string
+		Path           string
+		ProviderName   string
+		ProviderSource string
+		ExpectError    bool
+		ExpectSchemas  []string
 	}{
 		{
-			Name: "valid",
-			Path: "testdata/valid-providers-schema.json",
+			Name:          "valid",
+			Path:          "testdata/valid-providers-schema.json",
+			ProviderName:  "null",
+			ExpectSchemas: []string{"null"},
+		},
+		{
+			Name:          "valid multiple providers",
+			Path:          "testdata/valid-providers-schema-multiple.json",
+			ProviderName:  "test",
+			ExpectSchemas: []string{},
+		},
+		{
+			Name:           "valid multiple providers source",
+			Path:           "testdata/valid-providers-schema-multiple.json",
+			ProviderName:   "test",
+			ProviderSource: "registry.terraform.io/hashicorp/test",
+			ExpectSchemas:  []string{"registry.terraform.io/hashicorp/test"},
+		},
+		{
+			Name:          "valid other provider",
+			Path:          "testdata/valid-providers-schema.json",
+			ProviderName:  "other",
+			ExpectSchemas: []string{},
 		},
 		{
 			Name:        "invalid path",
@@ -120,7 +1410,7 @@ func TestProviderSchemas(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			_, err := providerSchemas(testCase.Path)
+			ps, err := providerSchemas(testCase.Path, testCase.ProviderName, testCase.ProviderSource)
 
 			if err == nil && testCase.ExpectError {
 				t.Errorf("expected error, got no error")
@@ -129,10 +1419,49 @@ func TestProviderSchemas(t *testing.T) {
 			if err != nil && !testCase.ExpectError {
 				t.Errorf("expected no error, got error: %s", err)
 			}
+
+			if err != nil {
+				return
+			}
+
+			got := make([]string, 0, len(ps.Schemas))
+
+			for address := range ps.Schemas {
+				got = append(got, address)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectSchemas) {
+				t.Errorf("expected schemas %v, got: %v", testCase.ExpectSchemas, got)
+			}
 		})
 	}
 }
 
+func TestProviderSchemasStdin(t *testing.T) {
+	file, err := os.Open("testdata/valid-providers-schema.json")
+
+	if err != nil {
+		t.Fatalf("unexpected error opening file: %s", err)
+	}
+
+	defer file.Close()
+
+	stdin := os.Stdin
+	os.Stdin = file
+
+	defer func() { os.Stdin = stdin }()
+
+	ps, err := providerSchemas(ProvidersSchemaJsonStdin, "null", "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if _, ok := ps.Schemas["null"]; !ok {
+		t.Errorf("expected null provider schema, got: %v", ps.Schemas)
+	}
+}
+
 func TestProviderSchemasDataSources(t *testing.T) {
 	testCases := []struct {
 		Name            string
@@ -332,3 +1661,254 @@ func TestProviderSchemasResources(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderSchemasActions(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ProviderName    string
+		ProviderSource  string
+		ProvidersSchema *tfjson.ProviderSchemas
+		Expect          map[string]*tfjson.Schema
+	}{
+		{
+			Name:            "no providers schemas",
+			ProviderName:    "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{},
+			Expect:          nil,
+		},
+		{
+			Name:         "provider name not found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+				},
+			},
+			Expect: nil,
+		},
+		{
+			Name:         "provider name found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+					"test": {
+						ActionSchemas: map[string]*tfjson.ActionSchema{
+							"test_action1": {},
+							"test_action2": {
+								Block: &tfjson.SchemaBlock{},
+							},
+						},
+						ResourceSchemas: map[string]*tfjson.Schema{
+							"test_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: map[string]*tfjson.Schema{
+				"test_action1": {},
+				"test_action2": {
+					Block: &tfjson.SchemaBlock{},
+				},
+			},
+		},
+		{
+			Name:           "provider source found",
+			ProviderSource: "registry.terraform.io/test/test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/test/incorrect": {},
+					"registry.terraform.io/test/test": {
+						ActionSchemas: map[string]*tfjson.ActionSchema{
+							"test_action1": {},
+						},
+					},
+				},
+			},
+			Expect: map[string]*tfjson.Schema{
+				"test_action1": {},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := providerSchemasActions(testCase.ProvidersSchema, testCase.ProviderName, testCase.ProviderSource)
+
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("mismatch:\n\nwant:\n\n%v\n\ngot:\n\n%v\n\n", want, got)
+			}
+		})
+	}
+}
+
+func TestProviderSchemasListResources(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ProviderName    string
+		ProviderSource  string
+		ProvidersSchema *tfjson.ProviderSchemas
+		Expect          map[string]*tfjson.Schema
+	}{
+		{
+			Name:            "no providers schemas",
+			ProviderName:    "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{},
+			Expect:          nil,
+		},
+		{
+			Name:         "provider name not found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+				},
+			},
+			Expect: nil,
+		},
+		{
+			Name:         "provider name found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+					"test": {
+						ListResourceSchemas: map[string]*tfjson.Schema{
+							"test_list_resource1": {},
+							"test_list_resource2": {
+								Block: &tfjson.SchemaBlock{},
+							},
+						},
+						ResourceSchemas: map[string]*tfjson.Schema{
+							"test_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: map[string]*tfjson.Schema{
+				"test_list_resource1": {},
+				"test_list_resource2": {
+					Block: &tfjson.SchemaBlock{},
+				},
+			},
+		},
+		{
+			Name:           "provider source found",
+			ProviderSource: "registry.terraform.io/test/test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/test/incorrect": {},
+					"registry.terraform.io/test/test": {
+						ListResourceSchemas: map[string]*tfjson.Schema{
+							"test_list_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: map[string]*tfjson.Schema{
+				"test_list_resource1": {},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := providerSchemasListResources(testCase.ProvidersSchema, testCase.ProviderName, testCase.ProviderSource)
+
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("mismatch:\n\nwant:\n\n%v\n\ngot:\n\n%v\n\n", want, got)
+			}
+		})
+	}
+}
+
+func TestProviderSchemasResourceIdentities(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ProviderName    string
+		ProviderSource  string
+		ProvidersSchema *tfjson.ProviderSchemas
+		Expect          map[string]*tfjson.IdentitySchema
+	}{
+		{
+			Name:            "no providers schemas",
+			ProviderName:    "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{},
+			Expect:          nil,
+		},
+		{
+			Name:         "provider name not found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+				},
+			},
+			Expect: nil,
+		},
+		{
+			Name:         "provider name found",
+			ProviderName: "test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"incorrect": {},
+					"test": {
+						ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+							"test_resource1": {},
+							"test_resource2": {
+								Attributes: map[string]*tfjson.IdentityAttribute{
+									"id": {
+										RequiredForImport: true,
+									},
+								},
+							},
+						},
+						ResourceSchemas: map[string]*tfjson.Schema{
+							"test_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: map[string]*tfjson.IdentitySchema{
+				"test_resource1": {},
+				"test_resource2": {
+					Attributes: map[string]*tfjson.IdentityAttribute{
+						"id": {
+							RequiredForImport: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:           "provider source found",
+			ProviderSource: "registry.terraform.io/test/test",
+			ProvidersSchema: &tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/test/incorrect": {},
+					"registry.terraform.io/test/test": {
+						ResourceIdentitySchemas: map[string]*tfjson.IdentitySchema{
+							"test_resource1": {},
+						},
+					},
+				},
+			},
+			Expect: map[string]*tfjson.IdentitySchema{
+				"test_resource1": {},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			want := testCase.Expect
+			got := providerSchemasResourceIdentities(testCase.ProvidersSchema, testCase.ProviderName, testCase.ProviderSource)
+
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("mismatch:\n\nwant:\n\n%v\n\ngot:\n\n%v\n\n", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package command
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/fatih/color"
+	"github.com/fsnotify/fsnotify"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// DefaultWatchDelay is the duration without further file changes before
+	// checks are re-run, since editors may write a file multiple times.
+	DefaultWatchDelay = 250 * time.Millisecond
+)
+
+// watch runs the checks, then re-runs them for changed files until
+// interrupted. Only the checks of individual files are re-run when existing
+// documentation files are written, otherwise all checks are re-run since
+// created, removed, or renamed files affect checks across files.
+func (c *CheckCommand) watch(config CheckCommandConfig, checkOpts *check.CheckOptions) int {
+	watcher, err := fsnotify.NewWatcher()
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error watching Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	defer watcher.Close()
+
+	watchDirectories := []string{check.RegistryIndexDirectory, check.LegacyIndexDirectory, check.TemplatesDirectory}
+
+	if config.DocsDirs != "" {
+		watchDirectories = append(strings.Split(config.DocsDirs, ","), check.TemplatesDirectory)
+	}
+
+	for _, directory := range watchDirectories {
+		if err := addWatchDirectories(watcher, filepath.Join(config.Path, directory)); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error watching Terraform Provider documentation: %s", err))
+			return 1
+		}
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	c.watchCheck(config, checkOpts, nil)
+	c.Ui.Output("\nWatching for documentation changes (press Ctrl+C to stop)...")
+
+	changes := make(map[string]fsnotify.Op)
+	timer := time.NewTimer(DefaultWatchDelay)
+	timer.Stop()
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return 0
+			}
+
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+
+			if event.Has(fsnotify.Create) {
+				if fi, err := os.Stat(event.Name); err == nil && fi.IsDir() {
+					if err := addWatchDirectories(watcher, event.Name); err != nil {
+						hclog.L().Warn("Unable to watch directory", "directory", event.Name, "error", err)
+					}
+				}
+			}
+
+			path, err := filepath.Rel(config.Path, event.Name)
+
+			if err != nil {
+				path = event.Name
+			}
+
+			changes[path] |= event.Op
+			timer.Reset(DefaultWatchDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return 0
+			}
+
+			hclog.L().Warn("Error watching Terraform Provider documentation", "error", err)
+		case <-timer.C:
+			c.watchCheck(config, checkOpts, changes)
+			changes = make(map[string]fsnotify.Op)
+		case <-interrupt:
+			return 0
+		}
+	}
+}
+
+// watchCheck runs the checks and outputs the findings and a single line
+// summary. All checks are run if changes is nil or requires it.
+func (c *CheckCommand) watchCheck(config CheckCommandConfig, checkOpts *check.CheckOptions, changes map[string]fsnotify.Op) {
+	directories, err := config.Directories()
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return
+	}
+
+	startTime := time.Now()
+	docsCheck := check.NewCheck(checkOpts)
+
+	if changedDirectories, ok := watchChangedDirectories(directories, changes); ok {
+		c.Ui.Output(fmt.Sprintf("\nChecking changed files: %s", strings.Join(watchChangedPaths(changes), ", ")))
+		err = docsCheck.RunFiles(changedDirectories)
+	} else {
+		if changes != nil {
+			c.Ui.Output(fmt.Sprintf("\nChecking all files after changes: %s", strings.Join(watchChangedPaths(changes), ", ")))
+		}
+
+		err = docsCheck.Run(directories)
+	}
+
+	findings, errs := check.Findings(err)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error checking Terraform Provider documentation:\n\n%s\n", newFindingsFormatter(!color.NoColor).Format(findings, errs)))
+	}
+
+	c.Ui.Output(checkSummaryLine(docsCheck.Summary, findings, time.Since(startTime)))
+}
+
+// watchChangedDirectories returns the documentation directories limited to
+// the changed files. It returns false if all checks should be run instead,
+// such as when files were created, removed, or renamed or a changed file is
+// not a documentation file (e.g. a template).
+func watchChangedDirectories(directories map[string][]string, changes map[string]fsnotify.Op) (map[string][]string, bool) {
+	if len(changes) == 0 {
+		return nil, false
+	}
+
+	files := make(map[string]string)
+
+	for directory, directoryFiles := range directories {
+		for _, file := range directoryFiles {
+			files[file] = directory
+		}
+	}
+
+	result := make(map[string][]string)
+
+	for path, op := range changes {
+		if op.Has(fsnotify.Create) || op.Has(fsnotify.Remove) || op.Has(fsnotify.Rename) {
+			return nil, false
+		}
+
+		directory, ok := files[path]
+
+		if !ok {
+			return nil, false
+		}
+
+		result[directory] = append(result[directory], path)
+	}
+
+	for directory := range result {
+		sort.Strings(result[directory])
+	}
+
+	return result, true
+}
+
+// watchChangedPaths returns the sorted changed paths.
+func watchChangedPaths(changes map[string]fsnotify.Op) []string {
+	paths := make([]string, 0, len(changes))
+
+	for path := range changes {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+// addWatchDirectories adds the directory and its subdirectories to the
+// watcher, as watches are not recursive. Missing directories are skipped.
+func addWatchDirectories(watcher *fsnotify.Watcher, root string) error {
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		return nil
+	}
+
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		hclog.L().Debug("Watching directory", "directory", path)
+
+		return watcher.Add(path)
+	})
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestWatchChangedDirectories(t *testing.T) {
+	directories := map[string][]string{
+		"docs/data-sources": {"docs/data-sources/thing.md"},
+		"docs/resources":    {"docs/resources/other.md", "docs/resources/thing.md"},
+	}
+
+	testCases := []struct {
+		Name              string
+		Changes           map[string]fsnotify.Op
+		ExpectDirectories map[string][]string
+		ExpectOk          bool
+	}{
+		{
+			Name: "no changes",
+		},
+		{
+			Name: "written files",
+			Changes: map[string]fsnotify.Op{
+				"docs/data-sources/thing.md": fsnotify.Write,
+				"docs/resources/thing.md":    fsnotify.Write,
+			},
+			ExpectDirectories: map[string][]string{
+				"docs/data-sources": {"docs/data-sources/thing.md"},
+				"docs/resources":    {"docs/resources/thing.md"},
+			},
+			ExpectOk: true,
+		},
+		{
+			Name: "created file",
+			Changes: map[string]fsnotify.Op{
+				"docs/resources/thing.md": fsnotify.Create | fsnotify.Write,
+			},
+		},
+		{
+			Name: "removed file",
+			Changes: map[string]fsnotify.Op{
+				"docs/resources/thing.md": fsnotify.Remove,
+			},
+		},
+		{
+			Name: "renamed file",
+			Changes: map[string]fsnotify.Op{
+				"docs/resources/thing.md": fsnotify.Rename,
+			},
+		},
+		{
+			Name: "non-documentation file",
+			Changes: map[string]fsnotify.Op{
+				"docs/resources/thing.md":           fsnotify.Write,
+				"templates/resources/thing.md.tmpl": fsnotify.Write,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, ok := watchChangedDirectories(directories, testCase.Changes)
+
+			if ok != testCase.ExpectOk {
+				t.Fatalf("expected ok %t, got: %t", testCase.ExpectOk, ok)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectDirectories) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectDirectories, got)
+			}
+		})
+	}
+}
+
+func TestAddWatchDirectories(t *testing.T) {
+	root := t.TempDir()
+
+	for _, directory := range []string{"docs/resources", "docs/guides/images"} {
+		if err := os.MkdirAll(filepath.Join(root, directory), 0755); err != nil {
+			t.Fatalf("error creating directory: %s", err)
+		}
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+
+	if err != nil {
+		t.Fatalf("error creating watcher: %s", err)
+	}
+
+	defer watcher.Close()
+
+	if err := addWatchDirectories(watcher, filepath.Join(root, "docs")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := addWatchDirectories(watcher, filepath.Join(root, "website/docs")); err != nil {
+		t.Fatalf("unexpected error for missing directory: %s", err)
+	}
+
+	got := watcher.WatchList()
+
+	if len(got) != 4 {
+		t.Errorf("expected 4 watched directories, got: %v", got)
+	}
+}
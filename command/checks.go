@@ -0,0 +1,124 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+)
+
+// ChecksCommand is a Command implementation
+type ChecksCommand struct {
+	Ui cli.Ui
+}
+
+// ChecksCommandCheck is the output representation of an available check.
+type ChecksCommandCheck struct {
+	*check.CheckInfo
+
+	Enabled bool `json:"enabled"`
+}
+
+func (*ChecksCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-json", "Output as JSON.")
+	CheckFlagsHelp(opts)
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs checks [options] [PATH]
+
+  Lists all available checks and whether each is enabled with the given check command
+  options and configuration file.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ChecksCommand) Name() string { return "checks" }
+
+func (c *ChecksCommand) Run(args []string) int {
+	var config CheckCommandConfig
+	var outputJson bool
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.BoolVar(&outputJson, "json", false, "")
+	CheckFlags(flags, &config)
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	checkOpts, err := config.CheckOptions()
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: %s", err))
+		return 1
+	}
+
+	checks := make([]*ChecksCommandCheck, 0, len(check.Checks))
+
+	for _, checkInfo := range check.Checks {
+		checks = append(checks, &ChecksCommandCheck{
+			CheckInfo: checkInfo,
+			Enabled:   checkOpts.CheckEnabled(checkInfo.ID),
+		})
+	}
+
+	if outputJson {
+		output, err := json.MarshalIndent(checks, "", "  ")
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error encoding JSON: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(string(output))
+
+		return 0
+	}
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	output := tabwriter.NewWriter(outputBuffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(output, "ID\tENABLED\tSCHEMA\tDESCRIPTION")
+
+	for _, checksCommandCheck := range checks {
+		fmt.Fprintf(output, "%s\t%t\t%t\t%s\n", checksCommandCheck.ID, checksCommandCheck.Enabled, checksCommandCheck.SchemaDependent, checksCommandCheck.Description)
+	}
+
+	output.Flush()
+	c.Ui.Output(strings.TrimSpace(outputBuffer.String()))
+
+	return 0
+}
+
+func (c *ChecksCommand) Synopsis() string {
+	return "Lists available Terraform Provider documentation checks"
+}
@@ -14,6 +14,57 @@ func Commands(ui cli.Ui) map[string]cli.CommandFactory {
 				Ui: ui,
 			}, nil
 		},
+		"checks": func() (cli.Command, error) {
+			return &ChecksCommand{
+				Ui: ui,
+			}, nil
+		},
+		"compare": func() (cli.Command, error) {
+			return &CompareCommand{
+				Ui: ui,
+			}, nil
+		},
+		"config": func() (cli.Command, error) {
+			return &ConfigCommand{
+				Ui: ui,
+			}, nil
+		},
+		"config validate": func() (cli.Command, error) {
+			return &ConfigValidateCommand{
+				Ui: ui,
+			}, nil
+		},
+		"doctor": func() (cli.Command, error) {
+			return &DoctorCommand{
+				Ui: ui,
+			}, nil
+		},
+		"init": func() (cli.Command, error) {
+			return &InitCommand{
+				Ui: ui,
+			}, nil
+		},
+		"list": func() (cli.Command, error) {
+			return &ListCommand{
+				Ui: ui,
+			}, nil
+		},
+		"lsp": func() (cli.Command, error) {
+			return &LSPCommand{
+				Ui: ui,
+			}, nil
+		},
+		"nav": func() (cli.Command, error) {
+			return &NavCommand{
+				Ui: ui,
+			}, nil
+		},
+		"self-update": func() (cli.Command, error) {
+			return &SelfUpdateCommand{
+				Ui:      ui,
+				Version: version.GetVersion(),
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &VersionCommand{
 				Version: version.GetVersion(),
@@ -0,0 +1,303 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+)
+
+type CompareCommandConfig struct {
+	ConfigFile     string
+	Json           bool
+	LogFormat      string
+	LogLevel       string
+	New            string
+	Old            string
+	Path           string
+	ProviderName   string
+	ProviderSource string
+}
+
+// CompareCommand is a Command implementation
+type CompareCommand struct {
+	Ui cli.Ui
+}
+
+func (*CompareCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-config", fmt.Sprintf("Path to YAML configuration file. Defaults to %s in the new Terraform Provider codebase path, if it exists.", DefaultConfigFile))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-json", "Output as JSON.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-path", "Path to the git repository of the Terraform Provider codebase for git references. Defaults to the current working directory.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if the new path or -path is prefixed with terraform-provider-*.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws). Automatically sets -provider-name by dropping hostname and namespace prefix.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs compare [options] OLD NEW
+
+  Outputs the documentation pages added and removed, and the data source and
+  resource attributes added, removed, or materially changed, between two
+  versions of a Terraform Provider codebase, such as for upgrade guides.
+
+  OLD and NEW are Terraform Provider codebase directories or, otherwise, git
+  references (e.g. v1.0.0 or main) of the -path git repository.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *CompareCommand) Name() string { return "compare" }
+
+func (c *CompareCommand) Run(args []string) int {
+	var config CompareCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.StringVar(&config.ConfigFile, "config", "", "")
+	flags.BoolVar(&config.Json, "json", false, "")
+	flags.StringVar(&config.Path, "path", "", "")
+	flags.StringVar(&config.ProviderName, "provider-name", "", "")
+	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) != 2 {
+		c.Ui.Error("Error comparing documentation: OLD and NEW arguments are required")
+		flags.Usage()
+		return 1
+	}
+
+	config.Old = args[0]
+	config.New = args[1]
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	ctx := context.Background()
+
+	oldPath, oldCleanup, err := comparePath(ctx, config.Path, config.Old)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading old Terraform Provider codebase (%s): %s", config.Old, err))
+		return 1
+	}
+
+	defer oldCleanup()
+
+	newPath, newCleanup, err := comparePath(ctx, config.Path, config.New)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading new Terraform Provider codebase (%s): %s", config.New, err))
+		return 1
+	}
+
+	defer newCleanup()
+
+	if newPath == config.New {
+		config.ProviderName = detectProviderName(config.ProviderName, config.ProviderSource, newPath)
+	} else {
+		config.ProviderName = detectProviderName(config.ProviderName, config.ProviderSource, config.Path)
+	}
+
+	configFile, err := loadConfigFile(config.ConfigFile, newPath)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading configuration file: %s", err))
+		return 1
+	}
+
+	oldOpts := &check.FileOptions{
+		BasePath: oldPath,
+	}
+
+	oldFiles, err := compareDocumentationFiles(oldOpts, configFile, config.ProviderName)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing old Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	newOpts := &check.FileOptions{
+		BasePath: newPath,
+	}
+
+	newFiles, err := compareDocumentationFiles(newOpts, configFile, config.ProviderName)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing new Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	comparison, err := check.CompareDocumentation(oldFiles, oldOpts, newFiles, newOpts, config.ProviderName)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error comparing documentation: %s", err))
+		return 1
+	}
+
+	if config.Json {
+		output, err := json.MarshalIndent(comparison, "", "  ")
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error encoding JSON: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(string(output))
+
+		return 0
+	}
+
+	c.Ui.Output(documentationComparisonSummary(comparison, config.Old, config.New))
+
+	return 0
+}
+
+func (c *CompareCommand) Synopsis() string {
+	return "Compares Terraform Provider documentation between two versions"
+}
+
+// comparePath returns the Terraform Provider codebase path of the compare
+// argument, which is the argument if it is a directory, otherwise the
+// contents of the git reference of the repository extracted into a temporary
+// directory. The returned function removes any temporary directory.
+func comparePath(ctx context.Context, repository string, arg string) (string, func() error, error) {
+	if info, err := os.Stat(arg); err == nil && info.IsDir() {
+		return arg, func() error { return nil }, nil
+	}
+
+	if repository == "" {
+		repository = "."
+	}
+
+	directory, err := os.MkdirTemp("", "tfproviderdocs-git-")
+
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	cleanup := func() error {
+		return os.RemoveAll(directory)
+	}
+
+	archivePath := filepath.Join(directory, "archive.tar.gz")
+
+	if err := checkGitArgument("reference", arg); err != nil {
+		cleanup()
+
+		return "", nil, fmt.Errorf("not a directory or git reference: %w", err)
+	}
+
+	if err := runGit(ctx, repository, "archive", "--format=tar.gz", "--output="+archivePath, arg); err != nil {
+		cleanup()
+
+		return "", nil, fmt.Errorf("not a directory or git reference: %w", err)
+	}
+
+	codebasePath := filepath.Join(directory, "codebase")
+	err = extractTarGz(archivePath, codebasePath, defaultArchiveLimits)
+	os.Remove(archivePath)
+
+	if err != nil {
+		cleanup()
+
+		return "", nil, err
+	}
+
+	return codebasePath, cleanup, nil
+}
+
+// compareDocumentationFiles returns the documentation files of the Terraform
+// Provider codebase with the file options base path.
+func compareDocumentationFiles(opts *check.FileOptions, configFile *ConfigFile, providerName string) ([]*check.DocumentationFile, error) {
+	directories, err := check.GetDirectories(opts.BasePath, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting Terraform Provider documentation directories: %w", err)
+	}
+
+	directories = check.RemoveOtherFiles(directories, configFile.CheckDirectoryOptions())
+
+	return check.DocumentationFiles(directories, opts, providerName)
+}
+
+// documentationComparisonSummary returns the human readable comparison
+// output.
+func documentationComparisonSummary(comparison *check.DocumentationComparison, old string, new string) string {
+	var output strings.Builder
+
+	fmt.Fprintf(&output, "Compared documentation of %s with %s\n", old, new)
+
+	if !comparison.HasChanges() {
+		fmt.Fprintf(&output, "\nNo changes\n")
+
+		return strings.TrimSpace(output.String())
+	}
+
+	if len(comparison.Added) > 0 {
+		fmt.Fprintf(&output, "\nAdded (%d):\n", len(comparison.Added))
+
+		for _, path := range comparison.Added {
+			fmt.Fprintf(&output, "  %s\n", path)
+		}
+	}
+
+	if len(comparison.Removed) > 0 {
+		fmt.Fprintf(&output, "\nRemoved (%d):\n", len(comparison.Removed))
+
+		for _, path := range comparison.Removed {
+			fmt.Fprintf(&output, "  %s\n", path)
+		}
+	}
+
+	if len(comparison.Changed) > 0 {
+		fmt.Fprintf(&output, "\nChanged (%d):\n", len(comparison.Changed))
+
+		for _, change := range comparison.Changed {
+			if change.OldPath != change.Path {
+				fmt.Fprintf(&output, "  %s (was %s)\n", change.Path, change.OldPath)
+			} else {
+				fmt.Fprintf(&output, "  %s\n", change.Path)
+			}
+
+			for _, name := range change.Attributes.Added {
+				fmt.Fprintf(&output, "    + %s\n", name)
+			}
+
+			for _, name := range change.Attributes.Removed {
+				fmt.Fprintf(&output, "    - %s\n", name)
+			}
+
+			for _, attribute := range change.Attributes.Changed {
+				fmt.Fprintf(&output, "    ~ %s\n", attribute)
+			}
+		}
+	}
+
+	return strings.TrimSpace(output.String())
+}
@@ -0,0 +1,147 @@
+package command
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/check/contents"
+	"github.com/mitchellh/cli"
+)
+
+func TestCompareCommand_implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &CompareCommand{}
+}
+
+func TestComparePath(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not found")
+	}
+
+	repository := t.TempDir()
+
+	testGit(t, repository, "init", "--quiet")
+	testGitCommit(t, repository, "docs/index.md", "# Test Provider v1\n")
+	testGit(t, repository, "tag", "v1.0.0")
+	testGitCommit(t, repository, "docs/index.md", "# Test Provider v2\n")
+
+	testCases := []struct {
+		Name        string
+		Arg         string
+		Expect      string
+		ExpectError bool
+	}{
+		{
+			Name:   "directory",
+			Arg:    repository,
+			Expect: "# Test Provider v2\n",
+		},
+		{
+			Name:   "tag",
+			Arg:    "v1.0.0",
+			Expect: "# Test Provider v1\n",
+		},
+		{
+			Name:        "not found",
+			Arg:         "v9.9.9",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			path, cleanup, err := comparePath(context.Background(), repository, testCase.Arg)
+
+			if err == nil && testCase.ExpectError {
+				cleanup()
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil {
+				if !testCase.ExpectError {
+					t.Fatalf("expected no error, got error: %s", err)
+				}
+
+				return
+			}
+
+			defer cleanup()
+
+			got, err := os.ReadFile(filepath.Join(path, "docs", "index.md"))
+
+			if err != nil {
+				t.Fatalf("unexpected error reading file: %s", err)
+			}
+
+			if string(got) != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestDocumentationComparisonSummary(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Comparison *check.DocumentationComparison
+		Expect     string
+	}{
+		{
+			Name:       "no changes",
+			Comparison: &check.DocumentationComparison{},
+			Expect: `Compared documentation of v1.0.0 with v2.0.0
+
+No changes`,
+		},
+		{
+			Name: "changes",
+			Comparison: &check.DocumentationComparison{
+				Added:   []string{"docs/resources/new.md"},
+				Removed: []string{"docs/resources/old.md"},
+				Changed: []*check.DocumentationChange{
+					{
+						Attributes: &contents.AttributeComparison{
+							Added:   []string{"type"},
+							Removed: []string{"tags"},
+							Changed: []*contents.AttributeChange{
+								{
+									Changes: []string{"now required"},
+									Name:    "name",
+								},
+							},
+						},
+						OldPath: "website/docs/r/thing.html.markdown",
+						Path:    "docs/resources/thing.md",
+					},
+				},
+			},
+			Expect: `Compared documentation of v1.0.0 with v2.0.0
+
+Added (1):
+  docs/resources/new.md
+
+Removed (1):
+  docs/resources/old.md
+
+Changed (1):
+  docs/resources/thing.md (was website/docs/r/thing.html.markdown)
+    + type
+    - tags
+    ~ name (now required)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := documentationComparisonSummary(testCase.Comparison, "v1.0.0", "v2.0.0")
+
+			if got != testCase.Expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
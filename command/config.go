@@ -0,0 +1,33 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// ConfigCommand is a Command implementation which groups the configuration
+// file subcommands.
+type ConfigCommand struct {
+	Ui cli.Ui
+}
+
+func (*ConfigCommand) Help() string {
+	helpText := `
+Usage: tfproviderdocs config <subcommand> [options] [args]
+
+  Commands for the configuration file.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ConfigCommand) Name() string { return "config" }
+
+func (c *ConfigCommand) Run(_ []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *ConfigCommand) Synopsis() string {
+	return "Configuration file commands"
+}
@@ -0,0 +1,464 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/hashicorp/go-hclog"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultConfigFile is the configuration file name automatically loaded
+	// from the Terraform Provider codebase path when -config is not given.
+	DefaultConfigFile = `.tfproviderdocs.yml`
+)
+
+// ConfigFile represents the YAML configuration file.
+type ConfigFile struct {
+	// AllowedGuideSubcategories contains the allowed guide frontmatter
+	// subcategories, in addition to -allowed-guide-subcategories.
+	AllowedGuideSubcategories []string `yaml:"allowed_guide_subcategories,omitempty"`
+
+	// AllowedResourceSubcategories contains the allowed data source and
+	// resource frontmatter subcategories, in addition to
+	// -allowed-resource-subcategories.
+	AllowedResourceSubcategories []string `yaml:"allowed_resource_subcategories,omitempty"`
+
+	// CanonicalHeadings contains the chosen heading names of common heading
+	// synonym groups (e.g. Attributes Reference instead of Attribute
+	// Reference), whose other names are reported.
+	CanonicalHeadings []string `yaml:"canonical_headings,omitempty"`
+
+	// DataSourceFiles maps data source names to documentation file names,
+	// without file extension, which differ from the data source name without
+	// provider prefix. Multiple data sources may share a documentation file.
+	DataSourceFiles map[string]string `yaml:"data_source_files,omitempty"`
+
+	// Directories contains per-directory configuration, keyed by the directory
+	// path relative to the Terraform Provider codebase (e.g. docs/guides/images).
+	Directories map[string]*ConfigFileDirectory `yaml:"directories,omitempty"`
+
+	// ExampleIdentifiers enables reporting of real-looking cloud identifiers,
+	// such as account IDs, in documentation code blocks.
+	ExampleIdentifiers *ConfigFileExampleIdentifiers `yaml:"example_identifiers,omitempty"`
+
+	// HeadingSynonyms maps canonical heading names to additional synonyms,
+	// which are reported.
+	HeadingSynonyms map[string][]string `yaml:"heading_synonyms,omitempty"`
+
+	// IgnoreFileMismatchDataSources contains the data source names of
+	// documentation files ignored by the file-mismatch check, in addition to
+	// -ignore-file-mismatch-data-sources.
+	IgnoreFileMismatchDataSources []string `yaml:"ignore_file_mismatch_data_sources,omitempty"`
+
+	// IgnoreFileMismatchResources contains the resource names of
+	// documentation files ignored by the file-mismatch check, in addition to
+	// -ignore-file-mismatch-resources.
+	IgnoreFileMismatchResources []string `yaml:"ignore_file_mismatch_resources,omitempty"`
+
+	// IgnoreFileMissingDataSources contains the data source names ignored by
+	// the file-missing check, in addition to -ignore-file-missing-data-sources.
+	IgnoreFileMissingDataSources []string `yaml:"ignore_file_missing_data_sources,omitempty"`
+
+	// IgnoreFileMissingResources contains the resource names ignored by the
+	// file-missing check, in addition to -ignore-file-missing-resources.
+	IgnoreFileMissingResources []string `yaml:"ignore_file_missing_resources,omitempty"`
+
+	// IgnoreLegacyNavigation contains glob patterns of documentation files or
+	// legacy navigation links ignored by the legacy-navigation check, in
+	// addition to -ignore-legacy-navigation.
+	IgnoreLegacyNavigation []string `yaml:"ignore_legacy_navigation,omitempty"`
+
+	// RemovedDataSources maps removed data source names to the provider
+	// version which removed them. Documentation kept for removed data sources
+	// is exempt from the file mismatch check and must contain a removal
+	// callout.
+	RemovedDataSources map[string]string `yaml:"removed_data_sources,omitempty"`
+
+	// RemovedResources maps removed resource names to the provider version
+	// which removed them. Documentation kept for removed resources is exempt
+	// from the file mismatch check and must contain a removal callout.
+	RemovedResources map[string]string `yaml:"removed_resources,omitempty"`
+
+	// RenamedDataSources maps previous data source names to their new names.
+	// Documentation for previous names is exempt from the file mismatch check
+	// and must contain a deprecation callout and link to the new documentation.
+	RenamedDataSources map[string]string `yaml:"renamed_data_sources,omitempty"`
+
+	// RenamedResources maps previous resource names to their new names.
+	// Documentation for previous names is exempt from the file mismatch check
+	// and must contain a deprecation callout and link to the new documentation.
+	RenamedResources map[string]string `yaml:"renamed_resources,omitempty"`
+
+	// RequiredFrontMatterKeys maps documentation types (action, data_source,
+	// guide, index, list_resource, or resource) to the YAML frontmatter keys
+	// required in their documentation, in addition to -require-frontmatter-keys.
+	RequiredFrontMatterKeys map[string][]string `yaml:"required_frontmatter_keys,omitempty"`
+
+	// RequiredIndexSections contains the section headings required in the
+	// provider index documentation, in addition to -require-index-sections.
+	// Alternative headings are separated by |, e.g. Argument Reference|Schema.
+	RequiredIndexSections []string `yaml:"required_index_sections,omitempty"`
+
+	// ResourceFiles maps resource names to documentation file names, without
+	// file extension, which differ from the resource name without provider
+	// prefix. Multiple resources may share a documentation file.
+	ResourceFiles map[string]string `yaml:"resource_files,omitempty"`
+
+	// Rules contains custom content rules, which report documentation files
+	// matching, or not matching, a regular expression.
+	Rules []*ConfigFileRule `yaml:"rules,omitempty"`
+}
+
+// configFileDocumentationTypes maps the documentation type names of the
+// configuration file to the check package documentation types.
+var configFileDocumentationTypes = map[string]string{
+	"action":        check.DocumentationTypeAction,
+	"data_source":   check.DocumentationTypeDataSource,
+	"guide":         check.DocumentationTypeGuide,
+	"index":         check.DocumentationTypeIndex,
+	"list_resource": check.DocumentationTypeListResource,
+	"resource":      check.DocumentationTypeResource,
+}
+
+// ConfigFileDirectory represents per-directory configuration.
+type ConfigFileDirectory struct {
+	// FileExtensions overrides the valid documentation file extensions.
+	FileExtensions []string `yaml:"file_extensions,omitempty"`
+
+	// OtherFileExtensions are extensions of additional files, such as images,
+	// which are allowed in the directory but not checked as documentation.
+	OtherFileExtensions []string `yaml:"other_file_extensions,omitempty"`
+}
+
+// ConfigFileExampleIdentifiers represents the configuration of real-looking
+// cloud identifiers in documentation code blocks.
+type ConfigFileExampleIdentifiers struct {
+	// AllowedValues contains sanctioned example identifier values, in
+	// addition to the default sanctioned values (e.g. 123456789012).
+	AllowedValues []string `yaml:"allowed_values,omitempty"`
+
+	// Patterns maps identifier names to regular expressions, in addition to
+	// the default patterns. Default patterns are replaced by patterns of the
+	// same name, or disabled by an empty regular expression.
+	Patterns map[string]string `yaml:"patterns,omitempty"`
+}
+
+// ConfigFileRule represents a custom content rule. Exactly one of Match or
+// RequiredMatch must be set.
+type ConfigFileRule struct {
+	// Match is a regular expression which is reported when found in a
+	// documentation file.
+	Match string `yaml:"match,omitempty"`
+
+	// Message describes the violation in findings. Defaults to the pattern.
+	Message string `yaml:"message,omitempty"`
+
+	// Name identifies the rule in findings.
+	Name string `yaml:"name,omitempty"`
+
+	// Paths contains glob patterns of documentation file paths, relative to
+	// the Terraform Provider codebase (e.g. docs/resources/*.md), which the
+	// rule applies to. The rule applies to all documentation files if empty.
+	Paths []string `yaml:"paths,omitempty"`
+
+	// RequiredMatch is a regular expression which is reported when not found
+	// in a documentation file.
+	RequiredMatch string `yaml:"required_match,omitempty"`
+
+	// Severity is error, which reports findings, or warning, which only logs
+	// warnings. Defaults to error.
+	Severity string `yaml:"severity,omitempty"`
+}
+
+// CheckDirectoryOptions returns the check package representation of the
+// per-directory configuration.
+func (c *ConfigFile) CheckDirectoryOptions() map[string]*check.DirectoryOptions {
+	if c == nil || len(c.Directories) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*check.DirectoryOptions, len(c.Directories))
+
+	for directory, directoryConfig := range c.Directories {
+		if directoryConfig == nil {
+			continue
+		}
+
+		result[filepath.ToSlash(filepath.Clean(directory))] = &check.DirectoryOptions{
+			FileExtensions:      directoryConfig.FileExtensions,
+			OtherFileExtensions: directoryConfig.OtherFileExtensions,
+		}
+	}
+
+	return result
+}
+
+// CheckExampleIdentifiers returns the check package representation of the
+// example identifier configuration, combining the default and configured
+// patterns and allowed values. Configured patterns follow the default patterns
+// in name order. No patterns are returned if not configured.
+func (c *ConfigFile) CheckExampleIdentifiers() ([]*check.ExampleIdentifierPattern, []string, error) {
+	if c == nil || c.ExampleIdentifiers == nil {
+		return nil, nil, nil
+	}
+
+	var patterns []*check.ExampleIdentifierPattern
+
+	for _, pattern := range check.DefaultExampleIdentifierPatterns {
+		if _, ok := c.ExampleIdentifiers.Patterns[pattern.Name]; !ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	names := make([]string, 0, len(c.ExampleIdentifiers.Patterns))
+
+	for name := range c.ExampleIdentifiers.Patterns {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		pattern := c.ExampleIdentifiers.Patterns[name]
+
+		if pattern == "" {
+			continue
+		}
+
+		compiled, err := regexp.Compile(pattern)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("example identifier (%s): invalid pattern (%s): %w", name, pattern, err)
+		}
+
+		patterns = append(patterns, &check.ExampleIdentifierPattern{
+			Name:    name,
+			Pattern: compiled,
+		})
+	}
+
+	allowedValues := append([]string(nil), check.DefaultExampleIdentifierAllowedValues...)
+	allowedValues = append(allowedValues, c.ExampleIdentifiers.AllowedValues...)
+
+	return patterns, allowedValues, nil
+}
+
+// CheckHeadingNames returns the canonical heading names mapped to their
+// synonyms, combining the synonyms of common heading synonym groups and the
+// configured synonyms.
+func (c *ConfigFile) CheckHeadingNames() (map[string][]string, error) {
+	if c == nil || (len(c.CanonicalHeadings) == 0 && len(c.HeadingSynonyms) == 0) {
+		return nil, nil
+	}
+
+	result := make(map[string][]string)
+
+	for _, canonical := range c.CanonicalHeadings {
+		synonyms, ok := check.CanonicalHeadingSynonyms(canonical)
+
+		if !ok {
+			var known []string
+
+			for _, group := range check.HeadingSynonyms {
+				known = append(known, group...)
+			}
+
+			return nil, fmt.Errorf("unknown canonical heading (%s), expected one of: %s, or configure its synonyms with heading_synonyms", canonical, strings.Join(known, ", "))
+		}
+
+		result[canonical] = append(result[canonical], synonyms...)
+	}
+
+	for canonical, synonyms := range c.HeadingSynonyms {
+		result[canonical] = append(result[canonical], synonyms...)
+	}
+
+	return result, nil
+}
+
+// CheckRequiredFrontMatterKeys returns the required YAML frontmatter keys
+// keyed by check package documentation type, combining the keys required in
+// all documentation with the configured per documentation type keys.
+func (c *ConfigFile) CheckRequiredFrontMatterKeys(keys []string) (map[string][]string, error) {
+	if len(keys) == 0 && (c == nil || len(c.RequiredFrontMatterKeys) == 0) {
+		return nil, nil
+	}
+
+	result := make(map[string][]string, len(configFileDocumentationTypes))
+
+	for _, documentationType := range configFileDocumentationTypes {
+		result[documentationType] = append(result[documentationType], keys...)
+	}
+
+	if c == nil {
+		return result, nil
+	}
+
+	for name, typeKeys := range c.RequiredFrontMatterKeys {
+		documentationType, ok := configFileDocumentationTypes[name]
+
+		if !ok {
+			names := make([]string, 0, len(configFileDocumentationTypes))
+
+			for name := range configFileDocumentationTypes {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			return nil, fmt.Errorf("required frontmatter keys: unknown documentation type (%s), valid documentation types: %s", name, strings.Join(names, ", "))
+		}
+
+		for _, key := range typeKeys {
+			if key == "" {
+				return nil, fmt.Errorf("required frontmatter keys (%s): empty key", name)
+			}
+
+			// Keys may also be given with -require-frontmatter-keys
+			if !containsKey(result[documentationType], key) {
+				result[documentationType] = append(result[documentationType], key)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// CheckRequiredIndexSections returns the alternative heading names of each
+// required provider index section, combining the given sections and the
+// configured sections. Alternative headings are separated by |.
+func (c *ConfigFile) CheckRequiredIndexSections(sections []string) ([][]string, error) {
+	if c != nil {
+		sections = append(sections, c.RequiredIndexSections...)
+	}
+
+	var result [][]string
+
+	for _, section := range sections {
+		var alternatives []string
+
+		for _, alternative := range strings.Split(section, "|") {
+			alternative = strings.TrimSpace(alternative)
+
+			if alternative == "" {
+				return nil, fmt.Errorf("section (%s): empty heading", section)
+			}
+
+			alternatives = append(alternatives, alternative)
+		}
+
+		result = append(result, alternatives)
+	}
+
+	return result, nil
+}
+
+// CheckRules returns the check package representation of the custom content
+// rules, with compiled regular expressions.
+func (c *ConfigFile) CheckRules() ([]*check.Rule, error) {
+	if c == nil || len(c.Rules) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*check.Rule, 0, len(c.Rules))
+	names := make(map[string]struct{}, len(c.Rules))
+
+	for index, rule := range c.Rules {
+		if rule == nil || rule.Name == "" {
+			return nil, fmt.Errorf("rules[%d]: missing name", index)
+		}
+
+		if _, ok := names[rule.Name]; ok {
+			return nil, fmt.Errorf("rule (%s): duplicate name", rule.Name)
+		}
+
+		names[rule.Name] = struct{}{}
+
+		if (rule.Match == "") == (rule.RequiredMatch == "") {
+			return nil, fmt.Errorf("rule (%s): exactly one of match or required_match must be given", rule.Name)
+		}
+
+		pattern := rule.Match
+
+		if pattern == "" {
+			pattern = rule.RequiredMatch
+		}
+
+		compiled, err := regexp.Compile(pattern)
+
+		if err != nil {
+			return nil, fmt.Errorf("rule (%s): invalid pattern (%s): %w", rule.Name, pattern, err)
+		}
+
+		severity := rule.Severity
+
+		switch severity {
+		case "":
+			severity = check.RuleSeverityError
+		case check.RuleSeverityError, check.RuleSeverityWarning:
+		default:
+			return nil, fmt.Errorf("rule (%s): invalid severity (%s), valid severities: %s, %s", rule.Name, severity, check.RuleSeverityError, check.RuleSeverityWarning)
+		}
+
+		result = append(result, &check.Rule{
+			Message:  rule.Message,
+			Name:     rule.Name,
+			Paths:    rule.Paths,
+			Pattern:  compiled,
+			Required: rule.RequiredMatch != "",
+			Severity: severity,
+		})
+	}
+
+	return result, nil
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadConfigFile loads the configuration file. If the path is empty, the default
+// configuration file within the Terraform Provider codebase path is loaded if
+// it exists, otherwise an empty configuration is returned.
+func loadConfigFile(path string, basePath string) (*ConfigFile, error) {
+	if path == "" {
+		defaultPath := filepath.Join(basePath, DefaultConfigFile)
+
+		if _, err := os.Stat(defaultPath); errors.Is(err, fs.ErrNotExist) {
+			return &ConfigFile{}, nil
+		}
+
+		path = defaultPath
+	}
+
+	hclog.L().Debug("Loading configuration file", "file", path)
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading configuration file (%s): %w", path, err)
+	}
+
+	var result ConfigFile
+
+	if err := yaml.UnmarshalStrict(content, &result); err != nil {
+		return nil, fmt.Errorf("error parsing configuration file (%s): %w", path, err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,197 @@
+package command
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigFileSchema is the JSON Schema of the configuration file.
+//
+//go:embed config_file.schema.json
+var ConfigFileSchema []byte
+
+// configFileSchema represents the subset of JSON Schema used by the
+// configuration file schema.
+type configFileSchema struct {
+	// AdditionalProperties is false or the schema of object values which are
+	// not in Properties. Other properties are allowed if nil.
+	AdditionalProperties *configFileSchemaOrFalse `json:"additionalProperties"`
+
+	Items      *configFileSchema            `json:"items"`
+	Properties map[string]*configFileSchema `json:"properties"`
+	Type       string                       `json:"type"`
+}
+
+// configFileSchemaOrFalse represents a JSON Schema or the false boolean
+// schema, which allows no values.
+type configFileSchemaOrFalse struct {
+	False  bool
+	Schema *configFileSchema
+}
+
+func (s *configFileSchemaOrFalse) UnmarshalJSON(data []byte) error {
+	var allowed bool
+
+	if err := json.Unmarshal(data, &allowed); err == nil {
+		s.False = !allowed
+
+		return nil
+	}
+
+	return json.Unmarshal(data, &s.Schema)
+}
+
+// validateConfigFile verifies the configuration file content against the
+// configuration file schema, returning an error for each unknown key or
+// value with an invalid type.
+func validateConfigFile(content []byte) error {
+	var schema configFileSchema
+
+	if err := json.Unmarshal(ConfigFileSchema, &schema); err != nil {
+		return fmt.Errorf("error parsing configuration file schema: %w", err)
+	}
+
+	var value interface{}
+
+	if err := yaml.Unmarshal(content, &value); err != nil {
+		return fmt.Errorf("error parsing YAML: %w", err)
+	}
+
+	// An empty file is an empty configuration.
+	if value == nil {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	for _, err := range schema.validate(value, "") {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// validate returns an error for each value, including nested values, which
+// does not match the schema. The path is the key path of the value.
+func (s *configFileSchema) validate(value interface{}, path string) []error {
+	if s == nil {
+		return nil
+	}
+
+	switch s.Type {
+	case "array":
+		values, ok := value.([]interface{})
+
+		if !ok {
+			return []error{configFileSchemaTypeError(path, s.Type, value)}
+		}
+
+		var errs []error
+
+		for index, item := range values {
+			errs = append(errs, s.Items.validate(item, fmt.Sprintf("%s[%d]", path, index))...)
+		}
+
+		return errs
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []error{configFileSchemaTypeError(path, s.Type, value)}
+		}
+	case "integer":
+		if _, ok := value.(int); !ok {
+			return []error{configFileSchemaTypeError(path, s.Type, value)}
+		}
+	case "object":
+		values, ok := value.(map[interface{}]interface{})
+
+		if !ok {
+			return []error{configFileSchemaTypeError(path, s.Type, value)}
+		}
+
+		return s.validateObject(values, path)
+	case "string":
+		if _, ok := value.(string); !ok {
+			return []error{configFileSchemaTypeError(path, s.Type, value)}
+		}
+	}
+
+	return nil
+}
+
+// validateObject returns an error for each unknown key or invalid value of
+// the object, in key order.
+func (s *configFileSchema) validateObject(values map[interface{}]interface{}, path string) []error {
+	keys := make([]string, 0, len(values))
+	keyValues := make(map[string]interface{}, len(values))
+
+	for key, value := range values {
+		keys = append(keys, fmt.Sprint(key))
+		keyValues[fmt.Sprint(key)] = value
+	}
+
+	sort.Strings(keys)
+
+	var errs []error
+
+	for _, key := range keys {
+		keyPath := key
+
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		value := keyValues[key]
+
+		if propertySchema, ok := s.Properties[key]; ok {
+			errs = append(errs, propertySchema.validate(value, keyPath)...)
+			continue
+		}
+
+		if s.AdditionalProperties == nil {
+			continue
+		}
+
+		if s.AdditionalProperties.False {
+			errs = append(errs, fmt.Errorf("%s: unknown key", keyPath))
+			continue
+		}
+
+		errs = append(errs, s.AdditionalProperties.Schema.validate(value, keyPath)...)
+	}
+
+	return errs
+}
+
+func configFileSchemaTypeError(path string, expected string, value interface{}) error {
+	var actual string
+
+	switch value.(type) {
+	case nil:
+		actual = "null"
+	case bool:
+		actual = "boolean"
+	case int, int64, uint64:
+		actual = "integer"
+	case float64:
+		actual = "number"
+	case string:
+		actual = "string"
+	case []interface{}:
+		actual = "array"
+	case map[interface{}]interface{}:
+		actual = "object"
+	default:
+		actual = fmt.Sprintf("%T", value)
+	}
+
+	if path == "" {
+		path = "(root)"
+	}
+
+	return fmt.Errorf("%s: expected %s, got %s", path, expected, actual)
+}
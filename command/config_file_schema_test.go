@@ -0,0 +1,122 @@
+package command
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+func TestConfigFileSchemaProperties(t *testing.T) {
+	var schema configFileSchema
+
+	if err := json.Unmarshal(ConfigFileSchema, &schema); err != nil {
+		t.Fatalf("unexpected error parsing schema: %s", err)
+	}
+
+	testCases := []struct {
+		Name   string
+		Schema *configFileSchema
+		Type   reflect.Type
+	}{
+		{
+			Name:   "root",
+			Schema: &schema,
+			Type:   reflect.TypeOf(ConfigFile{}),
+		},
+		{
+			Name:   "directories",
+			Schema: schema.Properties["directories"].AdditionalProperties.Schema,
+			Type:   reflect.TypeOf(ConfigFileDirectory{}),
+		},
+		{
+			Name:   "rules",
+			Schema: schema.Properties["rules"].Items,
+			Type:   reflect.TypeOf(ConfigFileRule{}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var want []string
+
+			for i := 0; i < testCase.Type.NumField(); i++ {
+				want = append(want, strings.Split(testCase.Type.Field(i).Tag.Get("yaml"), ",")[0])
+			}
+
+			var got []string
+
+			for property := range testCase.Schema.Properties {
+				got = append(got, property)
+			}
+
+			sort.Strings(want)
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected schema properties: %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
+func TestValidateConfigFile(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Path         string
+		ExpectErrors []string
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/config-file/valid.yml",
+		},
+		{
+			Name: "unknown key",
+			Path: "testdata/config-file/unknown-key.yml",
+			ExpectErrors: []string{
+				"directories.docs/guides/images.other_extensions: unknown key",
+			},
+		},
+		{
+			Name: "invalid types",
+			Path: "testdata/config-file/invalid-types.yml",
+			ExpectErrors: []string{
+				"data_source_files.test_thing_alias: expected string, got array",
+				"directories.docs/guides/images.other_file_extensions: expected array, got string",
+				"renamed_resources: expected object, got string",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			content, err := os.ReadFile(testCase.Path)
+
+			if err != nil {
+				t.Fatalf("unexpected error reading file: %s", err)
+			}
+
+			var got []string
+
+			if err := validateConfigFile(content); err != nil {
+				merr, ok := err.(*multierror.Error)
+
+				if !ok {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				for _, e := range merr.Errors {
+					got = append(got, e.Error())
+				}
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectErrors) {
+				t.Errorf("expected: %v, got: %v", testCase.ExpectErrors, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,554 @@
+package command
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check"
+)
+
+func TestLoadConfigFile(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Path        string
+		BasePath    string
+		Expect      *ConfigFile
+		ExpectError bool
+	}{
+		{
+			Name: "valid",
+			Path: "testdata/config-file/valid.yml",
+			Expect: &ConfigFile{
+				CanonicalHeadings: []string{"Attributes Reference"},
+				DataSourceFiles: map[string]string{
+					"test_thing_alias": "thing",
+				},
+				Directories: map[string]*ConfigFileDirectory{
+					"docs/guides/images": {
+						OtherFileExtensions: []string{".png", ".svg"},
+					},
+					"docs/resources": {
+						FileExtensions: []string{".md"},
+					},
+				},
+				ExampleIdentifiers: &ConfigFileExampleIdentifiers{
+					AllowedValues: []string{"210987654321"},
+					Patterns: map[string]string{
+						"guid":             "",
+						"internal_account": "acct-[0-9]{8}",
+					},
+				},
+				HeadingSynonyms: map[string][]string{
+					"Example Usage": {"Examples"},
+				},
+				RemovedDataSources: map[string]string{
+					"test_gone": "2.0.0",
+				},
+				RemovedResources: map[string]string{
+					"test_gone": "",
+				},
+				RenamedDataSources: map[string]string{
+					"test_old_thing": "test_thing",
+				},
+				RenamedResources: map[string]string{
+					"test_old_thing": "test_thing",
+				},
+				RequiredFrontMatterKeys: map[string][]string{
+					"guide":    {"description"},
+					"resource": {"description", "page_title"},
+				},
+				ResourceFiles: map[string]string{
+					"test_thing_alias": "thing",
+				},
+				Rules: []*ConfigFileRule{
+					{
+						Match:    "TODO",
+						Message:  "remove TODO comments",
+						Name:     "no-todo",
+						Paths:    []string{"docs/**/*.md"},
+						Severity: "warning",
+					},
+				},
+			},
+		},
+		{
+			Name:        "unknown key",
+			Path:        "testdata/config-file/unknown-key.yml",
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid path",
+			Path:        "testdata/config-file/does-not-exist.yml",
+			ExpectError: true,
+		},
+		{
+			Name:     "default",
+			BasePath: "testdata/config-file/default",
+			Expect: &ConfigFile{
+				Directories: map[string]*ConfigFileDirectory{
+					"docs/guides/images": {
+						OtherFileExtensions: []string{".png"},
+					},
+				},
+			},
+		},
+		{
+			Name:     "default not found",
+			BasePath: "testdata",
+			Expect:   &ConfigFile{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := loadConfigFile(testCase.Path, testCase.BasePath)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestConfigFileCheckDirectoryOptions(t *testing.T) {
+	configFile := &ConfigFile{
+		Directories: map[string]*ConfigFileDirectory{
+			"docs/guides/images/": {
+				OtherFileExtensions: []string{".png"},
+			},
+		},
+	}
+
+	want := map[string]*check.DirectoryOptions{
+		"docs/guides/images": {
+			OtherFileExtensions: []string{".png"},
+		},
+	}
+	got := configFile.CheckDirectoryOptions()
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("expected: %v, got: %v", want, got)
+	}
+}
+
+func TestConfigFileCheckExampleIdentifiers(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		ConfigFile          *ConfigFile
+		ExpectPatterns      []string
+		ExpectAllowedValues []string
+		ExpectError         string
+	}{
+		{
+			Name:       "empty",
+			ConfigFile: &ConfigFile{},
+		},
+		{
+			Name: "defaults",
+			ConfigFile: &ConfigFile{
+				ExampleIdentifiers: &ConfigFileExampleIdentifiers{},
+			},
+			ExpectPatterns:      []string{"aws_arn", "gcp_project_number", "aws_account_id", "guid"},
+			ExpectAllowedValues: check.DefaultExampleIdentifierAllowedValues,
+		},
+		{
+			Name: "configured",
+			ConfigFile: &ConfigFile{
+				ExampleIdentifiers: &ConfigFileExampleIdentifiers{
+					AllowedValues: []string{"210987654321"},
+					Patterns: map[string]string{
+						"aws_account_id":   `\b[0-9]{12}\b`,
+						"guid":             "",
+						"internal_account": `acct-[0-9]{8}`,
+					},
+				},
+			},
+			ExpectPatterns:      []string{"aws_arn", "gcp_project_number", "aws_account_id", "internal_account"},
+			ExpectAllowedValues: append(append([]string(nil), check.DefaultExampleIdentifierAllowedValues...), "210987654321"),
+		},
+		{
+			Name: "invalid pattern",
+			ConfigFile: &ConfigFile{
+				ExampleIdentifiers: &ConfigFileExampleIdentifiers{
+					Patterns: map[string]string{
+						"invalid": "(",
+					},
+				},
+			},
+			ExpectError: "example identifier (invalid): invalid pattern (():",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			patterns, allowedValues, err := testCase.ConfigFile.CheckExampleIdentifiers()
+
+			if err == nil && testCase.ExpectError != "" {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && (testCase.ExpectError == "" || !strings.HasPrefix(err.Error(), testCase.ExpectError)) {
+				t.Fatalf("expected error %q, got: %s", testCase.ExpectError, err)
+			}
+
+			var gotPatterns []string
+
+			for _, pattern := range patterns {
+				gotPatterns = append(gotPatterns, pattern.Name)
+			}
+
+			if !reflect.DeepEqual(gotPatterns, testCase.ExpectPatterns) {
+				t.Errorf("expected patterns: %v, got: %v", testCase.ExpectPatterns, gotPatterns)
+			}
+
+			if !reflect.DeepEqual(allowedValues, testCase.ExpectAllowedValues) {
+				t.Errorf("expected allowed values: %v, got: %v", testCase.ExpectAllowedValues, allowedValues)
+			}
+		})
+	}
+}
+
+func TestConfigFileCheckHeadingNames(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ConfigFile  *ConfigFile
+		Expect      map[string][]string
+		ExpectError bool
+	}{
+		{
+			Name:       "empty",
+			ConfigFile: &ConfigFile{},
+		},
+		{
+			Name: "canonical headings",
+			ConfigFile: &ConfigFile{
+				CanonicalHeadings: []string{"Argument Reference", "Attributes Reference"},
+			},
+			Expect: map[string][]string{
+				"Argument Reference":   {"Arguments Reference"},
+				"Attributes Reference": {"Attribute Reference"},
+			},
+		},
+		{
+			Name: "heading synonyms",
+			ConfigFile: &ConfigFile{
+				CanonicalHeadings: []string{"Attributes Reference"},
+				HeadingSynonyms: map[string][]string{
+					"Attributes Reference": {"Attributes"},
+					"Example Usage":        {"Examples"},
+				},
+			},
+			Expect: map[string][]string{
+				"Attributes Reference": {"Attribute Reference", "Attributes"},
+				"Example Usage":        {"Examples"},
+			},
+		},
+		{
+			Name: "unknown canonical heading",
+			ConfigFile: &ConfigFile{
+				CanonicalHeadings: []string{"Example Usage"},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.ConfigFile.CheckHeadingNames()
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestConfigFileCheckRequiredFrontMatterKeys(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ConfigFile  *ConfigFile
+		Keys        []string
+		Expect      map[string][]string
+		ExpectError bool
+	}{
+		{
+			Name:       "empty",
+			ConfigFile: &ConfigFile{},
+		},
+		{
+			Name:       "keys",
+			ConfigFile: &ConfigFile{},
+			Keys:       []string{"description"},
+			Expect: map[string][]string{
+				check.DocumentationTypeAction:       {"description"},
+				check.DocumentationTypeDataSource:   {"description"},
+				check.DocumentationTypeGuide:        {"description"},
+				check.DocumentationTypeIndex:        {"description"},
+				check.DocumentationTypeListResource: {"description"},
+				check.DocumentationTypeResource:     {"description"},
+			},
+		},
+		{
+			Name: "required frontmatter keys",
+			ConfigFile: &ConfigFile{
+				RequiredFrontMatterKeys: map[string][]string{
+					"data_source": {"description", "owner"},
+					"guide":       {"page_title"},
+				},
+			},
+			Keys: []string{"description"},
+			Expect: map[string][]string{
+				check.DocumentationTypeAction:       {"description"},
+				check.DocumentationTypeDataSource:   {"description", "owner"},
+				check.DocumentationTypeGuide:        {"description", "page_title"},
+				check.DocumentationTypeIndex:        {"description"},
+				check.DocumentationTypeListResource: {"description"},
+				check.DocumentationTypeResource:     {"description"},
+			},
+		},
+		{
+			Name: "unknown documentation type",
+			ConfigFile: &ConfigFile{
+				RequiredFrontMatterKeys: map[string][]string{
+					"function": {"description"},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "empty key",
+			ConfigFile: &ConfigFile{
+				RequiredFrontMatterKeys: map[string][]string{
+					"resource": {""},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.ConfigFile.CheckRequiredFrontMatterKeys(testCase.Keys)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestConfigFileCheckRequiredIndexSections(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ConfigFile  *ConfigFile
+		Sections    []string
+		Expect      [][]string
+		ExpectError bool
+	}{
+		{
+			Name:       "empty",
+			ConfigFile: &ConfigFile{},
+		},
+		{
+			Name:     "sections",
+			Sections: []string{"Example Usage", "Argument Reference | Schema"},
+			Expect:   [][]string{{"Example Usage"}, {"Argument Reference", "Schema"}},
+		},
+		{
+			Name: "configured sections",
+			ConfigFile: &ConfigFile{
+				RequiredIndexSections: []string{"Authentication"},
+			},
+			Sections: []string{"Example Usage"},
+			Expect:   [][]string{{"Example Usage"}, {"Authentication"}},
+		},
+		{
+			Name:        "empty heading",
+			Sections:    []string{"Argument Reference|"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.ConfigFile.CheckRequiredIndexSections(testCase.Sections)
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestConfigFileCheckRules(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ConfigFile  *ConfigFile
+		Expect      []*check.Rule
+		ExpectError string
+	}{
+		{
+			Name:       "empty",
+			ConfigFile: &ConfigFile{},
+		},
+		{
+			Name: "match",
+			ConfigFile: &ConfigFile{
+				Rules: []*ConfigFileRule{
+					{
+						Match:   "TODO",
+						Message: "remove TODO comments",
+						Name:    "no-todo",
+					},
+				},
+			},
+			Expect: []*check.Rule{
+				{
+					Message:  "remove TODO comments",
+					Name:     "no-todo",
+					Pattern:  regexp.MustCompile("TODO"),
+					Severity: check.RuleSeverityError,
+				},
+			},
+		},
+		{
+			Name: "required match",
+			ConfigFile: &ConfigFile{
+				Rules: []*ConfigFileRule{
+					{
+						Name:          "import-section",
+						Paths:         []string{"docs/resources/*.md"},
+						RequiredMatch: "(?m)^## Import$",
+						Severity:      "warning",
+					},
+				},
+			},
+			Expect: []*check.Rule{
+				{
+					Name:     "import-section",
+					Paths:    []string{"docs/resources/*.md"},
+					Pattern:  regexp.MustCompile("(?m)^## Import$"),
+					Required: true,
+					Severity: check.RuleSeverityWarning,
+				},
+			},
+		},
+		{
+			Name: "missing name",
+			ConfigFile: &ConfigFile{
+				Rules: []*ConfigFileRule{
+					{
+						Match: "TODO",
+					},
+				},
+			},
+			ExpectError: "rules[0]: missing name",
+		},
+		{
+			Name: "duplicate name",
+			ConfigFile: &ConfigFile{
+				Rules: []*ConfigFileRule{
+					{
+						Match: "TODO",
+						Name:  "no-todo",
+					},
+					{
+						Match: "FIXME",
+						Name:  "no-todo",
+					},
+				},
+			},
+			ExpectError: "rule (no-todo): duplicate name",
+		},
+		{
+			Name: "match and required match",
+			ConfigFile: &ConfigFile{
+				Rules: []*ConfigFileRule{
+					{
+						Match:         "TODO",
+						Name:          "no-todo",
+						RequiredMatch: "FIXME",
+					},
+				},
+			},
+			ExpectError: "rule (no-todo): exactly one of match or required_match must be given",
+		},
+		{
+			Name: "invalid pattern",
+			ConfigFile: &ConfigFile{
+				Rules: []*ConfigFileRule{
+					{
+						Match: "(",
+						Name:  "invalid",
+					},
+				},
+			},
+			ExpectError: "rule (invalid): invalid pattern (():",
+		},
+		{
+			Name: "invalid severity",
+			ConfigFile: &ConfigFile{
+				Rules: []*ConfigFileRule{
+					{
+						Match:    "TODO",
+						Name:     "no-todo",
+						Severity: "info",
+					},
+				},
+			},
+			ExpectError: "rule (no-todo): invalid severity (info), valid severities: error, warning",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.ConfigFile.CheckRules()
+
+			if err == nil && testCase.ExpectError != "" {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && (testCase.ExpectError == "" || !strings.HasPrefix(err.Error(), testCase.ExpectError)) {
+				t.Fatalf("expected error %q, got: %s", testCase.ExpectError, err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %v, got: %v", testCase.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/cli"
+	"gopkg.in/yaml.v2"
+)
+
+type ConfigValidateCommandConfig struct {
+	ConfigFile string
+	LogFormat  string
+	LogLevel   string
+	Path       string
+}
+
+// ConfigValidateCommand is a Command implementation
+type ConfigValidateCommand struct {
+	Ui cli.Ui
+}
+
+func (*ConfigValidateCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-config", fmt.Sprintf("Path to YAML configuration file. Defaults to %s in the Terraform Provider codebase path.", DefaultConfigFile))
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs config validate [options] [PATH]
+
+  Validates the configuration file against the configuration file JSON Schema,
+  reporting unknown keys and values with invalid types, then outputs the
+  effective configuration.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ConfigValidateCommand) Name() string { return "config validate" }
+
+func (c *ConfigValidateCommand) Run(args []string) int {
+	var config ConfigValidateCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.StringVar(&config.ConfigFile, "config", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	path := config.ConfigFile
+
+	if path == "" {
+		path = filepath.Join(config.Path, DefaultConfigFile)
+
+		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+			c.Ui.Error(fmt.Sprintf("Error validating configuration file: %s not found", path))
+			return 1
+		}
+	}
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading configuration file (%s): %s", path, err))
+		return 1
+	}
+
+	if err := validateConfigFile(content); err != nil {
+		var errs []string
+		var merr *multierror.Error
+
+		if errors.As(err, &merr) {
+			for _, e := range merr.Errors {
+				errs = append(errs, fmt.Sprintf("  %s", e))
+			}
+		} else {
+			errs = append(errs, fmt.Sprintf("  %s", err))
+		}
+
+		c.Ui.Error(fmt.Sprintf("Error validating configuration file (%s):\n\n%s", path, strings.Join(errs, "\n")))
+		return 1
+	}
+
+	configFile, err := loadConfigFile(path, config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error validating configuration file: %s", err))
+		return 1
+	}
+
+	if _, _, err := configFile.CheckExampleIdentifiers(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error validating configuration file: %s", err))
+		return 1
+	}
+
+	if _, err := configFile.CheckRequiredFrontMatterKeys(nil); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error validating configuration file: %s", err))
+		return 1
+	}
+
+	if _, err := configFile.CheckRules(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error validating configuration file: %s", err))
+		return 1
+	}
+
+	output, err := yaml.Marshal(configFile)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error encoding configuration: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Configuration file (%s) is valid.\n", path))
+	c.Ui.Output(fmt.Sprintf("Effective configuration:\n\n%s", strings.TrimSpace(string(output))))
+
+	return 0
+}
+
+func (c *ConfigValidateCommand) Synopsis() string {
+	return "Validates the configuration file"
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	// gzipMagicBytes is the header of gzip compressed data.
+	gzipMagicBytes = []byte{0x1f, 0x8b}
+
+	// zstdMagicBytes is the header of zstd compressed data.
+	zstdMagicBytes = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// decompressReader returns a reader of the decompressed data if the reader
+// data is gzip or zstd compressed, detected by the header magic bytes.
+// Otherwise, the reader is returned. The returned function releases the
+// resources of the decompressor.
+func decompressReader(r *bufio.Reader) (io.Reader, func(), error) {
+	header, err := r.Peek(len(zstdMagicBytes))
+
+	// Data shorter than the header is not compressed.
+	if err != nil && err != io.EOF {
+		return nil, nil, fmt.Errorf("error reading header: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagicBytes):
+		gzipReader, err := gzip.NewReader(r)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading gzip data: %w", err)
+		}
+
+		return gzipReader, func() { gzipReader.Close() }, nil
+	case bytes.HasPrefix(header, zstdMagicBytes):
+		zstdReader, err := zstd.NewReader(r)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading zstd data: %w", err)
+		}
+
+		return zstdReader, zstdReader.Close, nil
+	}
+
+	return r, func() {}, nil
+}
@@ -0,0 +1,129 @@
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestDecompressReader(t *testing.T) {
+	content := []byte(`{"format_version": "1.0"}`)
+
+	var gzipContent bytes.Buffer
+	gzipWriter := gzip.NewWriter(&gzipContent)
+
+	if _, err := gzipWriter.Write(content); err != nil {
+		t.Fatalf("unexpected error writing gzip data: %s", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("unexpected error writing gzip data: %s", err)
+	}
+
+	zstdWriter, err := zstd.NewWriter(nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error creating zstd writer: %s", err)
+	}
+
+	zstdContent := zstdWriter.EncodeAll(content, nil)
+
+	testCases := []struct {
+		Name    string
+		Content []byte
+		Expect  []byte
+	}{
+		{
+			Name:    "empty",
+			Content: []byte{},
+			Expect:  []byte{},
+		},
+		{
+			Name:    "gzip",
+			Content: gzipContent.Bytes(),
+			Expect:  content,
+		},
+		{
+			Name:    "short",
+			Content: []byte(`{}`),
+			Expect:  []byte(`{}`),
+		},
+		{
+			Name:    "uncompressed",
+			Content: content,
+			Expect:  content,
+		},
+		{
+			Name:    "zstd",
+			Content: zstdContent,
+			Expect:  content,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r, closeReader, err := decompressReader(bufio.NewReader(bytes.NewReader(testCase.Content)))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			defer closeReader()
+
+			got, err := io.ReadAll(r)
+
+			if err != nil {
+				t.Fatalf("unexpected error reading: %s", err)
+			}
+
+			if !bytes.Equal(got, testCase.Expect) {
+				t.Errorf("expected: %s, got: %s", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestProviderSchemasCompressed(t *testing.T) {
+	content, err := os.ReadFile("testdata/valid-providers-schema.json")
+
+	if err != nil {
+		t.Fatalf("unexpected error reading file: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "schema.json.gz")
+	file, err := os.Create(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error creating file: %s", err)
+	}
+
+	gzipWriter := gzip.NewWriter(file)
+
+	if _, err := gzipWriter.Write(content); err != nil {
+		t.Fatalf("unexpected error writing gzip data: %s", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("unexpected error writing gzip data: %s", err)
+	}
+
+	if err := file.Close(); err != nil {
+		t.Fatalf("unexpected error closing file: %s", err)
+	}
+
+	ps, err := providerSchemas(path, "null", "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got error: %s", err)
+	}
+
+	if _, ok := ps.Schemas["null"]; !ok {
+		t.Errorf("expected null provider schema, got: %v", ps.Schemas)
+	}
+}
@@ -0,0 +1,409 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	DoctorProviderNameSourceCurrentDirectory = "current directory"
+	DoctorProviderNameSourcePath             = "path"
+	DoctorProviderNameSourceProviderName     = "-provider-name"
+	DoctorProviderNameSourceProviderSource   = "-provider-source"
+)
+
+// DoctorCommand is a Command implementation
+type DoctorCommand struct {
+	Ui cli.Ui
+}
+
+// DoctorReport is the output representation of the effective configuration
+// and detection results of a Terraform Provider codebase.
+type DoctorReport struct {
+	// ConfigFile is the path of the loaded configuration file, if any.
+	ConfigFile string `json:"config_file,omitempty"`
+
+	// Directories contains the documentation directories, sorted by path.
+	Directories []*DoctorReportDirectory `json:"directories"`
+
+	// DisabledChecks contains the identifiers of the checks which would not
+	// run.
+	DisabledChecks []string `json:"disabled_checks"`
+
+	// EnabledChecks contains the identifiers of the checks which would run.
+	EnabledChecks []string `json:"enabled_checks"`
+
+	// Files contains the number of documentation files per classification,
+	// e.g. registry resource.
+	Files map[string]int `json:"files"`
+
+	Path string `json:"path"`
+
+	ProviderName string `json:"provider_name,omitempty"`
+
+	// ProviderNameSource describes where the provider name was determined
+	// from, e.g. -provider-name or path.
+	ProviderNameSource string `json:"provider_name_source,omitempty"`
+
+	ProviderSource string `json:"provider_source,omitempty"`
+
+	// Schema contains the number of schemas per type of the loaded providers
+	// schema, if any.
+	Schema *DoctorReportSchema `json:"schema,omitempty"`
+
+	// Structures contains the directory structures (legacy or registry) of
+	// the documentation directories.
+	Structures []string `json:"structures"`
+}
+
+// DoctorReportDirectory is the output representation of a documentation
+// directory.
+type DoctorReportDirectory struct {
+	// Classification is the directory structure and type, e.g. registry
+	// resource, or only the type unknown for invalid directories.
+	Classification string `json:"classification"`
+
+	// Files is the number of checked files in the directory.
+	Files int `json:"files"`
+
+	Path string `json:"path"`
+}
+
+// DoctorReportSchema is the output representation of a loaded providers
+// schema.
+type DoctorReportSchema struct {
+	Actions       int `json:"actions"`
+	DataSources   int `json:"data_sources"`
+	Functions     int `json:"functions"`
+	ListResources int `json:"list_resources"`
+	Resources     int `json:"resources"`
+}
+
+func (*DoctorCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-json", "Output as JSON.")
+	CheckFlagsHelp(opts)
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs doctor [options] [PATH]
+
+  Outputs the effective configuration and detection results of the given Terraform
+  Provider codebase with the given check command options and configuration file: the
+  provider name and source, configuration file, documentation directories and
+  structures, number of documentation files of each type, whether a providers schema
+  was loaded, and which checks would run.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *DoctorCommand) Name() string { return "doctor" }
+
+func (c *DoctorCommand) Run(args []string) int {
+	var config CheckCommandConfig
+	var outputJson bool
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.BoolVar(&outputJson, "json", false, "")
+	CheckFlags(flags, &config)
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	report, err := doctorReport(&config)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error diagnosing Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	if outputJson {
+		output, err := json.MarshalIndent(report, "", "  ")
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error encoding JSON: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(string(output))
+
+		return 0
+	}
+
+	c.Ui.Output(doctorReportSummary(report, config.DocsDirs))
+
+	return 0
+}
+
+func (c *DoctorCommand) Synopsis() string {
+	return "Outputs Terraform Provider documentation configuration and detection results"
+}
+
+// doctorReport returns the effective configuration and detection results of
+// the check configuration.
+func doctorReport(config *CheckCommandConfig) (*DoctorReport, error) {
+	report := &DoctorReport{
+		Files:              make(map[string]int),
+		Path:               config.Path,
+		ProviderNameSource: doctorProviderNameSource(config.ProviderName, config.ProviderSource, config.Path),
+		ProviderSource:     config.ProviderSource,
+	}
+
+	checkOpts, err := config.CheckOptions()
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring checks: %w", err)
+	}
+
+	report.ProviderName = config.ProviderName
+
+	if report.ProviderName == "" {
+		report.ProviderNameSource = ""
+	}
+
+	report.ConfigFile = config.ConfigFile
+
+	if report.ConfigFile == "" {
+		defaultPath := filepath.Join(config.Path, DefaultConfigFile)
+
+		if _, err := os.Stat(defaultPath); !errors.Is(err, fs.ErrNotExist) {
+			report.ConfigFile = defaultPath
+		}
+	}
+
+	if config.ProviderBinary != "" || config.ProvidersSchemaJson != "" || config.ProviderVersion != "" {
+		report.Schema = &DoctorReportSchema{
+			Actions:       len(checkOpts.ActionFileMismatch.Schemas),
+			DataSources:   len(checkOpts.DataSourceFileMismatch.Schemas),
+			Functions:     len(checkOpts.FunctionSignature.Schemas),
+			ListResources: len(checkOpts.ListResourceFileMismatch.Schemas),
+			Resources:     len(checkOpts.ResourceFileMismatch.Schemas),
+		}
+	}
+
+	for _, checkInfo := range check.Checks {
+		if checkOpts.CheckEnabled(checkInfo.ID) {
+			report.EnabledChecks = append(report.EnabledChecks, checkInfo.ID)
+		} else {
+			report.DisabledChecks = append(report.DisabledChecks, checkInfo.ID)
+		}
+	}
+
+	directories, err := config.Directories()
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting Terraform Provider documentation directories: %w", err)
+	}
+
+	directories = check.RemoveOtherFiles(directories, checkOpts.Directories)
+	structures := make(map[string]struct{})
+
+	for directory, files := range directories {
+		report.Directories = append(report.Directories, &DoctorReportDirectory{
+			Classification: check.DirectoryClassification(directory),
+			Files:          len(files),
+			Path:           directory,
+		})
+
+		if structure := check.DirectoryStructure(directory); structure != "" {
+			structures[structure] = struct{}{}
+		}
+	}
+
+	sort.Slice(report.Directories, func(i, j int) bool {
+		return report.Directories[i].Path < report.Directories[j].Path
+	})
+
+	for structure := range structures {
+		report.Structures = append(report.Structures, structure)
+	}
+
+	sort.Strings(report.Structures)
+
+	files, err := check.DocumentationFiles(directories, &check.FileOptions{BasePath: config.Path}, config.ProviderName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing Terraform Provider documentation: %w", err)
+	}
+
+	for _, file := range files {
+		report.Files[file.Classification()]++
+	}
+
+	// Ensure empty results are output as empty JSON arrays
+	if report.Directories == nil {
+		report.Directories = []*DoctorReportDirectory{}
+	}
+
+	if report.DisabledChecks == nil {
+		report.DisabledChecks = []string{}
+	}
+
+	if report.EnabledChecks == nil {
+		report.EnabledChecks = []string{}
+	}
+
+	if report.Structures == nil {
+		report.Structures = []string{}
+	}
+
+	return report, nil
+}
+
+// doctorProviderNameSource returns where the provider name is determined
+// from, following detectProviderName.
+func doctorProviderNameSource(providerName string, providerSource string, path string) string {
+	switch {
+	case providerName != "":
+		return DoctorProviderNameSourceProviderName
+	case providerSource != "":
+		return DoctorProviderNameSourceProviderSource
+	case path == "":
+		return DoctorProviderNameSourceCurrentDirectory
+	default:
+		return DoctorProviderNameSourcePath
+	}
+}
+
+// doctorReportSummary returns the human readable doctor output.
+func doctorReportSummary(report *DoctorReport, docsDirs string) string {
+	var output strings.Builder
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	settings := tabwriter.NewWriter(outputBuffer, 0, 0, 2, ' ', 0)
+
+	path := report.Path
+
+	if path == "" {
+		path = "(current directory)"
+	}
+
+	fmt.Fprintf(settings, "Path:\t%s\n", path)
+
+	if report.ProviderName == "" {
+		fmt.Fprintf(settings, "Provider name:\t(unknown, use -provider-name or -provider-source, or a terraform-provider-* path)\n")
+	} else {
+		fmt.Fprintf(settings, "Provider name:\t%s (from %s)\n", report.ProviderName, report.ProviderNameSource)
+	}
+
+	if report.ProviderSource == "" {
+		fmt.Fprintf(settings, "Provider source:\t(none)\n")
+	} else {
+		fmt.Fprintf(settings, "Provider source:\t%s\n", report.ProviderSource)
+	}
+
+	if report.ConfigFile == "" {
+		fmt.Fprintf(settings, "Configuration file:\t(none)\n")
+	} else {
+		fmt.Fprintf(settings, "Configuration file:\t%s\n", report.ConfigFile)
+	}
+
+	if report.Schema == nil {
+		fmt.Fprintf(settings, "Providers schema:\t(none, use -providers-schema-json, -provider-binary, or -provider-version for schema dependent checks)\n")
+	} else {
+		fmt.Fprintf(settings, "Providers schema:\t%d actions, %d data sources, %d functions, %d list resources, %d resources\n", report.Schema.Actions, report.Schema.DataSources, report.Schema.Functions, report.Schema.ListResources, report.Schema.Resources)
+	}
+
+	settings.Flush()
+	output.WriteString(outputBuffer.String())
+
+	if len(report.Directories) == 0 {
+		if docsDirs == "" {
+			fmt.Fprintf(&output, "\nNo documentation directories found. Documentation is expected in the %s (registry) or %s (legacy) directories of the path, otherwise use -docs-dir.\n", check.RegistryIndexDirectory, check.LegacyIndexDirectory)
+		} else {
+			fmt.Fprintf(&output, "\nNo documentation directories found in -docs-dir directories: %s\n", docsDirs)
+		}
+	} else {
+		structures := strings.Join(report.Structures, ", ")
+
+		if structures == "" {
+			structures = "none"
+		}
+
+		fmt.Fprintf(&output, "\nDocumentation directories (structures: %s):\n", structures)
+
+		outputBuffer.Reset()
+		directories := tabwriter.NewWriter(outputBuffer, 0, 0, 2, ' ', 0)
+
+		for _, directory := range report.Directories {
+			fmt.Fprintf(directories, "  %s\t%s\t%d file(s)\n", directory.Path, directory.Classification, directory.Files)
+		}
+
+		directories.Flush()
+		output.WriteString(outputBuffer.String())
+	}
+
+	if len(report.Files) > 0 {
+		classifications := make([]string, 0, len(report.Files))
+
+		for classification := range report.Files {
+			classifications = append(classifications, classification)
+		}
+
+		sort.Strings(classifications)
+
+		fmt.Fprintf(&output, "\nDocumentation files:\n")
+
+		outputBuffer.Reset()
+		files := tabwriter.NewWriter(outputBuffer, 0, 0, 2, ' ', 0)
+
+		for _, classification := range classifications {
+			fmt.Fprintf(files, "  %s\t%d\n", classification, report.Files[classification])
+		}
+
+		files.Flush()
+		output.WriteString(outputBuffer.String())
+	}
+
+	fmt.Fprintf(&output, "\nEnabled checks (%d of %d):\n", len(report.EnabledChecks), len(report.EnabledChecks)+len(report.DisabledChecks))
+
+	for _, id := range report.EnabledChecks {
+		fmt.Fprintf(&output, "  %s\n", id)
+	}
+
+	if len(report.DisabledChecks) > 0 {
+		fmt.Fprintf(&output, "\nDisabled checks (%d), run tfproviderdocs checks for details:\n", len(report.DisabledChecks))
+
+		for _, id := range report.DisabledChecks {
+			fmt.Fprintf(&output, "  %s\n", id)
+		}
+	}
+
+	return strings.TrimSpace(output.String())
+}
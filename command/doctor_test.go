@@ -0,0 +1,166 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+)
+
+func TestDoctorCommand_implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &DoctorCommand{}
+}
+
+func TestDoctorReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "terraform-provider-test")
+
+	for file, content := range map[string]string{
+		"docs/index.md":            "# Test Provider\n",
+		"docs/resources/thing.md":  "---\nsubcategory: \"Thing\"\n---\n\n# Resource: test_thing\n",
+		"docs/resources/widget.md": "---\nsubcategory: \"Thing\"\n---\n\n# Resource: test_widget\n",
+	} {
+		filePath := filepath.Join(path, filepath.FromSlash(file))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("unexpected error creating directory: %s", err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+			t.Fatalf("unexpected error writing file: %s", err)
+		}
+	}
+
+	testCases := []struct {
+		Name                     string
+		Config                   *CheckCommandConfig
+		ExpectDirectories        []*DoctorReportDirectory
+		ExpectEnabled            string
+		ExpectFiles              map[string]int
+		ExpectProviderName       string
+		ExpectProviderNameSource string
+		ExpectSchema             *DoctorReportSchema
+		ExpectStructures         []string
+	}{
+		{
+			Name: "registry",
+			Config: &CheckCommandConfig{
+				Path: path,
+			},
+			ExpectDirectories: []*DoctorReportDirectory{
+				{
+					Classification: "registry index",
+					Files:          1,
+					Path:           "docs",
+				},
+				{
+					Classification: "registry resource",
+					Files:          2,
+					Path:           "docs/resources",
+				},
+			},
+			ExpectEnabled: check.CheckIDFileSize,
+			ExpectFiles: map[string]int{
+				"registry index":    1,
+				"registry resource": 2,
+			},
+			ExpectProviderName:       "test",
+			ExpectProviderNameSource: DoctorProviderNameSourcePath,
+			ExpectStructures:         []string{check.DirectoryStructureRegistry},
+		},
+		{
+			Name: "providers schema",
+			Config: &CheckCommandConfig{
+				Path:                path,
+				ProviderName:        "null",
+				ProvidersSchemaJson: "testdata/valid-providers-schema.json",
+			},
+			ExpectDirectories: []*DoctorReportDirectory{
+				{
+					Classification: "registry index",
+					Files:          1,
+					Path:           "docs",
+				},
+				{
+					Classification: "registry resource",
+					Files:          2,
+					Path:           "docs/resources",
+				},
+			},
+			ExpectEnabled: check.CheckIDFileMismatch,
+			ExpectFiles: map[string]int{
+				"registry index":    1,
+				"registry resource": 2,
+			},
+			ExpectProviderName:       "null",
+			ExpectProviderNameSource: DoctorProviderNameSourceProviderName,
+			ExpectSchema: &DoctorReportSchema{
+				DataSources: 1,
+				Resources:   1,
+			},
+			ExpectStructures: []string{check.DirectoryStructureRegistry},
+		},
+		{
+			Name: "no documentation directories",
+			Config: &CheckCommandConfig{
+				Path:         t.TempDir(),
+				ProviderName: "test",
+			},
+			ExpectDirectories:        []*DoctorReportDirectory{},
+			ExpectEnabled:            check.CheckIDFileSize,
+			ExpectFiles:              map[string]int{},
+			ExpectProviderName:       "test",
+			ExpectProviderNameSource: DoctorProviderNameSourceProviderName,
+			ExpectStructures:         []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := doctorReport(testCase.Config)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got.Directories, testCase.ExpectDirectories) {
+				t.Errorf("expected directories %v, got: %v", testCase.ExpectDirectories, got.Directories)
+			}
+
+			if !reflect.DeepEqual(got.Files, testCase.ExpectFiles) {
+				t.Errorf("expected files %v, got: %v", testCase.ExpectFiles, got.Files)
+			}
+
+			if got.ProviderName != testCase.ExpectProviderName {
+				t.Errorf("expected provider name %q, got: %q", testCase.ExpectProviderName, got.ProviderName)
+			}
+
+			if got.ProviderNameSource != testCase.ExpectProviderNameSource {
+				t.Errorf("expected provider name source %q, got: %q", testCase.ExpectProviderNameSource, got.ProviderNameSource)
+			}
+
+			if !reflect.DeepEqual(got.Schema, testCase.ExpectSchema) {
+				t.Errorf("expected schema %v, got: %v", testCase.ExpectSchema, got.Schema)
+			}
+
+			if !reflect.DeepEqual(got.Structures, testCase.ExpectStructures) {
+				t.Errorf("expected structures %v, got: %v", testCase.ExpectStructures, got.Structures)
+			}
+
+			var enabled bool
+
+			for _, id := range got.EnabledChecks {
+				if id == testCase.ExpectEnabled {
+					enabled = true
+				}
+			}
+
+			if !enabled {
+				t.Errorf("expected enabled check %s, got: %v", testCase.ExpectEnabled, got.EnabledChecks)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// cloneGitRepository shallow fetches the reference, such as a branch, tag, or
+// commit, of the git repository into a temporary directory and returns its
+// path. The default branch is fetched if the reference is empty. The git
+// executable must be available. The returned function removes the temporary
+// directory. The git executable is killed if the context is done. URLs and
+// references starting with - are rejected, since git would parse them as
+// options.
+func cloneGitRepository(ctx context.Context, url string, ref string) (string, func() error, error) {
+	if err := checkGitArgument("URL", url); err != nil {
+		return "", nil, err
+	}
+
+	if err := checkGitArgument("reference", ref); err != nil {
+		return "", nil, err
+	}
+
+	directory, err := os.MkdirTemp("", "tfproviderdocs-git-")
+
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	cleanup := func() error {
+		return os.RemoveAll(directory)
+	}
+
+	fetchArgs := []string{"fetch", "--depth", "1", "--quiet", url}
+
+	if ref != "" {
+		fetchArgs = append(fetchArgs, ref)
+	}
+
+	commands := [][]string{
+		{"init", "--quiet"},
+		fetchArgs,
+		{"checkout", "--quiet", "FETCH_HEAD"},
+	}
+
+	for _, args := range commands {
+		if err := runGit(ctx, directory, args...); err != nil {
+			cleanup()
+
+			return "", nil, err
+		}
+	}
+
+	return directory, cleanup, nil
+}
+
+// checkGitArgument returns an error if the value of a git command line
+// argument starts with -, which git would parse as an option, such as
+// --upload-pack running an arbitrary command.
+func checkGitArgument(name string, value string) error {
+	if strings.HasPrefix(value, "-") {
+		return fmt.Errorf("invalid git %s (%s): must not start with -", name, value)
+	}
+
+	return nil
+}
+
+// runGit runs the git executable with the arguments in the directory,
+// returning an error including the standard error output if it fails.
+func runGit(ctx context.Context, directory string, args ...string) error {
+	_, err := gitOutput(ctx, directory, args...)
+
+	return err
+}
+
+// gitProviderName returns the Terraform Provider short name of git repository
+// URLs ending with terraform-provider-*, such as
+// https://github.com/example/terraform-provider-test or
+// git@github.com:example/terraform-provider-test.git, otherwise an empty
+// string.
+func gitProviderName(url string) string {
+	url = strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git")
+
+	if index := strings.LastIndexAny(url, "/:"); index != -1 {
+		url = url[index+1:]
+	}
+
+	return providerNameFromPath(url)
+}
+
+// gitOutput runs the git executable with the arguments in the directory,
+// returning the standard output with surrounding whitespace removed.
+func gitOutput(ctx context.Context, directory string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = directory
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("error running git %s: %w: %s", args[0], err, message)
+		}
+
+		return "", fmt.Errorf("error running git %s: %w", args[0], err)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// previousReleaseFiles returns the git reference and the slash separated
+// paths of all files, relative to the directory, of the previous release in
+// the git repository of the directory. The previous release is the reference,
+// if given, otherwise the most recent tag reachable from the current commit
+// which does not point at the current commit, so the previous release of a
+// release commit is the release before it.
+func previousReleaseFiles(ctx context.Context, logger hclog.Logger, directory string, ref string) (string, []string, error) {
+	if directory == "" {
+		directory = "."
+	}
+
+	if ref == "" {
+		args := []string{"describe", "--tags", "--abbrev=0"}
+
+		currentTags, err := gitOutput(ctx, directory, "tag", "--points-at", "HEAD")
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		for _, tag := range strings.Fields(currentTags) {
+			args = append(args, "--exclude="+tag)
+		}
+
+		ref, err = gitOutput(ctx, directory, args...)
+
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to determine previous release git tag: %w", err)
+		}
+	}
+
+	if err := checkGitArgument("reference", ref); err != nil {
+		return "", nil, err
+	}
+
+	output, err := gitOutput(ctx, directory, "ls-tree", "-r", "--name-only", ref)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	logger.Debug("Found previous release files", "ref", ref)
+
+	if output == "" {
+		return ref, nil, nil
+	}
+
+	return ref, strings.Split(output, "\n"), nil
+}
@@ -0,0 +1,217 @@
+package command
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestCloneGitRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not found")
+	}
+
+	repository := t.TempDir()
+
+	testGit(t, repository, "init", "--quiet")
+	testGitCommit(t, repository, "docs/index.md", "# Test Provider v1\n")
+	testGit(t, repository, "tag", "v1.0.0")
+	testGitCommit(t, repository, "docs/index.md", "# Test Provider v2\n")
+
+	testCases := []struct {
+		Name        string
+		URL         string
+		Ref         string
+		Expect      string
+		ExpectError bool
+	}{
+		{
+			Name:   "default branch",
+			Expect: "# Test Provider v2\n",
+		},
+		{
+			Name:   "tag",
+			Ref:    "v1.0.0",
+			Expect: "# Test Provider v1\n",
+		},
+		{
+			Name:        "ref not found",
+			Ref:         "v9.9.9",
+			ExpectError: true,
+		},
+		{
+			Name:        "ref option",
+			Ref:         "--upload-pack=touch " + filepath.Join(repository, "injected"),
+			ExpectError: true,
+		},
+		{
+			Name:        "url option",
+			URL:         "--upload-pack=touch " + filepath.Join(repository, "injected"),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			url := testCase.URL
+
+			if url == "" {
+				url = "file://" + filepath.ToSlash(repository)
+			}
+
+			path, cleanup, err := cloneGitRepository(context.Background(), url, testCase.Ref)
+
+			if _, statErr := os.Stat(filepath.Join(repository, "injected")); statErr == nil {
+				t.Fatalf("expected git option not to run command")
+			}
+
+			if err == nil && testCase.ExpectError {
+				cleanup()
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil {
+				if !testCase.ExpectError {
+					t.Fatalf("expected no error, got error: %s", err)
+				}
+
+				return
+			}
+
+			defer cleanup()
+
+			got, err := os.ReadFile(filepath.Join(path, "docs", "index.md"))
+
+			if err != nil {
+				t.Fatalf("unexpected error reading cloned file: %s", err)
+			}
+
+			if string(got) != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestGitProviderName(t *testing.T) {
+	testCases := []struct {
+		URL    string
+		Expect string
+	}{
+		{
+			URL:    "https://github.com/example/terraform-provider-test",
+			Expect: "test",
+		},
+		{
+			URL:    "https://github.com/example/terraform-provider-test.git/",
+			Expect: "test",
+		},
+		{
+			URL:    "git@github.com:example/terraform-provider-test.git",
+			Expect: "test",
+		},
+		{
+			URL:    "https://github.com/example/docs",
+			Expect: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.URL, func(t *testing.T) {
+			if got := gitProviderName(testCase.URL); got != testCase.Expect {
+				t.Errorf("expected %q, got %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestPreviousReleaseFiles(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not found")
+	}
+
+	repository := t.TempDir()
+
+	testGit(t, repository, "init", "--quiet")
+
+	if _, _, err := previousReleaseFiles(context.Background(), hclog.NewNullLogger(), repository, "HEAD"); err == nil {
+		t.Errorf("expected error without commits, got no error")
+	}
+
+	testGitCommit(t, repository, "docs/resources/one.md", "# Resource: test_one\n")
+	testGit(t, repository, "tag", "v1.0.0")
+
+	if _, _, err := previousReleaseFiles(context.Background(), hclog.NewNullLogger(), repository, ""); err == nil {
+		t.Errorf("expected error without previous tag, got no error")
+	}
+
+	testGitCommit(t, repository, "docs/resources/two.md", "# Resource: test_two\n")
+	testGit(t, repository, "tag", "v1.1.0")
+
+	testCases := []struct {
+		Name        string
+		Ref         string
+		ExpectRef   string
+		ExpectFiles []string
+	}{
+		{
+			Name:        "previous tag",
+			ExpectRef:   "v1.0.0",
+			ExpectFiles: []string{"docs/resources/one.md"},
+		},
+		{
+			Name:        "ref",
+			Ref:         "v1.1.0",
+			ExpectRef:   "v1.1.0",
+			ExpectFiles: []string{"docs/resources/one.md", "docs/resources/two.md"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ref, files, err := previousReleaseFiles(context.Background(), hclog.NewNullLogger(), repository, testCase.Ref)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ref != testCase.ExpectRef {
+				t.Errorf("expected ref %q, got %q", testCase.ExpectRef, ref)
+			}
+
+			if !reflect.DeepEqual(files, testCase.ExpectFiles) {
+				t.Errorf("expected files %q, got %q", testCase.ExpectFiles, files)
+			}
+		})
+	}
+}
+
+func testGit(t *testing.T, directory string, args ...string) {
+	t.Helper()
+
+	if err := runGit(context.Background(), directory, args...); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func testGitCommit(t *testing.T, directory string, file string, content string) {
+	t.Helper()
+
+	path := filepath.Join(directory, filepath.FromSlash(file))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("unexpected error creating directory: %s", err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+
+	testGit(t, directory, "add", file)
+	testGit(t, directory, "-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "commit.gpgsign=false", "commit", "--quiet", "--message", "test")
+}
@@ -0,0 +1,330 @@
+package command
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/hashicorp/go-hclog"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/mitchellh/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// InitConfigFileHeader is the comment written at the beginning of
+// configuration files generated by the init command.
+const InitConfigFileHeader = `# tfproviderdocs configuration file, generated by tfproviderdocs init.
+#
+# The allowed subcategories contain the frontmatter subcategories in use and
+# the ignore entries contain the existing file mismatches, which should be
+# removed once the documentation is fixed.
+`
+
+type InitCommandConfig struct {
+	ConfigFile          string
+	Force               bool
+	LogFormat           string
+	LogLevel            string
+	Path                string
+	ProviderBinary      string
+	ProviderName        string
+	ProviderSource      string
+	ProviderVersion     string
+	ProvidersSchemaJson string
+}
+
+// InitCommand is a Command implementation
+type InitCommand struct {
+	Ui cli.Ui
+}
+
+func (*InitCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-config", fmt.Sprintf("Path to write the YAML configuration file. Defaults to %s in the Terraform Provider codebase path.", DefaultConfigFile))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-force", "Overwrite an existing configuration file.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-binary", "Path to Terraform Provider binary, which is launched to get its schema for generating file mismatch ignore entries.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws). Automatically sets -provider-name by dropping hostname and namespace prefix.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-version", "Terraform Provider version (e.g. 1.2.3), whose binary is downloaded from the Terraform Registry to get its schema for generating file mismatch ignore entries. Requires -provider-source.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-providers-schema-json", "Path to terraform providers schema -json file for generating file mismatch ignore entries.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs init [options] [PATH]
+
+  Writes a starter configuration file for the given Terraform Provider codebase from
+  its existing documentation. The allowed guide and resource subcategories are seeded
+  with the frontmatter subcategories in use. If a providers schema is given, the file
+  mismatch and file missing ignore entries are populated with the existing data source
+  and resource mismatches, so the check command passes these checks on adoption.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *InitCommand) Name() string { return "init" }
+
+func (c *InitCommand) Run(args []string) int {
+	var config InitCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.StringVar(&config.ConfigFile, "config", "", "")
+	flags.BoolVar(&config.Force, "force", false, "")
+	flags.StringVar(&config.ProviderBinary, "provider-binary", "", "")
+	flags.StringVar(&config.ProviderName, "provider-name", "", "")
+	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
+	flags.StringVar(&config.ProviderVersion, "provider-version", "", "")
+	flags.StringVar(&config.ProvidersSchemaJson, "providers-schema-json", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	configFilePath := config.ConfigFile
+
+	if configFilePath == "" {
+		configFilePath = filepath.Join(config.Path, DefaultConfigFile)
+	}
+
+	if _, err := os.Stat(configFilePath); !errors.Is(err, fs.ErrNotExist) && !config.Force {
+		c.Ui.Error(fmt.Sprintf("Error initializing configuration file: %s already exists, use -force to overwrite", configFilePath))
+		return 1
+	}
+
+	config.ProviderName = detectProviderName(config.ProviderName, config.ProviderSource, config.Path)
+
+	ps, err := initProviderSchemas(config)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing configuration file: %s", err))
+		return 1
+	}
+
+	directories, err := check.GetDirectories(config.Path, nil)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	if len(directories) == 0 {
+		if config.Path == "" {
+			c.Ui.Error("No Terraform Provider documentation directories found in current path")
+		} else {
+			c.Ui.Error(fmt.Sprintf("No Terraform Provider documentation directories found in path: %s", config.Path))
+		}
+
+		return 1
+	}
+
+	configFile, err := initConfigFile(directories, config.Path, config.ProviderName, config.ProviderSource, ps)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing configuration file: %s", err))
+		return 1
+	}
+
+	content, err := yaml.Marshal(configFile)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error encoding configuration file: %s", err))
+		return 1
+	}
+
+	if err := os.WriteFile(configFilePath, append([]byte(InitConfigFileHeader+"\n"), content...), 0o644); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing configuration file: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Wrote configuration file: %s", configFilePath))
+	c.Ui.Output(fmt.Sprintf("  %d allowed resource subcategories, %d allowed guide subcategories", len(configFile.AllowedResourceSubcategories), len(configFile.AllowedGuideSubcategories)))
+
+	if ps == nil {
+		c.Ui.Output("  no file mismatch ignore entries, use -providers-schema-json, -provider-binary, or -provider-version to generate them")
+	} else {
+		c.Ui.Output(fmt.Sprintf("  %d file mismatch and %d file missing ignore entries", len(configFile.IgnoreFileMismatchDataSources)+len(configFile.IgnoreFileMismatchResources), len(configFile.IgnoreFileMissingDataSources)+len(configFile.IgnoreFileMissingResources)))
+	}
+
+	return 0
+}
+
+func (c *InitCommand) Synopsis() string {
+	return "Writes a starter configuration file from existing Terraform Provider documentation"
+}
+
+// initProviderSchemas returns the providers schema of the configuration, if
+// any schema source is given.
+func initProviderSchemas(config InitCommandConfig) (*tfjson.ProviderSchemas, error) {
+	var sources int
+
+	for _, source := range []string{config.ProviderBinary, config.ProviderVersion, config.ProvidersSchemaJson} {
+		if source != "" {
+			sources++
+		}
+	}
+
+	if sources == 0 {
+		return nil, nil
+	}
+
+	if sources > 1 {
+		return nil, fmt.Errorf("only one of -provider-binary, -provider-version, or -providers-schema-json can be given")
+	}
+
+	if config.ProviderName == "" {
+		return nil, fmt.Errorf("unknown provider name for generating file mismatch ignore entries, check that the current working directory or provided path is prefixed with terraform-provider-* or use -provider-name")
+	}
+
+	switch {
+	case config.ProviderBinary != "":
+		return providerBinarySchemas(config.ProviderBinary, config.ProviderName, config.ProviderSource)
+	case config.ProviderVersion != "":
+		return providerVersionSchemas(hclog.L(), config.ProviderVersion, config.ProviderName, config.ProviderSource, "")
+	default:
+		return providerSchemas(config.ProvidersSchemaJson, config.ProviderName, config.ProviderSource)
+	}
+}
+
+// initConfigFile returns the starter configuration file of the documentation
+// directories. The allowed subcategories contain the frontmatter
+// subcategories in use, and, if the providers schema is given, the ignore
+// entries contain the data source and resource file mismatches. CDKTF
+// documentation is ignored for file mismatches, as it is generated.
+func initConfigFile(directories map[string][]string, basePath string, providerName string, providerSource string, ps *tfjson.ProviderSchemas) (*ConfigFile, error) {
+	files, err := check.DocumentationFiles(directories, &check.FileOptions{BasePath: basePath}, providerName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing Terraform Provider documentation: %w", err)
+	}
+
+	guideSubcategories := make(map[string]struct{})
+	resourceSubcategories := make(map[string]struct{})
+
+	for _, file := range files {
+		if file.Subcategory == "" {
+			continue
+		}
+
+		switch file.Type {
+		case check.DocumentationTypeGuide:
+			guideSubcategories[file.Subcategory] = struct{}{}
+		case check.DocumentationTypeAction, check.DocumentationTypeDataSource, check.DocumentationTypeListResource, check.DocumentationTypeResource:
+			resourceSubcategories[file.Subcategory] = struct{}{}
+		}
+	}
+
+	result := &ConfigFile{
+		AllowedGuideSubcategories:    sortedSetKeys(guideSubcategories),
+		AllowedResourceSubcategories: sortedSetKeys(resourceSubcategories),
+	}
+
+	if ps == nil {
+		return result, nil
+	}
+
+	result.IgnoreFileMismatchDataSources, result.IgnoreFileMissingDataSources = initFileMismatches(
+		directories,
+		[]string{
+			fmt.Sprintf("%s/%s", check.LegacyIndexDirectory, check.LegacyDataSourcesDirectory),
+			fmt.Sprintf("%s/%s", check.RegistryIndexDirectory, check.RegistryDataSourcesDirectory),
+		},
+		&check.FileMismatchOptions{
+			ProviderName: providerName,
+			ResourceType: check.ResourceTypeDataSource,
+			Schemas:      providerSchemasDataSources(ps, providerName, providerSource),
+		},
+	)
+
+	result.IgnoreFileMismatchResources, result.IgnoreFileMissingResources = initFileMismatches(
+		directories,
+		[]string{
+			fmt.Sprintf("%s/%s", check.LegacyIndexDirectory, check.LegacyResourcesDirectory),
+			fmt.Sprintf("%s/%s", check.RegistryIndexDirectory, check.RegistryResourcesDirectory),
+		},
+		&check.FileMismatchOptions{
+			ProviderName: providerName,
+			ResourceType: check.ResourceTypeResource,
+			Schemas:      providerSchemasResources(ps, providerName, providerSource),
+		},
+	)
+
+	return result, nil
+}
+
+// initFileMismatches returns the sorted names of the extraneous
+// documentation files and of the missing documentation files of each
+// documentation directory, as expected by the ignore options.
+func initFileMismatches(directories map[string][]string, documentationDirectories []string, opts *check.FileMismatchOptions) ([]string, []string) {
+	if len(opts.Schemas) == 0 {
+		return nil, nil
+	}
+
+	fileMismatchCheck := check.NewFileMismatchCheck(opts)
+	extra := make(map[string]struct{})
+	missing := make(map[string]struct{})
+
+	for _, directory := range documentationDirectories {
+		files, ok := directories[directory]
+
+		if !ok {
+			continue
+		}
+
+		for _, file := range fileMismatchCheck.ExtraFiles(files) {
+			extra[fileMismatchCheck.FileResourceName(file)] = struct{}{}
+		}
+
+		for _, resourceName := range fileMismatchCheck.MissingResources(files) {
+			missing[resourceName] = struct{}{}
+		}
+	}
+
+	return sortedSetKeys(extra), sortedSetKeys(missing)
+}
+
+// sortedSetKeys returns the sorted keys of the set, or nil if empty.
+func sortedSetKeys(set map[string]struct{}) []string {
+	if len(set) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(set))
+
+	for key := range set {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestInitCommand_implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &InitCommand{}
+}
+
+func TestInitCommand(t *testing.T) {
+	path := t.TempDir()
+
+	for file, content := range map[string]string{
+		"docs/index.md":                    "# Null Provider\n",
+		"docs/data-sources/extra.md":       "---\nsubcategory: \"Network\"\n---\n\n# Data Source: null_extra\n",
+		"docs/guides/example.md":           "---\nsubcategory: \"Tutorials\"\npage_title: \"Example\"\n---\n\n# Example\n",
+		"docs/resources/resource.md":       "---\nsubcategory: \"Compute\"\n---\n\n# Resource: null_resource\n",
+		"docs/resources/thing.md":          "---\nsubcategory: \"Compute\"\n---\n\n# Resource: null_thing\n",
+		"docs/cdktf/python/resources/a.md": "---\nsubcategory: \"Compute\"\n---\n\n# Resource: null_a\n",
+	} {
+		filePath := filepath.Join(path, filepath.FromSlash(file))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("unexpected error creating directory: %s", err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+			t.Fatalf("unexpected error writing file: %s", err)
+		}
+	}
+
+	args := []string{"-provider-name", "null", "-providers-schema-json", "testdata/valid-providers-schema.json", path}
+	ui := cli.NewMockUi()
+
+	if code := (&InitCommand{Ui: ui}).Run(args); code != 0 {
+		t.Fatalf("expected exit code 0, got: %d: %s", code, ui.ErrorWriter.String())
+	}
+
+	configFilePath := filepath.Join(path, DefaultConfigFile)
+	content, err := os.ReadFile(configFilePath)
+
+	if err != nil {
+		t.Fatalf("unexpected error reading configuration file: %s", err)
+	}
+
+	if err := validateConfigFile(content); err != nil {
+		t.Errorf("unexpected error validating configuration file: %s", err)
+	}
+
+	got, err := loadConfigFile(configFilePath, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error loading configuration file: %s", err)
+	}
+
+	expect := &ConfigFile{
+		AllowedGuideSubcategories:     []string{"Tutorials"},
+		AllowedResourceSubcategories:  []string{"Compute", "Network"},
+		IgnoreFileMismatchDataSources: []string{"null_extra"},
+		IgnoreFileMismatchResources:   []string{"null_thing"},
+		IgnoreFileMissingDataSources:  []string{"null_data_source"},
+	}
+
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected configuration file %#v, got: %#v", expect, got)
+	}
+
+	if code := (&InitCommand{Ui: cli.NewMockUi()}).Run(args); code != 1 {
+		t.Errorf("expected exit code 1 for existing configuration file, got: %d", code)
+	}
+
+	if code := (&InitCommand{Ui: cli.NewMockUi()}).Run(append([]string{"-force"}, args...)); code != 0 {
+		t.Errorf("expected exit code 0 with -force, got: %d", code)
+	}
+}
+
+func TestInitCommand_noSchema(t *testing.T) {
+	path := t.TempDir()
+	filePath := filepath.Join(path, "docs", "resources", "thing.md")
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatalf("unexpected error creating directory: %s", err)
+	}
+
+	if err := os.WriteFile(filePath, []byte("---\nsubcategory: \"Compute\"\n---\n\n# Resource: test_thing\n"), 0o644); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+
+	configFilePath := filepath.Join(t.TempDir(), "config.yml")
+	ui := cli.NewMockUi()
+
+	if code := (&InitCommand{Ui: ui}).Run([]string{"-config", configFilePath, path}); code != 0 {
+		t.Fatalf("expected exit code 0, got: %d: %s", code, ui.ErrorWriter.String())
+	}
+
+	got, err := loadConfigFile(configFilePath, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error loading configuration file: %s", err)
+	}
+
+	expect := &ConfigFile{
+		AllowedResourceSubcategories: []string{"Compute"},
+	}
+
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected configuration file %#v, got: %#v", expect, got)
+	}
+}
@@ -0,0 +1,148 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+)
+
+type ListCommandConfig struct {
+	ConfigFile     string
+	Json           bool
+	LogFormat      string
+	LogLevel       string
+	Path           string
+	ProviderName   string
+	ProviderSource string
+}
+
+// ListCommand is a Command implementation
+type ListCommand struct {
+	Ui cli.Ui
+}
+
+func (*ListCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-config", fmt.Sprintf("Path to YAML configuration file. Defaults to %s in the Terraform Provider codebase path, if it exists.", DefaultConfigFile))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-json", "Output as JSON.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws). Automatically sets -provider-name by dropping hostname and namespace prefix.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs list [options] [PATH]
+
+  Lists the documentation files discovered in the given Terraform Provider codebase,
+  including their classification, inferred resource name, and subcategory.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ListCommand) Name() string { return "list" }
+
+func (c *ListCommand) Run(args []string) int {
+	var config ListCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.StringVar(&config.ConfigFile, "config", "", "")
+	flags.BoolVar(&config.Json, "json", false, "")
+	flags.StringVar(&config.ProviderName, "provider-name", "", "")
+	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	config.ProviderName = detectProviderName(config.ProviderName, config.ProviderSource, config.Path)
+
+	configFile, err := loadConfigFile(config.ConfigFile, config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading configuration file: %s", err))
+		return 1
+	}
+
+	directories, err := check.GetDirectories(config.Path, nil)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	directories = check.RemoveOtherFiles(directories, configFile.CheckDirectoryOptions())
+
+	fileOpts := &check.FileOptions{
+		BasePath: config.Path,
+	}
+
+	files, err := check.DocumentationFiles(directories, fileOpts, config.ProviderName)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	if config.Json {
+		// Ensure empty results are output as an empty JSON array
+		if files == nil {
+			files = []*check.DocumentationFile{}
+		}
+
+		output, err := json.MarshalIndent(files, "", "  ")
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error encoding JSON: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(string(output))
+
+		return 0
+	}
+
+	outputBuffer := bytes.NewBuffer([]byte{})
+	output := tabwriter.NewWriter(outputBuffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(output, "PATH\tCLASSIFICATION\tRESOURCE\tSUBCATEGORY")
+
+	for _, file := range files {
+		fmt.Fprintf(output, "%s\t%s\t%s\t%s\n", file.Path, file.Classification(), file.ResourceName, file.Subcategory)
+	}
+
+	output.Flush()
+	c.Ui.Output(strings.TrimSpace(outputBuffer.String()))
+
+	return 0
+}
+
+func (c *ListCommand) Synopsis() string {
+	return "Lists discovered Terraform Provider documentation"
+}
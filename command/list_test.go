@@ -0,0 +1,12 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestListCommand_implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &ListCommand{}
+}
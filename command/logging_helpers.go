@@ -4,16 +4,42 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"strings"
+	"sync/atomic"
 	"text/tabwriter"
 
 	"github.com/hashicorp/go-hclog"
 )
 
 const (
+	LogFormatJSON = `json`
+	LogFormatText = `text`
+
+	LogFormatFlagHelpDefinition  = `-log-format=[text|json]`
+	LogFormatFlagHelpDescription = `Log output format. The json format includes fields such as check, file, and provider.`
+
 	LogLevelFlagHelpDefinition  = `-log-level=[TRACE|DEBUG|INFO|WARN|ERROR]`
 	LogLevelFlagHelpDescription = `Log output level.`
 )
 
+var LogFormats = []string{
+	LogFormatJSON,
+	LogFormatText,
+}
+
+// rootLogger is the logger configured by ConfigureLogging, without fields
+// added for individual Terraform Providers. Sinks registered with the logger,
+// such as warning counters, also receive the messages of derived loggers.
+var rootLogger = hclog.NewInterceptLogger(nil)
+
+func LogFormatFlag(flagSet *flag.FlagSet, varToSave *string) {
+	flagSet.StringVar(varToSave, "log-format", LogFormatText, "")
+}
+
+func LogFormatFlagHelp(w *tabwriter.Writer) {
+	fmt.Fprintf(w, CommandHelpOptionFormat, LogFormatFlagHelpDefinition, LogFormatFlagHelpDescription)
+}
+
 func LogLevelFlag(flagSet *flag.FlagSet, varToSave *string) {
 	flagSet.StringVar(varToSave, "log-level", "INFO", "")
 }
@@ -22,14 +48,63 @@ func LogLevelFlagHelp(w *tabwriter.Writer) {
 	fmt.Fprintf(w, CommandHelpOptionFormat, LogLevelFlagHelpDefinition, LogLevelFlagHelpDescription)
 }
 
-func ConfigureLogging(loggerName string, logLevel string) {
+// ConfigureLogging configures the default hclog logger, which is also the
+// output of the standard library log package.
+func ConfigureLogging(loggerName string, logLevel string, logFormat string) error {
+	if logFormat != LogFormatJSON && logFormat != LogFormatText {
+		return fmt.Errorf("invalid -log-format (%s), valid formats: %s", logFormat, strings.Join(LogFormats, ", "))
+	}
+
 	loggerOptions := &hclog.LoggerOptions{
-		Name:  loggerName,
-		Level: hclog.LevelFromString(logLevel),
+		JSONFormat: logFormat == LogFormatJSON,
+		Level:      hclog.LevelFromString(logLevel),
+		Name:       loggerName,
 	}
-	logger := hclog.New(loggerOptions)
+	logger := hclog.NewInterceptLogger(loggerOptions)
+
+	rootLogger = logger
+	hclog.SetDefault(logger)
 
 	log.SetOutput(logger.StandardWriter(&hclog.StandardLoggerOptions{InferLevels: true}))
 	log.SetPrefix("")
 	log.SetFlags(0)
+
+	return nil
+}
+
+// configureProviderLogging adds the provider name field to the default
+// logger, e.g. when checking multiple Terraform Providers.
+func configureProviderLogging(providerName string) {
+	if providerName == "" {
+		hclog.SetDefault(rootLogger)
+
+		return
+	}
+
+	hclog.SetDefault(rootLogger.With("provider", providerName))
+}
+
+// warningCounter is a logging sink which counts warning and error messages,
+// regardless of the log level of the output, e.g. for -strict.
+type warningCounter struct {
+	count int64
+}
+
+func (c *warningCounter) Accept(_ string, level hclog.Level, _ string, _ ...interface{}) {
+	if level >= hclog.Warn {
+		atomic.AddInt64(&c.count, 1)
+	}
+}
+
+// countWarnings counts the warning and error messages of the configured
+// logger until the returned function is called, which returns the count.
+func countWarnings() func() int {
+	counter := &warningCounter{}
+	rootLogger.RegisterSink(counter)
+
+	return func() int {
+		rootLogger.DeregisterSink(counter)
+
+		return int(atomic.LoadInt64(&counter.count))
+	}
 }
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"testing"
+)
+
+func TestConfigureLogging(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		LogFormat   string
+		ExpectError bool
+	}{
+		{
+			Name:      "json",
+			LogFormat: LogFormatJSON,
+		},
+		{
+			Name:      "text",
+			LogFormat: LogFormatText,
+		},
+		{
+			Name:        "invalid",
+			LogFormat:   "xml",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := ConfigureLogging("test", "INFO", testCase.LogFormat)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+		})
+	}
+}
+
+func TestCountWarnings(t *testing.T) {
+	if err := ConfigureLogging("test", "ERROR", LogFormatText); err != nil {
+		t.Fatalf("unexpected error configuring logging: %s", err)
+	}
+
+	warnings := countWarnings()
+
+	rootLogger.Info("not counted")
+	rootLogger.Warn("counted")
+	rootLogger.With("provider", "test").Warn("counted")
+
+	if got, expected := warnings(), 2; got != expected {
+		t.Errorf("expected %d warnings, got %d", expected, got)
+	}
+
+	rootLogger.Warn("not counted after returned function is called")
+
+	if got, expected := warnings(), 2; got != expected {
+		t.Errorf("expected %d warnings, got %d", expected, got)
+	}
+}
@@ -0,0 +1,195 @@
+package command
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/lsp"
+	"github.com/bflad/tfproviderdocs/version"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	// DiagnosticSource is the source of Language Server Protocol diagnostics.
+	DiagnosticSource = "tfproviderdocs"
+)
+
+// LSPCommand is a Command implementation
+type LSPCommand struct {
+	Ui cli.Ui
+}
+
+func (*LSPCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	CheckFlagsHelp(opts)
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs lsp [options] [PATH]
+
+  Serves diagnostics for the documentation files of the given Terraform Provider codebase
+  over the Language Server Protocol (LSP) using standard input and output.
+
+  Diagnostics are published when documentation files are opened or saved and use the same
+  options as the check command. Checks across files, such as file mismatch checks, are not
+  reported. Logs are written to standard error.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *LSPCommand) Name() string { return "lsp" }
+
+func (c *LSPCommand) Run(args []string) int {
+	var config CheckCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	CheckFlags(flags, &config)
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	// Standard input is the Language Server Protocol transport.
+	if config.ProvidersSchemaJson == ProvidersSchemaJsonStdin {
+		c.Ui.Error("Error configuring checks: -providers-schema-json cannot read standard input with the lsp command")
+		return 1
+	}
+
+	checkOpts, err := config.CheckOptions()
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring checks: %s", err))
+		return 1
+	}
+
+	root, err := filepath.Abs(config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error determining Terraform Provider codebase path: %s", err))
+		return 1
+	}
+
+	server := lsp.NewServer(DiagnosticSource, version.GetVersion().FullVersionNumber(false), lspDiagnostics(config.Path, root, checkOpts))
+
+	if err := server.Serve(os.Stdin, os.Stdout); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error serving Language Server Protocol: %s", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *LSPCommand) Synopsis() string {
+	return "Serves documentation diagnostics over the Language Server Protocol"
+}
+
+// lspDiagnostics returns a function which runs the checks of individual
+// documentation files. Files outside the documentation directories have no
+// diagnostics.
+func lspDiagnostics(basePath string, root string, checkOpts *check.CheckOptions) lsp.DiagnosticsFunc {
+	return func(path string) ([]lsp.Diagnostic, error) {
+		file, err := filepath.Rel(root, path)
+
+		if err != nil || file == ".." || strings.HasPrefix(file, ".."+string(filepath.Separator)) {
+			return nil, nil
+		}
+
+		directories, err := check.GetDirectories(basePath, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		directory := filepath.Dir(file)
+
+		if !containsPath(directories[directory], file) {
+			return nil, nil
+		}
+
+		findings, errs := check.Findings(check.NewCheck(checkOpts).RunFiles(map[string][]string{
+			directory: {file},
+		}))
+
+		diagnostics := make([]lsp.Diagnostic, 0, len(findings)+len(errs))
+
+		for _, finding := range findings {
+			diagnostics = append(diagnostics, findingDiagnostic(finding))
+		}
+
+		for _, err := range errs {
+			diagnostics = append(diagnostics, lsp.Diagnostic{
+				Message:  strings.TrimPrefix(err.Error(), file+": "),
+				Severity: lsp.DiagnosticSeverityError,
+				Source:   DiagnosticSource,
+			})
+		}
+
+		return diagnostics, nil
+	}
+}
+
+// findingDiagnostic returns the finding as a diagnostic, which spans the line
+// of the finding. Findings without a line are reported on the first line.
+func findingDiagnostic(finding *check.Finding) lsp.Diagnostic {
+	message := strings.TrimPrefix(finding.Error(), finding.Path+": ")
+
+	if finding.Suggestion != "" {
+		message += "\n\nsuggestion: " + finding.Suggestion
+	}
+
+	diagnostic := lsp.Diagnostic{
+		Code:     finding.CheckID,
+		Message:  message,
+		Severity: lsp.DiagnosticSeverityError,
+		Source:   DiagnosticSource,
+	}
+
+	// Diagnostic positions are zero-based.
+	if finding.Line > 0 {
+		diagnostic.Range = lsp.Range{
+			End:   lsp.Position{Line: finding.Line},
+			Start: lsp.Position{Line: finding.Line - 1},
+		}
+	}
+
+	return diagnostic
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,132 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/lsp"
+)
+
+func TestLspDiagnostics(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"docs/index.md":           "---\npage_title: \"Provider: Test\"\n---\n\n# Test Provider\n",
+		"docs/resources/thing.md": "---\nlayout: test\n---\n\n# Resource: test_thing\n",
+		"README.md":               "# Test\n",
+	}
+
+	for file, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(file))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("error creating directory: %s", err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("error writing file: %s", err)
+		}
+	}
+
+	config := CheckCommandConfig{
+		Path:         root,
+		ProviderName: "test",
+	}
+
+	checkOpts, err := config.CheckOptions()
+
+	if err != nil {
+		t.Fatalf("error configuring checks: %s", err)
+	}
+
+	diagnostics := lspDiagnostics(root, root, checkOpts)
+
+	testCases := []struct {
+		Name       string
+		File       string
+		ExpectCode string
+	}{
+		{
+			Name: "valid",
+			File: "docs/index.md",
+		},
+		{
+			Name:       "invalid frontmatter",
+			File:       "docs/resources/thing.md",
+			ExpectCode: check.CheckIDFrontMatter,
+		},
+		{
+			Name: "not documentation",
+			File: "README.md",
+		},
+		{
+			Name: "outside path",
+			File: "../outside.md",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := diagnostics(filepath.Join(root, filepath.FromSlash(testCase.File)))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectCode == "" {
+				if len(got) > 0 {
+					t.Errorf("expected no diagnostics, got: %v", got)
+				}
+
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected 1 diagnostic, got: %v", got)
+			}
+
+			if got[0].Code != testCase.ExpectCode {
+				t.Errorf("expected code %s, got: %s", testCase.ExpectCode, got[0].Code)
+			}
+
+			if !strings.Contains(got[0].Message, "suggestion: remove `layout`") {
+				t.Errorf("expected suggestion in message, got: %s", got[0].Message)
+			}
+		})
+	}
+}
+
+func TestFindingDiagnostic(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Finding     *check.Finding
+		ExpectRange lsp.Range
+	}{
+		{
+			Name:    "no line",
+			Finding: check.NewFinding(check.CheckIDFrontMatter, "docs/index.md", errors.New("docs/index.md: test")),
+		},
+		{
+			Name:    "line",
+			Finding: check.NewFinding(check.CheckIDForbiddenWords, "docs/index.md", check.WithLine(errors.New("docs/index.md: forbidden words: TODO (line 7)"), 7)),
+			ExpectRange: lsp.Range{
+				End:   lsp.Position{Line: 7},
+				Start: lsp.Position{Line: 6},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := findingDiagnostic(testCase.Finding)
+
+			if got.Range != testCase.ExpectRange {
+				t.Errorf("expected range %v, got: %v", testCase.ExpectRange, got.Range)
+			}
+		})
+	}
+}
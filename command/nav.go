@@ -0,0 +1,170 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// NavFormatJSON outputs the navigation tree as JSON.
+	NavFormatJSON = "json"
+
+	// NavFormatYAML outputs the navigation tree as YAML.
+	NavFormatYAML = "yaml"
+)
+
+// NavFormats contains all available navigation output formats.
+var NavFormats = []string{
+	NavFormatJSON,
+	NavFormatYAML,
+}
+
+type NavCommandConfig struct {
+	ConfigFile     string
+	Format         string
+	LogFormat      string
+	LogLevel       string
+	Path           string
+	ProviderName   string
+	ProviderSource string
+}
+
+// NavCommand is a Command implementation
+type NavCommand struct {
+	Ui cli.Ui
+}
+
+func (*NavCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-config", fmt.Sprintf("Path to YAML configuration file. Defaults to %s in the Terraform Provider codebase path, if it exists.", DefaultConfigFile))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-format", fmt.Sprintf("Output format of the navigation tree (%s). Defaults to %s.", strings.Join(NavFormats, ", "), NavFormatJSON))
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-name", "Terraform Provider short name (e.g. aws). Automatically determined if -provider-source is given or if current working directory or provided path is prefixed with terraform-provider-*.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-provider-source", "Terraform Provider source address (e.g. registry.terraform.io/hashicorp/aws). Automatically sets -provider-name by dropping hostname and namespace prefix.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs nav [options] [PATH]
+
+  Outputs the navigation tree of the documentation in the given Terraform Provider
+  codebase, grouping actions, data sources, guides, list resources, and resources
+  by their YAML frontmatter subcategory.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *NavCommand) Name() string { return "nav" }
+
+func (c *NavCommand) Run(args []string) int {
+	var config NavCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.StringVar(&config.ConfigFile, "config", "", "")
+	flags.StringVar(&config.Format, "format", NavFormatJSON, "")
+	flags.StringVar(&config.ProviderName, "provider-name", "", "")
+	flags.StringVar(&config.ProviderSource, "provider-source", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	args = flags.Args()
+
+	if len(args) == 1 {
+		config.Path = args[0]
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	if config.Format != NavFormatJSON && config.Format != NavFormatYAML {
+		c.Ui.Error(fmt.Sprintf("Error configuring navigation: invalid -format (%s), valid formats: %s", config.Format, strings.Join(NavFormats, ", ")))
+		return 1
+	}
+
+	config.ProviderName = detectProviderName(config.ProviderName, config.ProviderSource, config.Path)
+
+	configFile, err := loadConfigFile(config.ConfigFile, config.Path)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading configuration file: %s", err))
+		return 1
+	}
+
+	directories, err := check.GetDirectories(config.Path, nil)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting Terraform Provider documentation directories: %s", err))
+		return 1
+	}
+
+	directories = check.RemoveOtherFiles(directories, configFile.CheckDirectoryOptions())
+
+	fileOpts := &check.FileOptions{
+		BasePath: config.Path,
+	}
+
+	files, err := check.DocumentationFiles(directories, fileOpts, config.ProviderName)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing Terraform Provider documentation: %s", err))
+		return 1
+	}
+
+	output, err := navOutput(check.NewNavigation(files), config.Format)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error encoding navigation: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(output)
+
+	return 0
+}
+
+func (c *NavCommand) Synopsis() string {
+	return "Outputs Terraform Provider documentation navigation tree"
+}
+
+// navOutput returns the navigation tree encoded in the output format.
+func navOutput(navigation *check.Navigation, format string) (string, error) {
+	if format == NavFormatYAML {
+		output, err := yaml.Marshal(navigation)
+
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(string(output)), nil
+	}
+
+	output, err := json.MarshalIndent(navigation, "", "  ")
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/mitchellh/cli"
+)
+
+func TestNavCommand_implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &NavCommand{}
+}
+
+func TestNavOutput(t *testing.T) {
+	navigation := &check.Navigation{
+		Subcategories: []*check.NavigationSubcategory{
+			{
+				Name: "Things",
+				Resources: []*check.NavigationEntry{
+					{
+						Name: "test_thing",
+						Path: "docs/resources/thing.md",
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name   string
+		Format string
+		Expect string
+	}{
+		{
+			Name:   "json",
+			Format: NavFormatJSON,
+			Expect: `{
+  "subcategories": [
+    {
+      "name": "Things",
+      "resources": [
+        {
+          "name": "test_thing",
+          "path": "docs/resources/thing.md"
+        }
+      ]
+    }
+  ]
+}`,
+		},
+		{
+			Name:   "yaml",
+			Format: NavFormatYAML,
+			Expect: `subcategories:
+- name: Things
+  resources:
+  - name: test_thing
+    path: docs/resources/thing.md`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := navOutput(navigation, testCase.Format)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != strings.TrimSpace(testCase.Expect) {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
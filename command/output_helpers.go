@@ -0,0 +1,475 @@
+package command
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/fatih/color"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// OutputFormatCodeClimate outputs findings in the Code Climate JSON issue
+	// format, which GitLab CI consumes as a code quality report.
+	OutputFormatCodeClimate = "codeclimate"
+
+	// OutputFormatShields outputs a shields.io endpoint badge JSON object
+	// with the number of checked files and findings, which repositories can
+	// publish for a documentation badge.
+	OutputFormatShields = "shields"
+
+	// OutputFormatTAP outputs a Test Anything Protocol stream with a test
+	// point per checked file and per check with findings outside of them.
+	OutputFormatTAP = "tap"
+
+	// OutputFormatText outputs human readable findings and a summary.
+	OutputFormatText = "text"
+
+	// SeverityError is the output severity of findings and errors.
+	SeverityError = "error"
+
+	// codeClimateSeverity is the Code Climate severity of findings.
+	codeClimateSeverity = "major"
+
+	// shieldsLabel is the label of shields.io endpoint badges.
+	shieldsLabel = "docs checked"
+)
+
+// OutputFormats contains all available check output formats.
+var OutputFormats = []string{
+	OutputFormatCodeClimate,
+	OutputFormatShields,
+	OutputFormatTAP,
+	OutputFormatText,
+}
+
+// findingsFormatter formats check findings and errors for terminal output.
+type findingsFormatter struct {
+	checkID    *color.Color
+	path       *color.Color
+	severity   *color.Color
+	suggestion *color.Color
+}
+
+// newFindingsFormatter returns a findingsFormatter, which colorizes the
+// severity, check identifier, and path of findings if enabled.
+func newFindingsFormatter(colorize bool) *findingsFormatter {
+	formatter := &findingsFormatter{
+		checkID:    color.New(color.FgYellow),
+		path:       color.New(color.FgCyan, color.Bold),
+		severity:   color.New(color.FgRed, color.Bold),
+		suggestion: color.New(color.FgGreen),
+	}
+
+	for _, c := range []*color.Color{formatter.checkID, formatter.path, formatter.severity, formatter.suggestion} {
+		if colorize {
+			c.EnableColor()
+		} else {
+			c.DisableColor()
+		}
+	}
+
+	return formatter
+}
+
+// Format returns the findings and errors, one per line, with continuation
+// lines of multiple line messages indented.
+func (f *findingsFormatter) Format(findings []*check.Finding, errs []error) string {
+	var lines []string
+
+	for _, finding := range findings {
+		lines = append(lines, f.FormatFinding(finding))
+	}
+
+	for _, err := range errs {
+		lines = append(lines, f.formatLine("", err.Error(), ""))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// FormatFinding returns a single finding as severity, path, message, and
+// check identifier, followed by an indented suggestion line if the finding
+// has a remediation hint.
+func (f *findingsFormatter) FormatFinding(finding *check.Finding) string {
+	line := f.formatLine(finding.Path, finding.Error(), finding.CheckID)
+
+	if finding.Suggestion == "" {
+		return line
+	}
+
+	return line + "\n    " + f.suggestion.Sprint("suggestion: "+finding.Suggestion)
+}
+
+func (f *findingsFormatter) formatLine(path string, message string, checkID string) string {
+	var b strings.Builder
+
+	b.WriteString("  ")
+	b.WriteString(f.severity.Sprint(SeverityError))
+	b.WriteString(" ")
+
+	if path != "" && strings.HasPrefix(message, path+": ") {
+		b.WriteString(f.path.Sprint(path))
+		message = strings.TrimPrefix(message, path)
+	}
+
+	b.WriteString(strings.ReplaceAll(strings.TrimSpace(message), "\n", "\n    "))
+
+	if checkID != "" {
+		b.WriteString(" ")
+		b.WriteString(f.checkID.Sprint(fmt.Sprintf("[%s]", checkID)))
+	}
+
+	return b.String()
+}
+
+// findingMessage returns the finding error without the path prefix.
+func findingMessage(finding *check.Finding) string {
+	message := strings.TrimSpace(finding.Error())
+
+	if finding.Path != "" {
+		message = strings.TrimPrefix(message, finding.Path+": ")
+	}
+
+	return message
+}
+
+// codeClimateIssue is an issue of the Code Climate JSON format.
+type codeClimateIssue struct {
+	Categories  []string            `json:"categories"`
+	CheckName   string              `json:"check_name"`
+	Content     *codeClimateContent `json:"content,omitempty"`
+	Description string              `json:"description"`
+	Fingerprint string              `json:"fingerprint"`
+	Location    codeClimateLocation `json:"location"`
+	Severity    string              `json:"severity"`
+	Type        string              `json:"type"`
+}
+
+type codeClimateContent struct {
+	Body string `json:"body"`
+}
+
+type codeClimateLocation struct {
+	Lines codeClimateLines `json:"lines"`
+	Path  string           `json:"path"`
+}
+
+type codeClimateLines struct {
+	Begin int `json:"begin"`
+}
+
+// codeClimateReport returns the findings as a Code Climate JSON issue array.
+// Paths are relative to the base path, which is the Terraform Provider
+// codebase path. Findings without a line are reported on the first line and
+// findings without a path on the base path.
+func codeClimateReport(findings []*check.Finding, basePath string) ([]byte, error) {
+	issues := make([]*codeClimateIssue, 0, len(findings))
+
+	for _, finding := range findings {
+		path := filepath.ToSlash(filepath.Join(basePath, finding.Path))
+
+		if path == "" {
+			path = "."
+		}
+
+		description := findingMessage(finding)
+		line := finding.Line
+
+		if line == 0 {
+			line = 1
+		}
+		fingerprint := sha256.Sum256([]byte(finding.CheckID + "\x00" + path + "\x00" + description))
+
+		issue := &codeClimateIssue{
+			Categories:  []string{"Style"},
+			CheckName:   finding.CheckID,
+			Description: description,
+			Fingerprint: hex.EncodeToString(fingerprint[:]),
+			Location: codeClimateLocation{
+				Lines: codeClimateLines{Begin: line},
+				Path:  path,
+			},
+			Severity: codeClimateSeverity,
+			Type:     "issue",
+		}
+
+		if finding.Suggestion != "" {
+			issue.Content = &codeClimateContent{
+				Body: "Suggestion: " + finding.Suggestion,
+			}
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return json.MarshalIndent(issues, "", "  ")
+}
+
+// shieldsBadge is a shields.io endpoint badge, see
+// https://shields.io/badges/endpoint-badge.
+type shieldsBadge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// shieldsReport returns a shields.io endpoint badge JSON object with the
+// number of checked documentation files and findings. The badge is green
+// without findings, orange with findings, and red if errors prevented
+// checking.
+func shieldsReport(summary *check.CheckSummary, findings []*check.Finding, errs []error) ([]byte, error) {
+	if summary == nil {
+		summary = &check.CheckSummary{}
+	}
+
+	totalFiles := 0
+
+	for _, files := range summary.Files {
+		totalFiles += files
+	}
+
+	badge := &shieldsBadge{
+		Color:         "brightgreen",
+		Label:         shieldsLabel,
+		Message:       fmt.Sprintf("%s, %s", pluralize(totalFiles, "file"), pluralize(len(findings), "finding")),
+		SchemaVersion: 1,
+	}
+
+	switch {
+	case len(errs) > 0:
+		badge.Color = "red"
+		badge.Message = "error"
+	case len(findings) > 0:
+		badge.Color = "orange"
+	}
+
+	return json.MarshalIndent(badge, "", "  ")
+}
+
+// pluralize returns the count and the noun, with an s suffix unless the count
+// is one.
+func pluralize(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, noun)
+	}
+
+	return fmt.Sprintf("%d %ss", count, noun)
+}
+
+// tapDiagnostic is the YAML diagnostic block of a failed TAP test point.
+type tapDiagnostic struct {
+	Findings []tapFinding `yaml:"findings"`
+}
+
+type tapFinding struct {
+	Check      string `yaml:"check"`
+	Path       string `yaml:"path,omitempty"`
+	Message    string `yaml:"message"`
+	Suggestion string `yaml:"suggestion,omitempty"`
+}
+
+// tapReport returns the findings as a TAP version 13 stream. Each checked
+// file is a test point, which fails with the findings of the file. Findings
+// of other paths, such as directories, or without a path are test points of
+// their check after the files.
+func tapReport(files []string, findings []*check.Finding) (string, error) {
+	fileFindings := make(map[string][]*check.Finding, len(files))
+
+	for _, file := range files {
+		fileFindings[file] = nil
+	}
+
+	checkFindings := make(map[string][]*check.Finding)
+
+	for _, finding := range findings {
+		if _, ok := fileFindings[finding.Path]; ok && finding.Path != "" {
+			fileFindings[finding.Path] = append(fileFindings[finding.Path], finding)
+			continue
+		}
+
+		checkFindings[finding.CheckID] = append(checkFindings[finding.CheckID], finding)
+	}
+
+	sortedFiles := make([]string, 0, len(fileFindings))
+
+	for file := range fileFindings {
+		sortedFiles = append(sortedFiles, file)
+	}
+
+	sort.Strings(sortedFiles)
+
+	checkIDs := make([]string, 0, len(checkFindings))
+
+	for checkID := range checkFindings {
+		checkIDs = append(checkIDs, checkID)
+	}
+
+	sort.Strings(checkIDs)
+
+	var b strings.Builder
+
+	fmt.Fprintln(&b, "TAP version 13")
+	fmt.Fprintf(&b, "1..%d\n", len(sortedFiles)+len(checkIDs))
+
+	testPoint := 0
+
+	for _, file := range sortedFiles {
+		testPoint++
+
+		if err := writeTAPTestPoint(&b, testPoint, filepath.ToSlash(file), fileFindings[file], false); err != nil {
+			return "", err
+		}
+	}
+
+	for _, checkID := range checkIDs {
+		testPoint++
+
+		if err := writeTAPTestPoint(&b, testPoint, "check "+checkID, checkFindings[checkID], true); err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// writeTAPTestPoint writes a test point, which fails with a YAML diagnostic
+// block if there are findings. Finding paths are only included if the test
+// point is not a file.
+func writeTAPTestPoint(b *strings.Builder, number int, description string, findings []*check.Finding, includePath bool) error {
+	// Hash characters start TAP directives, such as TODO and SKIP.
+	description = strings.ReplaceAll(description, "#", "\\#")
+
+	if len(findings) == 0 {
+		fmt.Fprintf(b, "ok %d - %s\n", number, description)
+
+		return nil
+	}
+
+	fmt.Fprintf(b, "not ok %d - %s\n", number, description)
+
+	diagnostic := tapDiagnostic{
+		Findings: make([]tapFinding, 0, len(findings)),
+	}
+
+	for _, finding := range findings {
+		tf := tapFinding{
+			Check:      finding.CheckID,
+			Message:    findingMessage(finding),
+			Suggestion: finding.Suggestion,
+		}
+
+		if includePath {
+			tf.Path = filepath.ToSlash(finding.Path)
+		}
+
+		diagnostic.Findings = append(diagnostic.Findings, tf)
+	}
+
+	content, err := yaml.Marshal(diagnostic)
+
+	if err != nil {
+		return fmt.Errorf("error encoding YAML: %w", err)
+	}
+
+	b.WriteString("  ---\n")
+
+	for _, line := range strings.Split(strings.TrimSuffix(string(content), "\n"), "\n") {
+		b.WriteString("  " + line + "\n")
+	}
+
+	b.WriteString("  ...\n")
+
+	return nil
+}
+
+// checkOutput is a check output format and, if given with -out, the file path
+// to write the report to instead of standard output.
+type checkOutput struct {
+	Format string
+	Path   string
+}
+
+// formatFlag is a flag.Value which appends a check output of each repeated
+// -format flag.
+type formatFlag []*checkOutput
+
+func (f *formatFlag) Set(value string) error {
+	*f = append(*f, &checkOutput{Format: value})
+
+	return nil
+}
+
+func (f *formatFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	formats := make([]string, 0, len(*f))
+
+	for _, output := range *f {
+		formats = append(formats, output.Format)
+	}
+
+	return strings.Join(formats, ",")
+}
+
+// outFlag is a flag.Value which sets the file path of the check output of
+// the preceding -format flag.
+type outFlag struct {
+	outputs *formatFlag
+}
+
+func (f outFlag) Set(value string) error {
+	outputs := *f.outputs
+
+	if len(outputs) == 0 || outputs[len(outputs)-1].Path != "" {
+		return fmt.Errorf("must follow a -format flag")
+	}
+
+	outputs[len(outputs)-1].Path = value
+
+	return nil
+}
+
+func (f outFlag) String() string {
+	return ""
+}
+
+// checkOutputs validates the check outputs and returns the format of standard
+// output, which defaults to text, and the outputs written to files.
+func checkOutputs(outputs []*checkOutput) (string, []*checkOutput, error) {
+	format := ""
+	var files []*checkOutput
+
+	for _, output := range outputs {
+		if output.Format != OutputFormatCodeClimate && output.Format != OutputFormatShields && output.Format != OutputFormatTAP && output.Format != OutputFormatText {
+			return "", nil, fmt.Errorf("invalid -format (%s), valid formats: %s", output.Format, strings.Join(OutputFormats, ", "))
+		}
+
+		if output.Path != "" {
+			files = append(files, output)
+
+			continue
+		}
+
+		if format != "" {
+			return "", nil, fmt.Errorf("only one -format can be given without -out")
+		}
+
+		format = output.Format
+	}
+
+	if format == "" {
+		format = OutputFormatText
+	}
+
+	return format, files, nil
+}
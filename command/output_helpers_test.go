@@ -0,0 +1,515 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check"
+)
+
+func TestFindingsFormatterFormat(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Findings []*check.Finding
+		Errs     []error
+		Expect   string
+	}{
+		{
+			Name: "finding with path",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", errors.New("docs/resources/thing.md: error checking file frontmatter: test")),
+			},
+			Expect: "  error docs/resources/thing.md: error checking file frontmatter: test [frontmatter]",
+		},
+		{
+			Name: "finding without path",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFileMissing, "", errors.New("missing documentation file for resource: test_thing")),
+			},
+			Expect: "  error missing documentation file for resource: test_thing [file-missing]",
+		},
+		{
+			Name: "multiple line finding",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDContents, "docs/resources/thing.md", errors.New("docs/resources/thing.md: error checking file contents: 1 error occurred:\n\t* test\n\n")),
+			},
+			Expect: "  error docs/resources/thing.md: error checking file contents: 1 error occurred:\n    \t* test [contents]",
+		},
+		{
+			Name: "finding with suggestion",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", check.WithSuggestion(errors.New("docs/resources/thing.md: error checking file frontmatter: test"), "add `subcategory: \"Example\"` to the YAML frontmatter")),
+			},
+			Expect: "  error docs/resources/thing.md: error checking file frontmatter: test [frontmatter]\n    suggestion: add `subcategory: \"Example\"` to the YAML frontmatter",
+		},
+		{
+			Name: "findings and errors",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFileSize, "docs/index.md", errors.New("docs/index.md: error checking file size: test")),
+			},
+			Errs: []error{
+				errors.New("docs/index.md: error reading file: test"),
+			},
+			Expect: "  error docs/index.md: error checking file size: test [file-size]\n  error docs/index.md: error reading file: test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := newFindingsFormatter(false).Format(testCase.Findings, testCase.Errs)
+
+			if got != testCase.Expect {
+				t.Errorf("expected:\n%q\n\ngot:\n%q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestFindingsFormatterFormatFinding_color(t *testing.T) {
+	finding := check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", errors.New("docs/resources/thing.md: error checking file frontmatter: test"))
+
+	got := newFindingsFormatter(true).FormatFinding(finding)
+
+	if !strings.Contains(got, "\x1b[") {
+		t.Errorf("expected color escape sequences, got: %q", got)
+	}
+
+	got = newFindingsFormatter(false).FormatFinding(finding)
+
+	if strings.Contains(got, "\x1b[") {
+		t.Errorf("expected no color escape sequences, got: %q", got)
+	}
+}
+
+func TestCodeClimateReport(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Findings []*check.Finding
+		BasePath string
+		Expect   string
+	}{
+		{
+			Name:   "no findings",
+			Expect: "[]",
+		},
+		{
+			Name:     "finding with path and suggestion",
+			BasePath: "terraform-provider-test",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", check.WithSuggestion(errors.New("docs/resources/thing.md: error checking file frontmatter: test"), "add `subcategory: \"Example\"` to the YAML frontmatter")),
+			},
+			Expect: `[
+  {
+    "categories": [
+      "Style"
+    ],
+    "check_name": "frontmatter",
+    "content": {
+      "body": "Suggestion: add ` + "`subcategory: \\\"Example\\\"`" + ` to the YAML frontmatter"
+    },
+    "description": "error checking file frontmatter: test",
+    "fingerprint": "%s",
+    "location": {
+      "lines": {
+        "begin": 1
+      },
+      "path": "terraform-provider-test/docs/resources/thing.md"
+    },
+    "severity": "major",
+    "type": "issue"
+  }
+]`,
+		},
+		{
+			Name: "finding with line",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDForbiddenWords, "docs/resources/thing.md", check.WithLine(errors.New("docs/resources/thing.md: forbidden words: TODO (line 7)"), 7)),
+			},
+			Expect: `[
+  {
+    "categories": [
+      "Style"
+    ],
+    "check_name": "forbidden-words",
+    "description": "forbidden words: TODO (line 7)",
+    "fingerprint": "%s",
+    "location": {
+      "lines": {
+        "begin": 7
+      },
+      "path": "docs/resources/thing.md"
+    },
+    "severity": "major",
+    "type": "issue"
+  }
+]`,
+		},
+		{
+			Name: "finding without path",
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFileMissing, "", errors.New("missing documentation file for resource: test_thing")),
+			},
+			Expect: `[
+  {
+    "categories": [
+      "Style"
+    ],
+    "check_name": "file-missing",
+    "description": "missing documentation file for resource: test_thing",
+    "fingerprint": "%s",
+    "location": {
+      "lines": {
+        "begin": 1
+      },
+      "path": "."
+    },
+    "severity": "major",
+    "type": "issue"
+  }
+]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := codeClimateReport(testCase.Findings, testCase.BasePath)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var issues []*codeClimateIssue
+
+			if err := json.Unmarshal(got, &issues); err != nil {
+				t.Fatalf("unexpected error decoding report: %s", err)
+			}
+
+			expect := testCase.Expect
+
+			if len(issues) > 0 {
+				if len(issues[0].Fingerprint) != 64 {
+					t.Errorf("expected SHA-256 hex fingerprint, got: %s", issues[0].Fingerprint)
+				}
+
+				expect = fmt.Sprintf(expect, issues[0].Fingerprint)
+			}
+
+			if string(got) != expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", expect, got)
+			}
+		})
+	}
+}
+
+func TestCodeClimateReport_fingerprint(t *testing.T) {
+	findings := []*check.Finding{
+		check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", errors.New("docs/resources/thing.md: error checking file frontmatter: test")),
+		check.NewFinding(check.CheckIDFrontMatter, "docs/resources/other.md", errors.New("docs/resources/other.md: error checking file frontmatter: test")),
+	}
+
+	first, err := codeClimateReport(findings, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	second, err := codeClimateReport(findings, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var firstIssues, secondIssues []*codeClimateIssue
+
+	if err := json.Unmarshal(first, &firstIssues); err != nil {
+		t.Fatalf("unexpected error decoding report: %s", err)
+	}
+
+	if err := json.Unmarshal(second, &secondIssues); err != nil {
+		t.Fatalf("unexpected error decoding report: %s", err)
+	}
+
+	if firstIssues[0].Fingerprint != secondIssues[0].Fingerprint {
+		t.Errorf("expected stable fingerprint, got: %s and %s", firstIssues[0].Fingerprint, secondIssues[0].Fingerprint)
+	}
+
+	if firstIssues[0].Fingerprint == firstIssues[1].Fingerprint {
+		t.Errorf("expected unique fingerprints, got: %s", firstIssues[0].Fingerprint)
+	}
+}
+
+func TestShieldsReport(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Summary  *check.CheckSummary
+		Findings []*check.Finding
+		Errs     []error
+		Expect   string
+	}{
+		{
+			Name: "no summary",
+			Expect: `{
+  "schemaVersion": 1,
+  "label": "docs checked",
+  "message": "0 files, 0 findings",
+  "color": "brightgreen"
+}`,
+		},
+		{
+			Name: "passing",
+			Summary: &check.CheckSummary{
+				Files: map[string]int{
+					"registry data source": 1,
+					"registry resource":    2,
+				},
+			},
+			Expect: `{
+  "schemaVersion": 1,
+  "label": "docs checked",
+  "message": "3 files, 0 findings",
+  "color": "brightgreen"
+}`,
+		},
+		{
+			Name: "findings",
+			Summary: &check.CheckSummary{
+				Files: map[string]int{
+					"registry resource": 1,
+				},
+			},
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFileExtension, "docs/resources/thing.md", errors.New("docs/resources/thing.md: invalid file extension")),
+			},
+			Expect: `{
+  "schemaVersion": 1,
+  "label": "docs checked",
+  "message": "1 file, 1 finding",
+  "color": "orange"
+}`,
+		},
+		{
+			Name: "errors",
+			Summary: &check.CheckSummary{
+				Files: map[string]int{
+					"registry resource": 1,
+				},
+			},
+			Errs: []error{errors.New("error reading file")},
+			Expect: `{
+  "schemaVersion": 1,
+  "label": "docs checked",
+  "message": "error",
+  "color": "red"
+}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := shieldsReport(testCase.Summary, testCase.Findings, testCase.Errs)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.Expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestTAPReport(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Files    []string
+		Findings []*check.Finding
+		Expect   string
+	}{
+		{
+			Name:   "no files",
+			Expect: "TAP version 13\n1..0",
+		},
+		{
+			Name:  "passing files",
+			Files: []string{"docs/resources/thing.md", "docs/data-sources/thing.md"},
+			Expect: `TAP version 13
+1..2
+ok 1 - docs/data-sources/thing.md
+ok 2 - docs/resources/thing.md`,
+		},
+		{
+			Name:  "file findings",
+			Files: []string{"docs/resources/thing.md", "docs/resources/other#thing.md"},
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFrontMatter, "docs/resources/thing.md", check.WithSuggestion(errors.New("docs/resources/thing.md: error checking file frontmatter: test"), "add subcategory")),
+				check.NewFinding(check.CheckIDFileExtension, "docs/resources/thing.md", errors.New("docs/resources/thing.md: invalid file extension")),
+			},
+			Expect: `TAP version 13
+1..2
+ok 1 - docs/resources/other\#thing.md
+not ok 2 - docs/resources/thing.md
+  ---
+  findings:
+  - check: frontmatter
+    message: 'error checking file frontmatter: test'
+    suggestion: add subcategory
+  - check: file-extension
+    message: invalid file extension
+  ...`,
+		},
+		{
+			Name:  "check findings",
+			Files: []string{"docs/resources/thing.md"},
+			Findings: []*check.Finding{
+				check.NewFinding(check.CheckIDFileMissing, "", errors.New("missing documentation file for resource: test_other")),
+				check.NewFinding(check.CheckIDDirectoryMixed, "docs", errors.New("docs: mixed directories")),
+			},
+			Expect: `TAP version 13
+1..3
+ok 1 - docs/resources/thing.md
+not ok 2 - check directory-mixed
+  ---
+  findings:
+  - check: directory-mixed
+    path: docs
+    message: mixed directories
+  ...
+not ok 3 - check file-missing
+  ---
+  findings:
+  - check: file-missing
+    message: 'missing documentation file for resource: test_other'
+  ...`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tapReport(testCase.Files, testCase.Findings)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expect {
+				t.Errorf("expected:\n%s\n\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestCheckOutputs(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Args          []string
+		ExpectFormat  string
+		ExpectFiles   []*checkOutput
+		ExpectError   bool
+		ExpectFlagErr bool
+	}{
+		{
+			Name:         "default",
+			ExpectFormat: OutputFormatText,
+		},
+		{
+			Name:         "format",
+			Args:         []string{"-format", OutputFormatTAP},
+			ExpectFormat: OutputFormatTAP,
+		},
+		{
+			Name:         "format with out",
+			Args:         []string{"-format", OutputFormatCodeClimate, "-out", "report.json"},
+			ExpectFormat: OutputFormatText,
+			ExpectFiles: []*checkOutput{
+				{Format: OutputFormatCodeClimate, Path: "report.json"},
+			},
+		},
+		{
+			Name: "multiple formats",
+			Args: []string{
+				"-format", OutputFormatCodeClimate, "-out", "report.json",
+				"-format", OutputFormatText,
+				"-format", OutputFormatTAP, "-out", "report.tap",
+			},
+			ExpectFormat: OutputFormatText,
+			ExpectFiles: []*checkOutput{
+				{Format: OutputFormatCodeClimate, Path: "report.json"},
+				{Format: OutputFormatTAP, Path: "report.tap"},
+			},
+		},
+		{
+			Name:        "multiple formats without out",
+			Args:        []string{"-format", OutputFormatCodeClimate, "-format", OutputFormatTAP},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid format",
+			Args:        []string{"-format", "xml", "-out", "report.xml"},
+			ExpectError: true,
+		},
+		{
+			Name:          "out without format",
+			Args:          []string{"-out", "report.json"},
+			ExpectFlagErr: true,
+		},
+		{
+			Name:          "repeated out",
+			Args:          []string{"-format", OutputFormatTAP, "-out", "report.tap", "-out", "other.tap"},
+			ExpectFlagErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var outputs formatFlag
+
+			flags := flag.NewFlagSet("test", flag.ContinueOnError)
+			flags.SetOutput(io.Discard)
+			flags.Var(&outputs, "format", "")
+			flags.Var(outFlag{outputs: &outputs}, "out", "")
+
+			err := flags.Parse(testCase.Args)
+
+			if err == nil && testCase.ExpectFlagErr {
+				t.Fatalf("expected flag error, got no error")
+			}
+
+			if err != nil {
+				if !testCase.ExpectFlagErr {
+					t.Fatalf("expected no flag error, got error: %s", err)
+				}
+
+				return
+			}
+
+			format, files, err := checkOutputs(outputs)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil {
+				if !testCase.ExpectError {
+					t.Fatalf("expected no error, got error: %s", err)
+				}
+
+				return
+			}
+
+			if format != testCase.ExpectFormat {
+				t.Errorf("expected format %q, got %q", testCase.ExpectFormat, format)
+			}
+
+			if !reflect.DeepEqual(files, testCase.ExpectFiles) {
+				t.Errorf("expected files %#v, got %#v", testCase.ExpectFiles, files)
+			}
+		})
+	}
+}
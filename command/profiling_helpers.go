@@ -0,0 +1,65 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// startProfiling starts writing a CPU profile to the cpuProfile path, if
+// given. The returned function stops the CPU profile and writes a heap
+// profile to the memProfile path, if given. Profiles can be inspected with
+// go tool pprof.
+func startProfiling(cpuProfile string, memProfile string) (func() error, error) {
+	var cpuProfileFile *os.File
+
+	if cpuProfile != "" {
+		var err error
+
+		cpuProfileFile, err = os.Create(cpuProfile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error creating CPU profile file: %w", err)
+		}
+
+		if err := pprof.StartCPUProfile(cpuProfileFile); err != nil {
+			cpuProfileFile.Close()
+
+			return nil, fmt.Errorf("error starting CPU profile: %w", err)
+		}
+	}
+
+	stop := func() error {
+		if cpuProfileFile != nil {
+			pprof.StopCPUProfile()
+
+			if err := cpuProfileFile.Close(); err != nil {
+				return fmt.Errorf("error closing CPU profile file: %w", err)
+			}
+		}
+
+		if memProfile == "" {
+			return nil
+		}
+
+		memProfileFile, err := os.Create(memProfile)
+
+		if err != nil {
+			return fmt.Errorf("error creating memory profile file: %w", err)
+		}
+
+		defer memProfileFile.Close()
+
+		// Collect garbage to report up-to-date allocation statistics.
+		runtime.GC()
+
+		if err := pprof.WriteHeapProfile(memProfileFile); err != nil {
+			return fmt.Errorf("error writing memory profile: %w", err)
+		}
+
+		return nil
+	}
+
+	return stop, nil
+}
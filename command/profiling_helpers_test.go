@@ -0,0 +1,47 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStartProfiling(t *testing.T) {
+	directory := t.TempDir()
+	cpuProfile := filepath.Join(directory, "cpu.pprof")
+	memProfile := filepath.Join(directory, "mem.pprof")
+
+	stop, err := startProfiling(cpuProfile, memProfile)
+
+	if err != nil {
+		t.Fatalf("unexpected error starting profiling: %s", err)
+	}
+
+	if err := stop(); err != nil {
+		t.Fatalf("unexpected error stopping profiling: %s", err)
+	}
+
+	for _, path := range []string{cpuProfile, memProfile} {
+		info, err := os.Stat(path)
+
+		if err != nil {
+			t.Fatalf("expected profile %s, got error: %s", path, err)
+		}
+
+		if info.Size() == 0 {
+			t.Errorf("expected profile %s to not be empty", path)
+		}
+	}
+}
+
+func TestStartProfilingNoProfiles(t *testing.T) {
+	stop, err := startProfiling("", "")
+
+	if err != nil {
+		t.Fatalf("unexpected error starting profiling: %s", err)
+	}
+
+	if err := stop(); err != nil {
+		t.Fatalf("unexpected error stopping profiling: %s", err)
+	}
+}
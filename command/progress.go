@@ -0,0 +1,67 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	// DefaultProgressInterval is the minimum duration between progress updates.
+	DefaultProgressInterval = 250 * time.Millisecond
+)
+
+// progressReporter periodically writes the number of checked documentation
+// files, overwriting the previous update on the same line.
+type progressReporter struct {
+	checked  int
+	interval time.Duration
+	lastTime time.Time
+	total    int
+	writer   io.Writer
+	written  bool
+}
+
+// newProgressReporter returns a progressReporter writing to the writer.
+func newProgressReporter(writer io.Writer, total int) *progressReporter {
+	return &progressReporter{
+		interval: DefaultProgressInterval,
+		total:    total,
+		writer:   writer,
+	}
+}
+
+// Progress records a checked file and writes an update if the interval has
+// elapsed since the previous update.
+func (p *progressReporter) Progress(path string) {
+	p.checked++
+
+	now := time.Now()
+
+	if p.written && now.Sub(p.lastTime) < p.interval {
+		return
+	}
+
+	p.lastTime = now
+	p.written = true
+
+	fmt.Fprintf(p.writer, "\r\x1b[KChecking documentation files: %d/%d", p.checked, p.total)
+}
+
+// Done clears the last update, if any.
+func (p *progressReporter) Done() {
+	if !p.written {
+		return
+	}
+
+	fmt.Fprint(p.writer, "\r\x1b[K")
+	p.written = false
+}
+
+// isTerminal returns true if the file is attached to a terminal.
+func isTerminal(f *os.File) bool {
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestProgressReporter(t *testing.T) {
+	var buf bytes.Buffer
+
+	progress := newProgressReporter(&buf, 3)
+	progress.interval = time.Hour
+
+	progress.Progress("docs/index.md")
+	progress.Progress("docs/resources/thing.md")
+
+	if expected, got := "\r\x1b[KChecking documentation files: 1/3", buf.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	progress.interval = 0
+	progress.Progress("docs/data-sources/thing.md")
+
+	if expected, got := "\r\x1b[KChecking documentation files: 1/3\r\x1b[KChecking documentation files: 3/3", buf.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	buf.Reset()
+	progress.Done()
+
+	if expected, got := "\r\x1b[K", buf.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	buf.Reset()
+	progress.Done()
+
+	if got := buf.String(); got != "" {
+		t.Errorf("expected no output after Done, got %q", got)
+	}
+}
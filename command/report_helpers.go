@@ -0,0 +1,97 @@
+package command
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderdocs/check"
+)
+
+const (
+	ReportFormatCSV  = "csv"
+	ReportFormatJSON = "json"
+)
+
+// reportFormat returns the report format from the path file extension, which
+// must be .csv or .json.
+func reportFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ReportFormatCSV, nil
+	case ".json":
+		return ReportFormatJSON, nil
+	default:
+		return "", fmt.Errorf("invalid report file (%s), must have a .csv or .json extension", path)
+	}
+}
+
+// writeMissingAttributesReport writes the undocumented schema attributes to
+// the path, as CSV with a resource, attribute, and reason header row or as a
+// JSON array of objects, depending on the file extension.
+func writeMissingAttributesReport(path string, attributes []*check.MissingAttribute) error {
+	format, err := reportFormat(path)
+
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+
+	if err != nil {
+		return fmt.Errorf("error creating report file: %w", err)
+	}
+
+	defer file.Close()
+
+	switch format {
+	case ReportFormatCSV:
+		writer := csv.NewWriter(file)
+
+		if err := writer.Write([]string{"resource", "attribute", "reason"}); err != nil {
+			return fmt.Errorf("error writing report file: %w", err)
+		}
+
+		for _, attribute := range attributes {
+			if err := writer.Write([]string{attribute.Resource, attribute.Attribute, attribute.Reason}); err != nil {
+				return fmt.Errorf("error writing report file: %w", err)
+			}
+		}
+
+		writer.Flush()
+
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("error writing report file: %w", err)
+		}
+	case ReportFormatJSON:
+		if attributes == nil {
+			attributes = []*check.MissingAttribute{}
+		}
+
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(attributes); err != nil {
+			return fmt.Errorf("error writing report file: %w", err)
+		}
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error closing report file: %w", err)
+	}
+
+	return nil
+}
+
+// writeReportFile writes the formatted findings report, such as a Code Climate
+// JSON issue array or TAP stream, to the path with a trailing newline.
+func writeReportFile(path string, report string) error {
+	if err := os.WriteFile(path, []byte(report+"\n"), 0o644); err != nil {
+		return fmt.Errorf("error writing report file: %w", err)
+	}
+
+	return nil
+}
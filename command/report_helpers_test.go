@@ -0,0 +1,141 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bflad/tfproviderdocs/check"
+)
+
+func TestReportFormat(t *testing.T) {
+	testCases := []struct {
+		Path        string
+		Expect      string
+		ExpectError bool
+	}{
+		{
+			Path:   "out.csv",
+			Expect: ReportFormatCSV,
+		},
+		{
+			Path:   "reports/OUT.JSON",
+			Expect: ReportFormatJSON,
+		},
+		{
+			Path:        "out.txt",
+			ExpectError: true,
+		},
+		{
+			Path:        "out",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Path, func(t *testing.T) {
+			got, err := reportFormat(testCase.Path)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if got != testCase.Expect {
+				t.Errorf("expected format %q, got: %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestWriteMissingAttributesReport(t *testing.T) {
+	attributes := []*check.MissingAttribute{
+		{Attribute: "password_wo", Reason: "undocumented write-only attribute", Resource: "test_thing"},
+		{Attribute: "setting.key", Reason: "undocumented attribute", Resource: "test_thing"},
+	}
+
+	testCases := []struct {
+		Name       string
+		File       string
+		Attributes []*check.MissingAttribute
+		Expect     string
+	}{
+		{
+			Name:       "csv",
+			File:       "out.csv",
+			Attributes: attributes,
+			Expect: `resource,attribute,reason
+test_thing,password_wo,undocumented write-only attribute
+test_thing,setting.key,undocumented attribute
+`,
+		},
+		{
+			Name:       "json",
+			File:       "out.json",
+			Attributes: attributes,
+			Expect: `[
+  {
+    "attribute": "password_wo",
+    "reason": "undocumented write-only attribute",
+    "resource": "test_thing"
+  },
+  {
+    "attribute": "setting.key",
+    "reason": "undocumented attribute",
+    "resource": "test_thing"
+  }
+]
+`,
+		},
+		{
+			Name:   "json empty",
+			File:   "out.json",
+			Expect: "[]\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), testCase.File)
+
+			if err := writeMissingAttributesReport(path, testCase.Attributes); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := os.ReadFile(path)
+
+			if err != nil {
+				t.Fatalf("unexpected error reading report: %s", err)
+			}
+
+			if string(got) != testCase.Expect {
+				t.Errorf("expected report:\n%s\ngot:\n%s", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func TestWriteReportFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	if err := writeReportFile(path, "[]"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error reading report: %s", err)
+	}
+
+	if expected := "[]\n"; string(got) != expected {
+		t.Errorf("expected report:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if err := writeReportFile(filepath.Join(t.TempDir(), "missing", "report.json"), "[]"); err == nil {
+		t.Errorf("expected error, got no error")
+	}
+}
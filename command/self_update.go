@@ -0,0 +1,491 @@
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/bflad/tfproviderdocs/version"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	// SelfUpdateDefaultReleasesURL is the GitHub API URL of the releases of
+	// this tool.
+	SelfUpdateDefaultReleasesURL = "https://api.github.com/repos/bflad/tfproviderdocs/releases"
+
+	// SelfUpdateSigningKeyName is the release asset name of the armored
+	// OpenPGP public key which signs the release checksums.
+	SelfUpdateSigningKeyName = "tfproviderdocs_signing_key.asc"
+
+	// SelfUpdateTimeout is the timeout of each self-update request, which
+	// includes downloading the release archive.
+	SelfUpdateTimeout = 5 * time.Minute
+)
+
+type SelfUpdateCommandConfig struct {
+	Check     bool
+	LogFormat string
+	LogLevel  string
+	Version   string
+}
+
+// SelfUpdateCommand is a Command implementation which replaces the running
+// executable with a release binary.
+type SelfUpdateCommand struct {
+	// Executable is the path of the executable to replace, defaulting to the
+	// running executable.
+	Executable string
+
+	HTTPClient *http.Client
+
+	// ReleasesURL is the GitHub API URL of the releases, defaulting to
+	// SelfUpdateDefaultReleasesURL.
+	ReleasesURL string
+
+	// SigningKeyFingerprint is the fingerprint of the OpenPGP key which must
+	// sign the release checksums, defaulting to the fingerprint pinned in
+	// release builds.
+	SigningKeyFingerprint string
+
+	Ui      cli.Ui
+	Version *version.VersionInfo
+}
+
+// selfUpdateRelease represents a GitHub release.
+type selfUpdateRelease struct {
+	Assets []struct {
+		BrowserDownloadURL string `json:"browser_download_url"`
+		Name               string `json:"name"`
+	} `json:"assets"`
+	TagName string `json:"tag_name"`
+}
+
+func (*SelfUpdateCommand) Help() string {
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	LogFormatFlagHelp(opts)
+	LogLevelFlagHelp(opts)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-check", "Only report whether a newer release is available, without updating.")
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-version", "Release version to install (e.g. 0.12.0). Defaults to the latest release.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs self-update [options]
+
+  Replaces the running tfproviderdocs executable with the release binary for
+  the current platform, which is verified against the SHA-256 checksums of
+  the release. The checksums must be signed by the release signing key
+  pinned in the running executable. Defaults to the latest release and does nothing if it is not
+  newer than the running version.
+
+  Installations managed by a package manager, such as Homebrew, should be
+  updated with the package manager instead.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *SelfUpdateCommand) Name() string { return "self-update" }
+
+func (c *SelfUpdateCommand) Run(args []string) int {
+	var config SelfUpdateCommandConfig
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	LogFormatFlag(flags, &config.LogFormat)
+	LogLevelFlag(flags, &config.LogLevel)
+	flags.BoolVar(&config.Check, "check", false, "")
+	flags.StringVar(&config.Version, "version", "", "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	if err := ConfigureLogging(c.Name(), config.LogLevel, config.LogFormat); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring logging: %s", err))
+		return 1
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{
+			Timeout: SelfUpdateTimeout,
+		}
+	}
+
+	if c.ReleasesURL == "" {
+		c.ReleasesURL = SelfUpdateDefaultReleasesURL
+	}
+
+	if c.SigningKeyFingerprint == "" {
+		c.SigningKeyFingerprint = version.SigningKeyFingerprint
+	}
+
+	if c.Version == nil {
+		c.Version = version.GetVersion()
+	}
+
+	release, err := c.release(config.Version)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting release: %s", err))
+		return 1
+	}
+
+	releaseVersion, err := goversion.NewVersion(release.TagName)
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing release version (%s): %s", release.TagName, err))
+		return 1
+	}
+
+	currentVersion, err := goversion.NewVersion(c.Version.VersionNumber())
+
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing current version (%s): %s", c.Version.VersionNumber(), err))
+		return 1
+	}
+
+	if config.Version == "" && !releaseVersion.GreaterThan(currentVersion) {
+		c.Ui.Output(fmt.Sprintf("tfproviderdocs v%s is the latest release", currentVersion))
+		return 0
+	}
+
+	if config.Check {
+		c.Ui.Output(fmt.Sprintf("tfproviderdocs v%s is available (current: v%s), update with: tfproviderdocs self-update", releaseVersion, currentVersion))
+		return 0
+	}
+
+	if c.Executable == "" {
+		c.Executable, err = os.Executable()
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting executable path: %s", err))
+			return 1
+		}
+	}
+
+	if err := c.update(release, releaseVersion.String()); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error updating tfproviderdocs: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Updated tfproviderdocs from v%s to v%s", currentVersion, releaseVersion))
+
+	return 0
+}
+
+func (c *SelfUpdateCommand) Synopsis() string {
+	return "Updates tfproviderdocs to the latest release"
+}
+
+// release returns the GitHub release of the version, or the latest release if
+// the version is empty.
+func (c *SelfUpdateCommand) release(releaseVersion string) (*selfUpdateRelease, error) {
+	url := c.ReleasesURL + "/latest"
+
+	if releaseVersion != "" {
+		url = c.ReleasesURL + "/tags/v" + strings.TrimPrefix(releaseVersion, "v")
+	}
+
+	body, err := c.download(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var release selfUpdateRelease
+
+	if err := json.Unmarshal(body, &release); err != nil {
+		return nil, fmt.Errorf("error parsing release (%s): %w", url, err)
+	}
+
+	return &release, nil
+}
+
+// update downloads the release archive of the current platform, verifies the
+// signature of the release checksums and the archive checksum, and replaces
+// the executable with its binary.
+func (c *SelfUpdateCommand) update(release *selfUpdateRelease, releaseVersion string) error {
+	if c.SigningKeyFingerprint == "" {
+		return fmt.Errorf("no release signing key is pinned in this build, download the release binary instead")
+	}
+
+	archiveName := selfUpdateArchiveName(releaseVersion, runtime.GOOS, runtime.GOARCH)
+	checksumsName := fmt.Sprintf("tfproviderdocs_%s_checksums.txt", releaseVersion)
+	signatureName := checksumsName + ".sig"
+
+	var archiveURL, checksumsURL, signatureURL, signingKeyURL string
+
+	for _, asset := range release.Assets {
+		switch asset.Name {
+		case archiveName:
+			archiveURL = asset.BrowserDownloadURL
+		case checksumsName:
+			checksumsURL = asset.BrowserDownloadURL
+		case signatureName:
+			signatureURL = asset.BrowserDownloadURL
+		case SelfUpdateSigningKeyName:
+			signingKeyURL = asset.BrowserDownloadURL
+		}
+	}
+
+	if archiveURL == "" {
+		return fmt.Errorf("release (%s) has no archive (%s) for the current platform", release.TagName, archiveName)
+	}
+
+	if checksumsURL == "" {
+		return fmt.Errorf("release (%s) has no checksums (%s)", release.TagName, checksumsName)
+	}
+
+	if signatureURL == "" {
+		return fmt.Errorf("release (%s) has no checksums signature (%s)", release.TagName, signatureName)
+	}
+
+	if signingKeyURL == "" {
+		return fmt.Errorf("release (%s) has no signing key (%s)", release.TagName, SelfUpdateSigningKeyName)
+	}
+
+	checksums, err := c.download(checksumsURL)
+
+	if err != nil {
+		return err
+	}
+
+	signature, err := c.download(signatureURL)
+
+	if err != nil {
+		return err
+	}
+
+	signingKey, err := c.download(signingKeyURL)
+
+	if err != nil {
+		return err
+	}
+
+	if err := selfUpdateVerifySignature(checksums, signature, signingKey, c.SigningKeyFingerprint); err != nil {
+		return fmt.Errorf("release (%s) checksums (%s): %w", release.TagName, checksumsName, err)
+	}
+
+	expectedChecksum, err := selfUpdateChecksum(checksums, archiveName)
+
+	if err != nil {
+		return err
+	}
+
+	archive, err := c.download(archiveURL)
+
+	if err != nil {
+		return err
+	}
+
+	checksum := sha256.Sum256(archive)
+
+	if actualChecksum := hex.EncodeToString(checksum[:]); actualChecksum != expectedChecksum {
+		return fmt.Errorf("archive (%s) checksum (%s) does not match expected checksum (%s)", archiveName, actualChecksum, expectedChecksum)
+	}
+
+	directory, err := os.MkdirTemp("", "tfproviderdocs-self-update-")
+
+	if err != nil {
+		return fmt.Errorf("error creating temporary directory: %w", err)
+	}
+
+	defer os.RemoveAll(directory)
+
+	archivePath := filepath.Join(directory, archiveName)
+
+	if err := os.WriteFile(archivePath, archive, 0o644); err != nil {
+		return fmt.Errorf("error writing archive: %w", err)
+	}
+
+	extractedPath, cleanup, err := extractArchive(archivePath)
+
+	if err != nil {
+		return err
+	}
+
+	defer cleanup()
+
+	binaryName := "tfproviderdocs"
+
+	if runtime.GOOS == "windows" {
+		binaryName += ".exe"
+	}
+
+	binary, err := os.ReadFile(filepath.Join(extractedPath, binaryName))
+
+	if err != nil {
+		return fmt.Errorf("error reading archive (%s) binary: %w", archiveName, err)
+	}
+
+	return replaceExecutable(c.Executable, binary)
+}
+
+// download returns the response body of the URL.
+func (c *SelfUpdateCommand) download(url string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating request (%s): %w", url, err)
+	}
+
+	req.Header.Set("User-Agent", "tfproviderdocs/"+c.Version.VersionNumber())
+
+	resp, err := c.HTTPClient.Do(req)
+
+	if err != nil {
+		return nil, fmt.Errorf("error requesting %s: %w", url, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error requesting %s: unexpected status code (%d)", url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading response (%s): %w", url, err)
+	}
+
+	return body, nil
+}
+
+// selfUpdateArchiveName returns the release archive name of the version and
+// platform, such as tfproviderdocs_0.12.0_linux_amd64.tar.gz.
+func selfUpdateArchiveName(releaseVersion string, goos string, goarch string) string {
+	extension := ".tar.gz"
+
+	if goos == "windows" {
+		extension = ".zip"
+	}
+
+	return fmt.Sprintf("tfproviderdocs_%s_%s_%s%s", releaseVersion, goos, goarch, extension)
+}
+
+// selfUpdateChecksum returns the SHA-256 checksum of the file name in the
+// release checksums, which contains lines of checksums and file names.
+func selfUpdateChecksum(checksums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 2 && fields[1] == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+
+	return "", fmt.Errorf("checksums have no entry for %s", name)
+}
+
+// selfUpdateVerifySignature verifies the detached signature, which is binary
+// or armored, of the release checksums was made by the armored signing key.
+// Only keys with the pinned fingerprint are trusted, so a release with a
+// replaced signing key cannot update the executable.
+func selfUpdateVerifySignature(checksums []byte, signature []byte, signingKey []byte, fingerprint string) error {
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(signingKey))
+
+	if err != nil {
+		return fmt.Errorf("error reading signing key: %w", err)
+	}
+
+	fingerprint = strings.ToLower(strings.ReplaceAll(fingerprint, " ", ""))
+
+	var pinned openpgp.EntityList
+
+	for _, entity := range keyring {
+		if hex.EncodeToString(entity.PrimaryKey.Fingerprint) == fingerprint {
+			pinned = append(pinned, entity)
+		}
+	}
+
+	if len(pinned) == 0 {
+		return fmt.Errorf("signing key does not match pinned fingerprint (%s)", fingerprint)
+	}
+
+	checkSignature := openpgp.CheckDetachedSignature
+
+	if bytes.HasPrefix(bytes.TrimSpace(signature), []byte("-----BEGIN")) {
+		checkSignature = openpgp.CheckArmoredDetachedSignature
+	}
+
+	if _, err := checkSignature(pinned, bytes.NewReader(checksums), bytes.NewReader(signature), nil); err != nil {
+		return fmt.Errorf("error verifying signature: %w", err)
+	}
+
+	return nil
+}
+
+// replaceExecutable replaces the executable at the path with the binary. The
+// binary is written next to the executable and renamed, so the executable is
+// not left partially written. The executable is moved aside first, since
+// running executables cannot be replaced on Windows.
+func replaceExecutable(path string, binary []byte) error {
+	path, err := filepath.EvalSymlinks(path)
+
+	if err != nil {
+		return fmt.Errorf("error resolving executable path: %w", err)
+	}
+
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return fmt.Errorf("error reading executable: %w", err)
+	}
+
+	newPath := path + ".new"
+	oldPath := path + ".old"
+
+	// Remove an executable left behind by a previous update on Windows.
+	_ = os.Remove(oldPath)
+
+	if err := os.WriteFile(newPath, binary, info.Mode().Perm()|0o111); err != nil {
+		return fmt.Errorf("error writing executable: %w", err)
+	}
+
+	if err := os.Rename(path, oldPath); err != nil {
+		os.Remove(newPath)
+
+		return fmt.Errorf("error moving executable: %w", err)
+	}
+
+	if err := os.Rename(newPath, path); err != nil {
+		if restoreErr := os.Rename(oldPath, path); restoreErr != nil {
+			err = errors.Join(err, restoreErr)
+		}
+
+		os.Remove(newPath)
+
+		return fmt.Errorf("error replacing executable: %w", err)
+	}
+
+	// The previous executable cannot be removed while running on Windows,
+	// where it is left behind.
+	_ = os.Remove(oldPath)
+
+	return nil
+}
@@ -0,0 +1,346 @@
+package command
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/bflad/tfproviderdocs/version"
+	"github.com/mitchellh/cli"
+)
+
+func TestSelfUpdateCommand_implements(t *testing.T) {
+	t.Parallel()
+	var _ cli.Command = &SelfUpdateCommand{}
+}
+
+func TestSelfUpdateCommand(t *testing.T) {
+	archiveName := selfUpdateArchiveName("1.0.0", runtime.GOOS, runtime.GOARCH)
+	archivePath := filepath.Join(t.TempDir(), archiveName)
+	binaryName := "tfproviderdocs"
+
+	if runtime.GOOS == "windows" {
+		binaryName += ".exe"
+	}
+
+	entries := map[string]string{
+		"LICENSE":  "license\n",
+		binaryName: "new binary\n",
+	}
+
+	if runtime.GOOS == "windows" {
+		testWriteZip(t, archivePath, entries)
+	} else {
+		testWriteTarGz(t, archivePath, entries)
+	}
+
+	archive, err := os.ReadFile(archivePath)
+
+	if err != nil {
+		t.Fatalf("unexpected error reading archive: %s", err)
+	}
+
+	checksum := sha256.Sum256(archive)
+	signingKey := testOpenPGPEntity(t)
+	otherKey := testOpenPGPEntity(t)
+
+	testCases := []struct {
+		Name           string
+		Args           []string
+		Checksum       string
+		CurrentVersion string
+		ExpectBinary   string
+		ExpectExitCode int
+		ExpectOutput   string
+		Fingerprint    string
+		SignatureKey   *openpgp.Entity
+	}{
+		{
+			Name:           "update",
+			Checksum:       hex.EncodeToString(checksum[:]),
+			CurrentVersion: "0.12.0",
+			ExpectBinary:   "new binary\n",
+			ExpectOutput:   "Updated tfproviderdocs from v0.12.0 to v1.0.0",
+		},
+		{
+			Name:           "update development version",
+			Checksum:       hex.EncodeToString(checksum[:]),
+			CurrentVersion: "1.0.0-dev",
+			ExpectBinary:   "new binary\n",
+			ExpectOutput:   "Updated tfproviderdocs from v1.0.0-dev to v1.0.0",
+		},
+		{
+			Name:           "latest release",
+			Checksum:       hex.EncodeToString(checksum[:]),
+			CurrentVersion: "1.0.0",
+			ExpectBinary:   "old binary\n",
+			ExpectOutput:   "tfproviderdocs v1.0.0 is the latest release",
+		},
+		{
+			Name:           "check",
+			Args:           []string{"-check"},
+			Checksum:       hex.EncodeToString(checksum[:]),
+			CurrentVersion: "0.12.0",
+			ExpectBinary:   "old binary\n",
+			ExpectOutput:   "tfproviderdocs v1.0.0 is available (current: v0.12.0), update with: tfproviderdocs self-update",
+		},
+		{
+			Name:           "version",
+			Args:           []string{"-version", "1.0.0"},
+			Checksum:       hex.EncodeToString(checksum[:]),
+			CurrentVersion: "1.0.0",
+			ExpectBinary:   "new binary\n",
+			ExpectOutput:   "Updated tfproviderdocs from v1.0.0 to v1.0.0",
+		},
+		{
+			Name:           "checksum mismatch",
+			Checksum:       strings.Repeat("0", 64),
+			CurrentVersion: "0.12.0",
+			ExpectBinary:   "old binary\n",
+			ExpectExitCode: 1,
+		},
+		{
+			Name:           "signature of other key",
+			Checksum:       hex.EncodeToString(checksum[:]),
+			CurrentVersion: "0.12.0",
+			ExpectBinary:   "old binary\n",
+			ExpectExitCode: 1,
+			SignatureKey:   otherKey,
+		},
+		{
+			Name:           "signing key not pinned",
+			Checksum:       hex.EncodeToString(checksum[:]),
+			CurrentVersion: "0.12.0",
+			ExpectBinary:   "old binary\n",
+			ExpectExitCode: 1,
+			Fingerprint:    hex.EncodeToString(otherKey.PrimaryKey.Fingerprint),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var server *httptest.Server
+
+			checksums := fmt.Sprintf("%s  %s\n", testCase.Checksum, archiveName)
+			signatureKey := testCase.SignatureKey
+
+			if signatureKey == nil {
+				signatureKey = signingKey
+			}
+
+			fingerprint := testCase.Fingerprint
+
+			if fingerprint == "" {
+				fingerprint = hex.EncodeToString(signingKey.PrimaryKey.Fingerprint)
+			}
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/releases/latest", "/releases/tags/v1.0.0":
+					fmt.Fprintf(w, `{"tag_name": "v1.0.0", "assets": [{"name": %q, "browser_download_url": %q}, {"name": "tfproviderdocs_1.0.0_checksums.txt", "browser_download_url": %q}, {"name": "tfproviderdocs_1.0.0_checksums.txt.sig", "browser_download_url": %q}, {"name": %q, "browser_download_url": %q}]}`, archiveName, server.URL+"/download/"+archiveName, server.URL+"/download/checksums.txt", server.URL+"/download/checksums.txt.sig", SelfUpdateSigningKeyName, server.URL+"/download/signing_key.asc")
+				case "/download/" + archiveName:
+					w.Write(archive)
+				case "/download/checksums.txt":
+					w.Write([]byte(checksums))
+				case "/download/checksums.txt.sig":
+					w.Write(testDetachSign(t, signatureKey, checksums))
+				case "/download/signing_key.asc":
+					w.Write(testArmoredPublicKey(t, signingKey))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			executable := filepath.Join(t.TempDir(), binaryName)
+
+			if err := os.WriteFile(executable, []byte("old binary\n"), 0o755); err != nil {
+				t.Fatalf("unexpected error writing executable: %s", err)
+			}
+
+			ui := cli.NewMockUi()
+			command := &SelfUpdateCommand{
+				Executable:            executable,
+				ReleasesURL:           server.URL + "/releases",
+				SigningKeyFingerprint: fingerprint,
+				Ui:                    ui,
+				Version:               testSelfUpdateVersion(testCase.CurrentVersion),
+			}
+
+			if got := command.Run(testCase.Args); got != testCase.ExpectExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.ExpectExitCode, got, ui.ErrorWriter.String())
+			}
+
+			if got := strings.TrimSpace(ui.OutputWriter.String()); got != testCase.ExpectOutput {
+				t.Errorf("expected output %q, got %q", testCase.ExpectOutput, got)
+			}
+
+			got, err := os.ReadFile(executable)
+
+			if err != nil {
+				t.Fatalf("unexpected error reading executable: %s", err)
+			}
+
+			if string(got) != testCase.ExpectBinary {
+				t.Errorf("expected executable %q, got %q", testCase.ExpectBinary, got)
+			}
+		})
+	}
+}
+
+func TestSelfUpdateChecksum(t *testing.T) {
+	checksums := []byte("abc123  tfproviderdocs_1.0.0_darwin_arm64.tar.gz\nDEF456  tfproviderdocs_1.0.0_linux_amd64.tar.gz\n")
+
+	got, err := selfUpdateChecksum(checksums, "tfproviderdocs_1.0.0_linux_amd64.tar.gz")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "def456"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if _, err := selfUpdateChecksum(checksums, "tfproviderdocs_1.0.0_windows_amd64.zip"); err == nil {
+		t.Errorf("expected error, got no error")
+	}
+}
+
+func TestSelfUpdateVerifySignature(t *testing.T) {
+	signingKey := testOpenPGPEntity(t)
+	signingKeyArmored := testArmoredPublicKey(t, signingKey)
+	fingerprint := hex.EncodeToString(signingKey.PrimaryKey.Fingerprint)
+	checksums := []byte("abc123  tfproviderdocs_1.0.0_linux_amd64.tar.gz\n")
+
+	var armoredSignature bytes.Buffer
+
+	if err := openpgp.ArmoredDetachSign(&armoredSignature, signingKey, bytes.NewReader(checksums), nil); err != nil {
+		t.Fatalf("unexpected error signing: %s", err)
+	}
+
+	testCases := []struct {
+		Name        string
+		Checksums   []byte
+		Signature   []byte
+		SigningKey  []byte
+		Fingerprint string
+		ExpectError bool
+	}{
+		{
+			Name:        "binary signature",
+			Checksums:   checksums,
+			Signature:   testDetachSign(t, signingKey, string(checksums)),
+			SigningKey:  signingKeyArmored,
+			Fingerprint: fingerprint,
+		},
+		{
+			Name:        "armored signature",
+			Checksums:   checksums,
+			Signature:   armoredSignature.Bytes(),
+			SigningKey:  signingKeyArmored,
+			Fingerprint: fingerprint,
+		},
+		{
+			Name:        "formatted fingerprint",
+			Checksums:   checksums,
+			Signature:   armoredSignature.Bytes(),
+			SigningKey:  signingKeyArmored,
+			Fingerprint: strings.ToUpper(fingerprint[:4] + " " + fingerprint[4:]),
+		},
+		{
+			Name:        "modified checksums",
+			Checksums:   []byte("def456  tfproviderdocs_1.0.0_linux_amd64.tar.gz\n"),
+			Signature:   armoredSignature.Bytes(),
+			SigningKey:  signingKeyArmored,
+			Fingerprint: fingerprint,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid signing key",
+			Checksums:   checksums,
+			Signature:   armoredSignature.Bytes(),
+			SigningKey:  []byte("not a key"),
+			Fingerprint: fingerprint,
+			ExpectError: true,
+		},
+		{
+			Name:        "missing signature",
+			Checksums:   checksums,
+			SigningKey:  signingKeyArmored,
+			Fingerprint: fingerprint,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := selfUpdateVerifySignature(testCase.Checksums, testCase.Signature, testCase.SigningKey, testCase.Fingerprint)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+		})
+	}
+}
+
+func testArmoredPublicKey(t *testing.T, entity *openpgp.Entity) []byte {
+	var result bytes.Buffer
+
+	w, err := armor.Encode(&result, openpgp.PublicKeyType, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error encoding public key: %s", err)
+	}
+
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("unexpected error serializing public key: %s", err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error encoding public key: %s", err)
+	}
+
+	return result.Bytes()
+}
+
+func testDetachSign(t *testing.T, entity *openpgp.Entity, message string) []byte {
+	var result bytes.Buffer
+
+	if err := openpgp.DetachSign(&result, entity, strings.NewReader(message), nil); err != nil {
+		t.Fatalf("unexpected error signing: %s", err)
+	}
+
+	return result.Bytes()
+}
+
+func testOpenPGPEntity(t *testing.T) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("tfproviderdocs", "", "tfproviderdocs@example.com", &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+
+	if err != nil {
+		t.Fatalf("unexpected error generating key: %s", err)
+	}
+
+	return entity
+}
+
+func testSelfUpdateVersion(v string) *version.VersionInfo {
+	result := &version.VersionInfo{}
+	result.Version, result.VersionPrerelease, _ = strings.Cut(v, "-")
+
+	return result
+}
@@ -1,6 +1,14 @@
 package command
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bflad/tfproviderdocs/check"
 	"github.com/bflad/tfproviderdocs/version"
 	"github.com/mitchellh/cli"
 )
@@ -11,14 +19,90 @@ type VersionCommand struct {
 	Ui      cli.Ui
 }
 
+// versionOutput represents the JSON output of the version command.
+type versionOutput struct {
+	BuildDate           string   `json:"build_date,omitempty"`
+	CdktfLanguages      []string `json:"cdktf_languages"`
+	DirectoryStructures []string `json:"directory_structures"`
+	DocumentationTypes  []string `json:"documentation_types"`
+	GoVersion           string   `json:"go_version"`
+	Platform            string   `json:"platform"`
+	Revision            string   `json:"revision,omitempty"`
+	Version             string   `json:"version"`
+}
+
 func (c *VersionCommand) Help() string {
-	return ""
+	optsBuffer := bytes.NewBuffer([]byte{})
+	opts := tabwriter.NewWriter(optsBuffer, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(opts, CommandHelpOptionFormat, "-json", "Output the version and build information as JSON.")
+	opts.Flush()
+
+	helpText := fmt.Sprintf(`
+Usage: tfproviderdocs version [options]
+
+  Prints the version, build information, and the documentation directory
+  structures, CDK for Terraform languages, and documentation types supported
+  by this build.
+
+Options:
+
+%s
+`, optsBuffer.String())
+
+	return strings.TrimSpace(helpText)
 }
 
 func (c *VersionCommand) Name() string { return "version" }
 
-func (c *VersionCommand) Run(_ []string) int {
+func (c *VersionCommand) Run(args []string) int {
+	var outputJSON bool
+
+	flags := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	flags.Usage = func() { c.Ui.Info(c.Help()) }
+	flags.BoolVar(&outputJSON, "json", false, "")
+
+	if err := flags.Parse(args); err != nil {
+		flags.Usage()
+		return 1
+	}
+
+	output := &versionOutput{
+		BuildDate:           c.Version.BuildDate,
+		CdktfLanguages:      check.ValidCdktfLanguages,
+		DirectoryStructures: check.DirectoryStructures,
+		DocumentationTypes:  check.DocumentationTypes,
+		GoVersion:           c.Version.GoVersion,
+		Platform:            c.Version.Platform,
+		Revision:            c.Version.Revision,
+		Version:             c.Version.VersionNumber(),
+	}
+
+	if outputJSON {
+		content, err := json.MarshalIndent(output, "", "  ")
+
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error encoding version: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(string(content))
+
+		return 0
+	}
+
+	// The first line is unchanged for scripts parsing the version.
 	c.Ui.Output(c.Version.FullVersionNumber(true))
+
+	if output.BuildDate != "" {
+		c.Ui.Output(fmt.Sprintf("  build date: %s", output.BuildDate))
+	}
+
+	c.Ui.Output(fmt.Sprintf("  go version: %s", output.GoVersion))
+	c.Ui.Output(fmt.Sprintf("  platform: %s", output.Platform))
+	c.Ui.Output(fmt.Sprintf("  directory structures: %s", strings.Join(output.DirectoryStructures, ", ")))
+	c.Ui.Output(fmt.Sprintf("  CDK for Terraform languages: %s", strings.Join(output.CdktfLanguages, ", ")))
+	c.Ui.Output(fmt.Sprintf("  documentation types: %s", strings.Join(output.DocumentationTypes, ", ")))
+
 	return 0
 }
 
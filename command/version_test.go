@@ -1,8 +1,13 @@
 package command
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/bflad/tfproviderdocs/check"
+	"github.com/bflad/tfproviderdocs/version"
 	"github.com/mitchellh/cli"
 )
 
@@ -10,3 +15,60 @@ func TestVersionCommand_implements(t *testing.T) {
 	t.Parallel()
 	var _ cli.Command = &VersionCommand{}
 }
+
+func TestVersionCommand(t *testing.T) {
+	versionInfo := &version.VersionInfo{
+		BuildDate: "2024-01-02T03:04:05Z",
+		GoVersion: "go1.22.0",
+		Platform:  "linux/amd64",
+		Revision:  "abc123",
+		Version:   "1.2.3",
+	}
+
+	t.Run("text", func(t *testing.T) {
+		ui := cli.NewMockUi()
+
+		if got := (&VersionCommand{Ui: ui, Version: versionInfo}).Run(nil); got != 0 {
+			t.Fatalf("expected exit code 0, got %d: %s", got, ui.ErrorWriter.String())
+		}
+
+		lines := strings.Split(strings.TrimSpace(ui.OutputWriter.String()), "\n")
+
+		if expected := "v1.2.3 (abc123)"; lines[0] != expected {
+			t.Errorf("expected first line %q, got %q", expected, lines[0])
+		}
+
+		if expected := "  build date: 2024-01-02T03:04:05Z"; lines[1] != expected {
+			t.Errorf("expected second line %q, got %q", expected, lines[1])
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+
+		if got := (&VersionCommand{Ui: ui, Version: versionInfo}).Run([]string{"-json"}); got != 0 {
+			t.Fatalf("expected exit code 0, got %d: %s", got, ui.ErrorWriter.String())
+		}
+
+		var got versionOutput
+
+		if err := json.Unmarshal(ui.OutputWriter.Bytes(), &got); err != nil {
+			t.Fatalf("unexpected error parsing output: %s", err)
+		}
+
+		expected := versionOutput{
+			BuildDate:           "2024-01-02T03:04:05Z",
+			CdktfLanguages:      check.ValidCdktfLanguages,
+			DirectoryStructures: []string{check.DirectoryStructureLegacy, check.DirectoryStructureRegistry},
+			DocumentationTypes:  check.DocumentationTypes,
+			GoVersion:           "go1.22.0",
+			Platform:            "linux/amd64",
+			Revision:            "abc123",
+			Version:             "1.2.3",
+		}
+
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("expected %+v, got %+v", expected, got)
+		}
+	})
+}
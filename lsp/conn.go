@@ -0,0 +1,70 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+)
+
+// readMessage reads a Content-Length framed JSON-RPC message.
+func readMessage(reader *bufio.Reader) (*message, error) {
+	header, err := textproto.NewReader(reader).ReadMIMEHeader()
+
+	if err != nil {
+		return nil, err
+	}
+
+	contentLength, err := strconv.Atoi(header.Get("Content-Length"))
+
+	if err != nil || contentLength < 0 {
+		return nil, fmt.Errorf("invalid Content-Length header: %q", header.Get("Content-Length"))
+	}
+
+	body := make([]byte, contentLength)
+
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return nil, fmt.Errorf("error reading message: %w", err)
+	}
+
+	msg := &message{}
+
+	if err := json.Unmarshal(body, msg); err != nil {
+		return nil, &parseError{err: err}
+	}
+
+	return msg, nil
+}
+
+// writeMessage writes a Content-Length framed JSON-RPC message.
+func writeMessage(writer io.Writer, msg *message) error {
+	msg.JSONRPC = jsonrpcVersion
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		return fmt.Errorf("error encoding message: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(writer, "Content-Length: %d\r\n\r\n%s", len(body), body); err != nil {
+		return fmt.Errorf("error writing message: %w", err)
+	}
+
+	return nil
+}
+
+// parseError is returned for messages with invalid JSON, which are reported
+// to the client instead of stopping the server.
+type parseError struct {
+	err error
+}
+
+func (e *parseError) Error() string {
+	return fmt.Sprintf("error parsing message: %s", e.err)
+}
+
+func (e *parseError) Unwrap() error {
+	return e.err
+}
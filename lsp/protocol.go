@@ -0,0 +1,92 @@
+package lsp
+
+import (
+	"encoding/json"
+)
+
+const (
+	// DiagnosticSeverityError is the Language Server Protocol error severity.
+	DiagnosticSeverityError = 1
+
+	// TextDocumentSyncKindNone disables sending document content changes, as
+	// diagnostics are only published for saved files.
+	TextDocumentSyncKindNone = 0
+
+	jsonrpcVersion = "2.0"
+
+	// JSON-RPC error codes
+	errorCodeInvalidRequest = -32600
+	errorCodeMethodNotFound = -32601
+	errorCodeParseError     = -32700
+)
+
+// Diagnostic represents a Language Server Protocol diagnostic.
+type Diagnostic struct {
+	Code     string `json:"code,omitempty"`
+	Message  string `json:"message"`
+	Range    Range  `json:"range"`
+	Severity int    `json:"severity"`
+	Source   string `json:"source,omitempty"`
+}
+
+// Position represents a zero-based line and character offset.
+type Position struct {
+	Character int `json:"character"`
+	Line      int `json:"line"`
+}
+
+// Range represents a text range within a document.
+type Range struct {
+	End   Position `json:"end"`
+	Start Position `json:"start"`
+}
+
+type initializeResult struct {
+	Capabilities serverCapabilities `json:"capabilities"`
+	ServerInfo   serverInfo         `json:"serverInfo"`
+}
+
+type publishDiagnosticsParams struct {
+	Diagnostics []Diagnostic `json:"diagnostics"`
+	URI         string       `json:"uri"`
+}
+
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type serverCapabilities struct {
+	TextDocumentSync textDocumentSyncOptions `json:"textDocumentSync"`
+}
+
+type serverInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type textDocumentParams struct {
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+}
+
+type textDocumentSyncOptions struct {
+	Change    int         `json:"change"`
+	OpenClose bool        `json:"openClose"`
+	Save      saveOptions `json:"save"`
+}
+
+type saveOptions struct {
+	IncludeText bool `json:"includeText"`
+}
+
+// message represents a JSON-RPC request, response, or notification.
+type message struct {
+	Error   *responseError   `json:"error,omitempty"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	JSONRPC string           `json:"jsonrpc"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  interface{}      `json:"result,omitempty"`
+}
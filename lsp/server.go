@@ -0,0 +1,230 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"runtime"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// windowsDriveURIPathRegexp matches a file URI path with a Windows drive
+// letter, e.g. /C:/provider/docs/index.md.
+var windowsDriveURIPathRegexp = regexp.MustCompile(`^/[A-Za-z]:`)
+
+// DiagnosticsFunc returns the diagnostics of the file at the absolute path.
+type DiagnosticsFunc func(path string) ([]Diagnostic, error)
+
+// Server is a Language Server Protocol server, which publishes diagnostics
+// when documents are opened or saved.
+type Server struct {
+	// Diagnostics returns the diagnostics of each opened or saved file.
+	Diagnostics DiagnosticsFunc
+
+	// Name and Version are returned as the server information.
+	Name    string
+	Version string
+
+	shutdown bool
+	writer   io.Writer
+}
+
+func NewServer(name string, version string, diagnostics DiagnosticsFunc) *Server {
+	return &Server{
+		Diagnostics: diagnostics,
+		Name:        name,
+		Version:     version,
+	}
+}
+
+// Serve handles messages from the reader until the exit notification or the
+// reader is closed. An error is returned if the server was not shut down
+// before exiting, as expected by the protocol.
+func (s *Server) Serve(reader io.Reader, writer io.Writer) error {
+	s.writer = writer
+	bufReader := bufio.NewReader(reader)
+
+	for {
+		msg, err := readMessage(bufReader)
+
+		if err != nil {
+			var parseErr *parseError
+
+			if errors.As(err, &parseErr) {
+				hclog.L().Warn("Invalid message", "error", err)
+
+				if err := s.respondError(nil, errorCodeParseError, err.Error()); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if errors.Is(err, io.EOF) {
+				if s.shutdown {
+					return nil
+				}
+
+				return fmt.Errorf("connection closed before shutdown")
+			}
+
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if s.shutdown {
+				return nil
+			}
+
+			return fmt.Errorf("exit before shutdown")
+		}
+
+		if err := s.handle(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) error {
+	hclog.L().Debug("Handling Language Server Protocol method", "method", msg.Method)
+
+	switch msg.Method {
+	case "initialize":
+		return s.respond(msg.ID, &initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync: textDocumentSyncOptions{
+					Change:    TextDocumentSyncKindNone,
+					OpenClose: true,
+				},
+			},
+			ServerInfo: serverInfo{
+				Name:    s.Name,
+				Version: s.Version,
+			},
+		})
+	case "initialized":
+		return nil
+	case "shutdown":
+		s.shutdown = true
+
+		return s.respond(msg.ID, json.RawMessage("null"))
+	case "textDocument/didOpen", "textDocument/didSave":
+		var params textDocumentParams
+
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			hclog.L().Warn("Invalid parameters", "method", msg.Method, "error", err)
+			return nil
+		}
+
+		return s.publishDiagnostics(params.TextDocument.URI)
+	case "textDocument/didClose":
+		var params textDocumentParams
+
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			hclog.L().Warn("Invalid parameters", "method", msg.Method, "error", err)
+			return nil
+		}
+
+		return s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+			Diagnostics: []Diagnostic{},
+			URI:         params.TextDocument.URI,
+		})
+	}
+
+	// Notifications, such as $/cancelRequest, do not require a response.
+	if msg.ID == nil {
+		return nil
+	}
+
+	if s.shutdown {
+		return s.respondError(msg.ID, errorCodeInvalidRequest, "server is shut down")
+	}
+
+	return s.respondError(msg.ID, errorCodeMethodNotFound, fmt.Sprintf("method not found: %s", msg.Method))
+}
+
+// publishDiagnostics publishes the diagnostics of the file URI. Files which
+// are not file URIs have no diagnostics.
+func (s *Server) publishDiagnostics(uri string) error {
+	diagnostics := []Diagnostic{}
+	path, err := uriPath(uri)
+
+	if err != nil {
+		hclog.L().Debug("Skipping diagnostics", "error", err)
+	} else if s.Diagnostics != nil {
+		result, err := s.Diagnostics(path)
+
+		if err != nil {
+			hclog.L().Error("Unable to check file", "file", path, "error", err)
+		}
+
+		diagnostics = append(diagnostics, result...)
+	}
+
+	return s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+		Diagnostics: diagnostics,
+		URI:         uri,
+	})
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	rawParams, err := json.Marshal(params)
+
+	if err != nil {
+		return fmt.Errorf("error encoding %s parameters: %w", method, err)
+	}
+
+	return writeMessage(s.writer, &message{
+		Method: method,
+		Params: rawParams,
+	})
+}
+
+func (s *Server) respond(id *json.RawMessage, result interface{}) error {
+	return writeMessage(s.writer, &message{
+		ID:     id,
+		Result: result,
+	})
+}
+
+func (s *Server) respondError(id *json.RawMessage, code int, errMessage string) error {
+	if id == nil {
+		null := json.RawMessage("null")
+		id = &null
+	}
+
+	return writeMessage(s.writer, &message{
+		Error: &responseError{
+			Code:    code,
+			Message: errMessage,
+		},
+		ID: id,
+	})
+}
+
+// uriPath returns the file path of the file URI.
+func uriPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid URI (%s): %w", uri, err)
+	}
+
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI (%s) scheme: %s", uri, u.Scheme)
+	}
+
+	path := u.Path
+
+	if runtime.GOOS == "windows" && windowsDriveURIPathRegexp.MatchString(path) {
+		path = path[1:]
+	}
+
+	return filepath.FromSlash(path), nil
+}
@@ -0,0 +1,168 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestServerServe(t *testing.T) {
+	var diagnosed []string
+
+	server := NewServer("test", "0.0.0", func(path string) ([]Diagnostic, error) {
+		diagnosed = append(diagnosed, path)
+
+		return []Diagnostic{
+			{
+				Code:     "frontmatter",
+				Message:  "test",
+				Severity: DiagnosticSeverityError,
+			},
+		}, nil
+	})
+
+	input := testMessages(
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		`{"jsonrpc":"2.0","method":"initialized","params":{}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///provider/docs/index.md","languageId":"markdown","version":1,"text":""}}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///provider/docs/index.md"}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"textDocument/hover","params":{}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+	)
+
+	var output bytes.Buffer
+
+	if err := server.Serve(input, &output); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{filepath.FromSlash("/provider/docs/index.md")}; !reflect.DeepEqual(diagnosed, expected) {
+		t.Errorf("expected diagnosed paths %v, got: %v", expected, diagnosed)
+	}
+
+	messages := testReadMessages(t, &output)
+
+	if len(messages) != 5 {
+		t.Fatalf("expected 5 messages, got %d: %v", len(messages), messages)
+	}
+
+	if messages[0].Result == nil {
+		t.Errorf("expected initialize result, got: %v", messages[0])
+	}
+
+	var opened publishDiagnosticsParams
+
+	if err := json.Unmarshal(messages[1].Params, &opened); err != nil {
+		t.Fatalf("error decoding diagnostics: %s", err)
+	}
+
+	if messages[1].Method != "textDocument/publishDiagnostics" || len(opened.Diagnostics) != 1 || opened.Diagnostics[0].Code != "frontmatter" {
+		t.Errorf("unexpected didOpen diagnostics: %s", messages[1].Params)
+	}
+
+	var closed publishDiagnosticsParams
+
+	if err := json.Unmarshal(messages[2].Params, &closed); err != nil {
+		t.Fatalf("error decoding diagnostics: %s", err)
+	}
+
+	if messages[2].Method != "textDocument/publishDiagnostics" || len(closed.Diagnostics) != 0 {
+		t.Errorf("unexpected didClose diagnostics: %s", messages[2].Params)
+	}
+
+	if messages[3].Error == nil || messages[3].Error.Code != errorCodeMethodNotFound {
+		t.Errorf("expected method not found error, got: %v", messages[3])
+	}
+
+	if messages[4].Error != nil {
+		t.Errorf("unexpected shutdown error: %v", messages[4].Error)
+	}
+}
+
+func TestServerServe_exitBeforeShutdown(t *testing.T) {
+	server := NewServer("test", "0.0.0", nil)
+	input := testMessages(`{"jsonrpc":"2.0","method":"exit"}`)
+
+	if err := server.Serve(input, &bytes.Buffer{}); err == nil {
+		t.Errorf("expected error, got no error")
+	}
+}
+
+func TestUriPath(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		URI         string
+		Expect      string
+		ExpectError bool
+	}{
+		{
+			Name:   "file",
+			URI:    "file:///provider/docs/index.md",
+			Expect: filepath.FromSlash("/provider/docs/index.md"),
+		},
+		{
+			Name:   "escaped",
+			URI:    "file:///provider/docs/my%20file.md",
+			Expect: filepath.FromSlash("/provider/docs/my file.md"),
+		},
+		{
+			Name:        "unsupported scheme",
+			URI:         "untitled:Untitled-1",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := uriPath(testCase.URI)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if got != testCase.Expect {
+				t.Errorf("expected %q, got: %q", testCase.Expect, got)
+			}
+		})
+	}
+}
+
+func testMessages(bodies ...string) *strings.Reader {
+	var b strings.Builder
+
+	for _, body := range bodies {
+		fmt.Fprintf(&b, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	}
+
+	return strings.NewReader(b.String())
+}
+
+func testReadMessages(t *testing.T, output *bytes.Buffer) []*message {
+	t.Helper()
+
+	var result []*message
+
+	reader := bufio.NewReader(output)
+
+	for reader.Buffered() > 0 || output.Len() > 0 {
+		msg, err := readMessage(reader)
+
+		if err != nil {
+			t.Fatalf("error reading output message: %s", err)
+		}
+
+		result = append(result, msg)
+	}
+
+	return result
+}
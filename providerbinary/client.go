@@ -0,0 +1,185 @@
+// Package providerbinary retrieves the schema of a Terraform Provider by
+// launching its binary over the Terraform Plugin Protocol, without requiring
+// Terraform CLI.
+package providerbinary
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	tfjson "github.com/hashicorp/terraform-json"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MaxResponseSize is the maximum size of a schema response, which matches
+	// Terraform CLI for providers with very large schemas.
+	MaxResponseSize = 256 << 20
+
+	// Handshake configuration of Terraform Providers, which refuse to start
+	// without the magic cookie environment variable.
+	magicCookieKey   = "TF_PLUGIN_MAGIC_COOKIE"
+	magicCookieValue = "d602bf8f470bc67ca7faa0386276bbdd4330efaf76d1a219cb4d6991ca9872b2"
+
+	pluginName = "provider"
+)
+
+// Schema launches the Terraform Provider binary at the path and returns its
+// schema, converted to the terraform providers schema -json format. Protocol
+// versions 5 and 6 are supported.
+func Schema(ctx context.Context, path string) (*tfjson.ProviderSchema, error) {
+	client := plugin.NewClient(&plugin.ClientConfig{
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		AutoMTLS:         true,
+		Cmd:              exec.Command(path),
+		GRPCDialOptions: []grpc.DialOption{
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxResponseSize)),
+		},
+		HandshakeConfig: plugin.HandshakeConfig{
+			MagicCookieKey:   magicCookieKey,
+			MagicCookieValue: magicCookieValue,
+		},
+		Logger: debugLogger{hclog.L().Named("provider-binary")},
+		VersionedPlugins: map[int]plugin.PluginSet{
+			5: {pluginName: &grpcPlugin{}},
+			6: {pluginName: &grpcPlugin{}},
+		},
+	})
+
+	defer client.Kill()
+
+	rpcClient, err := client.Client()
+
+	if err != nil {
+		return nil, fmt.Errorf("error launching provider binary (%s): %w", path, err)
+	}
+
+	raw, err := rpcClient.Dispense(pluginName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to provider binary (%s): %w", path, err)
+	}
+
+	conn, ok := raw.(*grpc.ClientConn)
+
+	if !ok {
+		return nil, fmt.Errorf("error connecting to provider binary (%s): unexpected client type: %T", path, raw)
+	}
+
+	protocolVersion := client.NegotiatedVersion()
+	service := fmt.Sprintf("/tfplugin%d.Provider", protocolVersion)
+
+	hclog.L().Debug("Connected to provider binary", "file", path, "protocol_version", protocolVersion)
+
+	// The GetProviderSchema RPC of protocol version 6 is named GetSchema in
+	// protocol version 5.
+	method := service + "/GetProviderSchema"
+
+	if protocolVersion == 5 {
+		method = service + "/GetSchema"
+	}
+
+	response, err := invoke(ctx, conn, method)
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting provider binary (%s) schema: %w", path, err)
+	}
+
+	schema, err := decodeGetProviderSchemaResponse(response, protocolVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding provider binary (%s) schema: %w", path, err)
+	}
+
+	response, err = invoke(ctx, conn, service+"/GetResourceIdentitySchemas")
+
+	// Providers built with older SDKs do not implement resource identity.
+	if status.Code(err) == codes.Unimplemented {
+		return schema, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting provider binary (%s) resource identity schemas: %w", path, err)
+	}
+
+	schema.ResourceIdentitySchemas, err = decodeGetResourceIdentitySchemasResponse(response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding provider binary (%s) resource identity schemas: %w", path, err)
+	}
+
+	return schema, nil
+}
+
+// debugLogger logs informational messages, such as the plugin process
+// lifecycle, at debug level, so they are not output by default.
+type debugLogger struct {
+	hclog.Logger
+}
+
+func (l debugLogger) Info(msg string, args ...interface{}) {
+	l.Logger.Debug(msg, args...)
+}
+
+// invoke calls the RPC method, which has an empty request message, and
+// returns the encoded response message.
+func invoke(ctx context.Context, conn *grpc.ClientConn, method string) ([]byte, error) {
+	request := []byte{}
+	var response []byte
+
+	if err := conn.Invoke(ctx, method, &request, &response, grpc.ForceCodec(rawCodec{})); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// grpcPlugin is the go-plugin client of a Terraform Provider, which returns
+// the gRPC connection.
+type grpcPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+}
+
+func (p *grpcPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return conn, nil
+}
+
+func (p *grpcPlugin) GRPCServer(*plugin.GRPCBroker, *grpc.Server) error {
+	return fmt.Errorf("serving Terraform Providers is not supported")
+}
+
+// rawCodec passes encoded protocol buffers messages, which are decoded
+// without generated code.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.(*[]byte)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type: %T", v)
+	}
+
+	return *b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+
+	if !ok {
+		return fmt.Errorf("unexpected message type: %T", v)
+	}
+
+	*b = append((*b)[:0], data...)
+
+	return nil
+}
+
+// Name returns the protocol buffers content subtype expected by providers.
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,122 @@
+package providerbinary
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// testProviderEnvVar is the environment variable which runs the test binary
+// as a Terraform Provider serving testProviderGetProviderSchemaResponse.
+const testProviderEnvVar = "TFPROVIDERDOCS_TEST_PROVIDER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(testProviderEnvVar) != "" {
+		serveTestProvider()
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestSchema(t *testing.T) {
+	t.Setenv(testProviderEnvVar, "1")
+
+	schema, err := Schema(context.Background(), os.Args[0])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resource, ok := schema.ResourceSchemas["test_thing"]
+
+	if !ok {
+		t.Fatalf("expected test_thing resource schema, got: %v", schema.ResourceSchemas)
+	}
+
+	testCheckSchemaBlock(t, resource.Block)
+
+	if schema.ResourceIdentitySchemas != nil {
+		t.Errorf("expected no resource identity schemas, got: %v", schema.ResourceIdentitySchemas)
+	}
+}
+
+// serveTestProvider serves protocol version 6 with a GetProviderSchema
+// response and no other implemented methods, e.g. GetResourceIdentitySchemas.
+func serveTestProvider() {
+	handler := func(_ interface{}, stream grpc.ServerStream) error {
+		method, _ := grpc.MethodFromServerStream(stream)
+
+		var request []byte
+
+		if err := stream.RecvMsg(&request); err != nil {
+			return err
+		}
+
+		if method != "/tfplugin6.Provider/GetProviderSchema" {
+			return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+		}
+
+		response := appendMapEntryField(nil, 2, "test_thing", testSchema(6))
+
+		return stream.SendMsg(&response)
+	}
+
+	plugin.Serve(&plugin.ServeConfig{
+		GRPCServer: func(opts []grpc.ServerOption) *grpc.Server {
+			opts = append(opts, grpc.ForceServerCodec(testServerCodec{}), grpc.UnknownServiceHandler(handler))
+
+			return grpc.NewServer(opts...)
+		},
+		HandshakeConfig: plugin.HandshakeConfig{
+			MagicCookieKey:   magicCookieKey,
+			MagicCookieValue: magicCookieValue,
+		},
+		VersionedPlugins: map[int]plugin.PluginSet{
+			6: {pluginName: &testServerPlugin{}},
+		},
+	})
+}
+
+// testServerCodec passes encoded messages of the unknown service handler
+// and encodes the messages of the go-plugin internal services.
+type testServerCodec struct{}
+
+func (testServerCodec) Marshal(v interface{}) ([]byte, error) {
+	if message, ok := v.(proto.Message); ok {
+		return proto.Marshal(message)
+	}
+
+	return rawCodec{}.Marshal(v)
+}
+
+func (testServerCodec) Unmarshal(data []byte, v interface{}) error {
+	if message, ok := v.(proto.Message); ok {
+		return proto.Unmarshal(data, message)
+	}
+
+	return rawCodec{}.Unmarshal(data, v)
+}
+
+func (testServerCodec) Name() string {
+	return "proto"
+}
+
+type testServerPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+}
+
+func (p *testServerPlugin) GRPCClient(context.Context, *plugin.GRPCBroker, *grpc.ClientConn) (interface{}, error) {
+	return nil, nil
+}
+
+func (p *testServerPlugin) GRPCServer(*plugin.GRPCBroker, *grpc.Server) error {
+	return nil
+}
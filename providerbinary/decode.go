@@ -0,0 +1,575 @@
+package providerbinary
+
+import (
+	"errors"
+	"fmt"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Protocol buffers enumeration values of the Terraform Plugin Protocol.
+const (
+	diagnosticSeverityError = 1
+
+	nestingModeSingle = 1
+	nestingModeList   = 2
+	nestingModeSet    = 3
+	nestingModeMap    = 4
+	nestingModeGroup  = 5
+
+	stringKindMarkdown = 1
+)
+
+// decodeMessage calls the function with each varint and length-delimited
+// field of the protocol buffers message, which are the only wire types of
+// the decoded messages. Fields of other wire types are skipped.
+func decodeMessage(b []byte, f func(num protowire.Number, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		b = b[n:]
+
+		var v uint64
+		var data []byte
+
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			data, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+
+		b = b[n:]
+
+		if typ != protowire.VarintType && typ != protowire.BytesType {
+			continue
+		}
+
+		if err := f(num, v, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeMapEntry returns the string key and message value of a map field
+// entry.
+func decodeMapEntry(b []byte) (string, []byte, error) {
+	var key string
+	var value []byte
+
+	err := decodeMessage(b, func(num protowire.Number, _ uint64, data []byte) error {
+		switch num {
+		case 1:
+			key = string(data)
+		case 2:
+			value = data
+		}
+
+		return nil
+	})
+
+	return key, value, err
+}
+
+// decodeGetProviderSchemaResponse decodes the GetProviderSchema (protocol
+// version 6) or GetSchema (protocol version 5) response.
+func decodeGetProviderSchemaResponse(b []byte, protocolVersion int) (*tfjson.ProviderSchema, error) {
+	schema := &tfjson.ProviderSchema{}
+	var diagnostics []error
+
+	err := decodeMessage(b, func(num protowire.Number, _ uint64, data []byte) error {
+		var err error
+
+		switch num {
+		case 1:
+			schema.ConfigSchema, err = decodeSchema(data, protocolVersion)
+		case 2:
+			schema.ResourceSchemas, err = decodeSchemaMapEntry(schema.ResourceSchemas, data, protocolVersion)
+		case 3:
+			schema.DataSourceSchemas, err = decodeSchemaMapEntry(schema.DataSourceSchemas, data, protocolVersion)
+		case 4:
+			diagnostics, err = decodeDiagnostic(diagnostics, data)
+		case 7:
+			var name string
+			var value []byte
+
+			name, value, err = decodeMapEntry(data)
+
+			if err != nil {
+				return err
+			}
+
+			if schema.Functions == nil {
+				schema.Functions = make(map[string]*tfjson.FunctionSignature)
+			}
+
+			schema.Functions[name], err = decodeFunction(value)
+		case 8:
+			schema.EphemeralResourceSchemas, err = decodeSchemaMapEntry(schema.EphemeralResourceSchemas, data, protocolVersion)
+		case 9:
+			schema.ListResourceSchemas, err = decodeSchemaMapEntry(schema.ListResourceSchemas, data, protocolVersion)
+		case 11:
+			var name string
+			var value []byte
+
+			name, value, err = decodeMapEntry(data)
+
+			if err != nil {
+				return err
+			}
+
+			if schema.ActionSchemas == nil {
+				schema.ActionSchemas = make(map[string]*tfjson.ActionSchema)
+			}
+
+			schema.ActionSchemas[name], err = decodeActionSchema(value, protocolVersion)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(diagnostics) > 0 {
+		return nil, errors.Join(diagnostics...)
+	}
+
+	return schema, nil
+}
+
+// decodeGetResourceIdentitySchemasResponse decodes the
+// GetResourceIdentitySchemas response.
+func decodeGetResourceIdentitySchemasResponse(b []byte) (map[string]*tfjson.IdentitySchema, error) {
+	var schemas map[string]*tfjson.IdentitySchema
+	var diagnostics []error
+
+	err := decodeMessage(b, func(num protowire.Number, _ uint64, data []byte) error {
+		switch num {
+		case 1:
+			name, value, err := decodeMapEntry(data)
+
+			if err != nil {
+				return err
+			}
+
+			if schemas == nil {
+				schemas = make(map[string]*tfjson.IdentitySchema)
+			}
+
+			schemas[name], err = decodeIdentitySchema(value)
+
+			return err
+		case 2:
+			var err error
+
+			diagnostics, err = decodeDiagnostic(diagnostics, data)
+
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(diagnostics) > 0 {
+		return nil, errors.Join(diagnostics...)
+	}
+
+	return schemas, nil
+}
+
+// decodeDiagnostic appends an error for an error diagnostic. Warning
+// diagnostics are ignored.
+func decodeDiagnostic(diagnostics []error, b []byte) ([]error, error) {
+	var severity uint64
+	var summary, detail string
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case 1:
+			severity = v
+		case 2:
+			summary = string(data)
+		case 3:
+			detail = string(data)
+		}
+
+		return nil
+	})
+
+	if err != nil || severity != diagnosticSeverityError {
+		return diagnostics, err
+	}
+
+	if detail != "" {
+		return append(diagnostics, fmt.Errorf("%s: %s", summary, detail)), nil
+	}
+
+	return append(diagnostics, errors.New(summary)), nil
+}
+
+// decodeSchemaMapEntry decodes a map field entry of schemas into the map,
+// which is created if nil.
+func decodeSchemaMapEntry(schemas map[string]*tfjson.Schema, b []byte, protocolVersion int) (map[string]*tfjson.Schema, error) {
+	name, value, err := decodeMapEntry(b)
+
+	if err != nil {
+		return schemas, err
+	}
+
+	if schemas == nil {
+		schemas = make(map[string]*tfjson.Schema)
+	}
+
+	schemas[name], err = decodeSchema(value, protocolVersion)
+
+	return schemas, err
+}
+
+func decodeSchema(b []byte, protocolVersion int) (*tfjson.Schema, error) {
+	schema := &tfjson.Schema{}
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		var err error
+
+		switch num {
+		case 1:
+			schema.Version = v
+		case 2:
+			schema.Block, err = decodeBlock(data, protocolVersion)
+		}
+
+		return err
+	})
+
+	return schema, err
+}
+
+func decodeActionSchema(b []byte, protocolVersion int) (*tfjson.ActionSchema, error) {
+	actionSchema := &tfjson.ActionSchema{}
+
+	err := decodeMessage(b, func(num protowire.Number, _ uint64, data []byte) error {
+		if num != 1 {
+			return nil
+		}
+
+		schema, err := decodeSchema(data, protocolVersion)
+
+		if err != nil {
+			return err
+		}
+
+		actionSchema.Block = schema.Block
+
+		return nil
+	})
+
+	return actionSchema, err
+}
+
+func decodeBlock(b []byte, protocolVersion int) (*tfjson.SchemaBlock, error) {
+	block := &tfjson.SchemaBlock{
+		DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+	}
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case 2:
+			name, attribute, err := decodeAttribute(data, protocolVersion)
+
+			if err != nil {
+				return err
+			}
+
+			if block.Attributes == nil {
+				block.Attributes = make(map[string]*tfjson.SchemaAttribute)
+			}
+
+			block.Attributes[name] = attribute
+		case 3:
+			name, blockType, err := decodeNestedBlock(data, protocolVersion)
+
+			if err != nil {
+				return err
+			}
+
+			if block.NestedBlocks == nil {
+				block.NestedBlocks = make(map[string]*tfjson.SchemaBlockType)
+			}
+
+			block.NestedBlocks[name] = blockType
+		case 4:
+			block.Description = string(data)
+		case 5:
+			block.DescriptionKind = decodeStringKind(v)
+		case 6:
+			block.Deprecated = v != 0
+		}
+
+		return nil
+	})
+
+	return block, err
+}
+
+// decodeAttribute returns the name and schema of an attribute. The
+// write_only field number differs between protocol versions, as protocol
+// version 5 does not support nested attributes.
+func decodeAttribute(b []byte, protocolVersion int) (string, *tfjson.SchemaAttribute, error) {
+	var name string
+	attribute := &tfjson.SchemaAttribute{
+		DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+	}
+
+	writeOnlyNumber := protowire.Number(11)
+
+	if protocolVersion == 5 {
+		writeOnlyNumber = 10
+	}
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		var err error
+
+		switch num {
+		case 1:
+			name = string(data)
+		case 2:
+			if len(data) > 0 {
+				attribute.AttributeType, err = ctyjson.UnmarshalType(data)
+			}
+		case 3:
+			attribute.Description = string(data)
+		case 4:
+			attribute.Required = v != 0
+		case 5:
+			attribute.Optional = v != 0
+		case 6:
+			attribute.Computed = v != 0
+		case 7:
+			attribute.Sensitive = v != 0
+		case 8:
+			attribute.DescriptionKind = decodeStringKind(v)
+		case 9:
+			attribute.Deprecated = v != 0
+		case writeOnlyNumber:
+			attribute.WriteOnly = v != 0
+		case 10:
+			attribute.AttributeNestedType, err = decodeObject(data, protocolVersion)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return name, nil, fmt.Errorf("attribute (%s): %w", name, err)
+	}
+
+	return name, attribute, nil
+}
+
+func decodeNestedBlock(b []byte, protocolVersion int) (string, *tfjson.SchemaBlockType, error) {
+	var name string
+	blockType := &tfjson.SchemaBlockType{}
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		var err error
+
+		switch num {
+		case 1:
+			name = string(data)
+		case 2:
+			blockType.Block, err = decodeBlock(data, protocolVersion)
+		case 3:
+			blockType.NestingMode = decodeNestingMode(v)
+		case 4:
+			blockType.MinItems = v
+		case 5:
+			blockType.MaxItems = v
+		}
+
+		return err
+	})
+
+	return name, blockType, err
+}
+
+// decodeObject decodes the nested attributes of an attribute, which are only
+// supported by protocol version 6.
+func decodeObject(b []byte, protocolVersion int) (*tfjson.SchemaNestedAttributeType, error) {
+	nestedType := &tfjson.SchemaNestedAttributeType{}
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case 1:
+			name, attribute, err := decodeAttribute(data, protocolVersion)
+
+			if err != nil {
+				return err
+			}
+
+			if nestedType.Attributes == nil {
+				nestedType.Attributes = make(map[string]*tfjson.SchemaAttribute)
+			}
+
+			nestedType.Attributes[name] = attribute
+		case 3:
+			nestedType.NestingMode = decodeNestingMode(v)
+		}
+
+		return nil
+	})
+
+	return nestedType, err
+}
+
+func decodeFunction(b []byte) (*tfjson.FunctionSignature, error) {
+	function := &tfjson.FunctionSignature{}
+
+	err := decodeMessage(b, func(num protowire.Number, _ uint64, data []byte) error {
+		var err error
+
+		switch num {
+		case 1:
+			var parameter *tfjson.FunctionParameter
+
+			parameter, err = decodeFunctionParameter(data)
+			function.Parameters = append(function.Parameters, parameter)
+		case 2:
+			function.VariadicParameter, err = decodeFunctionParameter(data)
+		case 3:
+			err = decodeMessage(data, func(num protowire.Number, _ uint64, data []byte) error {
+				var err error
+
+				if num == 1 {
+					function.ReturnType, err = ctyjson.UnmarshalType(data)
+				}
+
+				return err
+			})
+		case 4:
+			function.Summary = string(data)
+		case 5:
+			function.Description = string(data)
+		case 7:
+			function.DeprecationMessage = string(data)
+		}
+
+		return err
+	})
+
+	return function, err
+}
+
+func decodeFunctionParameter(b []byte) (*tfjson.FunctionParameter, error) {
+	parameter := &tfjson.FunctionParameter{}
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		var err error
+
+		switch num {
+		case 1:
+			parameter.Name = string(data)
+		case 2:
+			parameter.Type, err = ctyjson.UnmarshalType(data)
+		case 3:
+			parameter.IsNullable = v != 0
+		case 5:
+			parameter.Description = string(data)
+		}
+
+		return err
+	})
+
+	return parameter, err
+}
+
+func decodeIdentitySchema(b []byte) (*tfjson.IdentitySchema, error) {
+	schema := &tfjson.IdentitySchema{}
+
+	err := decodeMessage(b, func(num protowire.Number, v uint64, data []byte) error {
+		switch num {
+		case 1:
+			schema.Version = v
+		case 2:
+			var name string
+			attribute := &tfjson.IdentityAttribute{}
+
+			err := decodeMessage(data, func(num protowire.Number, v uint64, data []byte) error {
+				var err error
+
+				switch num {
+				case 1:
+					name = string(data)
+				case 2:
+					attribute.IdentityType, err = ctyjson.UnmarshalType(data)
+				case 3:
+					attribute.RequiredForImport = v != 0
+				case 4:
+					attribute.OptionalForImport = v != 0
+				case 5:
+					attribute.Description = string(data)
+				}
+
+				return err
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if schema.Attributes == nil {
+				schema.Attributes = make(map[string]*tfjson.IdentityAttribute)
+			}
+
+			schema.Attributes[name] = attribute
+		}
+
+		return nil
+	})
+
+	return schema, err
+}
+
+func decodeNestingMode(v uint64) tfjson.SchemaNestingMode {
+	switch v {
+	case nestingModeSingle:
+		return tfjson.SchemaNestingModeSingle
+	case nestingModeList:
+		return tfjson.SchemaNestingModeList
+	case nestingModeSet:
+		return tfjson.SchemaNestingModeSet
+	case nestingModeMap:
+		return tfjson.SchemaNestingModeMap
+	case nestingModeGroup:
+		return tfjson.SchemaNestingModeGroup
+	}
+
+	return ""
+}
+
+func decodeStringKind(v uint64) tfjson.SchemaDescriptionKind {
+	if v == stringKindMarkdown {
+		return tfjson.SchemaDescriptionKindMarkdown
+	}
+
+	return tfjson.SchemaDescriptionKindPlain
+}
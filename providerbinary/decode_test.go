@@ -0,0 +1,420 @@
+package providerbinary
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+
+	return protowire.AppendBytes(b, v)
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+
+	return protowire.AppendVarint(b, v)
+}
+
+func appendMapEntryField(b []byte, num protowire.Number, key string, value []byte) []byte {
+	var entry []byte
+
+	entry = appendBytesField(entry, 1, []byte(key))
+	entry = appendBytesField(entry, 2, value)
+
+	return appendBytesField(b, num, entry)
+}
+
+// testSchema returns an encoded Schema message with a required string
+// attribute, a write-only attribute, and a list nested block.
+func testSchema(protocolVersion int) []byte {
+	var nameAttribute []byte
+
+	nameAttribute = appendBytesField(nameAttribute, 1, []byte("name"))
+	nameAttribute = appendBytesField(nameAttribute, 2, []byte(`"string"`))
+	nameAttribute = appendBytesField(nameAttribute, 3, []byte("Name of the thing."))
+	nameAttribute = appendVarintField(nameAttribute, 4, 1)
+	nameAttribute = appendVarintField(nameAttribute, 8, stringKindMarkdown)
+
+	writeOnlyNumber := protowire.Number(11)
+
+	if protocolVersion == 5 {
+		writeOnlyNumber = 10
+	}
+
+	var passwordAttribute []byte
+
+	passwordAttribute = appendBytesField(passwordAttribute, 1, []byte("password_wo"))
+	passwordAttribute = appendBytesField(passwordAttribute, 2, []byte(`"string"`))
+	passwordAttribute = appendVarintField(passwordAttribute, 5, 1)
+	passwordAttribute = appendVarintField(passwordAttribute, 7, 1)
+	passwordAttribute = appendVarintField(passwordAttribute, writeOnlyNumber, 1)
+
+	var nestedBlockBlock []byte
+
+	nestedBlockBlock = appendBytesField(nestedBlockBlock, 2, nameAttribute)
+
+	var nestedBlock []byte
+
+	nestedBlock = appendBytesField(nestedBlock, 1, []byte("setting"))
+	nestedBlock = appendBytesField(nestedBlock, 2, nestedBlockBlock)
+	nestedBlock = appendVarintField(nestedBlock, 3, nestingModeList)
+	nestedBlock = appendVarintField(nestedBlock, 5, 2)
+
+	var block []byte
+
+	block = appendBytesField(block, 2, nameAttribute)
+	block = appendBytesField(block, 2, passwordAttribute)
+	block = appendBytesField(block, 3, nestedBlock)
+	block = appendBytesField(block, 4, []byte("Manages a thing."))
+
+	var schema []byte
+
+	schema = appendVarintField(schema, 1, 1)
+	schema = appendBytesField(schema, 2, block)
+
+	return schema
+}
+
+func TestDecodeGetProviderSchemaResponse(t *testing.T) {
+	for _, protocolVersion := range []int{5, 6} {
+		var parameter []byte
+
+		parameter = appendBytesField(parameter, 1, []byte("input"))
+		parameter = appendBytesField(parameter, 2, []byte(`"string"`))
+		parameter = appendVarintField(parameter, 3, 1)
+
+		var functionReturn []byte
+
+		functionReturn = appendBytesField(functionReturn, 1, []byte(`"number"`))
+
+		var function []byte
+
+		function = appendBytesField(function, 1, parameter)
+		function = appendBytesField(function, 3, functionReturn)
+		function = appendBytesField(function, 4, []byte("Parses input."))
+
+		var actionSchema []byte
+
+		actionSchema = appendBytesField(actionSchema, 1, testSchema(protocolVersion))
+
+		var response []byte
+
+		response = appendBytesField(response, 1, testSchema(protocolVersion))
+		response = appendMapEntryField(response, 2, "test_thing", testSchema(protocolVersion))
+		response = appendMapEntryField(response, 3, "test_thing", testSchema(protocolVersion))
+		response = appendMapEntryField(response, 7, "parse", function)
+		response = appendMapEntryField(response, 9, "test_thing", testSchema(protocolVersion))
+		response = appendMapEntryField(response, 11, "test_action", actionSchema)
+
+		got, err := decodeGetProviderSchemaResponse(response, protocolVersion)
+
+		if err != nil {
+			t.Fatalf("protocol version %d: unexpected error: %s", protocolVersion, err)
+		}
+
+		for schemaType, schemas := range map[string]map[string]*tfjson.Schema{
+			"data source":   got.DataSourceSchemas,
+			"list resource": got.ListResourceSchemas,
+			"resource":      got.ResourceSchemas,
+		} {
+			schema, ok := schemas["test_thing"]
+
+			if !ok {
+				t.Fatalf("protocol version %d: expected %s schema, got none", protocolVersion, schemaType)
+			}
+
+			testCheckSchemaBlock(t, schema.Block)
+		}
+
+		if got.ActionSchemas["test_action"] == nil {
+			t.Fatalf("protocol version %d: expected action schema, got none", protocolVersion)
+		}
+
+		testCheckSchemaBlock(t, got.ActionSchemas["test_action"].Block)
+
+		signature, ok := got.Functions["parse"]
+
+		if !ok {
+			t.Fatalf("protocol version %d: expected function, got none", protocolVersion)
+		}
+
+		if !signature.ReturnType.Equals(cty.Number) {
+			t.Errorf("protocol version %d: expected function return type number, got: %s", protocolVersion, signature.ReturnType.FriendlyName())
+		}
+
+		if len(signature.Parameters) != 1 || signature.Parameters[0].Name != "input" || !signature.Parameters[0].IsNullable {
+			t.Errorf("protocol version %d: unexpected function parameters: %v", protocolVersion, signature.Parameters)
+		}
+	}
+}
+
+func testCheckSchemaBlock(t *testing.T, block *tfjson.SchemaBlock) {
+	t.Helper()
+
+	if block == nil {
+		t.Fatalf("expected block, got none")
+	}
+
+	if block.Description != "Manages a thing." {
+		t.Errorf("unexpected block description: %s", block.Description)
+	}
+
+	name, ok := block.Attributes["name"]
+
+	if !ok {
+		t.Fatalf("expected name attribute, got none")
+	}
+
+	if !name.AttributeType.Equals(cty.String) || !name.Required || name.DescriptionKind != tfjson.SchemaDescriptionKindMarkdown {
+		t.Errorf("unexpected name attribute: %#v", name)
+	}
+
+	password, ok := block.Attributes["password_wo"]
+
+	if !ok {
+		t.Fatalf("expected password_wo attribute, got none")
+	}
+
+	if !password.Optional || !password.Sensitive || !password.WriteOnly || password.AttributeNestedType != nil {
+		t.Errorf("unexpected password_wo attribute: %#v", password)
+	}
+
+	setting, ok := block.NestedBlocks["setting"]
+
+	if !ok {
+		t.Fatalf("expected setting block, got none")
+	}
+
+	if setting.NestingMode != tfjson.SchemaNestingModeList || setting.MaxItems != 2 || setting.Block.Attributes["name"] == nil {
+		t.Errorf("unexpected setting block: %#v", setting)
+	}
+}
+
+func TestDecodeGetProviderSchemaResponseDiagnostics(t *testing.T) {
+	var warning []byte
+
+	warning = appendVarintField(warning, 1, 2)
+	warning = appendBytesField(warning, 2, []byte("warning"))
+
+	var diagnostic []byte
+
+	diagnostic = appendVarintField(diagnostic, 1, diagnosticSeverityError)
+	diagnostic = appendBytesField(diagnostic, 2, []byte("summary"))
+	diagnostic = appendBytesField(diagnostic, 3, []byte("detail"))
+
+	testCases := []struct {
+		Name        string
+		Response    []byte
+		ExpectError string
+	}{
+		{
+			Name:     "warning",
+			Response: appendBytesField(nil, 4, warning),
+		},
+		{
+			Name:        "error",
+			Response:    appendBytesField(appendBytesField(nil, 4, warning), 4, diagnostic),
+			ExpectError: "summary: detail",
+		},
+		{
+			Name:        "invalid",
+			Response:    []byte{0x0a, 0x05},
+			ExpectError: "unexpected EOF",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := decodeGetProviderSchemaResponse(testCase.Response, 6)
+
+			if err == nil && testCase.ExpectError != "" {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && err.Error() != testCase.ExpectError {
+				t.Fatalf("expected error %q, got: %s", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
+func TestDecodeGetProviderSchemaResponseNestedAttributes(t *testing.T) {
+	var nestedAttribute []byte
+
+	nestedAttribute = appendBytesField(nestedAttribute, 1, []byte("key"))
+	nestedAttribute = appendBytesField(nestedAttribute, 2, []byte(`"string"`))
+	nestedAttribute = appendVarintField(nestedAttribute, 4, 1)
+
+	var object []byte
+
+	object = appendBytesField(object, 1, nestedAttribute)
+	object = appendVarintField(object, 3, nestingModeSet)
+
+	var attribute []byte
+
+	attribute = appendBytesField(attribute, 1, []byte("tags"))
+	attribute = appendBytesField(attribute, 10, object)
+	attribute = appendVarintField(attribute, 5, 1)
+
+	var block []byte
+
+	block = appendBytesField(block, 2, attribute)
+
+	var schema []byte
+
+	schema = appendBytesField(schema, 2, block)
+
+	response := appendMapEntryField(nil, 2, "test_thing", schema)
+
+	got, err := decodeGetProviderSchemaResponse(response, 6)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tags := got.ResourceSchemas["test_thing"].Block.Attributes["tags"]
+
+	if tags == nil || tags.AttributeNestedType == nil {
+		t.Fatalf("expected tags nested attribute, got: %#v", tags)
+	}
+
+	if tags.AttributeNestedType.NestingMode != tfjson.SchemaNestingModeSet || tags.AttributeNestedType.Attributes["key"] == nil {
+		t.Errorf("unexpected tags nested attribute type: %#v", tags.AttributeNestedType)
+	}
+}
+
+func TestDecodeGetResourceIdentitySchemasResponse(t *testing.T) {
+	var identityAttribute []byte
+
+	identityAttribute = appendBytesField(identityAttribute, 1, []byte("id"))
+	identityAttribute = appendBytesField(identityAttribute, 2, []byte(`"string"`))
+	identityAttribute = appendVarintField(identityAttribute, 3, 1)
+	identityAttribute = appendBytesField(identityAttribute, 5, []byte("Identifier."))
+
+	var identitySchema []byte
+
+	identitySchema = appendVarintField(identitySchema, 1, 1)
+	identitySchema = appendBytesField(identitySchema, 2, identityAttribute)
+
+	response := appendMapEntryField(nil, 1, "test_thing", identitySchema)
+
+	got, err := decodeGetResourceIdentitySchemasResponse(response)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	schema, ok := got["test_thing"]
+
+	if !ok {
+		t.Fatalf("expected identity schema, got none")
+	}
+
+	id, ok := schema.Attributes["id"]
+
+	if !ok {
+		t.Fatalf("expected id identity attribute, got none")
+	}
+
+	if schema.Version != 1 || !id.IdentityType.Equals(cty.String) || !id.RequiredForImport || id.Description != "Identifier." {
+		t.Errorf("unexpected identity schema: %#v", schema)
+	}
+}
+
+// The golden response fixtures are encoded with the generated protocol buffers
+// types of terraform-plugin-go by the programs in testdata/generate, while the
+// expected JSON is written by hand in the terraform providers schema -json
+// format.
+
+func TestDecodeGetProviderSchemaResponseGolden(t *testing.T) {
+	for _, protocolVersion := range []int{5, 6} {
+		t.Run(fmt.Sprintf("protocol version %d", protocolVersion), func(t *testing.T) {
+			got, err := decodeGetProviderSchemaResponse(testReadGolden(t, fmt.Sprintf("get_provider_schema_response_v%d.binpb", protocolVersion)), protocolVersion)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			testCheckGoldenJSON(t, got, &tfjson.ProviderSchema{}, fmt.Sprintf("get_provider_schema_response_v%d.json", protocolVersion))
+		})
+	}
+}
+
+func TestDecodeGetProviderSchemaResponseGoldenDiagnostics(t *testing.T) {
+	for _, protocolVersion := range []int{5, 6} {
+		t.Run(fmt.Sprintf("protocol version %d", protocolVersion), func(t *testing.T) {
+			_, err := decodeGetProviderSchemaResponse(testReadGolden(t, fmt.Sprintf("get_provider_schema_response_error_v%d.binpb", protocolVersion)), protocolVersion)
+
+			if err == nil {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if expected := "Invalid schema: The provider schema could not be built."; err.Error() != expected {
+				t.Errorf("expected error %q, got: %s", expected, err)
+			}
+		})
+	}
+}
+
+func TestDecodeGetResourceIdentitySchemasResponseGolden(t *testing.T) {
+	for _, protocolVersion := range []int{5, 6} {
+		t.Run(fmt.Sprintf("protocol version %d", protocolVersion), func(t *testing.T) {
+			got, err := decodeGetResourceIdentitySchemasResponse(testReadGolden(t, fmt.Sprintf("get_resource_identity_schemas_response_v%d.binpb", protocolVersion)))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			testCheckGoldenJSON(t, got, &map[string]*tfjson.IdentitySchema{}, fmt.Sprintf("get_resource_identity_schemas_response_v%d.json", protocolVersion))
+		})
+	}
+}
+
+func testReadGolden(t *testing.T, name string) []byte {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+
+	if err != nil {
+		t.Fatalf("error reading golden file: %s", err)
+	}
+
+	return b
+}
+
+// testCheckGoldenJSON compares the JSON encoding of the decoded value with
+// the golden JSON file, after decoding the golden JSON file into expected so
+// both are encoded the same way.
+func testCheckGoldenJSON(t *testing.T, got interface{}, expected interface{}, name string) {
+	t.Helper()
+
+	if err := json.Unmarshal(testReadGolden(t, name), expected); err != nil {
+		t.Fatalf("error decoding golden JSON: %s", err)
+	}
+
+	gotJSON, err := json.MarshalIndent(got, "", "  ")
+
+	if err != nil {
+		t.Fatalf("error encoding decoded value: %s", err)
+	}
+
+	expectedJSON, err := json.MarshalIndent(expected, "", "  ")
+
+	if err != nil {
+		t.Fatalf("error encoding golden JSON: %s", err)
+	}
+
+	if !bytes.Equal(gotJSON, expectedJSON) {
+		t.Errorf("decoded value does not match %s:\n\ngot:\n%s\n\nexpected:\n%s", name, gotJSON, expectedJSON)
+	}
+}
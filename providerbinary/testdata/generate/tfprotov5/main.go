@@ -0,0 +1,198 @@
+// This program generates the protocol version 5 golden response fixtures of
+// the providerbinary package with the generated protocol buffers types of
+// terraform-plugin-go, which are internal to that module. To regenerate the
+// fixtures, copy this directory to tfprotov5/golden of a terraform-plugin-go
+// v0.31.0 checkout, then run from that checkout:
+//
+//	go run ./tfprotov5/golden PATH/TO/providerbinary/testdata
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/internal/tfplugin5"
+	"google.golang.org/protobuf/proto"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: golden DIRECTORY")
+	}
+
+	write(filepath.Join(os.Args[1], "get_provider_schema_response_v5.binpb"), getProviderSchemaResponse())
+	write(filepath.Join(os.Args[1], "get_provider_schema_response_error_v5.binpb"), getProviderSchemaResponseError())
+	write(filepath.Join(os.Args[1], "get_resource_identity_schemas_response_v5.binpb"), getResourceIdentitySchemasResponse())
+}
+
+func write(path string, m proto.Message) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+
+	if err != nil {
+		log.Fatalf("error marshalling %s: %s", path, err)
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", path, err)
+	}
+}
+
+func schema() *tfplugin5.Schema {
+	return &tfplugin5.Schema{
+		Version: 1,
+		Block: &tfplugin5.Schema_Block{
+			Attributes: []*tfplugin5.Schema_Attribute{
+				{
+					Name:            "name",
+					Type:            []byte(`"string"`),
+					Description:     "Name of the thing.",
+					Required:        true,
+					DescriptionKind: tfplugin5.StringKind_MARKDOWN,
+				},
+				{
+					Name:       "old_name",
+					Type:       []byte(`"string"`),
+					Optional:   true,
+					Computed:   true,
+					Deprecated: true,
+				},
+				{
+					Name:      "password_wo",
+					Type:      []byte(`"string"`),
+					Optional:  true,
+					Sensitive: true,
+					WriteOnly: true,
+				},
+				{
+					Name:     "tags",
+					Type:     []byte(`["map","string"]`),
+					Optional: true,
+				},
+			},
+			BlockTypes: []*tfplugin5.Schema_NestedBlock{
+				{
+					TypeName: "setting",
+					Block: &tfplugin5.Schema_Block{
+						Attributes: []*tfplugin5.Schema_Attribute{
+							{
+								Name:     "value",
+								Type:     []byte(`"number"`),
+								Required: true,
+							},
+						},
+					},
+					Nesting:  tfplugin5.Schema_NestedBlock_LIST,
+					MinItems: 1,
+					MaxItems: 2,
+				},
+			},
+			Description:     "Manages a thing.",
+			DescriptionKind: tfplugin5.StringKind_MARKDOWN,
+		},
+	}
+}
+
+func getProviderSchemaResponse() *tfplugin5.GetProviderSchema_Response {
+	return &tfplugin5.GetProviderSchema_Response{
+		Provider: &tfplugin5.Schema{
+			Block: &tfplugin5.Schema_Block{
+				Attributes: []*tfplugin5.Schema_Attribute{
+					{
+						Name:      "token",
+						Type:      []byte(`"string"`),
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		ResourceSchemas: map[string]*tfplugin5.Schema{
+			"test_thing": schema(),
+		},
+		DataSourceSchemas: map[string]*tfplugin5.Schema{
+			"test_thing": schema(),
+		},
+		Diagnostics: []*tfplugin5.Diagnostic{
+			{
+				Severity: tfplugin5.Diagnostic_WARNING,
+				Summary:  "Deprecated provider",
+			},
+		},
+		ServerCapabilities: &tfplugin5.ServerCapabilities{
+			PlanDestroy: true,
+		},
+		Functions: map[string]*tfplugin5.Function{
+			"parse": {
+				Parameters: []*tfplugin5.Function_Parameter{
+					{
+						Name:           "input",
+						Type:           []byte(`"string"`),
+						AllowNullValue: true,
+						Description:    "Input to parse.",
+					},
+				},
+				VariadicParameter: &tfplugin5.Function_Parameter{
+					Name: "options",
+					Type: []byte(`"string"`),
+				},
+				Return: &tfplugin5.Function_Return{
+					Type: []byte(`"number"`),
+				},
+				Summary:            "Parses input.",
+				Description:        "Parses the input into a number.",
+				DeprecationMessage: "Use parse_number instead.",
+			},
+		},
+		EphemeralResourceSchemas: map[string]*tfplugin5.Schema{
+			"test_thing": schema(),
+		},
+		ListResourceSchemas: map[string]*tfplugin5.Schema{
+			"test_thing": schema(),
+		},
+		ActionSchemas: map[string]*tfplugin5.ActionSchema{
+			"test_action": {
+				Schema: schema(),
+			},
+		},
+	}
+}
+
+func getProviderSchemaResponseError() *tfplugin5.GetProviderSchema_Response {
+	return &tfplugin5.GetProviderSchema_Response{
+		Diagnostics: []*tfplugin5.Diagnostic{
+			{
+				Severity: tfplugin5.Diagnostic_WARNING,
+				Summary:  "Deprecated provider",
+			},
+			{
+				Severity: tfplugin5.Diagnostic_ERROR,
+				Summary:  "Invalid schema",
+				Detail:   "The provider schema could not be built.",
+			},
+		},
+	}
+}
+
+func getResourceIdentitySchemasResponse() *tfplugin5.GetResourceIdentitySchemas_Response {
+	return &tfplugin5.GetResourceIdentitySchemas_Response{
+		IdentitySchemas: map[string]*tfplugin5.ResourceIdentitySchema{
+			"test_thing": {
+				Version: 1,
+				IdentityAttributes: []*tfplugin5.ResourceIdentitySchema_IdentityAttribute{
+					{
+						Name:              "id",
+						Type:              []byte(`"string"`),
+						RequiredForImport: true,
+						Description:       "Identifier.",
+					},
+					{
+						Name:              "region",
+						Type:              []byte(`"string"`),
+						OptionalForImport: true,
+					},
+				},
+			},
+		},
+	}
+}
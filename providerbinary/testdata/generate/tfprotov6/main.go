@@ -0,0 +1,212 @@
+// This program generates the protocol version 6 golden response fixtures of
+// the providerbinary package with the generated protocol buffers types of
+// terraform-plugin-go, which are internal to that module. To regenerate the
+// fixtures, copy this directory to tfprotov6/golden of a terraform-plugin-go
+// v0.31.0 checkout, then run from that checkout:
+//
+//	go run ./tfprotov6/golden PATH/TO/providerbinary/testdata
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/internal/tfplugin6"
+	"google.golang.org/protobuf/proto"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: golden DIRECTORY")
+	}
+
+	write(filepath.Join(os.Args[1], "get_provider_schema_response_v6.binpb"), getProviderSchemaResponse())
+	write(filepath.Join(os.Args[1], "get_provider_schema_response_error_v6.binpb"), getProviderSchemaResponseError())
+	write(filepath.Join(os.Args[1], "get_resource_identity_schemas_response_v6.binpb"), getResourceIdentitySchemasResponse())
+}
+
+func write(path string, m proto.Message) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+
+	if err != nil {
+		log.Fatalf("error marshalling %s: %s", path, err)
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", path, err)
+	}
+}
+
+func schema() *tfplugin6.Schema {
+	return &tfplugin6.Schema{
+		Version: 1,
+		Block: &tfplugin6.Schema_Block{
+			Attributes: []*tfplugin6.Schema_Attribute{
+				{
+					Name:            "name",
+					Type:            []byte(`"string"`),
+					Description:     "Name of the thing.",
+					Required:        true,
+					DescriptionKind: tfplugin6.StringKind_MARKDOWN,
+				},
+				{
+					Name:       "old_name",
+					Type:       []byte(`"string"`),
+					Optional:   true,
+					Computed:   true,
+					Deprecated: true,
+				},
+				{
+					Name:      "password_wo",
+					Type:      []byte(`"string"`),
+					Optional:  true,
+					Sensitive: true,
+					WriteOnly: true,
+				},
+				{
+					Name:     "tags",
+					Type:     []byte(`["map","string"]`),
+					Optional: true,
+				},
+				{
+					Name: "rule",
+					NestedType: &tfplugin6.Schema_Object{
+						Attributes: []*tfplugin6.Schema_Attribute{
+							{
+								Name:     "key",
+								Type:     []byte(`"string"`),
+								Required: true,
+							},
+						},
+						Nesting: tfplugin6.Schema_Object_SET,
+					},
+					Optional: true,
+				},
+			},
+			BlockTypes: []*tfplugin6.Schema_NestedBlock{
+				{
+					TypeName: "setting",
+					Block: &tfplugin6.Schema_Block{
+						Attributes: []*tfplugin6.Schema_Attribute{
+							{
+								Name:     "value",
+								Type:     []byte(`"number"`),
+								Required: true,
+							},
+						},
+					},
+					Nesting:  tfplugin6.Schema_NestedBlock_LIST,
+					MinItems: 1,
+					MaxItems: 2,
+				},
+			},
+			Description:     "Manages a thing.",
+			DescriptionKind: tfplugin6.StringKind_MARKDOWN,
+		},
+	}
+}
+
+func getProviderSchemaResponse() *tfplugin6.GetProviderSchema_Response {
+	return &tfplugin6.GetProviderSchema_Response{
+		Provider: &tfplugin6.Schema{
+			Block: &tfplugin6.Schema_Block{
+				Attributes: []*tfplugin6.Schema_Attribute{
+					{
+						Name:      "token",
+						Type:      []byte(`"string"`),
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		ResourceSchemas: map[string]*tfplugin6.Schema{
+			"test_thing": schema(),
+		},
+		DataSourceSchemas: map[string]*tfplugin6.Schema{
+			"test_thing": schema(),
+		},
+		Diagnostics: []*tfplugin6.Diagnostic{
+			{
+				Severity: tfplugin6.Diagnostic_WARNING,
+				Summary:  "Deprecated provider",
+			},
+		},
+		ServerCapabilities: &tfplugin6.ServerCapabilities{
+			PlanDestroy: true,
+		},
+		Functions: map[string]*tfplugin6.Function{
+			"parse": {
+				Parameters: []*tfplugin6.Function_Parameter{
+					{
+						Name:           "input",
+						Type:           []byte(`"string"`),
+						AllowNullValue: true,
+						Description:    "Input to parse.",
+					},
+				},
+				VariadicParameter: &tfplugin6.Function_Parameter{
+					Name: "options",
+					Type: []byte(`"string"`),
+				},
+				Return: &tfplugin6.Function_Return{
+					Type: []byte(`"number"`),
+				},
+				Summary:            "Parses input.",
+				Description:        "Parses the input into a number.",
+				DeprecationMessage: "Use parse_number instead.",
+			},
+		},
+		EphemeralResourceSchemas: map[string]*tfplugin6.Schema{
+			"test_thing": schema(),
+		},
+		ListResourceSchemas: map[string]*tfplugin6.Schema{
+			"test_thing": schema(),
+		},
+		ActionSchemas: map[string]*tfplugin6.ActionSchema{
+			"test_action": {
+				Schema: schema(),
+			},
+		},
+	}
+}
+
+func getProviderSchemaResponseError() *tfplugin6.GetProviderSchema_Response {
+	return &tfplugin6.GetProviderSchema_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{
+			{
+				Severity: tfplugin6.Diagnostic_WARNING,
+				Summary:  "Deprecated provider",
+			},
+			{
+				Severity: tfplugin6.Diagnostic_ERROR,
+				Summary:  "Invalid schema",
+				Detail:   "The provider schema could not be built.",
+			},
+		},
+	}
+}
+
+func getResourceIdentitySchemasResponse() *tfplugin6.GetResourceIdentitySchemas_Response {
+	return &tfplugin6.GetResourceIdentitySchemas_Response{
+		IdentitySchemas: map[string]*tfplugin6.ResourceIdentitySchema{
+			"test_thing": {
+				Version: 1,
+				IdentityAttributes: []*tfplugin6.ResourceIdentitySchema_IdentityAttribute{
+					{
+						Name:              "id",
+						Type:              []byte(`"string"`),
+						RequiredForImport: true,
+						Description:       "Identifier.",
+					},
+					{
+						Name:              "region",
+						Type:              []byte(`"string"`),
+						OptionalForImport: true,
+					},
+				},
+			},
+		},
+	}
+}
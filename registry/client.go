@@ -0,0 +1,195 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// DefaultBaseURL is the Terraform Registry base URL.
+	DefaultBaseURL = "https://registry.terraform.io"
+
+	// DefaultTimeout is the default timeout of each Terraform Registry request.
+	DefaultTimeout = 30 * time.Second
+
+	// LanguageHCL is the documentation language of non-CDKTF documentation.
+	LanguageHCL = "hcl"
+
+	providerDocsPageSize = 100
+)
+
+// Client is a Terraform Registry API client for provider documentation.
+type Client struct {
+	// BaseURL is the Terraform Registry base URL, defaulting to DefaultBaseURL.
+	BaseURL string
+
+	HTTPClient *http.Client
+
+	// Logger, if set, receives the diagnostic logging of the client.
+	// Otherwise, the default hclog logger is used.
+	Logger hclog.Logger
+}
+
+// ProviderDoc represents a published provider documentation page.
+type ProviderDoc struct {
+	// Category is the documentation category (e.g. data-sources, guides,
+	// overview, or resources).
+	Category string `json:"category"`
+
+	// Language is the documentation language (hcl or a CDKTF language).
+	Language string `json:"language"`
+
+	// Path is the file path relative to the Terraform Provider codebase.
+	Path string `json:"path"`
+
+	Slug        string `json:"slug"`
+	Subcategory string `json:"subcategory"`
+	Title       string `json:"title"`
+}
+
+func NewClient() *Client {
+	return &Client{
+		BaseURL: DefaultBaseURL,
+		HTTPClient: &http.Client{
+			Timeout: DefaultTimeout,
+		},
+	}
+}
+
+// ProviderDocs returns all published HCL documentation pages of the provider
+// version.
+func (c *Client) ProviderDocs(ctx context.Context, namespace string, name string, version string) ([]*ProviderDoc, error) {
+	versionID, err := c.providerVersionID(ctx, namespace, name, version)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*ProviderDoc
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("filter[provider-version]", versionID)
+		query.Set("filter[language]", LanguageHCL)
+		query.Set("page[number]", strconv.Itoa(page))
+		query.Set("page[size]", strconv.Itoa(providerDocsPageSize))
+
+		var response struct {
+			Data []struct {
+				Attributes *ProviderDoc `json:"attributes"`
+			} `json:"data"`
+			Meta struct {
+				Pagination struct {
+					NextPage *int `json:"next-page"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+
+		if err := c.get(ctx, "/v2/provider-docs?"+query.Encode(), &response); err != nil {
+			return nil, fmt.Errorf("error listing provider (%s/%s) version (%s) documentation: %w", namespace, name, version, err)
+		}
+
+		for _, data := range response.Data {
+			if data.Attributes != nil {
+				result = append(result, data.Attributes)
+			}
+		}
+
+		if len(response.Data) == 0 || response.Meta.Pagination.NextPage == nil {
+			break
+		}
+	}
+
+	c.log().Debug("Found published documentation pages", "count", len(result), "provider", name, "namespace", namespace, "version", version)
+
+	return result, nil
+}
+
+// providerVersionID returns the Terraform Registry identifier of the provider
+// version, which is required for listing documentation.
+func (c *Client) providerVersionID(ctx context.Context, namespace string, name string, version string) (string, error) {
+	var response struct {
+		Included []struct {
+			Attributes struct {
+				Version string `json:"version"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"included"`
+	}
+
+	path := fmt.Sprintf("/v2/providers/%s/%s?include=provider-versions", url.PathEscape(namespace), url.PathEscape(name))
+
+	if err := c.get(ctx, path, &response); err != nil {
+		return "", fmt.Errorf("error reading provider (%s/%s): %w", namespace, name, err)
+	}
+
+	version = strings.TrimPrefix(version, "v")
+
+	for _, included := range response.Included {
+		if included.Type == "provider-versions" && included.Attributes.Version == version {
+			return included.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("provider (%s/%s) version (%s) not found in Terraform Registry", namespace, name, version)
+}
+
+// log returns the Logger, if set, otherwise the default hclog logger.
+func (c *Client) log() hclog.Logger {
+	if c.Logger == nil {
+		return hclog.L()
+	}
+
+	return c.Logger
+}
+
+func (c *Client) get(ctx context.Context, path string, v interface{}) error {
+	baseURL := c.BaseURL
+
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	httpClient := c.HTTPClient
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	requestURL := strings.TrimSuffix(baseURL, "/") + path
+
+	c.log().Debug("Terraform Registry request", "method", "GET", "url", requestURL)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+
+	if err != nil {
+		return err
+	}
+
+	response, err := httpClient.Do(request)
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code (%d) from %s", response.StatusCode, requestURL)
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response from %s: %w", requestURL, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestClientProviderDocs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/providers/test/test":
+			fmt.Fprint(w, `{"data":{"id":"1","type":"providers"},"included":[{"id":"10","type":"provider-versions","attributes":{"version":"1.0.0"}},{"id":"20","type":"provider-versions","attributes":{"version":"2.0.0"}}]}`)
+		case "/v2/provider-docs":
+			if got := r.URL.Query().Get("filter[provider-version]"); got != "20" {
+				http.Error(w, "unexpected provider version: "+got, http.StatusBadRequest)
+				return
+			}
+
+			switch r.URL.Query().Get("page[number]") {
+			case "1":
+				fmt.Fprint(w, `{"data":[{"attributes":{"category":"overview","language":"hcl","path":"docs/index.md","slug":"index","title":"test"}}],"meta":{"pagination":{"next-page":2}}}`)
+			case "2":
+				fmt.Fprint(w, `{"data":[{"attributes":{"category":"resources","language":"hcl","path":"docs/resources/thing.md","slug":"thing","subcategory":"Things","title":"test_thing"}}],"meta":{"pagination":{"next-page":null}}}`)
+			default:
+				http.Error(w, "unexpected page", http.StatusBadRequest)
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.BaseURL = server.URL
+
+	got, err := client.ProviderDocs(context.Background(), "test", "test", "v2.0.0")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*ProviderDoc{
+		{
+			Category: "overview",
+			Language: "hcl",
+			Path:     "docs/index.md",
+			Slug:     "index",
+			Title:    "test",
+		},
+		{
+			Category:    "resources",
+			Language:    "hcl",
+			Path:        "docs/resources/thing.md",
+			Slug:        "thing",
+			Subcategory: "Things",
+			Title:       "test_thing",
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected: %#v, got: %#v", expected, got)
+	}
+
+	if _, err := client.ProviderDocs(context.Background(), "test", "test", "3.0.0"); err == nil {
+		t.Errorf("expected error for missing version, got no error")
+	}
+
+	if _, err := client.ProviderDocs(context.Background(), "test", "missing", "1.0.0"); err == nil {
+		t.Errorf("expected error for missing provider, got no error")
+	}
+}
+
+func TestClientLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"id":"1","type":"providers"},"included":[{"id":"10","type":"provider-versions","attributes":{"version":"1.0.0"}}]}`)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	client := NewClient()
+	client.BaseURL = server.URL
+	client.Logger = hclog.New(&hclog.LoggerOptions{Level: hclog.Debug, Output: &output})
+
+	if _, err := client.providerVersionID(context.Background(), "test", "test", "1.0.0"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Contains(output.Bytes(), []byte("Terraform Registry request")) {
+		t.Errorf("expected request in client logger output, got: %s", output.String())
+	}
+}
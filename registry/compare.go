@@ -0,0 +1,76 @@
+package registry
+
+import (
+	"path/filepath"
+	"sort"
+)
+
+// Comparison contains the differences between local and published
+// documentation, with paths sorted.
+type Comparison struct {
+	// Added contains paths of local documentation not published.
+	Added []string
+
+	// Removed contains paths of published documentation not found locally.
+	Removed []string
+
+	// SubcategoryChanged contains documentation with a different local
+	// frontmatter subcategory than published.
+	SubcategoryChanged []*SubcategoryChange
+}
+
+// SubcategoryChange represents a changed documentation subcategory.
+type SubcategoryChange struct {
+	Path      string
+	Local     string
+	Published string
+}
+
+// HasChanges returns true if any documentation was added, removed, or changed.
+func (c *Comparison) HasChanges() bool {
+	return len(c.Added) > 0 || len(c.Removed) > 0 || len(c.SubcategoryChanged) > 0
+}
+
+// Compare returns the differences between the local documentation
+// subcategories, keyed by slash separated path, and the published
+// documentation.
+func Compare(local map[string]string, published []*ProviderDoc) *Comparison {
+	result := &Comparison{}
+	publishedPaths := make(map[string]*ProviderDoc, len(published))
+
+	for _, doc := range published {
+		publishedPaths[filepath.ToSlash(doc.Path)] = doc
+	}
+
+	for path, subcategory := range local {
+		doc, ok := publishedPaths[path]
+
+		if !ok {
+			result.Added = append(result.Added, path)
+
+			continue
+		}
+
+		if doc.Subcategory != subcategory {
+			result.SubcategoryChanged = append(result.SubcategoryChanged, &SubcategoryChange{
+				Local:     subcategory,
+				Path:      path,
+				Published: doc.Subcategory,
+			})
+		}
+	}
+
+	for path := range publishedPaths {
+		if _, ok := local[path]; !ok {
+			result.Removed = append(result.Removed, path)
+		}
+	}
+
+	sort.Strings(result.Added)
+	sort.Strings(result.Removed)
+	sort.Slice(result.SubcategoryChanged, func(i, j int) bool {
+		return result.SubcategoryChanged[i].Path < result.SubcategoryChanged[j].Path
+	})
+
+	return result
+}
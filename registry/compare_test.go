@@ -0,0 +1,74 @@
+package registry
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Local     map[string]string
+		Published []*ProviderDoc
+		Expect    *Comparison
+	}{
+		{
+			Name: "no changes",
+			Local: map[string]string{
+				"docs/index.md":           "",
+				"docs/resources/thing.md": "Things",
+			},
+			Published: []*ProviderDoc{
+				{Path: "docs/index.md"},
+				{Path: "docs/resources/thing.md", Subcategory: "Things"},
+			},
+			Expect: &Comparison{},
+		},
+		{
+			Name: "changes",
+			Local: map[string]string{
+				"docs/index.md":              "",
+				"docs/resources/new.md":      "Things",
+				"docs/resources/renamed.md":  "New Things",
+				"docs/resources/thing.md":    "Things",
+				"docs/data-sources/thing.md": "Things",
+			},
+			Published: []*ProviderDoc{
+				{Path: "docs/index.md"},
+				{Path: "docs/resources/old.md", Subcategory: "Things"},
+				{Path: "docs/resources/renamed.md", Subcategory: "Things"},
+				{Path: "docs/resources/thing.md", Subcategory: "Things"},
+				{Path: "docs/data-sources/thing.md", Subcategory: "Things"},
+			},
+			Expect: &Comparison{
+				Added: []string{
+					"docs/resources/new.md",
+				},
+				Removed: []string{
+					"docs/resources/old.md",
+				},
+				SubcategoryChanged: []*SubcategoryChange{
+					{
+						Local:     "New Things",
+						Path:      "docs/resources/renamed.md",
+						Published: "Things",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := Compare(testCase.Local, testCase.Published)
+
+			if !reflect.DeepEqual(got, testCase.Expect) {
+				t.Errorf("expected: %#v, got: %#v", testCase.Expect, got)
+			}
+
+			if got.HasChanges() != testCase.Expect.HasChanges() {
+				t.Errorf("expected HasChanges: %t, got: %t", testCase.Expect.HasChanges(), got.HasChanges())
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package registry
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+// ProviderPackage represents the Terraform Registry provider package of a
+// provider version for an operating system and architecture.
+type ProviderPackage struct {
+	// DownloadURL is the URL of the provider zip archive.
+	DownloadURL string `json:"download_url"`
+
+	// Filename is the file name of the provider zip archive.
+	Filename string `json:"filename"`
+
+	// Shasum is the hex encoded SHA-256 checksum of the provider zip archive.
+	Shasum string `json:"shasum"`
+
+	// ShasumsURL is the URL of the SHA256SUMS file of the provider version,
+	// which contains the checksums of all provider zip archives.
+	ShasumsURL string `json:"shasums_url"`
+
+	// ShasumsSignatureURL is the URL of the detached OpenPGP signature of
+	// the SHA256SUMS file.
+	ShasumsSignatureURL string `json:"shasums_signature_url"`
+
+	// SigningKeys contains the public keys of the provider namespace, one of
+	// which signed the SHA256SUMS file.
+	SigningKeys ProviderSigningKeys `json:"signing_keys"`
+}
+
+// ProviderSigningKeys represents the signing keys of a provider package.
+type ProviderSigningKeys struct {
+	GPGPublicKeys []ProviderGPGPublicKey `json:"gpg_public_keys"`
+}
+
+// ProviderGPGPublicKey represents an OpenPGP public key of a provider
+// namespace.
+type ProviderGPGPublicKey struct {
+	// ASCIIArmor is the ASCII armored public key.
+	ASCIIArmor string `json:"ascii_armor"`
+
+	// KeyID is the hex encoded key identifier.
+	KeyID string `json:"key_id"`
+}
+
+// ProviderPackage returns the provider package of the provider version for the
+// operating system and architecture, e.g. linux and amd64.
+func (c *Client) ProviderPackage(ctx context.Context, namespace string, name string, version string, goos string, goarch string) (*ProviderPackage, error) {
+	version = strings.TrimPrefix(version, "v")
+	path := fmt.Sprintf("/v1/providers/%s/%s/%s/download/%s/%s", url.PathEscape(namespace), url.PathEscape(name), url.PathEscape(version), url.PathEscape(goos), url.PathEscape(goarch))
+
+	var result ProviderPackage
+
+	if err := c.get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("error reading provider (%s/%s) version (%s) package (%s_%s): %w", namespace, name, version, goos, goarch, err)
+	}
+
+	if result.DownloadURL == "" {
+		return nil, fmt.Errorf("provider (%s/%s) version (%s) package (%s_%s) has no download URL", namespace, name, version, goos, goarch)
+	}
+
+	return &result, nil
+}
+
+// DownloadProviderBinary downloads the provider package of the provider
+// version for the operating system and architecture, verifies its checksum
+// against the SHA256SUMS file signed by a signing key of the provider
+// namespace, and extracts the provider binary into the directory. The path of
+// the provider binary is returned.
+func (c *Client) DownloadProviderBinary(ctx context.Context, namespace string, name string, version string, goos string, goarch string, directory string) (string, error) {
+	providerPackage, err := c.ProviderPackage(ctx, namespace, name, version, goos, goarch)
+
+	if err != nil {
+		return "", err
+	}
+
+	shasum, err := c.verifiedShasum(ctx, providerPackage)
+
+	if err != nil {
+		return "", fmt.Errorf("error verifying provider (%s/%s) version (%s) package (%s): %w", namespace, name, version, providerPackage.Filename, err)
+	}
+
+	archivePath := filepath.Join(directory, "package.zip")
+
+	if err := c.download(ctx, providerPackage.DownloadURL, shasum, archivePath); err != nil {
+		return "", fmt.Errorf("error downloading provider (%s/%s) version (%s) package (%s): %w", namespace, name, version, providerPackage.Filename, err)
+	}
+
+	defer os.Remove(archivePath)
+
+	binaryPath, err := extractProviderBinary(archivePath, directory)
+
+	if err != nil {
+		return "", fmt.Errorf("error extracting provider (%s/%s) version (%s) package (%s): %w", namespace, name, version, providerPackage.Filename, err)
+	}
+
+	c.log().Debug("Downloaded provider binary", "provider", name, "namespace", namespace, "version", version, "file", binaryPath)
+
+	return binaryPath, nil
+}
+
+// verifiedShasum returns the checksum of the provider package from its
+// SHA256SUMS file, after verifying the signature of the file with the signing
+// keys of the provider package. The checksum must match the checksum of the
+// provider package.
+func (c *Client) verifiedShasum(ctx context.Context, providerPackage *ProviderPackage) (string, error) {
+	if providerPackage.ShasumsURL == "" || providerPackage.ShasumsSignatureURL == "" {
+		return "", fmt.Errorf("no SHA256SUMS or SHA256SUMS signature URL")
+	}
+
+	if len(providerPackage.SigningKeys.GPGPublicKeys) == 0 {
+		return "", fmt.Errorf("no signing keys")
+	}
+
+	shasums, err := c.downloadBytes(ctx, providerPackage.ShasumsURL)
+
+	if err != nil {
+		return "", fmt.Errorf("error downloading SHA256SUMS: %w", err)
+	}
+
+	signature, err := c.downloadBytes(ctx, providerPackage.ShasumsSignatureURL)
+
+	if err != nil {
+		return "", fmt.Errorf("error downloading SHA256SUMS signature: %w", err)
+	}
+
+	var keyring openpgp.EntityList
+
+	for _, key := range providerPackage.SigningKeys.GPGPublicKeys {
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key.ASCIIArmor))
+
+		if err != nil {
+			return "", fmt.Errorf("error reading signing key (%s): %w", key.KeyID, err)
+		}
+
+		keyring = append(keyring, entities...)
+	}
+
+	if _, err := openpgp.CheckDetachedSignature(keyring, bytes.NewReader(shasums), bytes.NewReader(signature), nil); err != nil {
+		return "", fmt.Errorf("error verifying SHA256SUMS signature: %w", err)
+	}
+
+	shasum, err := shasumsEntry(shasums, providerPackage.Filename)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.EqualFold(shasum, providerPackage.Shasum) {
+		return "", fmt.Errorf("SHA256SUMS checksum (%s) does not match package checksum (%s)", shasum, providerPackage.Shasum)
+	}
+
+	return shasum, nil
+}
+
+// shasumsEntry returns the checksum of the file name in the SHA256SUMS file,
+// which contains lines of checksums and file names.
+func shasumsEntry(shasums []byte, filename string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(shasums))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 2 && fields[1] == filename {
+			return fields[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("SHA256SUMS has no entry for %s", filename)
+}
+
+// download writes the contents of the URL, which may be relative to the base
+// URL, to the file and verifies the hex encoded SHA-256 checksum. Provider
+// packages can take longer to download than the request timeout, so the
+// download is only bounded by the context.
+func (c *Client) download(ctx context.Context, rawURL string, shasum string, target string) error {
+	response, requestURL, err := c.open(ctx, rawURL)
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	file, err := os.Create(target)
+
+	if err != nil {
+		return fmt.Errorf("error creating file: %w", err)
+	}
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(io.MultiWriter(file, hash), response.Body); err != nil {
+		file.Close()
+
+		return fmt.Errorf("error reading response from %s: %w", requestURL, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %w", target, err)
+	}
+
+	if got := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(got, shasum) {
+		return fmt.Errorf("checksum (%s) does not match expected checksum (%s)", got, shasum)
+	}
+
+	return nil
+}
+
+// downloadBytes returns the contents of the URL, which may be relative to the
+// base URL.
+func (c *Client) downloadBytes(ctx context.Context, rawURL string) ([]byte, error) {
+	response, requestURL, err := c.open(ctx, rawURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading response from %s: %w", requestURL, err)
+	}
+
+	return body, nil
+}
+
+// open requests the URL, which may be relative to the base URL, and returns
+// the successful response and the request URL. The response body must be
+// closed.
+func (c *Client) open(ctx context.Context, rawURL string) (*http.Response, string, error) {
+	baseURL := c.BaseURL
+
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	base, err := url.Parse(baseURL)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	reference, err := url.Parse(rawURL)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	requestURL := base.ResolveReference(reference).String()
+	httpClient := &http.Client{}
+
+	if c.HTTPClient != nil {
+		httpClient.Transport = c.HTTPClient.Transport
+	}
+
+	c.log().Debug("Terraform Registry request", "method", "GET", "url", requestURL)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	response, err := httpClient.Do(request)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+
+		return nil, "", fmt.Errorf("unexpected status code (%d) from %s", response.StatusCode, requestURL)
+	}
+
+	return response, requestURL, nil
+}
+
+// extractProviderBinary extracts the terraform-provider-* file of the zip
+// archive into the directory as an executable file.
+func extractProviderBinary(archivePath string, directory string) (string, error) {
+	reader, err := zip.OpenReader(archivePath)
+
+	if err != nil {
+		return "", fmt.Errorf("error opening zip archive: %w", err)
+	}
+
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		name := filepath.Base(filepath.FromSlash(file.Name))
+
+		if !file.Mode().IsRegular() || !strings.HasPrefix(name, "terraform-provider-") {
+			continue
+		}
+
+		src, err := file.Open()
+
+		if err != nil {
+			return "", fmt.Errorf("error reading zip archive file (%s): %w", file.Name, err)
+		}
+
+		defer src.Close()
+
+		target := filepath.Join(directory, name)
+		dst, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+
+		if err != nil {
+			return "", fmt.Errorf("error creating file: %w", err)
+		}
+
+		if _, err := io.Copy(dst, src); err != nil {
+			dst.Close()
+
+			return "", fmt.Errorf("error writing file (%s): %w", target, err)
+		}
+
+		if err := dst.Close(); err != nil {
+			return "", fmt.Errorf("error closing file (%s): %w", target, err)
+		}
+
+		return target, nil
+	}
+
+	return "", fmt.Errorf("no terraform-provider-* file found in zip archive")
+}
@@ -0,0 +1,167 @@
+package registry
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+func TestClientDownloadProviderBinary(t *testing.T) {
+	var archive bytes.Buffer
+
+	zipWriter := zip.NewWriter(&archive)
+
+	for name, contents := range map[string]string{
+		"LICENSE":                           "license",
+		"terraform-provider-test_v2.0.0_x5": "binary",
+	} {
+		writer, err := zipWriter.Create(name)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		fmt.Fprint(writer, contents)
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sum := sha256.Sum256(archive.Bytes())
+	shasum := hex.EncodeToString(sum[:])
+	shasums := fmt.Sprintf("%s  terraform-provider-test_2.0.0_linux_amd64.zip\n%s  terraform-provider-test_2.0.0_linux_arm64.zip\n", shasum, shasum)
+	signingKey := testOpenPGPEntity(t)
+	otherKey := testOpenPGPEntity(t)
+	signingKeys := fmt.Sprintf(`{"gpg_public_keys":[{"key_id":%q,"ascii_armor":%q}]}`, signingKey.PrimaryKey.KeyIdString(), testArmoredPublicKey(t, signingKey))
+	packageResponse := func(filename string, shasum string, signature string, signingKeys string) string {
+		return fmt.Sprintf(`{"download_url":"/files/terraform-provider-test_2.0.0_linux_amd64.zip","filename":%q,"shasum":%q,"shasums_url":"/files/SHA256SUMS","shasums_signature_url":%q,"signing_keys":%s}`, filename, shasum, "/files/"+signature, signingKeys)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/providers/test/test/2.0.0/download/linux/amd64":
+			fmt.Fprint(w, packageResponse("terraform-provider-test_2.0.0_linux_amd64.zip", shasum, "SHA256SUMS.sig", signingKeys))
+		case "/v1/providers/test/test/2.0.0/download/linux/arm64":
+			fmt.Fprint(w, packageResponse("terraform-provider-test_2.0.0_linux_arm64.zip", "0000", "SHA256SUMS.sig", signingKeys))
+		case "/v1/providers/test/test/2.0.0/download/darwin/amd64":
+			fmt.Fprint(w, packageResponse("terraform-provider-test_2.0.0_darwin_amd64.zip", shasum, "SHA256SUMS.sig", signingKeys))
+		case "/v1/providers/test/test/2.0.0/download/linux/386":
+			fmt.Fprint(w, packageResponse("terraform-provider-test_2.0.0_linux_amd64.zip", shasum, "SHA256SUMS.other.sig", signingKeys))
+		case "/v1/providers/test/test/2.0.0/download/linux/arm":
+			fmt.Fprint(w, packageResponse("terraform-provider-test_2.0.0_linux_amd64.zip", shasum, "SHA256SUMS.sig", `{"gpg_public_keys":[]}`))
+		case "/files/SHA256SUMS":
+			fmt.Fprint(w, shasums)
+		case "/files/SHA256SUMS.sig":
+			w.Write(testDetachSign(t, signingKey, shasums))
+		case "/files/SHA256SUMS.other.sig":
+			w.Write(testDetachSign(t, otherKey, shasums))
+		case "/files/terraform-provider-test_2.0.0_linux_amd64.zip":
+			w.Write(archive.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.BaseURL = server.URL
+
+	got, err := client.DownloadProviderBinary(context.Background(), "test", "test", "v2.0.0", "linux", "amd64", t.TempDir())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	contents, err := os.ReadFile(got)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(contents) != "binary" {
+		t.Errorf("expected provider binary contents, got: %q", contents)
+	}
+
+	info, err := os.Stat(got)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if info.Mode().Perm()&0o100 == 0 {
+		t.Errorf("expected executable provider binary, got mode: %s", info.Mode())
+	}
+
+	if _, err := client.DownloadProviderBinary(context.Background(), "test", "test", "2.0.0", "linux", "arm64", t.TempDir()); err == nil {
+		t.Errorf("expected error for checksum mismatch, got no error")
+	}
+
+	if _, err := client.DownloadProviderBinary(context.Background(), "test", "test", "2.0.0", "darwin", "amd64", t.TempDir()); err == nil {
+		t.Errorf("expected error for missing SHA256SUMS entry, got no error")
+	}
+
+	if _, err := client.DownloadProviderBinary(context.Background(), "test", "test", "2.0.0", "linux", "386", t.TempDir()); err == nil || !strings.Contains(err.Error(), "signature") {
+		t.Errorf("expected error for signature of other key, got: %v", err)
+	}
+
+	if _, err := client.DownloadProviderBinary(context.Background(), "test", "test", "2.0.0", "linux", "arm", t.TempDir()); err == nil {
+		t.Errorf("expected error for missing signing keys, got no error")
+	}
+
+	if _, err := client.DownloadProviderBinary(context.Background(), "test", "test", "3.0.0", "linux", "amd64", t.TempDir()); err == nil {
+		t.Errorf("expected error for missing version, got no error")
+	}
+}
+
+func testArmoredPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	var result bytes.Buffer
+
+	w, err := armor.Encode(&result, openpgp.PublicKeyType, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return result.String()
+}
+
+func testDetachSign(t *testing.T, entity *openpgp.Entity, message string) []byte {
+	var result bytes.Buffer
+
+	if err := openpgp.DetachSign(&result, entity, strings.NewReader(message), nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return result.Bytes()
+}
+
+func testOpenPGPEntity(t *testing.T) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return entity
+}
@@ -3,6 +3,8 @@ package version
 import (
 	"bytes"
 	"fmt"
+	"runtime"
+	"runtime/debug"
 )
 
 var (
@@ -10,6 +12,10 @@ var (
 	GitCommit   string
 	GitDescribe string
 
+	// BuildDate is the RFC 3339 date of the build. This will be filled in by
+	// the compiler.
+	BuildDate string
+
 	// The main version number that is being run at the moment.
 	Version = "0.11.1"
 
@@ -20,10 +26,18 @@ var (
 
 	// VersionMetadata is metadata further describing the build type.
 	VersionMetadata = ""
+
+	// SigningKeyFingerprint is the fingerprint of the OpenPGP key which signs
+	// the release checksums, used to verify self-updates. This will be filled
+	// in by the compiler.
+	SigningKeyFingerprint string
 )
 
 // VersionInfo
 type VersionInfo struct {
+	BuildDate         string
+	GoVersion         string
+	Platform          string
 	Revision          string
 	Version           string
 	VersionPrerelease string
@@ -41,12 +55,30 @@ func GetVersion() *VersionInfo {
 		rel = "dev"
 	}
 
-	return &VersionInfo{
+	result := &VersionInfo{
+		BuildDate:         BuildDate,
+		GoVersion:         runtime.Version(),
+		Platform:          runtime.GOOS + "/" + runtime.GOARCH,
 		Revision:          GitCommit,
 		Version:           ver,
 		VersionPrerelease: rel,
 		VersionMetadata:   md,
 	}
+
+	// Builds from a git checkout without the compiler flags, such as with go
+	// build, embed the commit and its date instead.
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range buildInfo.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && result.Revision == "":
+				result.Revision = setting.Value
+			case setting.Key == "vcs.time" && result.BuildDate == "":
+				result.BuildDate = setting.Value
+			}
+		}
+	}
+
+	return result
 }
 
 func (c *VersionInfo) VersionNumber() string {